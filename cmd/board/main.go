@@ -0,0 +1,289 @@
+// Quote board: a terminal table of live prices with REST fallback.
+//
+// Usage:
+//
+//	go run ./cmd/board [-refresh 15s] [-stale 30s] AAPL MSFT BTC-USD
+//
+// Prices stream from the live WebSocket. Symbols without a live update for
+// -stale, or all symbols while the stream is down, are refreshed from the
+// batched quote API every -refresh.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/live"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/multi"
+)
+
+// row is the board state of one symbol.
+type row struct {
+	name          string
+	price         float64
+	change        float64
+	changePercent float64
+	volume        int64
+	currency      string
+	marketState   string
+	source        string // "live" or "rest"
+	updated       time.Time
+	liveUpdated   time.Time
+}
+
+// board holds the rows shown on screen.
+type board struct {
+	mu      sync.Mutex
+	symbols []string
+	rows    map[string]*row
+	status  string
+}
+
+func newBoard(symbols []string) *board {
+	b := &board{symbols: symbols, rows: make(map[string]*row, len(symbols))}
+	for _, sym := range symbols {
+		b.rows[sym] = &row{}
+	}
+	return b
+}
+
+// applyLive updates a row from a streamed message.
+func (b *board) applyLive(data *models.PricingData) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r, ok := b.rows[strings.ToUpper(data.ID)]
+	if !ok {
+		return
+	}
+	now := time.Now()
+	r.price = float64(data.Price)
+	r.change = float64(data.Change)
+	r.changePercent = float64(data.ChangePercent)
+	if data.DayVolume > 0 {
+		r.volume = data.DayVolume
+	}
+	if data.Currency != "" {
+		r.currency = data.Currency
+	}
+	if data.ShortName != "" && r.name == "" {
+		r.name = data.ShortName
+	}
+	r.marketState = liveMarketState(data.MarketHours)
+	r.source = "live"
+	r.updated, r.liveUpdated = now, now
+}
+
+// applyQuotes updates rows from REST quotes. Only symbols in stale are
+// overwritten, so REST data never replaces fresher live prices.
+func (b *board) applyQuotes(quotes map[string]*models.Quote, stale map[string]bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	for sym, q := range quotes {
+		r, ok := b.rows[sym]
+		if !ok {
+			continue
+		}
+		if r.name == "" {
+			r.name = q.ShortName
+		}
+		if r.currency == "" {
+			r.currency = q.Currency
+		}
+		if !stale[sym] {
+			continue
+		}
+		r.price = q.RegularMarketPrice
+		r.change = q.RegularMarketChange
+		r.changePercent = q.RegularMarketChangePercent
+		r.volume = q.RegularMarketVolume
+		r.marketState = q.MarketState
+		r.source = "rest"
+		r.updated = now
+	}
+}
+
+// staleSymbols returns the symbols without a live update within maxAge.
+func (b *board) staleSymbols(maxAge time.Duration, streaming bool) map[string]bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stale := make(map[string]bool)
+	for sym, r := range b.rows {
+		if !streaming || time.Since(r.liveUpdated) > maxAge {
+			stale[sym] = true
+		}
+	}
+	return stale
+}
+
+func (b *board) setStatus(s string) {
+	b.mu.Lock()
+	b.status = s
+	b.mu.Unlock()
+}
+
+// render draws the board, replacing the previous frame.
+func (b *board) render() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var sb strings.Builder
+	sb.WriteString("\033[H\033[2J")
+	fmt.Fprintf(&sb, "go-yfinance quote board  %s  %s\n\n", time.Now().Format("15:04:05"), b.status)
+	fmt.Fprintf(&sb, "%-10s %-24s %12s %10s %8s %14s %-8s %-5s %8s\n",
+		"Symbol", "Name", "Price", "Change", "Chg%", "Volume", "State", "Src", "Age")
+	for _, sym := range b.symbols {
+		r := b.rows[sym]
+		if r.updated.IsZero() {
+			fmt.Fprintf(&sb, "%-10s %-24s %12s\n", sym, truncate(r.name, 24), "waiting...")
+			continue
+		}
+		fmt.Fprintf(&sb, "%-10s %-24s %8.2f %-3s %+10.2f %+7.2f%% %14d %-8s %-5s %8s\n",
+			sym, truncate(r.name, 24), r.price, r.currency, r.change, r.changePercent,
+			r.volume, r.marketState, r.source, time.Since(r.updated).Truncate(time.Second))
+	}
+	fmt.Print(sb.String())
+}
+
+// liveMarketState maps the stream's market hours code to the quote API's
+// market state names.
+func liveMarketState(hours int32) string {
+	switch hours {
+	case 0:
+		return "PRE"
+	case 1:
+		return "REGULAR"
+	case 2:
+		return "POST"
+	default:
+		return "CLOSED"
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-1] + "…"
+}
+
+func main() {
+	refresh := flag.Duration("refresh", 15*time.Second, "REST fallback poll interval")
+	staleAfter := flag.Duration("stale", 30*time.Second, "use REST for symbols without a live update for this long")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] SYMBOL...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	tickers, err := multi.NewTickers(flag.Args())
+	if err != nil {
+		log.Fatalf("Failed to create tickers: %v", err)
+	}
+	defer tickers.Close()
+
+	symbols := tickers.Symbols()
+	sort.Strings(symbols)
+	b := newBoard(symbols)
+
+	// Initial snapshot fills names, currencies and market state
+	if quotes, err := tickers.Quotes(); err != nil {
+		b.setStatus(fmt.Sprintf("quote error: %v", err))
+	} else {
+		b.applyQuotes(quotes, b.staleSymbols(0, false))
+	}
+
+	// Live stream
+	var (
+		streamMu  sync.Mutex
+		streaming bool
+	)
+	setStreaming := func(v bool, status string) {
+		streamMu.Lock()
+		streaming = v
+		streamMu.Unlock()
+		b.setStatus(status)
+	}
+	ws, err := live.New(live.WithErrorHandler(func(err error) {
+		setStreaming(false, fmt.Sprintf("stream error: %v (REST fallback)", err))
+	}))
+	if err != nil {
+		log.Fatalf("Failed to create stream: %v", err)
+	}
+	defer ws.Close()
+
+	if err := ws.Subscribe(symbols); err != nil {
+		b.setStatus(fmt.Sprintf("stream unavailable: %v (REST fallback)", err))
+	} else {
+		setStreaming(true, "streaming")
+		_ = ws.ListenAsync(func(data *models.PricingData) {
+			b.applyLive(data)
+			streamMu.Lock()
+			wasDown := !streaming
+			streaming = true
+			streamMu.Unlock()
+			if wasDown {
+				b.setStatus("streaming")
+			}
+		})
+	}
+
+	// REST fallback for stale symbols
+	go func() {
+		t := time.NewTicker(*refresh)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			streamMu.Lock()
+			up := streaming && ws.IsConnected()
+			streamMu.Unlock()
+
+			stale := b.staleSymbols(*staleAfter, up)
+			if len(stale) == 0 {
+				continue
+			}
+			quotes, err := tickers.Quotes()
+			if err != nil {
+				b.setStatus(fmt.Sprintf("quote error: %v", err))
+				continue
+			}
+			b.applyQuotes(quotes, stale)
+		}
+	}()
+
+	frame := time.NewTicker(time.Second)
+	defer frame.Stop()
+	for {
+		b.render()
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return
+		case <-frame.C:
+		}
+	}
+}
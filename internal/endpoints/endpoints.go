@@ -39,6 +39,9 @@ const (
 	// Lookup
 	LookupURL = Query1URL + "/v1/finance/lookup"
 
+	// Quote type (symbol validation and metadata)
+	QuoteTypeURL = Query1URL + "/v1/finance/quoteType"
+
 	// Screener
 	ScreenerURL = Query1URL + "/v1/finance/screener"
 
@@ -58,6 +61,9 @@ const (
 	// News
 	NewsURL = RootURL + "/xhr/ncp"
 
+	// ISIN lookup (Business Insider search suggestions, as in Python yfinance)
+	ISINSearchURL = "https://markets.businessinsider.com/ajax/SearchController_Suggest"
+
 	// WebSocket (real-time)
 	WebSocketURL = "wss://streamer.finance.yahoo.com/?version=2"
 )
@@ -0,0 +1,9 @@
+// Package numeric coerces loosely typed JSON values to numbers.
+//
+// This is an internal package and not intended for direct use. Yahoo
+// reports the same field as a JSON number, a numeric string or a
+// {"raw": ..., "fmt": ...} object depending on the endpoint; the exported
+// entry points are [github.com/wnjoon/go-yfinance/pkg/utils.Float] and the
+// [github.com/wnjoon/go-yfinance/pkg/models.Number] response type, which
+// share this parser.
+package numeric
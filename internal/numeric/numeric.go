@@ -0,0 +1,117 @@
+package numeric
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// magnitudeSuffixes are the multipliers of Yahoo's abbreviated "fmt" values
+// (e.g. "1.2B").
+var magnitudeSuffixes = map[byte]float64{
+	'k': 1e3, 'K': 1e3,
+	'M': 1e6,
+	'B': 1e9,
+	'T': 1e12,
+}
+
+// Float coerces a decoded JSON value to float64. It accepts numbers, numeric
+// strings and Yahoo's {"raw": ..., "fmt": ...} objects, preferring raw over
+// fmt. Strings may contain thousands separators, a trailing "%" or a
+// K/M/B/T magnitude suffix. A plain "1.5%" is 1.5, the unit of Yahoo's
+// numeric percent fields, while an fmt of "1.5%" is 0.015 like its raw
+// value. ok is false for null, NaN, infinities and anything unparseable.
+func Float(v any) (float64, bool) {
+	var f float64
+	switch val := v.(type) {
+	case float64:
+		f = val
+	case float32:
+		f = float64(val)
+	case int:
+		f = float64(val)
+	case int32:
+		f = float64(val)
+	case int64:
+		f = float64(val)
+	case json.Number:
+		parsed, err := val.Float64()
+		if err != nil {
+			return 0, false
+		}
+		f = parsed
+	case string:
+		return parseNumber(val, 1)
+	case map[string]any:
+		if raw, ok := Float(val["raw"]); ok {
+			return raw, true
+		}
+		if s, ok := val["fmt"].(string); ok {
+			return parseNumber(s, 0.01)
+		}
+		return 0, false
+	default:
+		return 0, false
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+// Int64 coerces a decoded JSON value to int64 like [Float], truncating
+// fractions. Integer strings are parsed exactly.
+func Int64(v any) (int64, bool) {
+	switch val := v.(type) {
+	case int64:
+		return val, true
+	case int:
+		return int64(val), true
+	case int32:
+		return int64(val), true
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i, true
+		}
+	case string:
+		if i, err := strconv.ParseInt(strings.ReplaceAll(strings.TrimSpace(val), ",", ""), 10, 64); err == nil {
+			return i, true
+		}
+	case map[string]any:
+		if i, ok := Int64(val["raw"]); ok {
+			return i, true
+		}
+	}
+
+	f, ok := Float(v)
+	if !ok || f > math.MaxInt64 || f < math.MinInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+// parseNumber parses a numeric string as described in [Float], multiplying
+// percentages by percent.
+func parseNumber(s string, percent float64) (float64, bool) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	if s == "" {
+		return 0, false
+	}
+
+	scale := 1.0
+	switch last := s[len(s)-1]; {
+	case last == '%':
+		scale = percent
+		s = s[:len(s)-1]
+	case magnitudeSuffixes[last] != 0:
+		scale = magnitudeSuffixes[last]
+		s = s[:len(s)-1]
+	}
+
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f * scale, true
+}
@@ -0,0 +1,7 @@
+// Package parallel runs per-item work on a bounded number of goroutines.
+//
+// This is an internal package and not intended for direct use. The
+// packages that fan requests out per symbol (download, search, sector and
+// calendars) share it so that their concurrency limits and cancellation
+// behave the same.
+package parallel
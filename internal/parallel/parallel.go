@@ -0,0 +1,52 @@
+package parallel
+
+import (
+	"context"
+	"sync"
+)
+
+// For calls work(i) for each i in [0, n) on up to workers goroutines and
+// returns once every call has returned. workers below 1 runs the calls one
+// at a time.
+//
+// Once ctx is done, the indices not yet started are passed to skip with
+// ctx's error instead of being worked on; skip may be nil.
+func For(ctx context.Context, n, workers int, work func(i int), skip func(i int, err error)) {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				work(i)
+			}
+		}()
+	}
+
+	defer wg.Wait()
+	defer close(jobs)
+	for i := 0; i < n; i++ {
+		// Checked first, as select picks at random when a worker is also ready
+		if ctx.Err() == nil {
+			select {
+			case jobs <- i:
+				continue
+			case <-ctx.Done():
+			}
+		}
+		if skip != nil {
+			for ; i < n; i++ {
+				skip(i, ctx.Err())
+			}
+		}
+		return
+	}
+}
@@ -0,0 +1,68 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFor(t *testing.T) {
+	var running, peak atomic.Int32
+	done := make([]bool, 20)
+	For(context.Background(), len(done), 3, func(i int) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		done[i] = true
+	}, nil)
+
+	for i, ok := range done {
+		if !ok {
+			t.Errorf("item %d was not worked on", i)
+		}
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got %d", p)
+	}
+
+	// No items must not start or block on workers
+	For(context.Background(), 0, 4, func(int) { t.Error("unexpected call") }, nil)
+}
+
+func TestForCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var (
+		mu      sync.Mutex
+		worked  int
+		skipped int
+	)
+	For(ctx, 10, 1, func(int) {
+		mu.Lock()
+		worked++
+		mu.Unlock()
+	}, func(_ int, err error) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		mu.Lock()
+		skipped++
+		mu.Unlock()
+	})
+	if worked != 0 || skipped != 10 {
+		t.Errorf("expected every item skipped, got %d worked and %d skipped", worked, skipped)
+	}
+
+	// A nil skip drops the remaining items
+	For(ctx, 10, 2, func(int) {}, nil)
+}
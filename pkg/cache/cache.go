@@ -2,6 +2,7 @@ package cache
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -31,6 +32,31 @@ type Cache struct {
 	items    map[string]*entry
 	ttl      time.Duration
 	stopChan chan struct{}
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// Stats reports how effective a cache has been.
+type Stats struct {
+	// Hits is the number of Get calls that found a live entry.
+	Hits int64 `json:"hits"`
+
+	// Misses is the number of Get calls that found no entry or an expired one.
+	Misses int64 `json:"misses"`
+
+	// Entries is the number of stored entries, including expired ones not
+	// yet cleaned up.
+	Entries int `json:"entries"`
+}
+
+// HitRatio returns Hits / (Hits + Misses), or 0 before any lookup.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
 }
 
 // Option is a function that configures a Cache.
@@ -84,14 +110,12 @@ func (c *Cache) Get(key string) (interface{}, bool) {
 	defer c.mu.RUnlock()
 
 	item, exists := c.items[key]
-	if !exists {
-		return nil, false
-	}
-
-	if item.isExpired() {
+	if !exists || item.isExpired() {
+		c.misses.Add(1)
 		return nil, false
 	}
 
+	c.hits.Add(1)
 	return item.value, true
 }
 
@@ -131,6 +155,26 @@ func (c *Cache) Len() int {
 	return len(c.items)
 }
 
+// Stats returns the hit and miss counters and the number of entries.
+//
+// Example:
+//
+//	st := c.Stats()
+//	fmt.Printf("hit ratio %.0f%% over %d entries\n", st.HitRatio()*100, st.Entries)
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: c.Len(),
+	}
+}
+
+// ResetStats sets the hit and miss counters to zero.
+func (c *Cache) ResetStats() {
+	c.hits.Store(0)
+	c.misses.Store(0)
+}
+
 // Close stops the cleanup goroutine and releases resources.
 func (c *Cache) Close() {
 	close(c.stopChan)
@@ -206,3 +250,8 @@ func DeleteGlobal(key string) {
 func ClearGlobal() {
 	getGlobalCache().Clear()
 }
+
+// GlobalStats returns the statistics of the global cache.
+func GlobalStats() Stats {
+	return getGlobalCache().Stats()
+}
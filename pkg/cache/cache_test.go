@@ -212,3 +212,53 @@ func TestConcurrency(t *testing.T) {
 	<-done
 	<-done
 }
+
+func TestStats(t *testing.T) {
+	c := New()
+	defer c.Close()
+
+	if got := c.Stats().HitRatio(); got != 0 {
+		t.Errorf("Expected hit ratio 0 before lookups, got %v", got)
+	}
+
+	c.Set("key", "value")
+	c.Get("key")
+	c.GetString("key")
+	c.Get("missing")
+
+	st := c.Stats()
+	if st.Hits != 2 || st.Misses != 1 || st.Entries != 1 {
+		t.Errorf("Unexpected stats: %+v", st)
+	}
+
+	c.ResetStats()
+	if st := c.Stats(); st.Hits != 0 || st.Misses != 0 || st.Entries != 1 {
+		t.Errorf("ResetStats should keep entries and clear counters, got %+v", st)
+	}
+}
+
+func TestSymbolMetadata(t *testing.T) {
+	ClearGlobal()
+	defer ClearGlobal()
+
+	if _, ok := SymbolTimezone("AAPL"); ok {
+		t.Error("Expected miss before set")
+	}
+
+	SetSymbolTimezone("aapl", "America/New_York")
+	SetSymbolQuoteType("AAPL", "EQUITY")
+	SetSymbolTimezone("MSFT", "")
+
+	if tz, ok := SymbolTimezone("AAPL"); !ok || tz != "America/New_York" {
+		t.Errorf("SymbolTimezone = %q, %v", tz, ok)
+	}
+	if qt, ok := SymbolQuoteType("aapl"); !ok || qt != "EQUITY" {
+		t.Errorf("SymbolQuoteType = %q, %v", qt, ok)
+	}
+	if _, ok := SymbolTimezone("MSFT"); ok {
+		t.Error("Empty timezone should not be cached")
+	}
+	if v, ok := GetGlobalString("AAPL:tz"); !ok || v != "America/New_York" {
+		t.Errorf("Expected AAPL:tz key, got %q, %v", v, ok)
+	}
+}
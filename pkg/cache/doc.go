@@ -21,6 +21,26 @@
 //	cache.SetGlobal("key", "value")
 //	value, ok := cache.GetGlobal("key")
 //
+// # Symbol Metadata
+//
+// Exchange timezones and quote types are shared across Ticker instances
+// through the global cache, mirroring Python yfinance's timezone cache.
+// Entries are keyed "<SYMBOL>:tz" and "<SYMBOL>:quoteType" and live for
+// [SymbolTTL]:
+//
+//	cache.SetSymbolTimezone("AAPL", "America/New_York")
+//	tz, ok := cache.SymbolTimezone("AAPL")
+//
+// The cache is in memory only; entries do not survive a restart.
+//
+// # Statistics
+//
+// Each cache counts hits and misses; use [Cache.Stats] or [GlobalStats] to
+// check whether caching pays off:
+//
+//	st := c.Stats()
+//	fmt.Printf("%d hits, %d misses (%.0f%%)\n", st.Hits, st.Misses, st.HitRatio()*100)
+//
 // # Configuration Options
 //
 //   - [WithTTL]: Set custom TTL for cache entries (default: 5 minutes)
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"strings"
+	"time"
+)
+
+// SymbolTTL is how long symbol metadata stays in the global cache.
+//
+// Exchange timezones and quote types practically never change, so entries
+// live far longer than [DefaultTTL].
+const SymbolTTL = 24 * time.Hour
+
+// SetSymbolTimezone records the exchange timezone (an IANA name such as
+// "America/New_York") of symbol in the global cache. Empty values are
+// ignored.
+//
+// Example:
+//
+//	cache.SetSymbolTimezone("AAPL", "America/New_York")
+func SetSymbolTimezone(symbol, tz string) {
+	setSymbolValue(symbol, "tz", tz)
+}
+
+// SymbolTimezone returns the cached exchange timezone of symbol.
+//
+// Example:
+//
+//	if tz, ok := cache.SymbolTimezone("AAPL"); ok {
+//	    fmt.Println(tz)
+//	}
+func SymbolTimezone(symbol string) (string, bool) {
+	return GetGlobalString(symbolKey(symbol, "tz"))
+}
+
+// SetSymbolQuoteType records the quote type (e.g. "EQUITY", "ETF") of
+// symbol in the global cache. Empty values are ignored.
+func SetSymbolQuoteType(symbol, quoteType string) {
+	setSymbolValue(symbol, "quoteType", quoteType)
+}
+
+// SymbolQuoteType returns the cached quote type of symbol.
+func SymbolQuoteType(symbol string) (string, bool) {
+	return GetGlobalString(symbolKey(symbol, "quoteType"))
+}
+
+func setSymbolValue(symbol, field, value string) {
+	if symbol == "" || value == "" {
+		return
+	}
+	SetGlobalWithTTL(symbolKey(symbol, field), value, SymbolTTL)
+}
+
+// symbolKey returns the global cache key of a per-symbol field, e.g. "AAPL:tz".
+func symbolKey(symbol, field string) string {
+	return strings.ToUpper(symbol) + ":" + field
+}
@@ -1,6 +1,7 @@
 package calendars
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -11,10 +12,15 @@ import (
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	yfconfig "github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 const dateFormat = "2006-01-02"
 
+// usMarketTimezone is the timezone of the US-only earnings and splits calendars.
+const usMarketTimezone = "America/New_York"
+
 // calendarConfig defines the configuration for each calendar type.
 type calendarConfig struct {
 	sortField     string
@@ -77,7 +83,6 @@ type Option func(*Calendars)
 func WithClient(c *client.Client) Option {
 	return func(cal *Calendars) {
 		cal.client = c
-		cal.ownsClient = false
 	}
 }
 
@@ -108,10 +113,9 @@ func WithDateRange(start, end time.Time) Option {
 func New(opts ...Option) (*Calendars, error) {
 	now := time.Now()
 	cal := &Calendars{
-		ownsClient: true,
-		start:      now,
-		end:        now.AddDate(0, 0, 7),
-		cache:      make(map[models.CalendarType]interface{}),
+		start: now,
+		end:   now.AddDate(0, 0, 7),
+		cache: make(map[models.CalendarType]interface{}),
 	}
 
 	for _, opt := range opts {
@@ -119,22 +123,25 @@ func New(opts ...Option) (*Calendars, error) {
 	}
 
 	if cal.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		cal.client = c
+		cal.ownsClient = true
 	}
 
-	cal.auth = client.NewAuthManager(cal.client)
+	cal.auth = cal.client.Auth()
 
 	return cal, nil
 }
 
-// Close releases resources used by the Calendars instance.
+// Close releases the shared [client.Default] client if the Calendars
+// acquired it. A client passed with WithClient is left open.
 func (c *Calendars) Close() {
-	if c.ownsClient && c.client != nil {
-		c.client.Close()
+	if c.ownsClient {
+		c.ownsClient = false
+		c.client.Release()
 	}
 }
 
@@ -179,7 +186,7 @@ func (c *Calendars) buildDateQuery(opts *models.CalendarOptions) query {
 }
 
 // fetchCalendar fetches calendar data from the API.
-func (c *Calendars) fetchCalendar(calType models.CalendarType, q query, opts *models.CalendarOptions) ([][]interface{}, []string, error) {
+func (c *Calendars) fetchCalendar(ctx context.Context, calType models.CalendarType, q query, opts *models.CalendarOptions) ([][]interface{}, []string, error) {
 	config, ok := calendarConfigs[calType]
 	if !ok {
 		return nil, nil, fmt.Errorf("unknown calendar type: %s", calType)
@@ -212,10 +219,14 @@ func (c *Calendars) fetchCalendar(calType models.CalendarType, q query, opts *mo
 	params.Set("lang", lang)
 	params.Set("region", region)
 
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
 	// Add crumb authentication
 	params, err := c.auth.AddCrumbToParams(params)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to add crumb: %w", err)
+		return nil, nil, yferr.Wrap(yferr.ErrAuth, "failed to add crumb", err)
 	}
 
 	// Marshal body to JSON
@@ -224,7 +235,7 @@ func (c *Calendars) fetchCalendar(calType models.CalendarType, q query, opts *mo
 		return nil, nil, fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	resp, err := c.client.PostJSON(endpoints.CalendarURL, params, bodyBytes)
+	resp, err := c.client.PostJSONIdempotentContext(ctx, endpoints.CalendarURL, params, bodyBytes)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to fetch calendar data: %w", err)
 	}
@@ -233,14 +244,19 @@ func (c *Calendars) fetchCalendar(calType models.CalendarType, q query, opts *mo
 		return nil, nil, client.HTTPStatusToError(resp.StatusCode, resp.Body)
 	}
 
+	return parseCalendarResponse(resp.Body)
+}
+
+// parseCalendarResponse returns the rows and column labels of the first
+// document of a calendar response.
+func parseCalendarResponse(body string) ([][]interface{}, []string, error) {
 	var raw models.CalendarResponse
-	if err := json.Unmarshal([]byte(resp.Body), &raw); err != nil {
-		return nil, nil, fmt.Errorf("failed to parse calendar response: %w", err)
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		return nil, nil, yferr.Parse("calendar response", err)
 	}
 
 	if raw.Finance.Error != nil {
-		return nil, nil, fmt.Errorf("calendar API error: %s - %s",
-			raw.Finance.Error.Code, raw.Finance.Error.Description)
+		return nil, nil, yferr.API("calendar", raw.Finance.Error.Code, raw.Finance.Error.Description)
 	}
 
 	if len(raw.Finance.Result) == 0 || len(raw.Finance.Result[0].Documents) == 0 {
@@ -250,7 +266,7 @@ func (c *Calendars) fetchCalendar(calType models.CalendarType, q query, opts *mo
 	doc := raw.Finance.Result[0].Documents[0]
 
 	// Extract column labels
-	var columns []string
+	columns := make([]string, 0, len(doc.Columns))
 	for _, col := range doc.Columns {
 		columns = append(columns, col.Label)
 	}
@@ -273,6 +289,12 @@ func (c *Calendars) fetchCalendar(calType models.CalendarType, q query, opts *mo
 //	        e.Symbol, e.EPSEstimate, e.EPSActual, e.SurprisePercent)
 //	}
 func (c *Calendars) Earnings(opts *models.CalendarOptions) ([]models.EarningsEvent, error) {
+	return c.EarningsContext(context.Background(), opts)
+}
+
+// EarningsContext is like [Calendars.Earnings] but returns ctx's error as soon
+// as ctx is done.
+func (c *Calendars) EarningsContext(ctx context.Context, opts *models.CalendarOptions) ([]models.EarningsEvent, error) {
 	gteQuery, lteQuery := c.buildDateQueries(opts)
 	q := query{
 		Operator: "AND",
@@ -290,7 +312,7 @@ func (c *Calendars) Earnings(opts *models.CalendarOptions) ([]models.EarningsEve
 		},
 	}
 
-	rows, columns, err := c.fetchCalendar(models.CalendarEarnings, q, opts)
+	rows, columns, err := c.fetchCalendar(ctx, models.CalendarEarnings, q, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -315,12 +337,7 @@ func (c *Calendars) parseEarnings(rows [][]interface{}, columns []string) []mode
 			SurprisePercent: getFloatAt(row, colIdx, "Surprise (%)"),
 		}
 
-		// Parse event time
-		if timeStr := getStringAt(row, colIdx, "Event Start Date"); timeStr != "" {
-			if t, err := time.Parse(time.RFC3339, timeStr); err == nil {
-				event.EventTime = &t
-			}
-		}
+		event.EventTime = getTimeAt(row, colIdx, "Event Start Date", usMarketTimezone)
 
 		if event.Symbol != "" {
 			events = append(events, event)
@@ -345,6 +362,12 @@ func (c *Calendars) parseEarnings(rows [][]interface{}, columns []string) []mode
 //	        ipo.Symbol, ipo.CompanyName, ipo.PriceFrom, ipo.PriceTo)
 //	}
 func (c *Calendars) IPOs(opts *models.CalendarOptions) ([]models.IPOEvent, error) {
+	return c.IPOsContext(context.Background(), opts)
+}
+
+// IPOsContext is like [Calendars.IPOs] but returns ctx's error as soon
+// as ctx is done.
+func (c *Calendars) IPOsContext(ctx context.Context, opts *models.CalendarOptions) ([]models.IPOEvent, error) {
 	start := c.start
 	end := c.end
 	if opts != nil {
@@ -368,7 +391,7 @@ func (c *Calendars) IPOs(opts *models.CalendarOptions) ([]models.IPOEvent, error
 		},
 	}
 
-	rows, columns, err := c.fetchCalendar(models.CalendarIPO, q, opts)
+	rows, columns, err := c.fetchCalendar(ctx, models.CalendarIPO, q, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -394,22 +417,11 @@ func (c *Calendars) parseIPOs(rows [][]interface{}, columns []string) []models.I
 			DealType:    getStringAt(row, colIdx, "Deal Type"),
 		}
 
-		// Parse dates
-		if timeStr := getStringAt(row, colIdx, "Filing Date"); timeStr != "" {
-			if t, err := time.Parse(time.RFC3339, timeStr); err == nil {
-				event.FilingDate = &t
-			}
-		}
-		if timeStr := getStringAt(row, colIdx, "Date"); timeStr != "" {
-			if t, err := time.Parse(time.RFC3339, timeStr); err == nil {
-				event.Date = &t
-			}
-		}
-		if timeStr := getStringAt(row, colIdx, "Amended Date"); timeStr != "" {
-			if t, err := time.Parse(time.RFC3339, timeStr); err == nil {
-				event.AmendedDate = &t
-			}
-		}
+		// Parse dates in the listing exchange's timezone
+		tz := utils.GetTimezone(event.Exchange)
+		event.FilingDate = getTimeAt(row, colIdx, "Filing Date", tz)
+		event.Date = getTimeAt(row, colIdx, "Date", tz)
+		event.AmendedDate = getTimeAt(row, colIdx, "Amended Date", tz)
 
 		if event.Symbol != "" || event.CompanyName != "" {
 			events = append(events, event)
@@ -434,9 +446,15 @@ func (c *Calendars) parseIPOs(rows [][]interface{}, columns []string) []models.I
 //	        e.Event, e.Region, e.Expected, e.Actual)
 //	}
 func (c *Calendars) EconomicEvents(opts *models.CalendarOptions) ([]models.EconomicEvent, error) {
+	return c.EconomicEventsContext(context.Background(), opts)
+}
+
+// EconomicEventsContext is like [Calendars.EconomicEvents] but returns ctx's error as soon
+// as ctx is done.
+func (c *Calendars) EconomicEventsContext(ctx context.Context, opts *models.CalendarOptions) ([]models.EconomicEvent, error) {
 	q := c.buildDateQuery(opts)
 
-	rows, columns, err := c.fetchCalendar(models.CalendarEconomicEvents, q, opts)
+	rows, columns, err := c.fetchCalendar(ctx, models.CalendarEconomicEvents, q, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -460,12 +478,8 @@ func (c *Calendars) parseEconomicEvents(rows [][]interface{}, columns []string)
 			Revised:  getFloatAt(row, colIdx, "Revised from"),
 		}
 
-		// Parse event time
-		if timeStr := getStringAt(row, colIdx, "Event Time"); timeStr != "" {
-			if t, err := time.Parse(time.RFC3339, timeStr); err == nil {
-				event.EventTime = &t
-			}
-		}
+		// Parse event time in the releasing region's timezone
+		event.EventTime = getTimeAt(row, colIdx, "Event Time", utils.RegionTimezone(event.Region))
 
 		if event.Event != "" {
 			events = append(events, event)
@@ -490,9 +504,15 @@ func (c *Calendars) parseEconomicEvents(rows [][]interface{}, columns []string)
 //	        s.Symbol, s.CompanyName, s.OldShareWorth, s.NewShareWorth)
 //	}
 func (c *Calendars) Splits(opts *models.CalendarOptions) ([]models.CalendarSplitEvent, error) {
+	return c.SplitsContext(context.Background(), opts)
+}
+
+// SplitsContext is like [Calendars.Splits] but returns ctx's error as soon
+// as ctx is done.
+func (c *Calendars) SplitsContext(ctx context.Context, opts *models.CalendarOptions) ([]models.CalendarSplitEvent, error) {
 	q := c.buildDateQuery(opts)
 
-	rows, columns, err := c.fetchCalendar(models.CalendarSplits, q, opts)
+	rows, columns, err := c.fetchCalendar(ctx, models.CalendarSplits, q, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -518,18 +538,9 @@ func (c *Calendars) parseSplits(rows [][]interface{}, columns []string) []models
 			event.Optionable = optStr == "Yes" || optStr == "true"
 		}
 
-		// Parse payable date
-		if timeStr := getStringAt(row, colIdx, "Payable On"); timeStr != "" {
-			if t, err := time.Parse(time.RFC3339, timeStr); err == nil {
-				event.PayableDate = &t
-			}
-		}
+		event.PayableDate = getTimeAt(row, colIdx, "Payable On", usMarketTimezone)
 
-		// Calculate ratio if possible
-		if event.OldShareWorth > 0 && event.NewShareWorth > 0 {
-			ratio := event.NewShareWorth / event.OldShareWorth
-			event.Ratio = fmt.Sprintf("%.0f:1", ratio)
-		}
+		event.Ratio, _ = models.NewSplitRatio(event.NewShareWorth, event.OldShareWorth)
 
 		if event.Symbol != "" {
 			events = append(events, event)
@@ -572,13 +583,21 @@ func getFloatAt(row []interface{}, colIdx map[string]int, colName string) float6
 	if !ok || idx >= len(row) {
 		return 0
 	}
-	switch v := row[idx].(type) {
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
+	f, _ := utils.Float(row[idx])
+	return f
+}
+
+// getTimeAt parses the time in the named column with [utils.ParseTime] and
+// converts it to timezone. It returns nil if the value is missing or
+// unparseable.
+func getTimeAt(row []interface{}, colIdx map[string]int, colName, timezone string) *time.Time {
+	idx, ok := colIdx[colName]
+	if !ok || idx >= len(row) {
+		return nil
+	}
+	t, ok := utils.ParseTime(row[idx], timezone)
+	if !ok {
+		return nil
 	}
-	return 0
+	return &t
 }
@@ -1,9 +1,12 @@
 package calendars
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -18,8 +21,16 @@ func TestNew(t *testing.T) {
 	}
 	defer cal.Close()
 
-	if cal.ownsClient != true {
-		t.Error("ownsClient should be true when no custom client is provided")
+	if !cal.ownsClient {
+		t.Error("ownsClient should be true when the shared default client is acquired")
+	}
+
+	def, err := client.Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	if cal.client != def {
+		t.Error("Client should be the shared default client")
 	}
 
 	// Default date range should be now to 7 days from now
@@ -45,7 +56,7 @@ func TestNewWithClient(t *testing.T) {
 		t.Fatalf("Failed to create second Calendars: %v", err)
 	}
 
-	if cal2.ownsClient != false {
+	if cal2.ownsClient {
 		t.Error("ownsClient should be false when custom client is provided")
 	}
 
@@ -447,7 +458,7 @@ func TestParseSplits(t *testing.T) {
 		t.Errorf("Expected new share worth 4.0, got %f", events[0].NewShareWorth)
 	}
 
-	if events[0].Ratio != "4:1" {
+	if events[0].Ratio.String() != "4:1" {
 		t.Errorf("Expected ratio '4:1', got '%s'", events[0].Ratio)
 	}
 
@@ -456,6 +467,82 @@ func TestParseSplits(t *testing.T) {
 	}
 }
 
+func TestParseSplitsRatios(t *testing.T) {
+	cal, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create Calendars: %v", err)
+	}
+	defer cal.Close()
+
+	columns := []string{"Symbol", "Old Share Worth", "New Share Worth"}
+	rows := [][]interface{}{
+		{"FWD", 2.0, 3.0},
+		{"REV", 10.0, 1.0},
+		{"ODD", 1.0, 1.5},
+		{"NONE", 1.0, nil},
+	}
+
+	events := cal.parseSplits(rows, columns)
+	want := []struct {
+		ratio   string
+		reverse bool
+	}{
+		{"3:2", false},
+		{"1:10", true},
+		{"3:2", false},
+		{"", false},
+	}
+	for i, w := range want {
+		r := events[i].Ratio
+		if r.String() != w.ratio || r.IsReverse() != w.reverse {
+			t.Errorf("%s: expected %q (reverse %v), got %q (reverse %v)",
+				events[i].Symbol, w.ratio, w.reverse, r, r.IsReverse())
+		}
+	}
+}
+
+func TestParseEventTimes(t *testing.T) {
+	cal, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create Calendars: %v", err)
+	}
+	defer cal.Close()
+
+	release := time.Date(2024, 7, 25, 20, 30, 0, 0, time.UTC)
+
+	earnings := cal.parseEarnings([][]interface{}{
+		{"AAPL", "2024-07-25T20:30:00.000Z"},
+		{"MSFT", "not a date"},
+	}, []string{"Symbol", "Event Start Date"})
+	if e := earnings[0].EventTime; e == nil || !e.Equal(release) || e.Location().String() != usMarketTimezone {
+		t.Errorf("Expected %v in %s, got %v", release, usMarketTimezone, e)
+	}
+	if earnings[1].EventTime != nil {
+		t.Errorf("Expected nil time for invalid value, got %v", earnings[1].EventTime)
+	}
+
+	ipos := cal.parseIPOs([][]interface{}{
+		{"NEWCO", "NMS", "2024-07-25", float64(release.UnixMilli())},
+	}, []string{"Symbol", "Exchange Short Name", "Date", "Filing Date"})
+	if d := ipos[0].Date; d == nil || d.Format(time.RFC3339) != "2024-07-25T00:00:00-04:00" {
+		t.Errorf("Expected exchange-local midnight, got %v", d)
+	}
+	if f := ipos[0].FilingDate; f == nil || !f.Equal(release) {
+		t.Errorf("Expected filing date %v from epoch millis, got %v", release, f)
+	}
+
+	econ := cal.parseEconomicEvents([][]interface{}{
+		{"BoJ Rate Decision", "JP", float64(release.Unix())},
+		{"Unknown Region", "XX", float64(release.Unix())},
+	}, []string{"Event", "Country Code", "Event Time"})
+	if e := econ[0].EventTime; e == nil || !e.Equal(release) || e.Location().String() != "Asia/Tokyo" {
+		t.Errorf("Expected %v in Asia/Tokyo, got %v", release, e)
+	}
+	if e := econ[1].EventTime; e == nil || e.Location() != time.UTC {
+		t.Errorf("Expected UTC for unknown region, got %v", e)
+	}
+}
+
 // Integration tests (require network access)
 // Run with: go test -v -run Integration
 
@@ -667,3 +754,53 @@ func TestDateRangeIntegration(t *testing.T) {
 
 	t.Logf("Found %d earnings events in 6-month range", len(earnings))
 }
+
+// earningsBody returns a calendar response with n earnings rows.
+func earningsBody(n int) string {
+	var b strings.Builder
+	b.WriteString(`{"finance":{"result":[{"documents":[{"columns":[
+		{"label":"Symbol","type":"STRING"},{"label":"Company Name","type":"STRING"},
+		{"label":"Market Cap (Intraday)","type":"NUMBER"},{"label":"Event Name","type":"STRING"},
+		{"label":"Event Start Date","type":"DATE"},{"label":"Timing","type":"STRING"},
+		{"label":"EPS Estimate","type":"NUMBER"},{"label":"Reported EPS","type":"NUMBER"},
+		{"label":"Surprise (%)","type":"NUMBER"}],"rows":[`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `["SYM%d","Company %d Inc",%d,"Q2 2024 Earnings Call","2024-07-%02dT20:00:00.000Z","AMC",%.2f,%.2f,%.2f]`,
+			i, i, 1000000000+i*12345, 1+i%28, 1+float64(i%100)/100, 1.1+float64(i%90)/100, float64(i%40)-20)
+	}
+	b.WriteString(`]}]}],"error":null}}`)
+	return b.String()
+}
+
+func TestParseCalendarResponse(t *testing.T) {
+	rows, columns, err := parseCalendarResponse(earningsBody(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := (&Calendars{}).parseEarnings(rows, columns)
+	if len(events) != 3 || events[1].Symbol != "SYM1" || events[1].EPSEstimate != 1.01 {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	if events[0].EventTime == nil || events[0].EventTime.UTC().Hour() != 20 {
+		t.Errorf("unexpected event time %v", events[0].EventTime)
+	}
+}
+
+func BenchmarkParseEarnings(b *testing.B) {
+	body := earningsBody(1000)
+	c := &Calendars{}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rows, columns, err := parseCalendarResponse(body)
+		if err != nil {
+			b.Fatal(err)
+		}
+		c.parseEarnings(rows, columns)
+	}
+}
@@ -0,0 +1,276 @@
+package calendars
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/internal/parallel"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+// dividendHistoryWindow is how far back payments are used to estimate a
+// holding's dividend frequency.
+const dividendHistoryWindow = 2 * 365 * 24 * time.Hour
+
+// dividendData is the per-symbol input of a dividend projection. info and
+// calendar may be nil when unavailable.
+type dividendData struct {
+	dividends []models.Dividend
+	info      *models.Info
+	calendar  *models.Calendar
+}
+
+// dividendFetcher returns the dividend data of a single symbol.
+type dividendFetcher func(symbol string) (dividendData, error)
+
+// ProjectDividends projects the dividend income of holdings over the next
+// 12 months, per position and in total per currency.
+//
+// Each holding's payment frequency and last ex-dividend date come from its
+// dividend history, the amount per payment from Info.DividendRate (or the
+// last payment when no rate is published), and the next ex-dividend date
+// from the ticker's calendar when announced. Later dates are extrapolated
+// from the frequency and are not Confirmed.
+//
+// Symbols are fetched concurrently. Symbols whose dividend history cannot
+// be fetched are reported in [models.DividendProjection.Errors] and project
+// no income; an error is returned only for invalid holdings.
+//
+// Example:
+//
+//	cal, _ := calendars.New()
+//	defer cal.Close()
+//
+//	proj, err := cal.ProjectDividends([]models.Holding{
+//	    {Symbol: "KO", Shares: 100},
+//	    {Symbol: "JNJ", Shares: 50},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, p := range proj.Payments {
+//	    fmt.Printf("%s %s %.2f %s\n", p.ExDate.Format("2006-01-02"), p.Symbol, p.Amount, p.Currency)
+//	}
+//	fmt.Println(proj.Totals)
+func (c *Calendars) ProjectDividends(holdings []models.Holding) (*models.DividendProjection, error) {
+	return c.ProjectDividendsContext(context.Background(), holdings)
+}
+
+// ProjectDividendsContext is like [Calendars.ProjectDividends] but returns
+// ctx's error as soon as ctx is done, including while symbols are being
+// fetched.
+func (c *Calendars) ProjectDividendsContext(ctx context.Context, holdings []models.Holding) (*models.DividendProjection, error) {
+	now := time.Now()
+	since := now.Add(-dividendHistoryWindow)
+	fetch := func(symbol string) (dividendData, error) {
+		tkr, err := ticker.New(symbol, ticker.WithClient(c.client))
+		if err != nil {
+			return dividendData{}, err
+		}
+		defer tkr.Close()
+
+		var data dividendData
+		// Only the window recentDividends looks at is fetched
+		actions, err := tkr.ActionsWithParamsContext(ctx, models.ActionsParams{Start: &since})
+		if err != nil {
+			return data, err
+		}
+		data.dividends = actions.Dividends
+		// Info and calendar refine the projection but are optional
+		data.info, _ = tkr.InfoContext(ctx)
+		data.calendar, _ = tkr.CalendarContext(ctx)
+		return data, nil
+	}
+	proj, err := projectDividends(holdings, fetch, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return proj, nil
+}
+
+// projectDividends builds the projection for the 12 months after now.
+func projectDividends(holdings []models.Holding, fetch dividendFetcher, now time.Time) (*models.DividendProjection, error) {
+	if len(holdings) == 0 {
+		return nil, fmt.Errorf("at least one holding is required")
+	}
+	var symbols []string
+	seen := make(map[string]bool)
+	for i, h := range holdings {
+		sym := strings.ToUpper(strings.TrimSpace(h.Symbol))
+		if sym == "" {
+			return nil, fmt.Errorf("holding %d has no symbol", i)
+		}
+		if h.Shares < 0 {
+			return nil, fmt.Errorf("holding %s has negative shares", sym)
+		}
+		if !seen[sym] {
+			seen[sym] = true
+			symbols = append(symbols, sym)
+		}
+	}
+
+	proj := &models.DividendProjection{
+		Start:  now,
+		End:    now.AddDate(1, 0, 0),
+		Totals: make(map[string]float64),
+		Errors: make(map[string]error),
+	}
+
+	data := make(map[string]dividendData, len(symbols))
+	var mu sync.Mutex
+	parallel.For(context.Background(), len(symbols), maxQuoteWorkers, func(i int) {
+		sym := symbols[i]
+		d, err := fetch(sym)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			proj.Errors[sym] = err
+			return
+		}
+		data[sym] = d
+	}, nil)
+
+	for _, h := range holdings {
+		sym := strings.ToUpper(strings.TrimSpace(h.Symbol))
+		pos := projectPosition(sym, h.Shares, data[sym], proj.Start, proj.End)
+		proj.Positions = append(proj.Positions, pos)
+		proj.Payments = append(proj.Payments, pos.Payments...)
+		proj.Totals[pos.Currency] += pos.Total
+	}
+
+	sort.SliceStable(proj.Payments, func(i, j int) bool {
+		if !proj.Payments[i].ExDate.Equal(proj.Payments[j].ExDate) {
+			return proj.Payments[i].ExDate.Before(proj.Payments[j].ExDate)
+		}
+		return proj.Payments[i].Symbol < proj.Payments[j].Symbol
+	})
+	return proj, nil
+}
+
+// projectPosition projects the payments of one holding within [start, end).
+func projectPosition(symbol string, shares float64, data dividendData, start, end time.Time) models.PositionDividends {
+	pos := models.PositionDividends{Symbol: symbol, Shares: shares}
+
+	recent := recentDividends(data.dividends, start)
+	var rate float64
+	if data.info != nil {
+		rate = data.info.DividendRate
+		pos.Currency = data.info.Currency
+	}
+	if n := len(recent); n > 0 && recent[n-1].Currency != "" {
+		pos.Currency = recent[n-1].Currency
+	}
+
+	var confirmed *time.Time
+	if data.calendar != nil && data.calendar.ExDividendDate != nil &&
+		!data.calendar.ExDividendDate.Before(start) && data.calendar.ExDividendDate.Before(end) {
+		confirmed = data.calendar.ExDividendDate
+	}
+
+	switch {
+	case len(recent) > 0:
+		pos.Frequency = dividendFrequency(recent)
+	case rate > 0 && confirmed != nil:
+		pos.Frequency = 1
+	default:
+		return pos
+	}
+
+	perShare := rate / float64(pos.Frequency)
+	if rate <= 0 {
+		perShare = recent[len(recent)-1].Amount
+	}
+	pos.AnnualRate = perShare * float64(pos.Frequency)
+
+	months := 12 / pos.Frequency
+
+	// First projected ex-date: the announced one, or the next step after
+	// the last payment
+	var next time.Time
+	if confirmed != nil {
+		next = *confirmed
+	} else {
+		next = recent[len(recent)-1].Date
+		for next.Before(start) {
+			next = next.AddDate(0, months, 0)
+		}
+	}
+
+	for d := next; d.Before(end); d = d.AddDate(0, months, 0) {
+		p := models.ProjectedDividend{
+			Symbol:   symbol,
+			ExDate:   d,
+			PerShare: perShare,
+			Amount:   perShare * shares,
+			Currency: pos.Currency,
+		}
+		if confirmed != nil && d.Equal(*confirmed) {
+			p.Confirmed = true
+			if pd := data.calendar.DividendDate; pd != nil && !pd.Before(d) {
+				payDate := *pd
+				p.PaymentDate = &payDate
+			}
+		}
+		pos.Payments = append(pos.Payments, p)
+		pos.Total += p.Amount
+	}
+	return pos
+}
+
+// recentDividends returns the positive payments in the history window
+// before now, ordered by date.
+func recentDividends(dividends []models.Dividend, now time.Time) []models.Dividend {
+	cutoff := now.Add(-dividendHistoryWindow)
+	var out []models.Dividend
+	for _, d := range dividends {
+		if d.Amount > 0 && d.Date.After(cutoff) && !d.Date.After(now) {
+			out = append(out, d)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out
+}
+
+// dividendFrequencies are the payment schedules a projection can follow:
+// each divides the year into whole months.
+var dividendFrequencies = []int{1, 2, 4, 12}
+
+// dividendFrequency estimates payments per year from the median gap between
+// payments, snapped to the nearest of dividendFrequencies so that the
+// projected payments per year match it. A single payment counts as annual.
+func dividendFrequency(dividends []models.Dividend) int {
+	if len(dividends) < 2 {
+		return 1
+	}
+	gaps := make([]float64, 0, len(dividends)-1)
+	for i := 1; i < len(dividends); i++ {
+		gaps = append(gaps, dividends[i].Date.Sub(dividends[i-1].Date).Hours()/24)
+	}
+	sort.Float64s(gaps)
+	median := gaps[len(gaps)/2]
+	if len(gaps)%2 == 0 {
+		median = (gaps[len(gaps)/2-1] + gaps[len(gaps)/2]) / 2
+	}
+	if median <= 0 {
+		return 1
+	}
+
+	raw := 365 / median
+	best := dividendFrequencies[0]
+	for _, f := range dividendFrequencies[1:] {
+		if math.Abs(math.Log(raw/float64(f))) < math.Abs(math.Log(raw/float64(best))) {
+			best = f
+		}
+	}
+	return best
+}
@@ -0,0 +1,148 @@
+package calendars
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestProjectDividends(t *testing.T) {
+	now := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	var quarterly []models.Dividend
+	for _, d := range []time.Time{day(2023, 3, 15), day(2023, 6, 14), day(2023, 9, 14), day(2023, 12, 14), day(2024, 3, 14)} {
+		quarterly = append(quarterly, models.Dividend{Date: d, Amount: 0.46})
+	}
+	exDate, payDate := day(2024, 6, 14), day(2024, 7, 1)
+
+	fetch := func(symbol string) (dividendData, error) {
+		switch symbol {
+		case "KO":
+			return dividendData{
+				dividends: quarterly,
+				info:      &models.Info{DividendRate: 1.94, Currency: "USD"},
+				calendar:  &models.Calendar{ExDividendDate: &exDate, DividendDate: &payDate},
+			}, nil
+		case "ANNUAL":
+			return dividendData{
+				dividends: []models.Dividend{{Date: day(2023, 9, 1), Amount: 2, Currency: "EUR"}},
+			}, nil
+		case "GROWTH":
+			return dividendData{info: &models.Info{Currency: "USD"}}, nil
+		}
+		return dividendData{}, fmt.Errorf("not found")
+	}
+
+	proj, err := projectDividends([]models.Holding{
+		{Symbol: "ko", Shares: 100},
+		{Symbol: "ANNUAL", Shares: 10},
+		{Symbol: "GROWTH", Shares: 5},
+		{Symbol: "BAD", Shares: 1},
+	}, fetch, now)
+	if err != nil {
+		t.Fatalf("projectDividends() error: %v", err)
+	}
+
+	ko := proj.Positions[0]
+	if ko.Frequency != 4 || len(ko.Payments) != 4 {
+		t.Fatalf("KO frequency/payments = %d/%d, want 4/4", ko.Frequency, len(ko.Payments))
+	}
+	first := ko.Payments[0]
+	if !first.Confirmed || !first.ExDate.Equal(exDate) || first.PaymentDate == nil || !first.PaymentDate.Equal(payDate) {
+		t.Errorf("first KO payment should be the announced one: %+v", first)
+	}
+	if ko.Payments[1].Confirmed || !ko.Payments[1].ExDate.Equal(day(2024, 9, 14)) {
+		t.Errorf("second KO payment = %+v", ko.Payments[1])
+	}
+	if math.Abs(ko.Total-194) > 1e-9 || math.Abs(first.Amount-48.5) > 1e-9 {
+		t.Errorf("KO total/amount = %v/%v, want 194/48.5", ko.Total, first.Amount)
+	}
+
+	annual := proj.Positions[1]
+	if annual.Frequency != 1 || len(annual.Payments) != 1 || !annual.Payments[0].ExDate.Equal(day(2024, 9, 1)) {
+		t.Errorf("annual payer = %+v", annual)
+	}
+	if annual.Currency != "EUR" || annual.Total != 20 {
+		t.Errorf("annual currency/total = %s/%v", annual.Currency, annual.Total)
+	}
+
+	if proj.Positions[2].Frequency != 0 || len(proj.Positions[2].Payments) != 0 {
+		t.Errorf("non-payer should project nothing: %+v", proj.Positions[2])
+	}
+	if proj.Errors["BAD"] == nil || len(proj.Errors) != 1 {
+		t.Errorf("errors = %v", proj.Errors)
+	}
+
+	if math.Abs(proj.Totals["USD"]-194) > 1e-9 || proj.Totals["EUR"] != 20 {
+		t.Errorf("totals = %v", proj.Totals)
+	}
+	if len(proj.Payments) != 5 {
+		t.Fatalf("payments = %d, want 5", len(proj.Payments))
+	}
+	for i := 1; i < len(proj.Payments); i++ {
+		if proj.Payments[i].ExDate.Before(proj.Payments[i-1].ExDate) {
+			t.Error("payments should be ordered by ex-date")
+		}
+	}
+}
+
+func TestProjectDividendsInvalid(t *testing.T) {
+	fetch := func(string) (dividendData, error) { return dividendData{}, nil }
+	if _, err := projectDividends(nil, fetch, time.Now()); err == nil {
+		t.Error("expected error for no holdings")
+	}
+	if _, err := projectDividends([]models.Holding{{Symbol: "KO", Shares: -1}}, fetch, time.Now()); err == nil {
+		t.Error("expected error for negative shares")
+	}
+}
+
+func TestDividendFrequency(t *testing.T) {
+	monthly := make([]models.Dividend, 6)
+	for i := range monthly {
+		monthly[i].Date = time.Date(2024, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)
+	}
+	if got := dividendFrequency(monthly); got != 12 {
+		t.Errorf("monthly = %d, want 12", got)
+	}
+	if got := dividendFrequency(monthly[:1]); got != 1 {
+		t.Errorf("single = %d, want 1", got)
+	}
+
+	// Payments every ~4 months (3 a year) snap to quarterly, ~2 months
+	// (6 a year) to quarterly as well, and ~6 weeks to monthly
+	for gap, want := range map[int]int{122: 4, 61: 4, 45: 12, 200: 2, 400: 1} {
+		divs := []models.Dividend{{Date: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}}
+		divs = append(divs, models.Dividend{Date: divs[0].Date.AddDate(0, 0, gap)})
+		if got := dividendFrequency(divs); got != want {
+			t.Errorf("gap of %d days = %d, want %d", gap, got, want)
+		}
+	}
+}
+
+func TestProjectPositionIrregularSchedule(t *testing.T) {
+	start := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	// Payments four months apart snap to a quarterly schedule; the
+	// projection must still add up to the annual rate
+	var divs []models.Dividend
+	for _, d := range []time.Time{
+		time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		divs = append(divs, models.Dividend{Date: d, Amount: 1})
+	}
+	pos := projectPosition("TRI", 10, dividendData{dividends: divs, info: &models.Info{DividendRate: 3}}, start, end)
+
+	if len(pos.Payments) != pos.Frequency {
+		t.Fatalf("payments = %d, want one per frequency step (%d)", len(pos.Payments), pos.Frequency)
+	}
+	if math.Abs(pos.Total-30) > 1e-9 || math.Abs(pos.AnnualRate-3) > 1e-9 {
+		t.Errorf("total/annual rate = %v/%v, want 30/3", pos.Total, pos.AnnualRate)
+	}
+}
@@ -36,6 +36,16 @@
 //	        e.Symbol, e.EPSEstimate, e.EPSActual, e.SurprisePercent)
 //	}
 //
+// Restrict the calendar to a watchlist or portfolio, with a current quote
+// for each match:
+//
+//	res, err := cal.EarningsForSymbols([]string{"AAPL", "MSFT"}, nil)
+//	for _, m := range res.Matches {
+//	    if m.Quote != nil {
+//	        fmt.Printf("%s @ %.2f\n", m.Event.Symbol, m.Quote.RegularMarketPrice)
+//	    }
+//	}
+//
 // # IPO Calendar
 //
 // Get upcoming and recent IPO information:
@@ -66,6 +76,50 @@
 //	        s.Symbol, s.CompanyName, s.Ratio)
 //	}
 //
+// Ratio is an exact [models.SplitRatio] such as 3:2; reverse splits report
+// IsReverse and a Factor below one.
+//
+// # Watchlist iCal Export
+//
+// Collect earnings and dividend dates for a watchlist from each ticker's
+// calendar and export them as an iCalendar file:
+//
+//	events, errs := cal.Watchlist([]string{"AAPL", "MSFT"})
+//	f, _ := os.Create("watchlist.ics")
+//	defer f.Close()
+//	err := calendars.WriteICal(f, events)
+//
+// # Dividend Projection
+//
+// Project a portfolio's dividend income over the next 12 months from each
+// holding's dividend history, dividend rate and announced ex-dividend date:
+//
+//	proj, err := cal.ProjectDividends([]models.Holding{
+//	    {Symbol: "KO", Shares: 100},
+//	    {Symbol: "JNJ", Shares: 50},
+//	})
+//	fmt.Println(proj.Totals["USD"])
+//
+// # Corporate Action Alerts
+//
+// Poll for stock splits and dividend changes announced by watchlist
+// symbols. Splits come from the splits calendar, dividends from each
+// ticker's calendar and dividend rate; each announcement is alerted once
+// and again whenever a dividend's dates or rate change:
+//
+//	alerts, err := cal.WatchCorporateActions(ctx, []string{"AAPL", "KO"}, time.Hour)
+//	for a := range alerts {
+//	    fmt.Println(a.Symbol, a.Kind)
+//	}
+//
+// # Event Times
+//
+// Yahoo returns event times as RFC3339 strings, epoch values or plain
+// dates; all are accepted. Times are returned in the market's timezone:
+// America/New_York for earnings and splits, the listing exchange's timezone
+// for IPOs, and the releasing country's timezone for economic events (UTC
+// when the country is unknown).
+//
 // # Custom Date Range
 //
 // Specify a custom date range for calendar queries:
@@ -100,6 +154,10 @@
 //	c, _ := client.New()
 //	cal, _ := calendars.New(calendars.WithClient(c))
 //
+// Calendar requests are retried on network errors and HTTP 429 and 5xx
+// responses with the policy set by config.SetMaxRetries and
+// config.SetRetryDelay.
+//
 // # Thread Safety
 //
 // All Calendars methods are safe for concurrent use from multiple goroutines.
@@ -0,0 +1,177 @@
+package calendars
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+// icalNow returns the DTSTAMP time; overridable in tests.
+var icalNow = time.Now
+
+// EventsFromCalendar converts a ticker's calendar into watchlist events
+// (earnings window, ex-dividend and dividend dates).
+func EventsFromCalendar(symbol string, cal *models.Calendar) []models.WatchlistEvent {
+	if cal == nil {
+		return nil
+	}
+
+	var events []models.WatchlistEvent
+	// A zero "now" keeps the window regardless of date.
+	if w := cal.NextEarningsWindow(time.Time{}); w != nil {
+		events = append(events, models.WatchlistEvent{
+			Symbol:    symbol,
+			Kind:      models.WatchlistEarnings,
+			Start:     w.Start,
+			End:       w.End,
+			Estimated: w.Estimated,
+		})
+	}
+	if cal.ExDividendDate != nil {
+		events = append(events, models.WatchlistEvent{
+			Symbol: symbol,
+			Kind:   models.WatchlistExDividend,
+			Start:  *cal.ExDividendDate,
+			End:    *cal.ExDividendDate,
+		})
+	}
+	if cal.DividendDate != nil {
+		events = append(events, models.WatchlistEvent{
+			Symbol: symbol,
+			Kind:   models.WatchlistDividend,
+			Start:  *cal.DividendDate,
+			End:    *cal.DividendDate,
+		})
+	}
+	return events
+}
+
+// Watchlist fetches the earnings and dividend dates of each symbol from its
+// ticker calendar, sorted by date. Per-symbol failures are returned in the
+// error map and do not abort the rest of the watchlist.
+//
+// Example:
+//
+//	events, errs := cal.Watchlist([]string{"AAPL", "MSFT"})
+//	for sym, err := range errs {
+//	    log.Printf("%s: %v", sym, err)
+//	}
+//	f, _ := os.Create("watchlist.ics")
+//	defer f.Close()
+//	calendars.WriteICal(f, events)
+func (c *Calendars) Watchlist(symbols []string) ([]models.WatchlistEvent, map[string]error) {
+	var events []models.WatchlistEvent
+	errs := make(map[string]error)
+
+	for _, symbol := range symbols {
+		tkr, err := ticker.New(symbol, ticker.WithClient(c.client))
+		if err != nil {
+			errs[symbol] = err
+			continue
+		}
+		cal, err := tkr.Calendar()
+		tkr.Close()
+		if err != nil {
+			errs[symbol] = err
+			continue
+		}
+		events = append(events, EventsFromCalendar(strings.ToUpper(symbol), cal)...)
+	}
+
+	sortWatchlistEvents(events)
+	return events, errs
+}
+
+func sortWatchlistEvents(events []models.WatchlistEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Start.Equal(events[j].Start) {
+			return events[i].Start.Before(events[j].Start)
+		}
+		return events[i].Symbol < events[j].Symbol
+	})
+}
+
+// WriteICal writes events as an iCalendar (.ics, RFC 5545) document of
+// all-day events, suitable for importing into calendar applications.
+func WriteICal(w io.Writer, events []models.WatchlistEvent) error {
+	var b strings.Builder
+	stamp := icalNow().UTC().Format("20060102T150405Z")
+
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//go-yfinance//calendars//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+
+	for _, ev := range events {
+		start := ev.Start.UTC()
+		end := ev.End.UTC()
+		if end.Before(start) {
+			end = start
+		}
+
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, fmt.Sprintf("UID:%s-%s-%s@go-yfinance", ev.Symbol, ev.Kind, start.Format("20060102")))
+		writeICalLine(&b, "DTSTAMP:"+stamp)
+		writeICalLine(&b, "DTSTART;VALUE=DATE:"+start.Format("20060102"))
+		// DTEND is exclusive for all-day events
+		writeICalLine(&b, "DTEND;VALUE=DATE:"+end.AddDate(0, 0, 1).Format("20060102"))
+		writeICalLine(&b, "SUMMARY:"+escapeICalText(watchlistSummary(ev)))
+		if ev.Estimated {
+			writeICalLine(&b, "STATUS:TENTATIVE")
+		} else {
+			writeICalLine(&b, "STATUS:CONFIRMED")
+		}
+		writeICalLine(&b, "TRANSP:TRANSPARENT")
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func watchlistSummary(ev models.WatchlistEvent) string {
+	switch ev.Kind {
+	case models.WatchlistEarnings:
+		if ev.Estimated {
+			return ev.Symbol + " earnings (estimated)"
+		}
+		return ev.Symbol + " earnings"
+	case models.WatchlistExDividend:
+		return ev.Symbol + " ex-dividend"
+	case models.WatchlistDividend:
+		return ev.Symbol + " dividend payment"
+	default:
+		return ev.Symbol + " " + string(ev.Kind)
+	}
+}
+
+// writeICalLine writes a content line, folding it at 75 octets as required
+// by RFC 5545.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// Do not split a UTF-8 sequence
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74 // continuation lines start with a space
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func escapeICalText(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	return r.Replace(s)
+}
@@ -0,0 +1,84 @@
+package calendars
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestEventsFromCalendar(t *testing.T) {
+	exDiv := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	cal := &models.Calendar{
+		ExDividendDate: &exDiv,
+		EarningsDate: []time.Time{
+			time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	events := EventsFromCalendar("AAPL", cal)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	if events[0].Kind != models.WatchlistEarnings || !events[0].Estimated {
+		t.Errorf("expected estimated earnings event, got %+v", events[0])
+	}
+	if !events[0].Start.Equal(time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected window to start at earliest date, got %s", events[0].Start)
+	}
+	if events[1].Kind != models.WatchlistExDividend {
+		t.Errorf("expected ex-dividend event, got %+v", events[1])
+	}
+}
+
+func TestWriteICal(t *testing.T) {
+	icalNow = func() time.Time { return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC) }
+	defer func() { icalNow = time.Now }()
+
+	day := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	events := []models.WatchlistEvent{
+		{Symbol: "AAPL", Kind: models.WatchlistEarnings, Start: day, End: day.AddDate(0, 0, 2), Estimated: true},
+		{Symbol: "MSFT", Kind: models.WatchlistExDividend, Start: day, End: day},
+	}
+
+	var b strings.Builder
+	if err := WriteICal(&b, events); err != nil {
+		t.Fatalf("WriteICal() error: %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:AAPL-earnings-20240430@go-yfinance\r\n",
+		"DTSTAMP:20240101T120000Z\r\n",
+		"DTSTART;VALUE=DATE:20240430\r\n",
+		"DTEND;VALUE=DATE:20240503\r\n",
+		"SUMMARY:AAPL earnings (estimated)\r\n",
+		"STATUS:TENTATIVE\r\n",
+		"SUMMARY:MSFT ex-dividend\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	if strings.Count(out, "BEGIN:VEVENT") != 2 {
+		t.Errorf("expected 2 events in output")
+	}
+}
+
+func TestWriteICalLineFolding(t *testing.T) {
+	var b strings.Builder
+	writeICalLine(&b, "SUMMARY:"+strings.Repeat("x", 200))
+
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line exceeds 75 octets: %d", len(line))
+		}
+	}
+	if got := strings.ReplaceAll(b.String(), "\r\n ", ""); got != "SUMMARY:"+strings.Repeat("x", 200)+"\r\n" {
+		t.Error("unfolded line does not match input")
+	}
+}
@@ -0,0 +1,166 @@
+package calendars
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/wnjoon/go-yfinance/internal/parallel"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+const (
+	// earningsPageSize is the largest page the calendar endpoint serves.
+	earningsPageSize = 100
+
+	// maxEarningsPages bounds pagination when scanning for watchlist symbols.
+	maxEarningsPages = 20
+
+	// maxQuoteWorkers bounds the number of concurrent per-symbol requests.
+	maxQuoteWorkers = 8
+)
+
+// quoteFetcher returns the current quote of a single symbol.
+type quoteFetcher func(symbol string) (*models.Quote, error)
+
+// FilterEarnings returns the events whose symbol is in symbols, keeping
+// their order. Symbols are compared case-insensitively.
+//
+// Example:
+//
+//	events, _ := cal.Earnings(nil)
+//	mine := calendars.FilterEarnings(events, []string{"AAPL", "msft"})
+func FilterEarnings(events []models.EarningsEvent, symbols []string) []models.EarningsEvent {
+	set := symbolSet(symbols)
+	var out []models.EarningsEvent
+	for _, e := range events {
+		if _, ok := set[strings.ToUpper(e.Symbol)]; ok {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// EarningsForSymbols scans every page of the earnings calendar in the date
+// range for the given watchlist or portfolio symbols and returns only their
+// events, each enriched with the symbol's current quote.
+//
+// Quotes are fetched concurrently, once per symbol. Quote failures are
+// reported in [models.EarningsMatches.Errors] and leave the match's Quote
+// nil; an error is returned only if the calendar cannot be fetched.
+// opts.Limit and opts.Offset are ignored.
+//
+// Example:
+//
+//	cal, _ := calendars.New()
+//	defer cal.Close()
+//
+//	res, err := cal.EarningsForSymbols([]string{"AAPL", "MSFT", "NVDA"}, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, m := range res.Matches {
+//	    fmt.Printf("%s %s est %.2f\n", m.Event.Symbol, m.Event.Timing, m.Event.EPSEstimate)
+//	}
+func (c *Calendars) EarningsForSymbols(symbols []string, opts *models.CalendarOptions) (*models.EarningsMatches, error) {
+	return c.EarningsForSymbolsContext(context.Background(), symbols, opts)
+}
+
+// EarningsForSymbolsContext is like [Calendars.EarningsForSymbols] but
+// returns ctx's error as soon as ctx is done, including while quotes are
+// being fetched.
+func (c *Calendars) EarningsForSymbolsContext(ctx context.Context, symbols []string, opts *models.CalendarOptions) (*models.EarningsMatches, error) {
+	var page models.CalendarOptions
+	if opts != nil {
+		page = *opts
+	}
+	page.Limit = earningsPageSize
+
+	var events []models.EarningsEvent
+	for i := 0; i < maxEarningsPages; i++ {
+		page.Offset = i * earningsPageSize
+		batch, err := c.EarningsContext(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, batch...)
+		if len(batch) < earningsPageSize {
+			break
+		}
+	}
+
+	fetch := func(symbol string) (*models.Quote, error) {
+		tkr, err := ticker.New(symbol, ticker.WithClient(c.client))
+		if err != nil {
+			return nil, err
+		}
+		defer tkr.Close()
+		return tkr.QuoteContext(ctx)
+	}
+
+	result := matchEarnings(events, symbols, fetch)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// matchEarnings intersects events with symbols and attaches quotes.
+func matchEarnings(events []models.EarningsEvent, symbols []string, fetch quoteFetcher) *models.EarningsMatches {
+	result := &models.EarningsMatches{Errors: make(map[string]error)}
+
+	matched := FilterEarnings(events, symbols)
+	seen := make(map[string]struct{})
+	var quoteSymbols []string
+	for _, e := range matched {
+		sym := strings.ToUpper(e.Symbol)
+		if _, ok := seen[sym]; !ok {
+			seen[sym] = struct{}{}
+			quoteSymbols = append(quoteSymbols, sym)
+		}
+	}
+	for sym := range symbolSet(symbols) {
+		if _, ok := seen[sym]; !ok {
+			result.Missing = append(result.Missing, sym)
+		}
+	}
+	sort.Strings(result.Missing)
+
+	quotes := make(map[string]*models.Quote, len(quoteSymbols))
+	var mu sync.Mutex
+	parallel.For(context.Background(), len(quoteSymbols), maxQuoteWorkers, func(i int) {
+		sym := quoteSymbols[i]
+		q, err := fetch(sym)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Errors[sym] = err
+			return
+		}
+		quotes[sym] = q
+	}, nil)
+
+	result.Matches = make([]models.EarningsMatch, 0, len(matched))
+	for _, e := range matched {
+		result.Matches = append(result.Matches, models.EarningsMatch{
+			Event: e,
+			Quote: quotes[strings.ToUpper(e.Symbol)],
+		})
+	}
+	return result
+}
+
+// symbolSet returns the upper-cased, non-empty symbols as a set.
+func symbolSet(symbols []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(symbols))
+	for _, s := range symbols {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if s != "" {
+			set[s] = struct{}{}
+		}
+	}
+	return set
+}
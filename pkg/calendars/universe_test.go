@@ -0,0 +1,64 @@
+package calendars
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestFilterEarnings(t *testing.T) {
+	events := []models.EarningsEvent{
+		{Symbol: "AAPL"}, {Symbol: "TSLA"}, {Symbol: "msft"}, {Symbol: "AAPL", EventName: "call"},
+	}
+
+	got := FilterEarnings(events, []string{"aapl", " MSFT "})
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(got))
+	}
+	if got[0].Symbol != "AAPL" || got[1].Symbol != "msft" || got[2].EventName != "call" {
+		t.Errorf("Unexpected filtered events: %+v", got)
+	}
+}
+
+func TestMatchEarnings(t *testing.T) {
+	events := []models.EarningsEvent{
+		{Symbol: "AAPL"}, {Symbol: "TSLA"}, {Symbol: "NVDA"}, {Symbol: "AAPL"},
+	}
+
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+	fetch := func(symbol string) (*models.Quote, error) {
+		mu.Lock()
+		calls[symbol]++
+		mu.Unlock()
+		if symbol == "NVDA" {
+			return nil, errors.New("quote failed")
+		}
+		return &models.Quote{Symbol: symbol, RegularMarketPrice: 100}, nil
+	}
+
+	res := matchEarnings(events, []string{"AAPL", "NVDA", "IBM"}, fetch)
+
+	if len(res.Matches) != 3 {
+		t.Fatalf("Expected 3 matches, got %d", len(res.Matches))
+	}
+	if res.Matches[0].Quote == nil || res.Matches[0].Quote.Symbol != "AAPL" {
+		t.Errorf("Expected AAPL quote, got %+v", res.Matches[0].Quote)
+	}
+	if res.Matches[1].Quote != nil {
+		t.Error("Expected nil quote for failed symbol")
+	}
+	if calls["AAPL"] != 1 || calls["TSLA"] != 0 {
+		t.Errorf("Expected one quote per matched symbol, got %v", calls)
+	}
+	if res.Errors["NVDA"] == nil {
+		t.Error("Expected NVDA quote error")
+	}
+	if len(res.Missing) != 1 || res.Missing[0] != "IBM" {
+		t.Errorf("Expected IBM missing, got %v", res.Missing)
+	}
+}
@@ -0,0 +1,224 @@
+package calendars
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+)
+
+const (
+	// watchBuffer is the capacity of the channel returned by WatchCorporateActions.
+	watchBuffer = 64
+
+	// splitWatchWindow is how far ahead the splits calendar is scanned on
+	// each poll.
+	splitWatchWindow = 90 * 24 * time.Hour
+)
+
+// actionFetchers returns the inputs of one corporate action poll.
+type actionFetchers struct {
+	// splits returns the splits calendar from now to the watch window end.
+	splits func(now time.Time) ([]models.CalendarSplitEvent, error)
+
+	// dividend returns the current dividend announcement of one symbol.
+	dividend func(symbol string) (models.DividendAnnouncement, error)
+}
+
+// actionState is what a corporate action watch has already reported.
+type actionState struct {
+	splits    map[string]bool
+	dividends map[string]models.DividendAnnouncement
+}
+
+// WatchCorporateActions polls every interval for split and dividend
+// announcements of the watchlist symbols and emits an alert for each new
+// one.
+//
+// Each poll scans the splits calendar over the next 90 days for watchlist
+// symbols, and reads every symbol's ex-dividend and payment dates from its
+// ticker calendar and its dividend rate from its info. A split is alerted
+// once; a dividend is alerted when its dates or rate differ from the
+// previous poll, with the old announcement in Previous.
+//
+// The first poll runs immediately and alerts every split and dividend
+// already announced, with a nil Previous. A failed fetch emits an alert
+// with Err set (and Symbol set for a per-symbol failure) and watching
+// continues. The channel is closed when ctx is done.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	alerts, err := cal.WatchCorporateActions(ctx, []string{"AAPL", "KO", "NVDA"}, time.Hour)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for a := range alerts {
+//	    switch {
+//	    case a.Err != nil:
+//	        log.Println(a.Symbol, a.Err)
+//	    case a.Kind == models.CorporateActionSplit:
+//	        fmt.Printf("%s announced a %s split\n", a.Symbol, a.Split.Ratio)
+//	    case a.Previous != nil:
+//	        fmt.Printf("%s dividend rate %.2f -> %.2f\n", a.Symbol, a.Previous.Rate, a.Dividend.Rate)
+//	    }
+//	}
+func (c *Calendars) WatchCorporateActions(ctx context.Context, symbols []string, interval time.Duration) (<-chan models.CorporateActionAlert, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("watch interval must be positive, got %s", interval)
+	}
+	symbols = utils.NormalizeSymbols(symbols)
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("at least one symbol is required")
+	}
+
+	fetch := actionFetchers{
+		splits: func(now time.Time) ([]models.CalendarSplitEvent, error) {
+			page := models.CalendarOptions{
+				Start: now,
+				End:   now.Add(splitWatchWindow),
+				Limit: earningsPageSize,
+			}
+			var events []models.CalendarSplitEvent
+			for i := 0; i < maxEarningsPages; i++ {
+				page.Offset = i * earningsPageSize
+				batch, err := c.SplitsContext(ctx, &page)
+				if err != nil {
+					return nil, err
+				}
+				events = append(events, batch...)
+				if len(batch) < earningsPageSize {
+					break
+				}
+			}
+			return events, nil
+		},
+		dividend: func(symbol string) (models.DividendAnnouncement, error) {
+			tkr, err := ticker.New(symbol, ticker.WithClient(c.client))
+			if err != nil {
+				return models.DividendAnnouncement{}, err
+			}
+			defer tkr.Close()
+
+			cal, err := tkr.CalendarContext(ctx)
+			if err != nil {
+				return models.DividendAnnouncement{}, err
+			}
+			a := models.DividendAnnouncement{
+				ExDividendDate: cal.ExDividendDate,
+				PaymentDate:    cal.DividendDate,
+			}
+			// The rate refines the alert but is optional
+			if info, err := tkr.InfoContext(ctx); err == nil {
+				a.Rate = info.DividendRate
+			}
+			return a, nil
+		},
+	}
+
+	out := make(chan models.CorporateActionAlert, watchBuffer)
+	go watchCorporateActions(ctx, interval, symbols, fetch, out)
+	return out, nil
+}
+
+// watchCorporateActions runs the polling loop of WatchCorporateActions and
+// closes out when ctx is done.
+func watchCorporateActions(ctx context.Context, interval time.Duration, symbols []string, fetch actionFetchers, out chan<- models.CorporateActionAlert) {
+	defer close(out)
+
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+
+	state := &actionState{
+		splits:    make(map[string]bool),
+		dividends: make(map[string]models.DividendAnnouncement),
+	}
+	for {
+		for _, alert := range pollCorporateActions(symbols, fetch, state, time.Now()) {
+			select {
+			case out <- alert:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-tick.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// pollCorporateActions fetches the current announcements and returns the
+// alerts for those not yet in state, updating state. Split alerts come
+// first, then dividend alerts in symbol order.
+func pollCorporateActions(symbols []string, fetch actionFetchers, state *actionState, now time.Time) []models.CorporateActionAlert {
+	var alerts []models.CorporateActionAlert
+
+	splits, err := fetch.splits(now)
+	if err != nil {
+		alerts = append(alerts, models.CorporateActionAlert{Time: time.Now(), Err: err})
+	}
+	set := symbolSet(symbols)
+	for _, ev := range splits {
+		sym := strings.ToUpper(ev.Symbol)
+		if _, ok := set[sym]; !ok {
+			continue
+		}
+		key := splitKey(sym, ev)
+		if state.splits[key] {
+			continue
+		}
+		state.splits[key] = true
+
+		split := ev
+		alerts = append(alerts, models.CorporateActionAlert{
+			Symbol: sym,
+			Kind:   models.CorporateActionSplit,
+			Split:  &split,
+			Time:   time.Now(),
+		})
+	}
+
+	for _, sym := range symbols {
+		cur, err := fetch.dividend(sym)
+		if err != nil {
+			alerts = append(alerts, models.CorporateActionAlert{Symbol: sym, Time: time.Now(), Err: err})
+			continue
+		}
+
+		prev, seen := state.dividends[sym]
+		state.dividends[sym] = cur
+		if (seen && prev.Equal(cur)) || (!seen && cur.IsZero()) {
+			continue
+		}
+
+		alert := models.CorporateActionAlert{
+			Symbol:   sym,
+			Kind:     models.CorporateActionDividend,
+			Dividend: &cur,
+			Time:     time.Now(),
+		}
+		if seen {
+			alert.Previous = &prev
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts
+}
+
+// splitKey identifies one split announcement of sym.
+func splitKey(sym string, ev models.CalendarSplitEvent) string {
+	date := ""
+	if ev.PayableDate != nil {
+		date = ev.PayableDate.Format(dateFormat)
+	}
+	return sym + "|" + date + "|" + ev.Ratio.String()
+}
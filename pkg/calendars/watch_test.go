@@ -0,0 +1,125 @@
+package calendars
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestPollCorporateActions(t *testing.T) {
+	exDate := time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC)
+	payDate := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	splitDate := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	ratio, _ := models.NewSplitRatio(10, 1)
+
+	splits := []models.CalendarSplitEvent{
+		{Symbol: "nvda", PayableDate: &splitDate, Ratio: ratio},
+		{Symbol: "OTHER", PayableDate: &splitDate, Ratio: ratio},
+	}
+	dividends := map[string]models.DividendAnnouncement{
+		"KO":   {ExDividendDate: &exDate, PaymentDate: &payDate, Rate: 1.94},
+		"NVDA": {},
+	}
+	var splitsErr error
+	fetch := actionFetchers{
+		splits: func(time.Time) ([]models.CalendarSplitEvent, error) {
+			return splits, splitsErr
+		},
+		dividend: func(symbol string) (models.DividendAnnouncement, error) {
+			if symbol == "BAD" {
+				return models.DividendAnnouncement{}, fmt.Errorf("not found")
+			}
+			return dividends[symbol], nil
+		},
+	}
+
+	symbols := []string{"KO", "NVDA", "BAD"}
+	state := &actionState{
+		splits:    make(map[string]bool),
+		dividends: make(map[string]models.DividendAnnouncement),
+	}
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	alerts := pollCorporateActions(symbols, fetch, state, now)
+	if len(alerts) != 3 {
+		t.Fatalf("expected 3 alerts on first poll, got %+v", alerts)
+	}
+	if a := alerts[0]; a.Kind != models.CorporateActionSplit || a.Symbol != "NVDA" || a.Split.Ratio.String() != "10:1" {
+		t.Errorf("unexpected split alert: %+v", a)
+	}
+	if a := alerts[1]; a.Kind != models.CorporateActionDividend || a.Symbol != "KO" || a.Previous != nil || a.Dividend.Rate != 1.94 {
+		t.Errorf("unexpected dividend alert: %+v", a)
+	}
+	if a := alerts[2]; a.Symbol != "BAD" || a.Err == nil {
+		t.Errorf("expected error alert for BAD, got %+v", a)
+	}
+
+	delete(dividends, "BAD")
+	symbols = symbols[:2]
+	if alerts := pollCorporateActions(symbols, fetch, state, now); len(alerts) != 0 {
+		t.Fatalf("expected no alerts for unchanged announcements, got %+v", alerts)
+	}
+
+	dividends["KO"] = models.DividendAnnouncement{ExDividendDate: &exDate, PaymentDate: &payDate, Rate: 2.04}
+	splitsErr = fmt.Errorf("calendar unavailable")
+	alerts = pollCorporateActions(symbols, fetch, state, now)
+	if len(alerts) != 2 {
+		t.Fatalf("expected splits error and dividend change, got %+v", alerts)
+	}
+	if alerts[0].Err == nil || alerts[0].Symbol != "" {
+		t.Errorf("expected splits calendar error, got %+v", alerts[0])
+	}
+	if a := alerts[1]; a.Previous == nil || a.Previous.Rate != 1.94 || a.Dividend.Rate != 2.04 {
+		t.Errorf("expected rate change 1.94 -> 2.04, got %+v", a)
+	}
+}
+
+func TestWatchCorporateActionsClosesOnCancel(t *testing.T) {
+	fetch := actionFetchers{
+		splits: func(time.Time) ([]models.CalendarSplitEvent, error) { return nil, nil },
+		dividend: func(string) (models.DividendAnnouncement, error) {
+			return models.DividendAnnouncement{Rate: 1}, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan models.CorporateActionAlert, watchBuffer)
+	go watchCorporateActions(ctx, time.Hour, []string{"KO"}, fetch, out)
+
+	if a := <-out; a.Symbol != "KO" || a.Kind != models.CorporateActionDividend {
+		t.Errorf("unexpected first alert: %+v", a)
+	}
+	cancel()
+	for range out {
+	}
+}
+
+func TestWatchCorporateActionsInvalid(t *testing.T) {
+	cal := &Calendars{}
+	if _, err := cal.WatchCorporateActions(context.Background(), []string{"KO"}, 0); err == nil {
+		t.Error("expected error for zero interval")
+	}
+	if _, err := cal.WatchCorporateActions(context.Background(), []string{" "}, time.Minute); err == nil {
+		t.Error("expected error for empty watchlist")
+	}
+}
+
+func TestDividendAnnouncementEqual(t *testing.T) {
+	d1 := time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC)
+	d2 := d1.In(time.FixedZone("X", 3600))
+
+	a := models.DividendAnnouncement{ExDividendDate: &d1, Rate: 1}
+	b := models.DividendAnnouncement{ExDividendDate: &d2, Rate: 1}
+	if !a.Equal(b) {
+		t.Error("expected the same instant in different zones to be equal")
+	}
+	if a.Equal(models.DividendAnnouncement{Rate: 1}) {
+		t.Error("expected a missing ex-date to differ")
+	}
+	if !(models.DividendAnnouncement{}).IsZero() {
+		t.Error("expected empty announcement to be zero")
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/config"
 )
 
 // AuthStrategy represents the authentication strategy.
@@ -22,7 +24,22 @@ const (
 	StrategyCSRF
 )
 
+// String returns the strategy name used in the config ("basic" or "csrf").
+func (s AuthStrategy) String() string {
+	if s == StrategyCSRF {
+		return config.AuthStrategyCSRF
+	}
+	return config.AuthStrategyBasic
+}
+
+// crumbTTL is how long a fetched crumb is reused; Yahoo crumbs are
+// typically valid for about an hour.
+const crumbTTL = 1 * time.Hour
+
 // AuthManager handles Yahoo Finance authentication (Cookie + Crumb).
+//
+// Concurrent crumb refreshes are coalesced: while a handshake is in flight,
+// other callers wait for its result instead of starting their own.
 type AuthManager struct {
 	client   *Client
 	mu       sync.RWMutex
@@ -31,6 +48,31 @@ type AuthManager struct {
 	strategy AuthStrategy
 	expiry   time.Time
 	user     map[string]interface{}
+
+	// consent is set once Yahoo answered with a consent form
+	consent bool
+
+	// inflight is the running handshake, nil when none is in progress
+	inflight *authCall
+
+	// handshake fetches a crumb starting with the given strategy and
+	// returns the strategy that succeeded; overridable in tests.
+	handshake func(AuthStrategy) (string, AuthStrategy, error)
+
+	// get and post send the handshake requests; overridable in tests.
+	get  authResponseGetter
+	post func(rawURL string, params url.Values, body map[string]string) (*Response, error)
+}
+
+// errHandshakePanicked is returned to the callers waiting on a handshake
+// that panicked.
+var errHandshakePanicked = errors.New("authentication failed: handshake panicked")
+
+// authCall is a crumb handshake shared by concurrent callers.
+type authCall struct {
+	done  chan struct{}
+	crumb string
+	err   error
 }
 
 type authResponseGetter func(rawURL string, params url.Values) (*Response, error)
@@ -42,11 +84,38 @@ var subscriptionTierNames = map[int]string{
 }
 
 // NewAuthManager creates a new AuthManager with the given client.
+//
+// Packages share the manager of their client through [Client.Auth]; a
+// separate manager runs its own cookie and crumb handshake.
+//
+// The first handshake uses the consent (CSRF) strategy when the config
+// region is in the EEA, the UK or Switzerland, and the basic one otherwise.
 func NewAuthManager(client *Client) *AuthManager {
-	return &AuthManager{
+	a := &AuthManager{
 		client:   client,
 		strategy: StrategyBasic,
+		get:      client.Get,
+		post:     client.Post,
+	}
+	if _, region := config.Get().GetLocale(); isConsentRegion(region) {
+		a.strategy = StrategyCSRF
 	}
+	a.handshake = a.fetchCrumb
+	return a
+}
+
+// Auth returns the AuthManager shared by everything using this client, so
+// a Ticker, Sector and Calendars on the same client authenticate once.
+//
+// Example:
+//
+//	c, _ := client.Default()
+//	crumb, err := c.Auth().GetCrumb()
+func (c *Client) Auth() *AuthManager {
+	c.authOnce.Do(func() {
+		c.auth = NewAuthManager(c)
+	})
+	return c.auth
 }
 
 // SetLoginCookies sets manually retrieved Yahoo Finance login cookies.
@@ -227,131 +296,181 @@ func (a *AuthManager) GetCrumb() (string, error) {
 	return a.refreshAuth()
 }
 
-// refreshAuth fetches new cookie and crumb.
+// refreshAuth fetches a new cookie and crumb, or waits for the handshake
+// already in flight and shares its result.
 func (a *AuthManager) refreshAuth() (string, error) {
 	a.mu.Lock()
-	defer a.mu.Unlock()
-
 	// Double-check after acquiring write lock
 	if a.crumb != "" && time.Now().Before(a.expiry) {
-		return a.crumb, nil
+		crumb := a.crumb
+		a.mu.Unlock()
+		return crumb, nil
 	}
-
-	var err error
-	if a.strategy == StrategyBasic {
-		err = a.fetchBasic()
-		if err != nil {
-			// Fallback to CSRF strategy
-			a.strategy = StrategyCSRF
-			err = a.fetchCSRF()
-		}
-	} else {
-		err = a.fetchCSRF()
-		if err != nil {
-			// Fallback to Basic strategy
-			a.strategy = StrategyBasic
-			err = a.fetchBasic()
-		}
+	if call := a.inflight; call != nil {
+		a.mu.Unlock()
+		<-call.done
+		return call.crumb, call.err
 	}
+	// Waiters get this error if the handshake panics
+	call := &authCall{done: make(chan struct{}), err: errHandshakePanicked}
+	a.inflight = call
+	strategy := a.strategy
+	a.mu.Unlock()
 
+	// Released even if the handshake panics, so waiters never block forever
+	defer func() {
+		a.mu.Lock()
+		a.inflight = nil
+		a.mu.Unlock()
+		close(call.done)
+	}()
+
+	crumb, strategy, err := a.handshake(strategy)
 	if err != nil {
-		return "", fmt.Errorf("authentication failed: %w", err)
+		err = fmt.Errorf("authentication failed: %w", err)
 	}
 
-	return a.crumb, nil
+	a.mu.Lock()
+	a.strategy = strategy
+	if err == nil {
+		a.crumb = crumb
+		a.expiry = time.Now().Add(crumbTTL)
+	}
+	a.mu.Unlock()
+
+	call.crumb, call.err = crumb, err
+	return crumb, err
+}
+
+// Strategy returns the strategy the next handshake starts with.
+func (a *AuthManager) Strategy() AuthStrategy {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.strategy
+}
+
+// ConsentRequired reports whether Yahoo has asked this manager for GDPR
+// consent, which it then accepts automatically.
+func (a *AuthManager) ConsentRequired() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.consent
+}
+
+// fetchCrumb runs the handshake of strategy, falling back to the other
+// strategy if it fails, and returns the crumb and the strategy used.
+//
+// A strategy forced with config.SetAuthStrategy is used alone.
+func (a *AuthManager) fetchCrumb(strategy AuthStrategy) (string, AuthStrategy, error) {
+	switch config.Get().GetAuthStrategy() {
+	case config.AuthStrategyBasic:
+		crumb, err := a.fetchBasic()
+		return crumb, StrategyBasic, err
+	case config.AuthStrategyCSRF:
+		crumb, err := a.fetchCSRF()
+		return crumb, StrategyCSRF, err
+	}
+
+	first, second := a.fetchBasic, a.fetchCSRF
+	fallback := StrategyCSRF
+	if strategy != StrategyBasic {
+		first, second = a.fetchCSRF, a.fetchBasic
+		fallback = StrategyBasic
+	}
+
+	crumb, err := first()
+	if err == nil {
+		return crumb, strategy, nil
+	}
+	crumb, err = second()
+	return crumb, fallback, err
 }
 
 // fetchBasic implements the basic authentication strategy.
 // 1. GET https://fc.yahoo.com -> captures cookies
 // 2. GET https://query2.finance.yahoo.com/v1/test/getcrumb -> gets crumb
-func (a *AuthManager) fetchBasic() error {
+//
+// If fc.yahoo.com redirects to a consent form (EU), the consent is accepted
+// before asking for the crumb.
+func (a *AuthManager) fetchBasic() (string, error) {
 	// Step 1: Get cookie from fc.yahoo.com
-	resp, err := a.client.Get(endpoints.CookieURL, nil)
+	resp, err := a.get(endpoints.CookieURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get cookie: %w", err)
+		return "", fmt.Errorf("failed to get cookie: %w", err)
 	}
 
 	// Extract cookies from response headers
 	a.extractCookies(resp.Headers)
 
+	if isConsentPage(resp.Body) {
+		a.markConsent()
+		if err := a.submitConsent(resp.Body); err != nil {
+			return "", err
+		}
+	}
+
 	// Step 2: Get crumb
-	resp, err = a.client.Get(endpoints.CrumbURL, nil)
+	resp, err = a.get(endpoints.CrumbURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get crumb: %w", err)
+		return "", fmt.Errorf("failed to get crumb: %w", err)
+	}
+
+	if isConsentPage(resp.Body) {
+		a.markConsent()
+		return "", fmt.Errorf("consent required")
 	}
 
 	if resp.StatusCode == 429 || strings.Contains(resp.Body, "Too Many Requests") {
-		return fmt.Errorf("rate limited")
+		return "", fmt.Errorf("rate limited")
 	}
 
 	if resp.Body == "" || strings.Contains(resp.Body, "<html>") {
-		return fmt.Errorf("invalid crumb response")
+		return "", fmt.Errorf("invalid crumb response")
 	}
 
-	a.crumb = strings.TrimSpace(resp.Body)
-	a.expiry = time.Now().Add(1 * time.Hour) // Crumb typically valid for ~1 hour
-
-	return nil
+	return strings.TrimSpace(resp.Body), nil
 }
 
 // fetchCSRF implements the CSRF consent-based authentication strategy.
 // This is used when basic strategy fails (e.g., for EU users).
-func (a *AuthManager) fetchCSRF() error {
+func (a *AuthManager) fetchCSRF() (string, error) {
 	// Step 1: Get consent page
-	resp, err := a.client.Get(endpoints.ConsentURL, nil)
+	resp, err := a.get(endpoints.ConsentURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get consent page: %w", err)
-	}
-
-	// Extract CSRF token and session ID from HTML
-	csrfToken := extractInputValue(resp.Body, "csrfToken")
-	sessionID := extractInputValue(resp.Body, "sessionId")
-
-	if csrfToken == "" || sessionID == "" {
-		return fmt.Errorf("failed to extract CSRF tokens")
+		return "", fmt.Errorf("failed to get consent page: %w", err)
 	}
-
-	// Step 2: Submit consent
-	consentData := map[string]string{
-		"agree":           "agree",
-		"consentUUID":     "default",
-		"sessionId":       sessionID,
-		"csrfToken":       csrfToken,
-		"originalDoneUrl": "https://finance.yahoo.com/",
-		"namespace":       "yahoo",
-	}
-
-	collectURL := fmt.Sprintf("%s?sessionId=%s", endpoints.CollectConsentURL, sessionID)
-	_, err = a.client.Post(collectURL, nil, consentData)
-	if err != nil {
-		return fmt.Errorf("failed to submit consent: %w", err)
+	a.extractCookies(resp.Headers)
+	if isConsentPage(resp.Body) {
+		a.markConsent()
 	}
 
-	// Step 3: Copy consent
-	copyURL := fmt.Sprintf("%s?sessionId=%s", endpoints.CopyConsentURL, sessionID)
-	_, err = a.client.Get(copyURL, nil)
-	if err != nil {
-		return fmt.Errorf("failed to copy consent: %w", err)
+	// Steps 2-3: Submit the consent form and copy the consent
+	if err := a.submitConsent(resp.Body); err != nil {
+		return "", err
 	}
 
 	// Step 4: Get crumb
-	resp, err = a.client.Get(endpoints.CrumbCSRFURL, nil)
+	resp, err = a.get(endpoints.CrumbCSRFURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get crumb: %w", err)
+		return "", fmt.Errorf("failed to get crumb: %w", err)
 	}
 
 	if resp.StatusCode == 429 || strings.Contains(resp.Body, "Too Many Requests") {
-		return fmt.Errorf("rate limited")
+		return "", fmt.Errorf("rate limited")
 	}
 
 	if resp.Body == "" || strings.Contains(resp.Body, "<html>") {
-		return fmt.Errorf("invalid crumb response")
+		return "", fmt.Errorf("invalid crumb response")
 	}
 
-	a.crumb = strings.TrimSpace(resp.Body)
-	a.expiry = time.Now().Add(1 * time.Hour)
+	return strings.TrimSpace(resp.Body), nil
+}
 
-	return nil
+// markConsent records that Yahoo asked for consent; see ConsentRequired.
+func (a *AuthManager) markConsent() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.consent = true
 }
 
 // extractCookies extracts and stores cookies from response headers.
@@ -361,9 +480,12 @@ func (a *AuthManager) extractCookies(headers map[string]string) {
 			// Extract just the cookie name=value part (before any attributes like Expires, Path, etc.)
 			parts := strings.Split(value, ";")
 			if len(parts) > 0 {
-				a.cookie = strings.TrimSpace(parts[0])
+				cookie := strings.TrimSpace(parts[0])
+				a.mu.Lock()
+				a.cookie = cookie
+				a.mu.Unlock()
 				// Set cookie on the client for subsequent requests
-				a.client.SetCookie(a.cookie)
+				a.client.SetCookie(cookie)
 			}
 			break
 		}
@@ -1,9 +1,15 @@
 package client
 
 import (
+	"errors"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/config"
 )
 
 func TestExtractInputValue(t *testing.T) {
@@ -306,3 +312,306 @@ func TestParseLoginUserMissing(t *testing.T) {
 		t.Errorf("Expected nil user, got %v", user)
 	}
 }
+
+func TestClientAuthShared(t *testing.T) {
+	c, _ := New()
+	if c.Auth() == nil || c.Auth() != c.Auth() {
+		t.Error("Expected one AuthManager per client")
+	}
+	other, _ := New()
+	if other.Auth() == c.Auth() {
+		t.Error("Expected separate clients to have separate AuthManagers")
+	}
+}
+
+func TestAuthManagerCoalescesRefresh(t *testing.T) {
+	c, _ := New()
+	auth := NewAuthManager(c)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	auth.handshake = func(s AuthStrategy) (string, AuthStrategy, error) {
+		calls.Add(1)
+		<-release
+		return "shared-crumb", s, nil
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	crumbs := make([]string, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			crumbs[i], _ = auth.GetCrumb()
+		}(i)
+	}
+	// Let every caller reach the in-flight handshake before it completes
+	for {
+		auth.mu.RLock()
+		started := auth.inflight != nil
+		auth.mu.RUnlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("Expected 1 handshake, got %d", n)
+	}
+	for i, crumb := range crumbs {
+		if crumb != "shared-crumb" {
+			t.Errorf("caller %d got crumb %q", i, crumb)
+		}
+	}
+
+	// Cached crumb: no further handshake
+	if _, err := auth.GetCrumb(); err != nil || calls.Load() != 1 {
+		t.Errorf("Expected cached crumb, got err=%v calls=%d", err, calls.Load())
+	}
+}
+
+func TestAuthManagerRefreshError(t *testing.T) {
+	c, _ := New()
+	auth := NewAuthManager(c)
+
+	failure := errors.New("rate limited")
+	var calls int
+	auth.handshake = func(AuthStrategy) (string, AuthStrategy, error) {
+		calls++
+		return "", StrategyCSRF, failure
+	}
+
+	if _, err := auth.GetCrumb(); !errors.Is(err, failure) {
+		t.Fatalf("Expected wrapped handshake error, got %v", err)
+	}
+	if auth.strategy != StrategyCSRF {
+		t.Error("Expected strategy from the handshake to be kept")
+	}
+	if auth.inflight != nil {
+		t.Error("Expected no handshake in flight after failure")
+	}
+
+	// Failures are not cached
+	_, _ = auth.GetCrumb()
+	if calls != 2 {
+		t.Errorf("Expected a new handshake after failure, got %d calls", calls)
+	}
+}
+
+func TestAuthManagerRefreshPanic(t *testing.T) {
+	c, _ := New()
+	auth := NewAuthManager(c)
+
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	auth.handshake = func(s AuthStrategy) (string, AuthStrategy, error) {
+		if calls.Add(1) > 1 {
+			// A caller that missed the panicking handshake starts its own
+			return "", s, errors.New("second handshake")
+		}
+		close(started)
+		<-release
+		panic("handshake bug")
+	}
+
+	leader := make(chan any)
+	go func() {
+		defer func() { leader <- recover() }()
+		auth.GetCrumb()
+	}()
+	<-started
+
+	waiter := make(chan error)
+	go func() {
+		_, err := auth.GetCrumb()
+		waiter <- err
+	}()
+	// Let the waiter reach the in-flight handshake
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if r := <-leader; r == nil {
+		t.Error("Expected the panic to reach the caller running the handshake")
+	}
+	select {
+	case err := <-waiter:
+		if calls.Load() == 1 && !errors.Is(err, errHandshakePanicked) {
+			t.Errorf("Expected errHandshakePanicked, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Waiter blocked after the handshake panicked")
+	}
+	if auth.inflight != nil {
+		t.Error("Expected no handshake in flight after a panic")
+	}
+}
+
+const testConsentPage = `<html><body>
+<form method="post" action="/v2/collectConsent?sessionId=3_cc-session&amp;lang=de-DE">
+<input type="hidden" name="csrfToken" value="tok123">
+<input type="hidden" name="sessionId" value="3_cc-session">
+<input type="hidden" name="originalDoneUrl" value="https://finance.yahoo.com/?guccounter=1">
+<input type="checkbox" name="marketing" value="on">
+<button type="submit" name="agree" value="agree">Accept all</button>
+<button type="submit" name="reject" value="reject">Reject all</button>
+</form></body></html>`
+
+func TestParseConsentForm(t *testing.T) {
+	form, err := parseConsentForm(testConsentPage)
+	if err != nil {
+		t.Fatalf("parseConsentForm returned error: %v", err)
+	}
+	if got := form.submitURL(); got != "https://consent.yahoo.com/v2/collectConsent?sessionId=3_cc-session&lang=de-DE" {
+		t.Errorf("Unexpected submit URL %s", got)
+	}
+
+	data := form.data()
+	want := map[string]string{
+		"agree":           "agree",
+		"csrfToken":       "tok123",
+		"sessionId":       "3_cc-session",
+		"originalDoneUrl": "https://finance.yahoo.com/?guccounter=1",
+		"namespace":       "yahoo",
+		"consentUUID":     "default",
+	}
+	for k, v := range want {
+		if data[k] != v {
+			t.Errorf("data[%s] = %q, want %q", k, data[k], v)
+		}
+	}
+	if _, ok := data["marketing"]; ok {
+		t.Error("Expected only hidden fields to be submitted")
+	}
+	if _, ok := data["reject"]; ok {
+		t.Error("Expected the consent to be accepted, not rejected")
+	}
+
+	if _, err := parseConsentForm(`<html><form action="/x"></form></html>`); err == nil {
+		t.Error("Expected an error for a page without CSRF tokens")
+	}
+	if !isConsentPage(testConsentPage) || isConsentPage("abcCrumb") {
+		t.Error("isConsentPage misclassified a response")
+	}
+}
+
+func TestIsConsentRegion(t *testing.T) {
+	for region, want := range map[string]bool{"DE": true, "fr": true, "GB": true, "CH": true, "US": false, "KR": false, "": false} {
+		if got := isConsentRegion(region); got != want {
+			t.Errorf("isConsentRegion(%q) = %v, want %v", region, got, want)
+		}
+	}
+
+	config.Reset()
+	t.Cleanup(config.Reset)
+	config.Get().SetLocale("de-DE", "DE")
+	c, _ := New()
+	if s := NewAuthManager(c).Strategy(); s != StrategyCSRF {
+		t.Errorf("Expected an EU region to start with the CSRF strategy, got %s", s)
+	}
+}
+
+// fakeConsentFlow serves a Yahoo that redirects fc.yahoo.com to a consent
+// form and only returns a crumb once the consent is submitted.
+type fakeConsentFlow struct {
+	mu        sync.Mutex
+	requested []string
+	consented bool
+}
+
+func (f *fakeConsentFlow) get(rawURL string, _ url.Values) (*Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requested = append(f.requested, "GET "+rawURL)
+	switch {
+	case rawURL == endpoints.CookieURL, rawURL == endpoints.ConsentURL:
+		if f.consented {
+			return &Response{StatusCode: 200, Body: "<html>finance</html>"}, nil
+		}
+		return &Response{StatusCode: 200, Body: testConsentPage}, nil
+	case rawURL == endpoints.CrumbURL:
+		if !f.consented {
+			return &Response{StatusCode: 200, Body: testConsentPage}, nil
+		}
+		return &Response{StatusCode: 200, Body: "crumb-eu"}, nil
+	}
+	return &Response{StatusCode: 200, Headers: map[string]string{"Set-Cookie": "A3=consented; Path=/"}}, nil
+}
+
+func (f *fakeConsentFlow) post(rawURL string, _ url.Values, body map[string]string) (*Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requested = append(f.requested, "POST "+rawURL)
+	if body["agree"] != "agree" || body["csrfToken"] != "tok123" {
+		return nil, errors.New("bad consent form")
+	}
+	f.consented = true
+	return &Response{StatusCode: 302, Headers: map[string]string{"Set-Cookie": "GUCS=abc; Path=/"}}, nil
+}
+
+func TestAuthManagerAcceptsConsent(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+
+	c, _ := New()
+	auth := NewAuthManager(c)
+	flow := &fakeConsentFlow{}
+	auth.get, auth.post = flow.get, flow.post
+
+	crumb, err := auth.GetCrumb()
+	if err != nil {
+		t.Fatalf("GetCrumb returned error: %v", err)
+	}
+	if crumb != "crumb-eu" {
+		t.Errorf("Expected crumb-eu, got %q", crumb)
+	}
+	if !auth.ConsentRequired() {
+		t.Error("Expected the consent form to be recorded")
+	}
+	if auth.Strategy() != StrategyBasic {
+		t.Errorf("Expected basic strategy to succeed after consent, got %s", auth.Strategy())
+	}
+	if c.GetCookie() == "" {
+		t.Error("Expected the consent cookies to be kept")
+	}
+}
+
+func TestAuthManagerForcedStrategy(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+	config.Get().SetAuthStrategy(config.AuthStrategyCSRF)
+
+	c, _ := New()
+	auth := NewAuthManager(c)
+	flow := &fakeConsentFlow{}
+	auth.get, auth.post = flow.get, flow.post
+
+	if _, err := auth.GetCrumb(); err != nil {
+		t.Fatalf("GetCrumb returned error: %v", err)
+	}
+	if flow.requested[0] != "GET "+endpoints.ConsentURL {
+		t.Errorf("Expected the forced CSRF strategy to start at the consent page, got %v", flow.requested)
+	}
+	for _, r := range flow.requested {
+		if r == "GET "+endpoints.CookieURL {
+			t.Error("Expected no basic handshake with a forced CSRF strategy")
+		}
+	}
+
+	config.Get().SetAuthStrategy(config.AuthStrategyBasic)
+	auth.Reset()
+	auth.get = func(rawURL string, _ url.Values) (*Response, error) {
+		return nil, errors.New("offline")
+	}
+	if _, err := auth.GetCrumb(); err == nil {
+		t.Error("Expected a forced basic strategy not to fall back")
+	}
+	if auth.Strategy() != StrategyBasic {
+		t.Errorf("Expected forced basic strategy, got %s", auth.Strategy())
+	}
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/config"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// TimeRange is the half-open time range [Start, End).
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// BarSpan is the bars and events of one series over [Start, End), with the
+// chart meta of the response they came from.
+type BarSpan struct {
+	Start   time.Time
+	End     time.Time
+	Bars    []models.Bar
+	Actions models.Actions
+	Meta    models.ChartMeta
+
+	// Fetched is when the bars were fetched. Their AdjClose only accounts
+	// for the actions known then. Store sets it to the current time if
+	// zero.
+	Fetched time.Time
+
+	expires time.Time
+}
+
+// Clip returns a copy of s restricted to [start, end), sharing no slices
+// with s.
+func (s BarSpan) Clip(start, end time.Time) BarSpan {
+	if s.Start.Before(start) {
+		s.Start = start
+	}
+	if s.End.After(end) {
+		s.End = end
+	}
+	in := func(t time.Time) bool {
+		return !t.Before(s.Start) && t.Before(s.End)
+	}
+
+	out := BarSpan{Start: s.Start, End: s.End, Meta: s.Meta, Fetched: s.Fetched, expires: s.expires}
+	for _, b := range s.Bars {
+		if in(b.Date) {
+			if b.Decimal != nil {
+				d := *b.Decimal
+				b.Decimal = &d
+			}
+			out.Bars = append(out.Bars, b)
+		}
+	}
+	for _, d := range s.Actions.Dividends {
+		if in(d.Date) {
+			out.Actions.Dividends = append(out.Actions.Dividends, d)
+		}
+	}
+	for _, sp := range s.Actions.Splits {
+		if in(sp.Date) {
+			out.Actions.Splits = append(out.Actions.Splits, sp)
+		}
+	}
+	for _, g := range s.Actions.CapitalGains {
+		if in(g.Date) {
+			out.Actions.CapitalGains = append(out.Actions.CapitalGains, g)
+		}
+	}
+	return out
+}
+
+// BarCache keeps history bars by series and time range, so that overlapping
+// history requests of the Tickers sharing a Client fetch only the ranges
+// not cached yet. Spans expire after the config cache TTL.
+//
+// It is safe for concurrent use.
+type BarCache struct {
+	mu     sync.Mutex
+	series map[string][]BarSpan // sorted by Start, non-overlapping
+}
+
+// BarCache returns the client's bar cache, or nil when caching is disabled
+// in the config (see config.EnableCache).
+func (c *Client) BarCache() *BarCache {
+	if !config.Get().IsCacheEnabled() {
+		return nil
+	}
+
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	if c.barCache == nil {
+		c.barCache = &BarCache{}
+	}
+	return c.barCache
+}
+
+// Lookup returns copies of the cached spans of series key overlapping
+// [start, end), clipped to it and oldest first, and the sub-ranges of
+// [start, end) that are not cached.
+//
+// Example:
+//
+//	spans, missing := bc.Lookup(key, start, end)
+//	for _, r := range missing {
+//	    // fetch r.Start..r.End and Store it
+//	}
+func (b *BarCache) Lookup(key string, start, end time.Time) (spans []BarSpan, missing []TimeRange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	cursor := start
+	live := b.series[key][:0]
+	for _, s := range b.series[key] {
+		if !now.Before(s.expires) {
+			continue
+		}
+		live = append(live, s)
+		if !s.End.After(start) || !s.Start.Before(end) {
+			continue
+		}
+		if s.Start.After(cursor) {
+			missing = append(missing, TimeRange{Start: cursor, End: s.Start})
+		}
+		spans = append(spans, s.Clip(start, end))
+		if s.End.After(cursor) {
+			cursor = s.End
+		}
+	}
+	b.setSeries(key, live)
+
+	if cursor.Before(end) {
+		missing = append(missing, TimeRange{Start: cursor, End: end})
+	}
+	return spans, missing
+}
+
+// Store caches a copy of span for series key, clipped to its own range,
+// replacing the overlapping parts of spans already cached.
+func (b *BarCache) Store(key string, span BarSpan) {
+	if !span.Start.Before(span.End) {
+		return
+	}
+	ttl := config.Get().GetCacheTTL()
+	if ttl <= 0 {
+		ttl = config.DefaultCacheTTL
+	}
+	span = span.Clip(span.Start, span.End)
+	now := time.Now()
+	if span.Fetched.IsZero() {
+		span.Fetched = now
+	}
+	span.expires = now.Add(ttl)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var kept []BarSpan
+	for _, s := range b.series[key] {
+		if !s.End.After(span.Start) || !s.Start.Before(span.End) {
+			kept = append(kept, s)
+			continue
+		}
+		if s.Start.Before(span.Start) {
+			kept = append(kept, s.Clip(s.Start, span.Start))
+		}
+		if s.End.After(span.End) {
+			kept = append(kept, s.Clip(span.End, s.End))
+		}
+	}
+	kept = append(kept, span)
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Start.Before(kept[j].Start) })
+	b.setSeries(key, kept)
+}
+
+// Drop drops the cached spans of series key.
+func (b *BarCache) Drop(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.series, key)
+}
+
+// Clear drops every cached span.
+func (b *BarCache) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.series = nil
+}
+
+// setSeries replaces the spans of key; b.mu must be held.
+func (b *BarCache) setSeries(key string, spans []BarSpan) {
+	if len(spans) == 0 {
+		delete(b.series, key)
+		return
+	}
+	if b.series == nil {
+		b.series = make(map[string][]BarSpan)
+	}
+	b.series[key] = spans
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/config"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+}
+
+func dailyBars(from, to int) []models.Bar {
+	var bars []models.Bar
+	for d := from; d < to; d++ {
+		bars = append(bars, models.Bar{Date: day(d).Add(14 * time.Hour), Close: float64(d)})
+	}
+	return bars
+}
+
+func TestBarCache(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+
+	c, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	if c.BarCache() != nil {
+		t.Fatal("Bar cache should be nil while caching is disabled")
+	}
+	config.Get().EnableCache(time.Minute)
+	bc := c.BarCache()
+	if bc == nil || c.BarCache() != bc {
+		t.Fatal("Bar cache should be created once and shared")
+	}
+
+	const key = "AAPL 1d"
+	bc.Store(key, BarSpan{
+		Start:   day(5),
+		End:     day(10),
+		Bars:    dailyBars(4, 11), // bars outside the span are dropped
+		Actions: models.Actions{Dividends: []models.Dividend{{Date: day(6), Amount: 0.25}, {Date: day(12)}}},
+		Meta:    models.ChartMeta{Symbol: "AAPL"},
+	})
+	bc.Store(key, BarSpan{Start: day(15), End: day(20), Bars: dailyBars(15, 20)})
+
+	spans, missing := bc.Lookup(key, day(1), day(25))
+	if len(spans) != 2 || len(spans[0].Bars) != 5 || len(spans[1].Bars) != 5 {
+		t.Fatalf("spans = %+v", spans)
+	}
+	if len(spans[0].Actions.Dividends) != 1 || spans[0].Meta.Symbol != "AAPL" {
+		t.Errorf("span events or meta not kept: %+v", spans[0])
+	}
+	want := []TimeRange{{day(1), day(5)}, {day(10), day(15)}, {day(20), day(25)}}
+	if len(missing) != len(want) {
+		t.Fatalf("missing = %v, want %v", missing, want)
+	}
+	for i := range want {
+		if !missing[i].Start.Equal(want[i].Start) || !missing[i].End.Equal(want[i].End) {
+			t.Errorf("missing[%d] = %v, want %v", i, missing[i], want[i])
+		}
+	}
+
+	// Lookups return copies clipped to the requested range
+	spans, missing = bc.Lookup(key, day(7), day(9))
+	if len(missing) != 0 || len(spans) != 1 || len(spans[0].Bars) != 2 {
+		t.Fatalf("clipped lookup = %+v, missing %v", spans, missing)
+	}
+	spans[0].Bars[0].Close = -1
+	if again, _ := bc.Lookup(key, day(7), day(9)); again[0].Bars[0].Close != 7 {
+		t.Error("Lookup should not share bars with callers")
+	}
+
+	// A new span replaces the overlapping part of cached ones
+	bc.Store(key, BarSpan{Start: day(8), End: day(16), Bars: []models.Bar{{Date: day(9).Add(14 * time.Hour), Close: 99}}})
+	spans, _ = bc.Lookup(key, day(5), day(20))
+	if len(spans) != 3 || !spans[0].End.Equal(day(8)) || !spans[2].Start.Equal(day(16)) || spans[1].Bars[0].Close != 99 {
+		t.Errorf("spans after overlapping store = %+v", spans)
+	}
+
+	if spans[0].Fetched.IsZero() {
+		t.Error("Store should record the fetch time")
+	}
+	bc.Drop(key)
+	if spans, _ := bc.Lookup(key, day(1), day(25)); len(spans) != 0 {
+		t.Errorf("Drop should drop the series, got %d spans", len(spans))
+	}
+
+	bc.Store(key, BarSpan{Start: day(15), End: day(20), Bars: dailyBars(15, 20)})
+	c.ClearCache()
+	if spans, _ := bc.Lookup(key, day(1), day(25)); len(spans) != 0 {
+		t.Errorf("ClearCache should drop cached bars, got %d spans", len(spans))
+	}
+}
@@ -1,15 +1,21 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/Danny-Dasilva/CycleTLS/cycletls"
 
+	"github.com/wnjoon/go-yfinance/pkg/cache"
 	"github.com/wnjoon/go-yfinance/pkg/config"
 )
 
@@ -29,6 +35,24 @@ type Client struct {
 
 	// Cookie storage for authentication
 	cookies map[string]string
+
+	// Shared authentication, created on first use by Auth
+	authOnce sync.Once
+	auth     *AuthManager
+
+	// Request counters per endpoint category
+	stats RequestStats
+
+	// Response and bar caches, created when caching is first enabled
+	cacheMu      sync.Mutex
+	respCache    *cache.Cache
+	barCache     *BarCache
+	latencySaved atomic.Int64
+
+	// References taken with AcquireDefault, guarded by defaultMu; a
+	// detached client has been replaced by ResetDefault
+	refs     int
+	detached bool
 }
 
 // Chrome JA3 fingerprint for TLS spoofing
@@ -113,43 +137,140 @@ type Response struct {
 }
 
 // Get performs an HTTP GET request.
+//
+// When caching is enabled in the config, successful responses are cached
+// for the configured TTL and served without a request; see
+// [Client.CacheStats].
 func (c *Client) Get(rawURL string, params url.Values) (*Response, error) {
-	c.init()
-
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	return c.GetContext(context.Background(), rawURL, params)
+}
 
+// GetContext is like [Client.Get] but stops waiting for the response when
+// ctx is done and returns ctx's error. A ctx deadline earlier than the
+// client timeout also shortens the request timeout.
+//
+// The underlying TLS client cannot abort a request in flight, so a
+// cancelled request finishes in the background, bounded by the client
+// timeout, and its response is discarded.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	resp, err := c.GetContext(ctx, endpoints.QuoteURL, params)
+//	if errors.Is(err, context.DeadlineExceeded) {
+//	    log.Println("Yahoo did not answer within 5s")
+//	}
+func (c *Client) GetContext(ctx context.Context, rawURL string, params url.Values) (*Response, error) {
+	fullURL := rawURL
 	if len(params) > 0 {
-		rawURL = fmt.Sprintf("%s?%s", rawURL, params.Encode())
+		fullURL = fmt.Sprintf("%s?%s", rawURL, params.Encode())
 	}
 
-	headers := map[string]string{
+	rc := c.responseCache(rawURL)
+	if resp, ok := c.cachedResponse(rc, fullURL); ok {
+		return resp, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("GET request failed: %w", contextError(err))
+	}
+
+	c.init()
+	c.record(ctx, rawURL)
+
+	start := time.Now()
+	resp, err := c.do(ctx, "GET", fullURL, "", map[string]string{
 		"Accept":          "application/json,text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
 		"Accept-Language": "en-US,en;q=0.5",
 		"Connection":      "keep-alive",
+	})
+	if err != nil {
+		return nil, err
 	}
+	c.storeResponse(rc, fullURL, resp, time.Since(start))
+	return resp, nil
+}
 
-	// Add cookie if available
-	if cookie := c.cookieHeaderLocked(); cookie != "" {
-		headers["Cookie"] = cookie
+// do sends a request with the cookies of the client. When ctx can be done,
+// the request runs in its own goroutine and do returns as soon as ctx is
+// done, leaving the request to finish in the background.
+func (c *Client) do(ctx context.Context, method, rawURL, body string, headers map[string]string) (*Response, error) {
+	send := func() (*Response, error) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		// Add cookie if available
+		if cookie := c.cookieHeaderLocked(); cookie != "" {
+			headers["Cookie"] = cookie
+		}
+
+		resp, err := c.cycleTLS.Do(rawURL, cycletls.Options{
+			Timeout:   requestTimeout(ctx, c.timeout),
+			Ja3:       c.ja3,
+			UserAgent: c.userAgent,
+			Proxy:     c.proxy,
+			Body:      body,
+			Headers:   headers,
+		}, method)
+		if err != nil {
+			return nil, fmt.Errorf("%s request failed: %w", method, WrapNetworkError(err))
+		}
+
+		return &Response{
+			StatusCode: resp.Status,
+			Body:       resp.Body,
+			Headers:    resp.Headers,
+		}, nil
 	}
 
-	resp, err := c.cycleTLS.Do(rawURL, cycletls.Options{
-		Timeout:   c.timeout,
-		Ja3:       c.ja3,
-		UserAgent: c.userAgent,
-		Proxy:     c.proxy,
-		Headers:   headers,
-	}, "GET")
-	if err != nil {
-		return nil, fmt.Errorf("GET request failed: %w", err)
+	if ctx.Done() == nil {
+		return send()
 	}
 
-	return &Response{
-		StatusCode: resp.Status,
-		Body:       resp.Body,
-		Headers:    resp.Headers,
-	}, nil
+	type result struct {
+		resp *Response
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := send()
+		done <- result{resp, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.resp, r.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s request failed: %w", method, contextError(ctx.Err()))
+	}
+}
+
+// requestTimeout returns the request timeout in seconds: the client
+// timeout, or the time left until ctx's deadline if that is sooner, but at
+// least one second.
+func requestTimeout(ctx context.Context, timeout int) int {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return timeout
+	}
+	left := int(math.Ceil(time.Until(deadline).Seconds()))
+	if left < 1 {
+		left = 1
+	}
+	if left < timeout {
+		return left
+	}
+	return timeout
+}
+
+// contextError wraps an expired ctx deadline as a timeout error, so that
+// [IsTimeoutError] matches it. Both keep matching the context errors with
+// errors.Is.
+func contextError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return WrapTimeoutError(err)
+	}
+	return err
 }
 
 // SetCookie sets or replaces one cookie for subsequent requests.
@@ -213,7 +334,13 @@ func (c *Client) cookieHeaderLocked() string {
 
 // GetJSON performs an HTTP GET request and unmarshals the JSON response.
 func (c *Client) GetJSON(rawURL string, params url.Values, v interface{}) error {
-	resp, err := c.Get(rawURL, params)
+	return c.GetJSONContext(context.Background(), rawURL, params, v)
+}
+
+// GetJSONContext is like [Client.GetJSON] but honors ctx as
+// [Client.GetContext] does.
+func (c *Client) GetJSONContext(ctx context.Context, rawURL string, params url.Values, v interface{}) error {
+	resp, err := c.GetContext(ctx, rawURL, params)
 	if err != nil {
 		return err
 	}
@@ -231,101 +358,118 @@ func (c *Client) GetJSON(rawURL string, params url.Values, v interface{}) error
 
 // Post performs an HTTP POST request with form data.
 func (c *Client) Post(rawURL string, params url.Values, body map[string]string) (*Response, error) {
-	c.init()
+	return c.PostContext(context.Background(), rawURL, params, body)
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+// PostContext is like [Client.Post] but honors ctx as [Client.GetContext]
+// does.
+func (c *Client) PostContext(ctx context.Context, rawURL string, params url.Values, body map[string]string) (*Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("POST request failed: %w", contextError(err))
+	}
+
+	c.init()
+	c.record(ctx, rawURL)
 
 	if len(params) > 0 {
 		rawURL = fmt.Sprintf("%s?%s", rawURL, params.Encode())
 	}
 
-	headers := map[string]string{
+	return c.do(ctx, "POST", rawURL, mapToFormData(body), map[string]string{
 		"Accept":          "application/json,text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
 		"Accept-Language": "en-US,en;q=0.5",
 		"Content-Type":    "application/x-www-form-urlencoded",
 		"Connection":      "keep-alive",
-	}
-
-	// Add cookie if available
-	if cookie := c.cookieHeaderLocked(); cookie != "" {
-		headers["Cookie"] = cookie
-	}
-
-	resp, err := c.cycleTLS.Do(rawURL, cycletls.Options{
-		Timeout:   c.timeout,
-		Ja3:       c.ja3,
-		UserAgent: c.userAgent,
-		Proxy:     c.proxy,
-		Body:      mapToFormData(body),
-		Headers:   headers,
-	}, "POST")
-	if err != nil {
-		return nil, fmt.Errorf("POST request failed: %w", err)
-	}
-
-	return &Response{
-		StatusCode: resp.Status,
-		Body:       resp.Body,
-		Headers:    resp.Headers,
-	}, nil
+	})
 }
 
 // PostJSON performs an HTTP POST request with JSON body.
 func (c *Client) PostJSON(rawURL string, params url.Values, body []byte) (*Response, error) {
-	c.init()
+	return c.PostJSONContext(context.Background(), rawURL, params, body)
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+// PostJSONContext is like [Client.PostJSON] but honors ctx as
+// [Client.GetContext] does.
+func (c *Client) PostJSONContext(ctx context.Context, rawURL string, params url.Values, body []byte) (*Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("POST request failed: %w", contextError(err))
+	}
+
+	c.init()
+	c.record(ctx, rawURL)
 
 	if len(params) > 0 {
 		rawURL = fmt.Sprintf("%s?%s", rawURL, params.Encode())
 	}
 
-	headers := map[string]string{
+	return c.do(ctx, "POST", rawURL, string(body), map[string]string{
 		"Accept":          "application/json",
 		"Accept-Language": "en-US,en;q=0.5",
 		"Content-Type":    "application/json",
 		"Connection":      "keep-alive",
-	}
+	})
+}
 
-	// Add cookie if available
-	if cookie := c.cookieHeaderLocked(); cookie != "" {
-		headers["Cookie"] = cookie
-	}
+// PostJSONIdempotent performs a JSON POST that is safe to repeat, retrying
+// it like [Retry] with [DefaultRetryPolicy] on network errors and on HTTP
+// 429 and 5xx responses.
+//
+// Only use it for bodies that read data, such as screener and calendar
+// queries: a retried request may have reached Yahoo before it failed.
+func (c *Client) PostJSONIdempotent(rawURL string, params url.Values, body []byte) (*Response, error) {
+	return c.PostJSONIdempotentContext(context.Background(), rawURL, params, body)
+}
 
-	resp, err := c.cycleTLS.Do(rawURL, cycletls.Options{
-		Timeout:   c.timeout,
-		Ja3:       c.ja3,
-		UserAgent: c.userAgent,
-		Proxy:     c.proxy,
-		Body:      string(body),
-		Headers:   headers,
-	}, "POST")
-	if err != nil {
-		return nil, fmt.Errorf("POST request failed: %w", err)
-	}
+// PostJSONIdempotentContext is like [Client.PostJSONIdempotent] but stops
+// retrying and returns ctx's error as soon as ctx is done.
+//
+// Example:
+//
+//	resp, err := c.PostJSONIdempotentContext(ctx, endpoints.ScreenerURL, nil, body)
+//	var rerr *client.RetryError
+//	if errors.As(err, &rerr) {
+//	    log.Printf("screener gave up after %d attempts", len(rerr.Attempts))
+//	}
+func (c *Client) PostJSONIdempotentContext(ctx context.Context, rawURL string, params url.Values, body []byte) (*Response, error) {
+	return retryResponse(ctx, DefaultRetryPolicy(), func() (*Response, error) {
+		return c.PostJSONContext(ctx, rawURL, params, body)
+	}, sleepContext)
+}
 
-	return &Response{
-		StatusCode: resp.Status,
-		Body:       resp.Body,
-		Headers:    resp.Headers,
-	}, nil
+// Stats returns the request counters of the client.
+//
+// Every request made through the client is counted, including authentication
+// requests, so a client shared by several tickers reports their combined
+// footprint.
+//
+// Example:
+//
+//	for category, n := range c.Stats().Counts() {
+//	    fmt.Printf("%s: %d\n", category, n)
+//	}
+func (c *Client) Stats() *RequestStats {
+	return &c.stats
 }
 
 // Close closes the CycleTLS client.
 func (c *Client) Close() {
+	c.closeResponseCache()
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Only close if initialized and not already closed
-	if c.initialized && !c.closed {
+	if c.closed {
+		return
+	}
+	c.closed = true
+
+	// Only close CycleTLS if it was initialized
+	if c.initialized {
 		// Recover from panic in case CycleTLS has internal nil channel issue
 		defer func() {
 			_ = recover() // Silently ignore panic from CycleTLS close
 		}()
 		c.cycleTLS.Close()
-		c.closed = true
 	}
 }
 
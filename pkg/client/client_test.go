@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -91,6 +93,78 @@ func TestNewClientUsesGlobalConfig(t *testing.T) {
 	}
 }
 
+func TestDefaultClient(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+	ResetDefault()
+	t.Cleanup(ResetDefault)
+
+	config.Get().SetUserAgent("first-agent")
+	c1, err := Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	c2, _ := Default()
+	if c1 != c2 {
+		t.Error("Default() should return the same client")
+	}
+	if c1.userAgent != "first-agent" {
+		t.Errorf("Expected global user agent, got %q", c1.userAgent)
+	}
+
+	config.Get().SetUserAgent("second-agent")
+	ResetDefault()
+	c3, _ := Default()
+	if c3 == c1 {
+		t.Error("ResetDefault() should discard the shared client")
+	}
+	if c3.userAgent != "second-agent" {
+		t.Errorf("Expected updated user agent, got %q", c3.userAgent)
+	}
+	if !c1.isClosed() {
+		t.Error("ResetDefault() should close an old client nobody holds")
+	}
+}
+
+func TestAcquireDefault(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+	ResetDefault()
+	t.Cleanup(ResetDefault)
+
+	c1, err := AcquireDefault()
+	if err != nil {
+		t.Fatalf("AcquireDefault() error: %v", err)
+	}
+	c2, _ := AcquireDefault()
+	if c1 != c2 {
+		t.Fatal("AcquireDefault() should return the shared client")
+	}
+
+	ResetDefault()
+	if c1.isClosed() {
+		t.Fatal("ResetDefault() should keep an acquired client open")
+	}
+	c1.Release()
+	if c1.isClosed() {
+		t.Fatal("Release() should keep the client open while references remain")
+	}
+	c2.Release()
+	if !c1.isClosed() {
+		t.Error("the last Release() after ResetDefault() should close the client")
+	}
+	c2.Release() // extra releases are ignored
+
+	c3, _ := AcquireDefault()
+	if c3 == c1 {
+		t.Error("AcquireDefault() should create a new client after ResetDefault()")
+	}
+	c3.Release()
+	if c3.isClosed() {
+		t.Error("Release() should not close the current shared client")
+	}
+}
+
 func TestClientCookieMerge(t *testing.T) {
 	config.Reset()
 	t.Cleanup(config.Reset)
@@ -131,3 +205,91 @@ func TestRandomUserAgent(t *testing.T) {
 		t.Error("RandomUserAgent should return a value from UserAgents list")
 	}
 }
+
+func TestEndpointCategory(t *testing.T) {
+	tests := map[string]string{
+		"https://query2.finance.yahoo.com/v8/finance/chart/AAPL?range=1d":           CategoryChart,
+		"https://query2.finance.yahoo.com/v10/finance/quoteSummary/AAPL":            CategoryQuoteSummary,
+		"https://query1.finance.yahoo.com/v7/finance/quote?symbols=AAPL":            CategoryQuote,
+		"https://query1.finance.yahoo.com/v6/finance/quote/marketSummary":           CategoryMarketSummary,
+		"https://query2.finance.yahoo.com/ws/fundamentals-timeseries/v1/finance/ts": CategoryFundamentals,
+		"https://query1.finance.yahoo.com/v1/finance/quoteType/AAPL":                CategoryQuoteType,
+		"https://query2.finance.yahoo.com/v1/test/getcrumb":                         CategoryAuth,
+		"https://fc.yahoo.com":    CategoryAuth,
+		"https://example.com/foo": CategoryOther,
+	}
+	for rawURL, want := range tests {
+		if got := EndpointCategory(rawURL); got != want {
+			t.Errorf("EndpointCategory(%q) = %q, want %q", rawURL, got, want)
+		}
+	}
+}
+
+func TestRequestStats(t *testing.T) {
+	var s RequestStats
+	s.Record("https://query2.finance.yahoo.com/v8/finance/chart/AAPL")
+	s.Record("https://query2.finance.yahoo.com/v8/finance/chart/MSFT")
+	s.Record("https://query2.finance.yahoo.com/v10/finance/quoteSummary/AAPL")
+
+	counts := s.Counts()
+	if counts[CategoryChart] != 2 || counts[CategoryQuoteSummary] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+	if s.Total() != 3 {
+		t.Errorf("expected total 3, got %d", s.Total())
+	}
+
+	counts[CategoryChart] = 100
+	if s.Counts()[CategoryChart] != 2 {
+		t.Error("Counts should return a copy")
+	}
+
+	s.Reset()
+	if s.Total() != 0 {
+		t.Errorf("expected 0 after reset, got %d", s.Total())
+	}
+}
+
+func TestContextRequestsCancelled(t *testing.T) {
+	c, _ := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.GetContext(ctx, "https://query2.finance.yahoo.com/v7/finance/quote", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := c.PostJSONContext(ctx, "https://query2.finance.yahoo.com/v1/finance/screener", nil, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("PostJSONContext: expected context.Canceled, got %v", err)
+	}
+	if c.Stats().Total() != 0 {
+		t.Errorf("expected no requests to be sent, got %d", c.Stats().Total())
+	}
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	_, err := c.GetContext(ctx, "https://query2.finance.yahoo.com/v7/finance/quote", nil)
+	if !errors.Is(err, context.DeadlineExceeded) || !IsTimeoutError(err) {
+		t.Errorf("expected a timeout wrapping context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	if got := requestTimeout(context.Background(), 30); got != 30 {
+		t.Errorf("no deadline: expected 30, got %d", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 4500*time.Millisecond)
+	defer cancel()
+	if got := requestTimeout(ctx, 30); got != 5 {
+		t.Errorf("4.5s deadline: expected 5, got %d", got)
+	}
+	if got := requestTimeout(ctx, 2); got != 2 {
+		t.Errorf("client timeout sooner: expected 2, got %d", got)
+	}
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if got := requestTimeout(ctx, 30); got != 1 {
+		t.Errorf("past deadline: expected 1, got %d", got)
+	}
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/internal/endpoints"
+)
+
+// consentRegions are the regions where Yahoo puts a GDPR consent form in
+// front of its cookies: the EEA, the UK and Switzerland.
+var consentRegions = map[string]bool{
+	"AT": true, "BE": true, "BG": true, "CH": true, "CY": true, "CZ": true,
+	"DE": true, "DK": true, "EE": true, "ES": true, "FI": true, "FR": true,
+	"GB": true, "GR": true, "HR": true, "HU": true, "IE": true, "IS": true,
+	"IT": true, "LI": true, "LT": true, "LU": true, "LV": true, "MT": true,
+	"NL": true, "NO": true, "PL": true, "PT": true, "RO": true, "SE": true,
+	"SI": true, "SK": true, "UK": true,
+}
+
+var (
+	consentFormRe  = regexp.MustCompile(`(?is)<form[^>]*action=["']([^"']*)["']`)
+	consentInputRe = regexp.MustCompile(`(?is)<input[^>]*>`)
+	attrRe         = regexp.MustCompile(`(?is)\b(name|value|type)=["']([^"']*)["']`)
+)
+
+// isConsentRegion reports whether region (a config locale region such as
+// "DE") is subject to the consent form.
+func isConsentRegion(region string) bool {
+	return consentRegions[strings.ToUpper(strings.TrimSpace(region))]
+}
+
+// isConsentPage reports whether body is a Yahoo consent form rather than
+// the page or crumb that was requested.
+func isConsentPage(body string) bool {
+	if strings.Contains(body, "collectConsent") || strings.Contains(body, "consent.yahoo.com") {
+		return true
+	}
+	return strings.Contains(body, `name="csrfToken"`) && strings.Contains(body, `name="sessionId"`)
+}
+
+// consentForm is the consent form of a Yahoo consent page.
+type consentForm struct {
+	action string
+	fields map[string]string
+}
+
+// parseConsentForm extracts the form action and hidden fields of a consent
+// page; csrfToken and sessionId are required.
+func parseConsentForm(page string) (*consentForm, error) {
+	form := &consentForm{fields: make(map[string]string)}
+	if m := consentFormRe.FindStringSubmatch(page); m != nil {
+		form.action = html.UnescapeString(m[1])
+	}
+
+	for _, input := range consentInputRe.FindAllString(page, -1) {
+		attrs := make(map[string]string, 3)
+		for _, m := range attrRe.FindAllStringSubmatch(input, -1) {
+			attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2])
+		}
+		if attrs["name"] == "" || !strings.EqualFold(attrs["type"], "hidden") {
+			continue
+		}
+		form.fields[attrs["name"]] = attrs["value"]
+	}
+
+	if form.fields["csrfToken"] == "" || form.fields["sessionId"] == "" {
+		return nil, fmt.Errorf("failed to extract CSRF tokens")
+	}
+	return form, nil
+}
+
+// data returns the form fields accepting the consent.
+func (f *consentForm) data() map[string]string {
+	data := map[string]string{
+		"agree":           "agree",
+		"consentUUID":     "default",
+		"originalDoneUrl": "https://finance.yahoo.com/",
+		"namespace":       "yahoo",
+	}
+	for k, v := range f.fields {
+		if v != "" || data[k] == "" {
+			data[k] = v
+		}
+	}
+	return data
+}
+
+// submitURL returns the URL the form posts to, resolving a relative action
+// against consent.yahoo.com.
+func (f *consentForm) submitURL() string {
+	fallback := fmt.Sprintf("%s?sessionId=%s", endpoints.CollectConsentURL, url.QueryEscape(f.fields["sessionId"]))
+	if f.action == "" {
+		return fallback
+	}
+	base, _ := url.Parse(endpoints.CollectConsentURL)
+	action, err := url.Parse(f.action)
+	if err != nil {
+		return fallback
+	}
+	return base.ResolveReference(action).String()
+}
+
+// submitConsent accepts the consent form of page and copies the consent to
+// the Yahoo domains, keeping the cookies set on the way.
+func (a *AuthManager) submitConsent(page string) error {
+	form, err := parseConsentForm(page)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.post(form.submitURL(), nil, form.data())
+	if err != nil {
+		return fmt.Errorf("failed to submit consent: %w", err)
+	}
+	a.extractCookies(resp.Headers)
+
+	copyURL := fmt.Sprintf("%s?sessionId=%s", endpoints.CopyConsentURL, url.QueryEscape(form.fields["sessionId"]))
+	resp, err = a.get(copyURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to copy consent: %w", err)
+	}
+	a.extractCookies(resp.Headers)
+	return nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import "sync"
+
+var (
+	defaultMu     sync.Mutex
+	defaultClient *Client
+)
+
+// Default returns the process-wide shared client.
+//
+// The client is created on first use from the global config; if it has
+// been closed, a new one is created. Code that keeps the client beyond a
+// single call should use [AcquireDefault] instead, so that [ResetDefault]
+// does not close it while it is still in use.
+//
+// Example:
+//
+//	c, err := client.Default()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(c.Stats().Total()) // requests made by all default instances
+func Default() (*Client, error) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	return defaultLocked()
+}
+
+// AcquireDefault returns the shared client like [Default] and takes a
+// reference on it, which the caller gives back with [Client.Release].
+//
+// Package constructors (ticker.New, search.New, ...) acquire the shared
+// client unless one is passed with their WithClient option, and release it
+// in their Close method, so creating many instances does not open a
+// CycleTLS session each.
+//
+// Example:
+//
+//	c, err := client.AcquireDefault()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer c.Release()
+func AcquireDefault() (*Client, error) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	c, err := defaultLocked()
+	if err != nil {
+		return nil, err
+	}
+	c.refs++
+	return c, nil
+}
+
+// defaultLocked returns the shared client, creating it if needed.
+// defaultMu must be held.
+func defaultLocked() (*Client, error) {
+	if defaultClient != nil && !defaultClient.isClosed() {
+		return defaultClient, nil
+	}
+
+	c, err := New()
+	if err != nil {
+		return nil, err
+	}
+	defaultClient = c
+	return c, nil
+}
+
+// Release gives back a reference taken with [AcquireDefault]. Once the
+// shared client has been replaced by [ResetDefault], the last release
+// closes it. Release is a no-op for clients without references.
+func (c *Client) Release() {
+	defaultMu.Lock()
+	if c.refs == 0 {
+		defaultMu.Unlock()
+		return
+	}
+	c.refs--
+	last := c.refs == 0 && c.detached
+	defaultMu.Unlock()
+
+	if last {
+		c.Close()
+	}
+}
+
+// ResetDefault discards the shared client. The next call to [Default]
+// creates a new one, picking up changes made to the global config since.
+//
+// The old client is closed right away if nothing holds a reference on it,
+// and otherwise by the last [Client.Release] of the instances created
+// before the reset, which keep using it until then.
+//
+// Example:
+//
+//	config.Get().SetProxy("http://proxy:8080")
+//	client.ResetDefault()
+func ResetDefault() {
+	defaultMu.Lock()
+	old := defaultClient
+	defaultClient = nil
+	unused := false
+	if old != nil {
+		old.detached = true
+		unused = old.refs == 0
+	}
+	defaultMu.Unlock()
+
+	if unused {
+		old.Close()
+	}
+}
+
+// isClosed reports whether Close has been called on the client.
+func (c *Client) isClosed() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.closed
+}
@@ -14,6 +14,18 @@
 //   - CSRF: Uses guce.yahoo.com consent flow (for EU users)
 //
 // The AuthManager automatically falls back to the alternate strategy if one fails.
+// When Yahoo answers with a GDPR consent form, the form is accepted
+// automatically, and clients whose config region is in the EEA, the UK or
+// Switzerland start with the CSRF strategy. To disable the fallback and
+// always use one strategy:
+//
+//	config.Get().SetAuthStrategy(config.AuthStrategyCSRF)
+//
+// Each client has one AuthManager, returned by [Client.Auth] and shared by
+// every package instance using the client, so a Ticker, Sector and
+// Calendars created together perform a single cookie/crumb handshake.
+// Concurrent crumb requests made while a handshake is running wait for it
+// and share its result.
 //
 // # Usage
 //
@@ -28,6 +40,74 @@
 //
 //	resp, err := c.Get("https://query2.finance.yahoo.com/v8/finance/chart/AAPL", nil)
 //
+// # Shared Client
+//
+// Package constructors such as ticker.New and search.New share the client
+// returned by [Default] unless one is passed with their WithClient option,
+// so casual use opens a single CycleTLS session. It is created from the
+// global config on first use; call [ResetDefault] to apply later changes.
+// Instances hold a reference on it taken with [AcquireDefault] and give it
+// back in their Close method; a client replaced by ResetDefault is closed
+// once the last reference is released.
+//
+// # Symbol Lookup
+//
+// [Client.QuoteType] resolves a symbol's quote type, exchange and timezone
+// in one small request and reports unknown symbols as [ErrInvalidSymbol]:
+//
+//	st, err := c.QuoteType("AAPL")
+//
+// # Request Statistics
+//
+// Every request is counted per endpoint category ("chart", "quoteSummary",
+// "auth", ...). Share one client between tickers to audit their combined
+// footprint:
+//
+//	fmt.Println(c.Stats().Counts(), c.Stats().Total())
+//
+// # Response Cache
+//
+// With caching enabled in the config, successful GET responses are cached
+// for CacheTTL. Cache hits are not counted as requests; [Client.CacheStats]
+// reports hits, misses and the latency they saved:
+//
+//	config.Get().EnableCache(5 * time.Minute)
+//	st := c.CacheStats()
+//	fmt.Printf("%.0f%% hits, saved %s\n", st.HitRatio()*100, st.LatencySaved)
+//
+// # Bar Cache
+//
+// Caching also enables the client's [BarCache], which keeps history bars
+// by symbol and time range. Tickers sharing the client, including those of
+// the download package, serve overlapping Start/End history requests from
+// it and fetch only the missing ranges. [Client.ClearCache] clears both
+// caches.
+//
+// # Retries
+//
+// [Retry] repeats a call on transient errors (see [IsRetryable]) with a
+// doubling delay. When retries are exhausted the error is a [*RetryError]
+// holding every attempt's status code, delay and error:
+//
+//	err := client.Retry(ctx, client.DefaultRetryPolicy(), fetch)
+//	var rerr *client.RetryError
+//	if errors.As(err, &rerr) {
+//	    fmt.Println(len(rerr.Attempts), rerr.TotalDelay())
+//	}
+//
+// [Client.PostJSONIdempotent] applies the same policy to JSON POSTs that
+// only read data, such as screener and calendar queries, retrying network
+// errors and HTTP 429 and 5xx responses.
+//
+// # Cancellation
+//
+// [Client.GetContext], [Client.GetJSONContext], [Client.PostContext] and
+// [Client.PostJSONContext] return as soon as their context is done, with
+// an error matching context.Canceled or context.DeadlineExceeded; an
+// expired deadline also matches [IsTimeoutError]. The TLS client cannot
+// abort a request in flight, so an abandoned request still runs to
+// completion in the background, bounded by the client timeout.
+//
 // # Error Handling
 //
 // The package provides typed errors via [YFError] for easy error handling:
@@ -36,5 +116,7 @@
 //	    // Handle rate limiting
 //	}
 //
-// See [ErrorCode] for all available error types.
+// See [ErrorCode] for all available error types. A [YFError] also matches
+// the yferr sentinel of its kind, such as yferr.ErrRateLimited, which
+// higher-level packages use for their own errors.
 package client
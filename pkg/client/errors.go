@@ -3,6 +3,8 @@ package client
 import (
 	"errors"
 	"fmt"
+
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // ErrorCode represents the type of error.
@@ -34,6 +36,9 @@ type YFError struct {
 	Code    ErrorCode
 	Message string
 	Cause   error
+
+	// StatusCode is the HTTP status that caused the error, or 0.
+	StatusCode int
 }
 
 // Error implements the error interface.
@@ -49,8 +54,20 @@ func (e *YFError) Unwrap() error {
 	return e.Cause
 }
 
-// Is reports whether the error matches the target.
+// Is reports whether the error matches the target: a YFError with the
+// same code, or the [yferr] sentinel of its kind. Not found errors match
+// yferr.ErrNoData.
 func (e *YFError) Is(target error) bool {
+	switch target {
+	case yferr.ErrNoData:
+		return e.Code == ErrCodeNoData || e.Code == ErrCodeNotFound
+	case yferr.ErrRateLimited:
+		return e.Code == ErrCodeRateLimit
+	case yferr.ErrAuth:
+		return e.Code == ErrCodeAuth
+	case yferr.ErrParse:
+		return e.Code == ErrCodeInvalidResponse
+	}
 	t, ok := target.(*YFError)
 	if !ok {
 		return false
@@ -151,19 +168,22 @@ func IsTimeoutError(err error) bool {
 
 // HTTPStatusToError converts an HTTP status code to an appropriate error.
 func HTTPStatusToError(statusCode int, body string) *YFError {
+	var err *YFError
 	switch statusCode {
 	case 401, 403:
-		return WrapAuthError(fmt.Errorf("HTTP %d", statusCode))
+		err = WrapAuthError(fmt.Errorf("HTTP %d", statusCode))
 	case 404:
-		return NewError(ErrCodeNotFound, "resource not found", nil)
+		err = NewError(ErrCodeNotFound, "resource not found", nil)
 	case 429:
-		return WrapRateLimitError()
+		err = WrapRateLimitError()
 	case 500, 502, 503, 504:
-		return NewError(ErrCodeNetwork, fmt.Sprintf("server error: HTTP %d", statusCode), nil)
+		err = NewError(ErrCodeNetwork, fmt.Sprintf("server error: HTTP %d", statusCode), nil)
 	default:
-		if statusCode >= 400 {
-			return NewError(ErrCodeUnknown, fmt.Sprintf("HTTP %d: %s", statusCode, body), nil)
+		if statusCode < 400 {
+			return nil
 		}
-		return nil
+		err = NewError(ErrCodeUnknown, fmt.Sprintf("HTTP %d: %s", statusCode, body), nil)
 	}
+	err.StatusCode = statusCode
+	return err
 }
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 func TestYFError(t *testing.T) {
@@ -55,6 +57,27 @@ func TestYFErrorIs(t *testing.T) {
 	}
 }
 
+func TestYFErrorIsYFErrSentinel(t *testing.T) {
+	tests := []struct {
+		err  error
+		kind error
+	}{
+		{WrapRateLimitError(), yferr.ErrRateLimited},
+		{WrapAuthError(fmt.Errorf("HTTP 401")), yferr.ErrAuth},
+		{WrapInvalidResponseError(fmt.Errorf("bad json")), yferr.ErrParse},
+		{WrapNoDataError("AAPL"), yferr.ErrNoData},
+		{WrapNotFoundError("AAPL"), yferr.ErrNoData},
+	}
+	for _, tt := range tests {
+		if !errors.Is(fmt.Errorf("wrapped: %w", tt.err), tt.kind) {
+			t.Errorf("%v should match %v", tt.err, tt.kind)
+		}
+	}
+	if errors.Is(WrapNetworkError(fmt.Errorf("reset")), yferr.ErrRateLimited) {
+		t.Error("network error should not match ErrRateLimited")
+	}
+}
+
 func TestErrorHelpers(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/internal/endpoints"
+)
+
+// SymbolType is the quote type, exchange and timezone of a symbol, as
+// returned by [Client.QuoteType].
+type SymbolType struct {
+	Symbol string `json:"symbol"`
+
+	// QuoteType is e.g. "EQUITY", "ETF", "MUTUALFUND" or "CRYPTOCURRENCY".
+	QuoteType string `json:"quoteType"`
+
+	// Exchange is Yahoo's exchange code, e.g. "NMS" or "JPX".
+	Exchange string `json:"exchange"`
+
+	Market    string `json:"market,omitempty"`
+	ShortName string `json:"shortName,omitempty"`
+	LongName  string `json:"longName,omitempty"`
+
+	// Timezone is the IANA name of the exchange timezone, e.g.
+	// "America/New_York"; TimezoneShortName is its abbreviation.
+	Timezone          string `json:"exchangeTimeZoneName"`
+	TimezoneShortName string `json:"exchangeTimeZoneShortName,omitempty"`
+}
+
+// QuoteType resolves a symbol's quote type, exchange and timezone in one
+// small request, much lighter than a chart or quoteSummary call. An unknown
+// symbol returns an error matching [ErrInvalidSymbol].
+//
+// Example:
+//
+//	st, err := c.QuoteType("7203.T")
+//	if client.IsInvalidSymbolError(err) {
+//	    log.Fatal("no such symbol")
+//	}
+//	fmt.Println(st.QuoteType, st.Exchange, st.Timezone) // EQUITY JPX Asia/Tokyo
+func (c *Client) QuoteType(symbol string) (*SymbolType, error) {
+	return c.QuoteTypeContext(context.Background(), symbol)
+}
+
+// QuoteTypeContext is like [Client.QuoteType] but returns ctx's error as
+// soon as ctx is done.
+func (c *Client) QuoteTypeContext(ctx context.Context, symbol string) (*SymbolType, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return nil, fmt.Errorf("symbol cannot be empty")
+	}
+
+	params, err := c.Auth().AddCrumbToParams(url.Values{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get crumb: %w", err)
+	}
+
+	resp, err := c.GetContext(ctx, endpoints.QuoteTypeURL+"/"+url.PathEscape(symbol), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch quote type: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, HTTPStatusToError(resp.StatusCode, resp.Body)
+	}
+	return parseQuoteType(symbol, resp.Body)
+}
+
+// parseQuoteType decodes a quoteType response for symbol.
+func parseQuoteType(symbol, body string) (*SymbolType, error) {
+	var raw struct {
+		QuoteType struct {
+			Result []SymbolType `json:"result"`
+			Error  *struct {
+				Code        string `json:"code"`
+				Description string `json:"description"`
+			} `json:"error"`
+		} `json:"quoteType"`
+	}
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		return nil, WrapInvalidResponseError(err)
+	}
+	if raw.QuoteType.Error != nil {
+		return nil, fmt.Errorf("quote type API error: %s - %s",
+			raw.QuoteType.Error.Code, raw.QuoteType.Error.Description)
+	}
+
+	for i := range raw.QuoteType.Result {
+		st := raw.QuoteType.Result[i]
+		// Unknown symbols come back empty or with quote type "NONE"
+		if strings.EqualFold(st.Symbol, symbol) && st.QuoteType != "" && st.QuoteType != "NONE" {
+			return &st, nil
+		}
+	}
+	return nil, WrapInvalidSymbolError(symbol)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseQuoteType(t *testing.T) {
+	st, err := parseQuoteType("7203.T", `{"quoteType":{"result":[{
+		"symbol":"7203.T","quoteType":"EQUITY","exchange":"JPX","market":"jp_market",
+		"shortName":"TOYOTA MOTOR CORP","exchangeTimeZoneName":"Asia/Tokyo","exchangeTimeZoneShortName":"JST"
+	}],"error":null}}`)
+	if err != nil {
+		t.Fatalf("parseQuoteType() error: %v", err)
+	}
+	if st.QuoteType != "EQUITY" || st.Exchange != "JPX" || st.Timezone != "Asia/Tokyo" || st.TimezoneShortName != "JST" {
+		t.Errorf("unexpected symbol type: %+v", st)
+	}
+}
+
+func TestParseQuoteTypeUnknown(t *testing.T) {
+	for _, body := range []string{
+		`{"quoteType":{"result":[],"error":null}}`,
+		`{"quoteType":{"result":[{"symbol":"NOPE","quoteType":"NONE"}],"error":null}}`,
+	} {
+		if _, err := parseQuoteType("NOPE", body); !errors.Is(err, ErrInvalidSymbol) {
+			t.Errorf("expected ErrInvalidSymbol for %s, got %v", body, err)
+		}
+	}
+
+	_, err := parseQuoteType("AAPL", `{"quoteType":{"result":null,"error":{"code":"Bad Request","description":"bad"}}}`)
+	if err == nil || errors.Is(err, ErrInvalidSymbol) {
+		t.Errorf("expected API error, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/cache"
+	"github.com/wnjoon/go-yfinance/pkg/config"
+)
+
+// cachedResponse is a stored GET response and the time it took to fetch.
+type cachedResponse struct {
+	resp    *Response
+	latency time.Duration
+}
+
+// CacheStats reports the effectiveness of the response cache enabled with
+// config.EnableCache.
+type CacheStats struct {
+	// Enabled reports whether caching is currently enabled in the config.
+	Enabled bool `json:"enabled"`
+
+	cache.Stats
+
+	// LatencySaved is the sum of the original request latencies of all
+	// responses served from the cache.
+	LatencySaved time.Duration `json:"latencySaved"`
+}
+
+// CacheStats returns hit, miss and latency-saved metrics of the response
+// cache. All counters are zero until caching is enabled.
+//
+// Example:
+//
+//	config.Get().EnableCache(5 * time.Minute)
+//	// ... requests ...
+//	st := c.CacheStats()
+//	fmt.Printf("hit ratio %.0f%%, saved %s\n", st.HitRatio()*100, st.LatencySaved)
+func (c *Client) CacheStats() CacheStats {
+	st := CacheStats{
+		Enabled:      config.Get().IsCacheEnabled(),
+		LatencySaved: time.Duration(c.latencySaved.Load()),
+	}
+
+	c.cacheMu.Lock()
+	rc := c.respCache
+	c.cacheMu.Unlock()
+	if rc != nil {
+		st.Stats = rc.Stats()
+	}
+	return st
+}
+
+// ClearCache drops all cached responses and bars and resets the cache
+// metrics.
+func (c *Client) ClearCache() {
+	c.cacheMu.Lock()
+	rc, bc := c.respCache, c.barCache
+	c.cacheMu.Unlock()
+	if rc != nil {
+		rc.Clear()
+		rc.ResetStats()
+	}
+	if bc != nil {
+		bc.Clear()
+	}
+	c.latencySaved.Store(0)
+}
+
+// responseCache returns the response cache to use for rawURL, or nil when
+// caching is disabled or the URL must not be cached.
+func (c *Client) responseCache(rawURL string) *cache.Cache {
+	if !config.Get().IsCacheEnabled() || EndpointCategory(rawURL) == CategoryAuth {
+		return nil
+	}
+
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	if c.respCache == nil {
+		c.respCache = cache.New()
+	}
+	return c.respCache
+}
+
+// cachedResponse returns a copy of the cached response for key, if any.
+func (c *Client) cachedResponse(rc *cache.Cache, key string) (*Response, bool) {
+	if rc == nil {
+		return nil, false
+	}
+	v, ok := rc.Get(key)
+	if !ok {
+		return nil, false
+	}
+	cached := v.(cachedResponse)
+	c.latencySaved.Add(int64(cached.latency))
+	return cached.resp.clone(), true
+}
+
+// storeResponse caches a successful response for the configured TTL.
+func (c *Client) storeResponse(rc *cache.Cache, key string, resp *Response, latency time.Duration) {
+	if rc == nil || resp.StatusCode != 200 {
+		return
+	}
+	ttl := config.Get().GetCacheTTL()
+	if ttl <= 0 {
+		ttl = config.DefaultCacheTTL
+	}
+	rc.SetWithTTL(key, cachedResponse{resp: resp.clone(), latency: latency}, ttl)
+}
+
+// closeResponseCache stops the response cache's cleanup goroutine and drops
+// the bar cache.
+func (c *Client) closeResponseCache() {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	if c.respCache != nil {
+		c.respCache.Close()
+		c.respCache = nil
+	}
+	c.barCache = nil
+}
+
+// clone returns a copy of r that does not share its headers map.
+func (r *Response) clone() *Response {
+	out := *r
+	if r.Headers != nil {
+		out.Headers = make(map[string]string, len(r.Headers))
+		for k, v := range r.Headers {
+			out.Headers[k] = v
+		}
+	}
+	return &out
+}
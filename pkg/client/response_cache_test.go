@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/config"
+)
+
+func TestResponseCacheStats(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+
+	c, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	if rc := c.responseCache("https://query2.finance.yahoo.com/v8/finance/chart/AAPL"); rc != nil {
+		t.Fatal("Response cache should be nil while caching is disabled")
+	}
+
+	config.Get().EnableCache(time.Minute)
+	const chartURL = "https://query2.finance.yahoo.com/v8/finance/chart/AAPL"
+	rc := c.responseCache(chartURL)
+	if rc == nil {
+		t.Fatal("Response cache should be created once caching is enabled")
+	}
+	if c.responseCache("https://fc.yahoo.com") != nil {
+		t.Error("Auth requests should never be cached")
+	}
+
+	if _, ok := c.cachedResponse(rc, chartURL); ok {
+		t.Fatal("Expected a miss before storing")
+	}
+	c.storeResponse(rc, chartURL, &Response{StatusCode: 200, Body: "ok", Headers: map[string]string{"a": "b"}}, 150*time.Millisecond)
+	c.storeResponse(rc, chartURL+"?bad", &Response{StatusCode: 500}, time.Second)
+
+	resp, ok := c.cachedResponse(rc, chartURL)
+	if !ok || resp.Body != "ok" {
+		t.Fatalf("Expected cached response, got %+v (ok=%v)", resp, ok)
+	}
+	resp.Headers["a"] = "modified"
+	if again, _ := c.cachedResponse(rc, chartURL); again.Headers["a"] != "b" {
+		t.Error("Cached response should not share headers with callers")
+	}
+	if _, ok := c.cachedResponse(rc, chartURL+"?bad"); ok {
+		t.Error("Error responses should not be cached")
+	}
+
+	st := c.CacheStats()
+	if !st.Enabled || st.Hits != 2 || st.Misses != 2 || st.Entries != 1 {
+		t.Errorf("Unexpected stats: %+v", st)
+	}
+	if st.LatencySaved != 300*time.Millisecond {
+		t.Errorf("LatencySaved should be 300ms, got %s", st.LatencySaved)
+	}
+	if st.HitRatio() != 0.5 {
+		t.Errorf("HitRatio should be 0.5, got %v", st.HitRatio())
+	}
+
+	c.ClearCache()
+	if st := c.CacheStats(); st.Hits != 0 || st.Entries != 0 || st.LatencySaved != 0 {
+		t.Errorf("ClearCache should reset stats, got %+v", st)
+	}
+}
+
+func TestCachedResponseNotRecorded(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+	config.Get().EnableCache(time.Minute)
+
+	c, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	const chartURL = "https://query2.finance.yahoo.com/v8/finance/chart/AAPL"
+	c.storeResponse(c.responseCache(chartURL), chartURL, &Response{StatusCode: 200, Body: "ok"}, time.Millisecond)
+
+	var stats RequestStats
+	resp, err := c.GetContext(WithStats(context.Background(), &stats), chartURL, nil)
+	if err != nil || resp.Body != "ok" {
+		t.Fatalf("Expected cached response, got %+v, %v", resp, err)
+	}
+	if stats.Total() != 0 || c.Stats().Total() != 0 {
+		t.Errorf("Cached responses should not be recorded, got %v and %v", stats.Counts(), c.Stats().Counts())
+	}
+
+	ctx, cancel := context.WithCancel(WithStats(context.Background(), &stats))
+	cancel()
+	if _, err := c.GetContext(ctx, chartURL+"/MSFT", nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if stats.Total() != 0 {
+		t.Errorf("Cancelled requests should not be recorded, got %v", stats.Counts())
+	}
+}
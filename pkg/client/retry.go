@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/config"
+)
+
+// RetryPolicy controls how [Retry] repeats a failing call.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+
+	// Delay is the wait before the first retry; it doubles after each retry.
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy returns the policy configured by config.SetMaxRetries
+// and config.SetRetryDelay.
+func DefaultRetryPolicy() RetryPolicy {
+	cfg := config.Get()
+	return RetryPolicy{MaxRetries: cfg.GetMaxRetries(), Delay: cfg.GetRetryDelay()}
+}
+
+// RetryAttempt records one failed attempt of a retried call.
+type RetryAttempt struct {
+	// Attempt is the 1-based attempt number.
+	Attempt int
+
+	// Time is when the attempt failed.
+	Time time.Time
+
+	// Err is the error returned by the attempt.
+	Err error
+
+	// StatusCode is the HTTP status of the failure, or 0 if unknown.
+	StatusCode int
+
+	// Delay is the wait before the next attempt, 0 for the last one.
+	Delay time.Duration
+}
+
+// RetryError is returned by [Retry] when a call still fails after being
+// retried, or when ctx is done while waiting to retry. It wraps the last
+// error, so errors.Is checks such as [IsRateLimitError] keep working, also
+// matches the context error in the latter case, and carries the full
+// history:
+//
+//	var rerr *client.RetryError
+//	if errors.As(err, &rerr) {
+//	    for _, a := range rerr.Attempts {
+//	        log.Printf("attempt %d: HTTP %d after %s: %v", a.Attempt, a.StatusCode, a.Delay, a.Err)
+//	    }
+//	}
+type RetryError struct {
+	Attempts []RetryAttempt
+
+	// Err is the context error that stopped the retries, or nil if they
+	// ran out or the last error was not retryable.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *RetryError) Error() string {
+	codes := make([]string, 0, len(e.Attempts))
+	for _, a := range e.Attempts {
+		if a.StatusCode != 0 {
+			codes = append(codes, fmt.Sprintf("%d", a.StatusCode))
+		}
+	}
+	msg := fmt.Sprintf("failed after %d attempts", len(e.Attempts))
+	if len(codes) > 0 {
+		msg += fmt.Sprintf(" (HTTP %s)", strings.Join(codes, ", "))
+	}
+	if e.Err != nil {
+		msg += fmt.Sprintf(" (%v)", e.Err)
+	}
+	return fmt.Sprintf("%s: %v", msg, e.Unwrap())
+}
+
+// Is reports whether target matches the context error that stopped the
+// retries, so errors.Is(err, context.Canceled) holds for a call canceled
+// while waiting to retry.
+func (e *RetryError) Is(target error) bool {
+	return e.Err != nil && errors.Is(e.Err, target)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetryError) Unwrap() error {
+	if len(e.Attempts) == 0 {
+		return nil
+	}
+	return e.Attempts[len(e.Attempts)-1].Err
+}
+
+// TotalDelay returns the time spent waiting between attempts.
+func (e *RetryError) TotalDelay() time.Duration {
+	var total time.Duration
+	for _, a := range e.Attempts {
+		total += a.Delay
+	}
+	return total
+}
+
+// IsRetryable reports whether err is transient: network, timeout and rate
+// limit errors, including HTTP 5xx responses.
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrNetwork) || errors.Is(err, ErrTimeout) || errors.Is(err, ErrRateLimit)
+}
+
+// Retry calls fn until it succeeds, returns an error that is not
+// [IsRetryable], policy.MaxRetries retries are used up or ctx is done.
+// A failure after at least one retry, or a cancellation while waiting to
+// retry, is returned as a [*RetryError] holding every attempt; a first
+// attempt that fails permanently returns its error unchanged.
+//
+// Example:
+//
+//	err := client.Retry(ctx, client.DefaultRetryPolicy(), func() error {
+//	    bars, err = tkr.History(params)
+//	    return err
+//	})
+func Retry(ctx context.Context, policy RetryPolicy, fn func() error) error {
+	return retry(ctx, policy, fn, sleepContext)
+}
+
+func retry(ctx context.Context, policy RetryPolicy, fn func() error, sleep func(context.Context, time.Duration) error) error {
+	var attempts []RetryAttempt
+	delay := policy.Delay
+
+	for n := 1; ; n++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		attempt := RetryAttempt{Attempt: n, Time: time.Now(), Err: err}
+		var yfErr *YFError
+		if errors.As(err, &yfErr) {
+			attempt.StatusCode = yfErr.StatusCode
+		}
+
+		if !IsRetryable(err) || n > policy.MaxRetries {
+			attempts = append(attempts, attempt)
+			break
+		}
+
+		attempt.Delay = delay
+		attempts = append(attempts, attempt)
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			attempts[len(attempts)-1].Delay = 0
+			return &RetryError{Attempts: attempts, Err: sleepErr}
+		}
+		delay *= 2
+	}
+
+	if len(attempts) == 1 {
+		return attempts[0].Err
+	}
+	return &RetryError{Attempts: attempts}
+}
+
+// retryResponse retries send like retry, also treating HTTP 429 and 5xx
+// responses as failures. It returns the first other response.
+func retryResponse(ctx context.Context, policy RetryPolicy, send func() (*Response, error), sleep func(context.Context, time.Duration) error) (*Response, error) {
+	var resp *Response
+	err := retry(ctx, policy, func() error {
+		r, err := send()
+		if err != nil {
+			return err
+		}
+		if r.StatusCode == 429 || r.StatusCode >= 500 {
+			return HTTPStatusToError(r.StatusCode, r.Body)
+		}
+		resp = r
+		return nil
+	}, sleep)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
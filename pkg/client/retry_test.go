@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetrySucceedsAfterRetries(t *testing.T) {
+	var slept []time.Duration
+	sleep := func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	calls := 0
+	err := retry(context.Background(), RetryPolicy{MaxRetries: 3, Delay: time.Second}, func() error {
+		calls++
+		if calls < 3 {
+			return HTTPStatusToError(503, "")
+		}
+		return nil
+	}, sleep)
+
+	if err != nil {
+		t.Fatalf("retry() error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+	if len(slept) != 2 || slept[0] != time.Second || slept[1] != 2*time.Second {
+		t.Errorf("delays = %v, want [1s 2s]", slept)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	sleep := func(context.Context, time.Duration) error { return nil }
+
+	codes := []int{503, 429, 429}
+	calls := 0
+	err := retry(context.Background(), RetryPolicy{MaxRetries: 2, Delay: 100 * time.Millisecond}, func() error {
+		code := codes[calls]
+		calls++
+		return HTTPStatusToError(code, "")
+	}, sleep)
+
+	var rerr *RetryError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected *RetryError, got %T: %v", err, err)
+	}
+	if len(rerr.Attempts) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(rerr.Attempts))
+	}
+	for i, a := range rerr.Attempts {
+		if a.Attempt != i+1 || a.StatusCode != codes[i] {
+			t.Errorf("attempt %d = %+v", i, a)
+		}
+	}
+	if rerr.Attempts[2].Delay != 0 {
+		t.Error("last attempt should have no delay")
+	}
+	if rerr.TotalDelay() != 300*time.Millisecond {
+		t.Errorf("TotalDelay() = %v, want 300ms", rerr.TotalDelay())
+	}
+	if !IsRateLimitError(err) {
+		t.Error("wrapped error should still be a rate limit error")
+	}
+	if want := "failed after 3 attempts (HTTP 503, 429, 429)"; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
+
+func TestRetryNonRetryable(t *testing.T) {
+	sleep := func(context.Context, time.Duration) error {
+		t.Fatal("should not sleep")
+		return nil
+	}
+
+	want := fmt.Errorf("bad symbol")
+	calls := 0
+	err := retry(context.Background(), RetryPolicy{MaxRetries: 3, Delay: time.Second}, func() error {
+		calls++
+		return want
+	}, sleep)
+
+	if err != want {
+		t.Errorf("err = %v, want original error", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := Retry(ctx, RetryPolicy{MaxRetries: 3, Delay: time.Hour}, func() error {
+		calls++
+		return WrapNetworkError(fmt.Errorf("connection reset"))
+	})
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+	if !errors.Is(err, ErrNetwork) {
+		t.Errorf("err = %v, want network error", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
+
+func TestRetryCanceledDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sleeps := 0
+	sleep := func(ctx context.Context, _ time.Duration) error {
+		sleeps++
+		if sleeps == 2 {
+			cancel()
+		}
+		return ctx.Err()
+	}
+
+	calls := 0
+	err := retry(ctx, RetryPolicy{MaxRetries: 3, Delay: time.Second}, func() error {
+		calls++
+		return HTTPStatusToError(503, "")
+	}, sleep)
+
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if !IsRetryable(err) {
+		t.Errorf("err = %v, want the last attempt's error", err)
+	}
+	var rerr *RetryError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("err = %T, want *RetryError", err)
+	}
+	if len(rerr.Attempts) != 2 || rerr.Attempts[1].Delay != 0 {
+		t.Errorf("attempts = %+v, want 2 with no delay after the last", rerr.Attempts)
+	}
+}
+
+func TestRetryResponse(t *testing.T) {
+	sleep := func(context.Context, time.Duration) error { return nil }
+
+	codes := []int{429, 503, 200}
+	calls := 0
+	resp, err := retryResponse(context.Background(), RetryPolicy{MaxRetries: 3}, func() (*Response, error) {
+		code := codes[calls]
+		calls++
+		return &Response{StatusCode: code, Body: fmt.Sprintf("attempt %d", calls)}, nil
+	}, sleep)
+	if err != nil {
+		t.Fatalf("retryResponse() error: %v", err)
+	}
+	if calls != 3 || resp.Body != "attempt 3" {
+		t.Errorf("calls = %d, body = %q", calls, resp.Body)
+	}
+
+	calls = 0
+	resp, err = retryResponse(context.Background(), RetryPolicy{MaxRetries: 3}, func() (*Response, error) {
+		calls++
+		return &Response{StatusCode: 400, Body: "bad query"}, nil
+	}, sleep)
+	if err != nil || calls != 1 || resp.StatusCode != 400 {
+		t.Errorf("expected 400 returned without retry, got %v, %v after %d calls", resp, err, calls)
+	}
+
+	calls = 0
+	_, err = retryResponse(context.Background(), RetryPolicy{MaxRetries: 1}, func() (*Response, error) {
+		calls++
+		return &Response{StatusCode: 429}, nil
+	}, sleep)
+	var rerr *RetryError
+	if !errors.As(err, &rerr) || calls != 2 || !IsRateLimitError(err) {
+		t.Errorf("expected rate limit *RetryError after 2 calls, got %v after %d", err, calls)
+	}
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Endpoint categories reported by [RequestStats].
+const (
+	CategoryChart         = "chart"
+	CategoryQuoteSummary  = "quoteSummary"
+	CategoryQuote         = "quote"
+	CategoryOptions       = "options"
+	CategoryFundamentals  = "fundamentals"
+	CategorySearch        = "search"
+	CategoryLookup        = "lookup"
+	CategoryQuoteType     = "quoteType"
+	CategoryScreener      = "screener"
+	CategoryMarketSummary = "marketSummary"
+	CategoryMarketTime    = "marketTime"
+	CategorySector        = "sector"
+	CategoryIndustry      = "industry"
+	CategoryCalendar      = "calendar"
+	CategoryNews          = "news"
+	CategoryAuth          = "auth"
+	CategoryOther         = "other"
+)
+
+// endpointCategories maps URL path prefixes to categories. More specific
+// prefixes must come before the prefixes they extend.
+var endpointCategories = []struct {
+	prefix   string
+	category string
+}{
+	{"/v8/finance/chart", CategoryChart},
+	{"/v10/finance/quoteSummary", CategoryQuoteSummary},
+	{"/v6/finance/quote/marketSummary", CategoryMarketSummary},
+	{"/v7/finance/quote", CategoryQuote},
+	{"/v7/finance/options", CategoryOptions},
+	{"/ws/fundamentals-timeseries", CategoryFundamentals},
+	{"/v1/finance/search", CategorySearch},
+	{"/v1/finance/lookup", CategoryLookup},
+	{"/v1/finance/quoteType", CategoryQuoteType},
+	{"/v1/finance/screener", CategoryScreener},
+	{"/v6/finance/markettime", CategoryMarketTime},
+	{"/v1/finance/sectors", CategorySector},
+	{"/v1/finance/industries", CategoryIndustry},
+	{"/v1/finance/visualization", CategoryCalendar},
+	{"/xhr/ncp", CategoryNews},
+	{"/v1/test/getcrumb", CategoryAuth},
+	{"/ws/obi-integration", CategoryAuth},
+}
+
+// authHosts are hosts used only for cookie and consent handling.
+var authHosts = []string{"fc.yahoo.com", "guce.yahoo.com", "consent.yahoo.com"}
+
+// EndpointCategory returns the category of a Yahoo Finance request URL,
+// such as "chart" or "quoteSummary". Unknown URLs return "other".
+func EndpointCategory(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return CategoryOther
+	}
+
+	for _, host := range authHosts {
+		if u.Hostname() == host {
+			return CategoryAuth
+		}
+	}
+	for _, ec := range endpointCategories {
+		if strings.HasPrefix(u.Path, ec.prefix) {
+			return ec.category
+		}
+	}
+	return CategoryOther
+}
+
+// RequestStats counts HTTP requests per endpoint category.
+// The zero value is ready to use and it is safe for concurrent use.
+type RequestStats struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+// Record counts one request to rawURL.
+func (s *RequestStats) Record(rawURL string) {
+	category := EndpointCategory(rawURL)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.counts == nil {
+		s.counts = make(map[string]int64)
+	}
+	s.counts[category]++
+}
+
+// Counts returns a copy of the request counts keyed by category.
+func (s *RequestStats) Counts() map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]int64, len(s.counts))
+	for k, v := range s.counts {
+		out[k] = v
+	}
+	return out
+}
+
+// Total returns the total number of recorded requests.
+func (s *RequestStats) Total() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var total int64
+	for _, v := range s.counts {
+		total += v
+	}
+	return total
+}
+
+// statsKey is the context key of the stats set by [WithStats].
+type statsKey struct{}
+
+// WithStats returns a copy of ctx that makes the client also record the
+// requests made with it in stats, in addition to [Client.Stats]. Responses
+// served from the response cache are not requests and are not recorded.
+//
+// Example:
+//
+//	var stats client.RequestStats
+//	resp, err := c.GetContext(client.WithStats(ctx, &stats), endpoints.QuoteURL, params)
+func WithStats(ctx context.Context, stats *RequestStats) context.Context {
+	return context.WithValue(ctx, statsKey{}, stats)
+}
+
+// record counts one request to rawURL in the client's stats and in the
+// stats attached to ctx, if any.
+func (c *Client) record(ctx context.Context, rawURL string) {
+	c.stats.Record(rawURL)
+	if stats, ok := ctx.Value(statsKey{}).(*RequestStats); ok && stats != nil {
+		stats.Record(rawURL)
+	}
+}
+
+// Reset clears all counters.
+func (s *RequestStats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts = nil
+}
@@ -30,6 +30,9 @@ type Config struct {
 	Lang   string
 	Region string
 
+	// AuthStrategy forces a cookie/crumb strategy; see SetAuthStrategy
+	AuthStrategy string
+
 	// Debug settings
 	Debug bool
 }
@@ -45,6 +48,19 @@ const (
 	DefaultRegion        = "US"
 )
 
+// Authentication strategies for SetAuthStrategy.
+const (
+	// AuthStrategyAuto starts with the strategy suited to the region and
+	// falls back to the other one on failure.
+	AuthStrategyAuto = "auto"
+
+	// AuthStrategyBasic always uses the fc.yahoo.com cookie handshake.
+	AuthStrategyBasic = "basic"
+
+	// AuthStrategyCSRF always uses the consent (GDPR) form handshake.
+	AuthStrategyCSRF = "csrf"
+)
+
 // Default JA3 fingerprint (Chrome)
 const DefaultJA3 = "771,4865-4866-4867-49195-49199-49196-49200-52393-52392-49171-49172-156-157-47-53,0-23-65281-10-11-35-16-5-13-18-51-45-43-27-17513,29-23-24,0"
 
@@ -75,6 +91,7 @@ func NewDefault() *Config {
 		CacheTTL:      DefaultCacheTTL,
 		Lang:          DefaultLang,
 		Region:        DefaultRegion,
+		AuthStrategy:  AuthStrategyAuto,
 		Debug:         false,
 	}
 }
@@ -135,7 +152,7 @@ func (c *Config) SetMaxConcurrent(n int) *Config {
 	return c
 }
 
-// EnableCache enables response caching.
+// EnableCache enables response and history bar caching.
 func (c *Config) EnableCache(ttl time.Duration) *Config {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -161,6 +178,22 @@ func (c *Config) SetLocale(lang, region string) *Config {
 	return c
 }
 
+// SetAuthStrategy forces the authentication strategy: AuthStrategyBasic or
+// AuthStrategyCSRF disable the fallback to the other strategy, and
+// AuthStrategyAuto (the default) restores it. Unknown values act as
+// AuthStrategyAuto.
+//
+// Example:
+//
+//	// Behind an EU proxy, go straight to the consent flow
+//	config.Get().SetAuthStrategy(config.AuthStrategyCSRF)
+func (c *Config) SetAuthStrategy(strategy string) *Config {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.AuthStrategy = strategy
+	return c
+}
+
 // SetDebug enables or disables debug mode.
 func (c *Config) SetDebug(debug bool) *Config {
 	c.mu.Lock()
@@ -204,6 +237,13 @@ func (c *Config) GetLocale() (lang, region string) {
 	return c.Lang, c.Region
 }
 
+// GetAuthStrategy returns the configured authentication strategy.
+func (c *Config) GetAuthStrategy() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.AuthStrategy
+}
+
 // IsDebug returns whether debug mode is enabled.
 func (c *Config) IsDebug() bool {
 	c.mu.RLock()
@@ -218,6 +258,34 @@ func (c *Config) IsCacheEnabled() bool {
 	return c.CacheEnabled
 }
 
+// GetMaxRetries returns the maximum number of retries.
+func (c *Config) GetMaxRetries() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.MaxRetries
+}
+
+// GetRetryDelay returns the delay before the first retry.
+func (c *Config) GetRetryDelay() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.RetryDelay
+}
+
+// GetMaxConcurrent returns the maximum number of concurrent requests.
+func (c *Config) GetMaxConcurrent() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.MaxConcurrent
+}
+
+// GetCacheTTL returns the response cache time-to-live.
+func (c *Config) GetCacheTTL() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CacheTTL
+}
+
 // Clone creates a copy of the configuration.
 func (c *Config) Clone() *Config {
 	c.mu.RLock()
@@ -235,6 +303,7 @@ func (c *Config) Clone() *Config {
 		CacheTTL:      c.CacheTTL,
 		Lang:          c.Lang,
 		Region:        c.Region,
+		AuthStrategy:  c.AuthStrategy,
 		Debug:         c.Debug,
 	}
 }
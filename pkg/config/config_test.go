@@ -55,16 +55,29 @@ func TestConfigSetters(t *testing.T) {
 	}
 
 	cfg.SetMaxRetries(5)
-	if cfg.MaxRetries != 5 {
+	if cfg.GetMaxRetries() != 5 {
 		t.Errorf("MaxRetries should be 5")
 	}
 
+	cfg.SetRetryDelay(250 * time.Millisecond)
+	if cfg.GetRetryDelay() != 250*time.Millisecond {
+		t.Errorf("RetryDelay should be 250ms")
+	}
+
 	cfg.SetLocale("ja-JP", "JP")
 	lang, region := cfg.GetLocale()
 	if lang != "ja-JP" || region != "JP" {
 		t.Errorf("Locale should be ja-JP/JP, got %s/%s", lang, region)
 	}
 
+	if cfg.GetAuthStrategy() != AuthStrategyAuto {
+		t.Errorf("AuthStrategy should default to auto, got %s", cfg.GetAuthStrategy())
+	}
+	cfg.SetAuthStrategy(AuthStrategyCSRF)
+	if cfg.GetAuthStrategy() != AuthStrategyCSRF || cfg.Clone().GetAuthStrategy() != AuthStrategyCSRF {
+		t.Errorf("AuthStrategy should be csrf, got %s", cfg.GetAuthStrategy())
+	}
+
 	cfg.SetDebug(true)
 	if !cfg.IsDebug() {
 		t.Errorf("Debug should be true")
@@ -85,6 +98,9 @@ func TestConfigCache(t *testing.T) {
 	if cfg.CacheTTL != 10*time.Minute {
 		t.Errorf("CacheTTL should be 10 minutes")
 	}
+	if cfg.GetCacheTTL() != 10*time.Minute {
+		t.Errorf("GetCacheTTL should return 10 minutes")
+	}
 
 	cfg.DisableCache()
 	if cfg.IsCacheEnabled() {
@@ -29,10 +29,16 @@
 //   - RetryDelay: Delay between retries
 //   - MaxConcurrent: Maximum concurrent requests
 //
+// Authentication:
+//   - AuthStrategy: Force the "basic" or "csrf" (consent form) cookie
+//     handshake instead of "auto"
+//
 // Caching:
-//   - CacheEnabled: Enable/disable response caching
+//   - CacheEnabled: Enable/disable response and history bar caching
 //   - CacheTTL: Cache time-to-live duration
 //
+// Cache effectiveness is reported by client.Client.CacheStats.
+//
 // Debug:
 //   - Debug: Enable debug logging
 //
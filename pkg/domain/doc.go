@@ -0,0 +1,28 @@
+// Package domain combines ticker, sector and industry data.
+//
+// # Overview
+//
+// Yahoo Finance groups companies into sectors (e.g. "technology") and
+// industries (e.g. "semiconductors"). The sector and industry packages
+// fetch one domain at a time; this package answers questions that span a
+// company and its domains.
+//
+// # Market Weight
+//
+// [WeightOf] returns a company's share of the market capitalization of its
+// industry and sector, useful for index-tilt analysis:
+//
+//	w, err := domain.WeightOf("NVDA")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Industry: %.2f%%\n", w.Industry.Weight*100)
+//	fmt.Printf("Sector:   %.2f%%\n", w.Sector.Weight*100)
+//
+// Weights come from Yahoo's top companies lists when the company is listed
+// there, and from market caps otherwise.
+//
+// # Thread Safety
+//
+// All domain package functions are safe for concurrent use.
+package domain
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/industry"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/sector"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+// fetchers loads the data WeightOf needs; overridable in tests.
+type fetchers struct {
+	info     func(symbol string) (*models.Info, error)
+	industry func(key string) (*models.IndustryData, error)
+	sector   func(key string) (*models.SectorData, error)
+}
+
+// WeightOf returns the market weight of symbol within its industry and
+// sector.
+//
+// The weight is taken from the industry's and sector's top companies when
+// the company is listed there. Otherwise it is the company's market cap
+// divided by the total market cap of the industry or sector.
+//
+// Example:
+//
+//	w, err := domain.WeightOf("AAPL")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: %.2f%% of %s\n", w.Symbol, w.Industry.Weight*100, w.Industry.Name)
+//	fmt.Printf("%s: %.2f%% of %s\n", w.Symbol, w.Sector.Weight*100, w.Sector.Name)
+func WeightOf(symbol string) (*models.DomainWeight, error) {
+	c, err := client.AcquireDefault()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	defer c.Release()
+
+	return weightOf(symbol, fetchers{
+		info: func(symbol string) (*models.Info, error) {
+			tkr, err := ticker.New(symbol, ticker.WithClient(c))
+			if err != nil {
+				return nil, err
+			}
+			defer tkr.Close()
+			return tkr.Info()
+		},
+		industry: func(key string) (*models.IndustryData, error) {
+			i, err := industry.New(key, industry.WithClient(c))
+			if err != nil {
+				return nil, err
+			}
+			defer i.Close()
+			return i.Data()
+		},
+		sector: func(key string) (*models.SectorData, error) {
+			s, err := sector.New(key, sector.WithClient(c))
+			if err != nil {
+				return nil, err
+			}
+			defer s.Close()
+			return s.Data()
+		},
+	})
+}
+
+func weightOf(symbol string, fetch fetchers) (*models.DomainWeight, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return nil, fmt.Errorf("symbol cannot be empty")
+	}
+
+	info, err := fetch.info(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch info for %s: %w", symbol, err)
+	}
+
+	industryKey := info.IndustryKey
+	if industryKey == "" {
+		industryKey = models.KeyFromName(info.Industry)
+	}
+	sectorKey := info.SectorKey
+	if sectorKey == "" {
+		sectorKey = models.KeyFromName(info.Sector)
+	}
+	if industryKey == "" || sectorKey == "" {
+		return nil, fmt.Errorf("sector and industry not available for %s", symbol)
+	}
+
+	ind, err := fetch.industry(industryKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch industry %s: %w", industryKey, err)
+	}
+	sec, err := fetch.sector(sectorKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sector %s: %w", sectorKey, err)
+	}
+
+	w := &models.DomainWeight{
+		Symbol:    symbol,
+		MarketCap: info.MarketCap,
+		Industry: models.DomainShare{
+			Key:       industryKey,
+			Name:      firstNonEmpty(ind.Name, info.Industry),
+			MarketCap: ind.Overview.MarketCap,
+		},
+		Sector: models.DomainShare{
+			Key:       sectorKey,
+			Name:      firstNonEmpty(sec.Name, info.Sector),
+			MarketCap: sec.Overview.MarketCap,
+		},
+	}
+
+	for _, c := range ind.TopCompanies {
+		if strings.EqualFold(c.Symbol, symbol) && c.MarketWeight > 0 {
+			w.Industry.Weight, w.Industry.TopCompany = c.MarketWeight, true
+			break
+		}
+	}
+	for _, c := range sec.TopCompanies {
+		if strings.EqualFold(c.Symbol, symbol) && c.MarketWeight > 0 {
+			w.Sector.Weight, w.Sector.TopCompany = c.MarketWeight, true
+			break
+		}
+	}
+	if !w.Industry.TopCompany {
+		w.Industry.Weight = capWeight(info.MarketCap, ind.Overview.MarketCap)
+	}
+	if !w.Sector.TopCompany {
+		w.Sector.Weight = capWeight(info.MarketCap, sec.Overview.MarketCap)
+	}
+
+	return w, nil
+}
+
+// capWeight returns marketCap / total, or 0 if either is unknown.
+func capWeight(marketCap int64, total float64) float64 {
+	if marketCap <= 0 || total <= 0 {
+		return 0
+	}
+	return float64(marketCap) / total
+}
+
+func firstNonEmpty(a, b string) string {
+	if a != "" {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func testFetchers(info *models.Info) (fetchers, *[]string) {
+	var keys []string
+	return fetchers{
+		info: func(symbol string) (*models.Info, error) {
+			return info, nil
+		},
+		industry: func(key string) (*models.IndustryData, error) {
+			keys = append(keys, key)
+			return &models.IndustryData{
+				Key:      key,
+				Name:     "Semiconductors",
+				Overview: models.IndustryOverview{MarketCap: 1000},
+				TopCompanies: []models.IndustryTopCompany{
+					{Symbol: "NVDA", MarketWeight: 0.45},
+					{Symbol: "AVGO", MarketWeight: 0.2},
+				},
+			}, nil
+		},
+		sector: func(key string) (*models.SectorData, error) {
+			keys = append(keys, key)
+			return &models.SectorData{
+				Key:      key,
+				Name:     "Technology",
+				Overview: models.SectorOverview{MarketCap: 4000},
+				TopCompanies: []models.SectorTopCompany{
+					{Symbol: "AAPL", MarketWeight: 0.2},
+				},
+			}, nil
+		},
+	}, &keys
+}
+
+func TestWeightOf(t *testing.T) {
+	fetch, keys := testFetchers(&models.Info{
+		Symbol:      "NVDA",
+		Industry:    "Semiconductors",
+		Sector:      "Technology",
+		IndustryKey: "semiconductors",
+		SectorKey:   "technology",
+		MarketCap:   500,
+	})
+
+	w, err := weightOf(" nvda ", fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Symbol != "NVDA" || w.MarketCap != 500 {
+		t.Errorf("unexpected weight %+v", w)
+	}
+	if len(*keys) != 2 || (*keys)[0] != "semiconductors" || (*keys)[1] != "technology" {
+		t.Errorf("unexpected keys %v", *keys)
+	}
+
+	// Listed in the industry's top companies
+	if !w.Industry.TopCompany || w.Industry.Weight != 0.45 || w.Industry.Name != "Semiconductors" {
+		t.Errorf("unexpected industry share %+v", w.Industry)
+	}
+	// Computed from market caps in the sector
+	if w.Sector.TopCompany || math.Abs(w.Sector.Weight-0.125) > 1e-12 || w.Sector.MarketCap != 4000 {
+		t.Errorf("unexpected sector share %+v", w.Sector)
+	}
+}
+
+func TestWeightOfKeysFromNames(t *testing.T) {
+	fetch, keys := testFetchers(&models.Info{
+		Industry: "Software—Infrastructure",
+		Sector:   "Technology",
+	})
+
+	w, err := weightOf("MSFT", fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*keys)[0] != "software-infrastructure" || (*keys)[1] != "technology" {
+		t.Errorf("unexpected keys %v", *keys)
+	}
+	if w.Industry.Weight != 0 || w.Sector.Weight != 0 {
+		t.Errorf("expected zero weights without market cap, got %+v", w)
+	}
+}
+
+func TestWeightOfErrors(t *testing.T) {
+	fetch, _ := testFetchers(&models.Info{})
+	if _, err := weightOf("", fetch); err == nil {
+		t.Error("expected error for empty symbol")
+	}
+	if _, err := weightOf("XYZ", fetch); err == nil {
+		t.Error("expected error without sector and industry")
+	}
+
+	fetch, _ = testFetchers(&models.Info{IndustryKey: "semiconductors", SectorKey: "technology"})
+	failed := errors.New("boom")
+	fetch.sector = func(string) (*models.SectorData, error) { return nil, failed }
+	if _, err := weightOf("NVDA", fetch); !errors.Is(err, failed) {
+		t.Errorf("expected wrapped sector error, got %v", err)
+	}
+}
@@ -0,0 +1,205 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/cache"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/stats"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+)
+
+// CorrelationMatrix downloads the closes of symbols and returns the pairwise
+// correlation matrix of their returns, labelled by symbol.
+//
+// Closes are aligned by timestamp, or by trading day in each symbol's
+// exchange timezone for daily and longer intervals, so symbols listed on
+// different exchanges can be compared. Each pair uses the returns both
+// symbols have, as pandas' DataFrame.corr does. Enable params.AutoAdjust
+// (the default) so dividends and splits do not show up as returns.
+//
+// Symbols are fetched like [History]. Symbols that fail to download are
+// reported in [models.CorrelationMatrix.Errors] and left out; an error is
+// returned only for invalid params or when fewer than two symbols are
+// given.
+//
+// Example:
+//
+//	m, err := download.CorrelationMatrix([]string{"AAPL", "MSFT", "XOM", "TLT"}, &models.DownloadParams{
+//	    Period:     "1y",
+//	    Interval:   "1d",
+//	    AutoAdjust: true,
+//	    Threads:    4,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for i, row := range m.Values {
+//	    fmt.Printf("%-5s %v\n", m.Symbols[i], row)
+//	}
+func CorrelationMatrix(symbols []string, params *models.DownloadParams) (*models.CorrelationMatrix, error) {
+	return CorrelationMatrixContext(context.Background(), symbols, params)
+}
+
+// CorrelationMatrixContext is like [CorrelationMatrix] but stops retrying
+// and starting symbols once ctx is done; symbols not fetched by then are
+// reported in [models.CorrelationMatrix.Errors].
+func CorrelationMatrixContext(ctx context.Context, symbols []string, params *models.DownloadParams) (*models.CorrelationMatrix, error) {
+	fetch, release, err := tickerHistory(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return correlationMatrix(ctx, symbols, params, fetch)
+}
+
+func correlationMatrix(ctx context.Context, symbols []string, params *models.DownloadParams, fetch historyFetcher) (*models.CorrelationMatrix, error) {
+	if params == nil {
+		defaultParams := models.DefaultDownloadParams()
+		params = &defaultParams
+	}
+
+	histParams := historyParams(params)
+	if histParams.Interval == "" {
+		histParams.Interval = "1d"
+	}
+	if err := histParams.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid history params: %w", err)
+	}
+	symbols = utils.NormalizeSymbols(symbols)
+	if len(symbols) < 2 {
+		return nil, fmt.Errorf("at least two symbols are required")
+	}
+	byDay := dailyOrLonger(histParams.Interval)
+
+	var (
+		mu      sync.Mutex
+		returns = make(map[string]map[string]float64, len(symbols))
+		errs    = make(map[string]error)
+	)
+	record := func(symbol string, r map[string]float64, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[symbol] = err
+			return
+		}
+		returns[symbol] = r
+	}
+
+	eachSymbol(ctx, symbols, params.Threads, func(symbol string) {
+		bars, err := fetch(symbol, histParams)
+		if err != nil {
+			record(symbol, nil, err)
+			return
+		}
+		record(symbol, closeReturns(bars, symbolLocation(symbol), byDay), nil)
+	}, func(symbol string, err error) {
+		record(symbol, nil, err)
+	})
+
+	m := &models.CorrelationMatrix{Errors: errs}
+	for _, sym := range symbols {
+		if _, ok := returns[sym]; ok {
+			m.Symbols = append(m.Symbols, sym)
+		}
+	}
+
+	n := len(m.Symbols)
+	m.Values = make([][]float64, n)
+	m.Observations = make([][]int, n)
+	for i := range m.Symbols {
+		m.Values[i] = make([]float64, n)
+		m.Observations[i] = make([]int, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			x, y := pairedReturns(returns[m.Symbols[i]], returns[m.Symbols[j]])
+			rho := math.NaN()
+			if len(x) >= 2 {
+				rho = stats.Correlation(x, y)
+			}
+			m.Values[i][j], m.Values[j][i] = rho, rho
+			m.Observations[i][j], m.Observations[j][i] = len(x), len(x)
+		}
+	}
+	return m, nil
+}
+
+// symbolLocation returns the exchange timezone of symbol, which the chart
+// response of its history leaves in the global symbol cache, or UTC if it
+// is unknown.
+func symbolLocation(symbol string) *time.Location {
+	if tz, ok := cache.SymbolTimezone(symbol); ok {
+		if loc := utils.LoadLocation(tz); loc != nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// closeReturns returns the simple returns between consecutive closes, keyed
+// by the later close's timestamp, or its trading day in loc when byDay is
+// set.
+func closeReturns(bars []models.Bar, loc *time.Location, byDay bool) map[string]float64 {
+
+	var (
+		keys   []string
+		closes []float64
+	)
+	for _, b := range bars {
+		if math.IsNaN(b.Close) || b.Close <= 0 {
+			continue
+		}
+		key := b.Date.UTC().Format(time.RFC3339)
+		if byDay {
+			key = b.Date.In(loc).Format("2006-01-02")
+		}
+		// Keep the last bar of a key, e.g. a live bar after the daily one
+		if n := len(keys); n > 0 && keys[n-1] == key {
+			closes[n-1] = b.Close
+			continue
+		}
+		keys = append(keys, key)
+		closes = append(closes, b.Close)
+	}
+
+	out := make(map[string]float64, len(closes))
+	for i, r := range stats.PctChange(closes) {
+		out[keys[i+1]] = r
+	}
+	return out
+}
+
+// pairedReturns returns the returns of a and b on the keys both have,
+// ordered by key.
+func pairedReturns(a, b map[string]float64) ([]float64, []float64) {
+	keys := make([]string, 0, len(a))
+	for k := range a {
+		if _, ok := b[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	x := make([]float64, len(keys))
+	y := make([]float64, len(keys))
+	for i, k := range keys {
+		x[i], y[i] = a[k], b[k]
+	}
+	return x, y
+}
+
+// dailyOrLonger reports whether bars of interval span whole trading days.
+func dailyOrLonger(interval string) bool {
+	switch interval {
+	case "1d", "5d", "1wk", "1mo", "3mo":
+		return true
+	}
+	return false
+}
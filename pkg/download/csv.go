@@ -0,0 +1,59 @@
+package download
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// CSVHeader is the column header written by WriteBarsCSV.
+var CSVHeader = []string{
+	"Date", "Open", "High", "Low", "Close", "Adj Close", "Volume",
+	"Dividends", "Stock Splits", "Capital Gains",
+}
+
+// WriteBarsCSV writes bars as CSV, including the header row.
+//
+// Dates are written in RFC 3339. Missing (NaN) prices are written as empty fields.
+//
+// Example:
+//
+//	bars, _ := t.History(models.HistoryParams{Period: "1mo"})
+//	err := download.WriteBarsCSV(os.Stdout, bars)
+func WriteBarsCSV(w io.Writer, bars []models.Bar) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVHeader); err != nil {
+		return err
+	}
+
+	row := make([]string, len(CSVHeader))
+	for _, bar := range bars {
+		row[0] = bar.Date.Format(time.RFC3339)
+		row[1] = formatPrice(bar.Open)
+		row[2] = formatPrice(bar.High)
+		row[3] = formatPrice(bar.Low)
+		row[4] = formatPrice(bar.Close)
+		row[5] = formatPrice(bar.AdjClose)
+		row[6] = strconv.FormatInt(bar.Volume, 10)
+		row[7] = formatPrice(bar.Dividends)
+		row[8] = formatPrice(bar.Splits)
+		row[9] = formatPrice(bar.CapitalGains)
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatPrice(v float64) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return ""
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
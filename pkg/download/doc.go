@@ -0,0 +1,84 @@
+// Package download provides bulk export of historical data for many symbols.
+//
+// # Overview
+//
+// The download package streams history for large symbol lists straight to
+// disk, one CSV file per symbol, so memory use stays bounded by the number
+// of concurrent workers rather than the size of the whole export. Smaller
+// lists can be downloaded into memory with [History].
+//
+// # Basic Usage
+//
+//	ctx := context.Background()
+//	result, err := download.HistoryToCSV(ctx, []string{"AAPL", "MSFT"}, &models.DownloadParams{
+//	    Period:   "1y",
+//	    Interval: "1d",
+//	    Threads:  8,
+//	}, "./data")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for symbol, path := range result.Files {
+//	    fmt.Printf("%s -> %s\n", symbol, path)
+//	}
+//
+// # In-Memory Download
+//
+// [History] is the equivalent of Python yfinance's yf.download: it fetches
+// many symbols with the same worker pool and returns a
+// [models.MultiTickerResult] of bars per symbol plus per-symbol errors.
+// It takes a context, so a large download can be cancelled part way
+// through; multi.Download runs on it as well:
+//
+//	result, err := download.History(ctx, []string{"AAPL", "MSFT"}, &models.DownloadParams{
+//	    Period:  "6mo",
+//	    Threads: 4,
+//	})
+//	aapl := result.Get("AAPL")
+//
+// # Incremental Download
+//
+// [HistoryToStore] keeps history in a [store.Store] (files or SQLite) and
+// fetches only the bars added since the previous run, so a daily job stays
+// cheap however long the stored history grows. [StoredHistory] reads it
+// back:
+//
+//	st, _ := store.NewFileStore("./history")
+//	_, err := download.HistoryToStore(ctx, st, symbols, &models.DownloadParams{Period: "max"})
+//	bars, _ := download.StoredHistory(st, "AAPL", "1d")
+//
+// # CSV Format
+//
+// Files use the header Date,Open,High,Low,Close,Adj Close,Volume,Dividends,
+// Stock Splits,Capital Gains. Missing (NaN) prices are written as empty
+// fields. Use [WriteBarsCSV] to write bars to any io.Writer.
+//
+// # NDJSON
+//
+// [HistoryNDJSON] streams a symbol's history as newline-delimited JSON, one
+// bar per line, for piping through Unix tools or log-based ingestion.
+// Missing (NaN) prices are written as null. [NewBarEncoder] and
+// [NewBarDecoder] work with any io.Writer or io.Reader:
+//
+//	r := download.HistoryNDJSON(ctx, "AAPL", models.HistoryParams{Period: "1y"})
+//	defer r.Close()
+//	io.Copy(os.Stdout, r)
+//
+// # Correlation
+//
+// [CorrelationMatrix] downloads closes for a symbol list and returns the
+// pairwise correlation of their returns, labelled by symbol, aligning
+// listings on different exchanges by trading day:
+//
+//	m, err := download.CorrelationMatrix([]string{"AAPL", "MSFT", "7203.T"}, nil)
+//	rho, _ := m.Get("AAPL", "7203.T")
+//
+// # Cancellation
+//
+// Cancelling the context stops dispatching new symbols; symbols that were not
+// started are reported with the context error.
+//
+// # Thread Safety
+//
+// All download package functions are safe for concurrent use.
+package download
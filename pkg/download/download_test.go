@@ -0,0 +1,310 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/cache"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestWriteBarsCSV(t *testing.T) {
+	bars := []models.Bar{
+		{Date: time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC), Open: 1.5, High: 2, Low: 1, Close: 1.75, AdjClose: 1.7, Volume: 100},
+		{Date: time.Date(2024, 1, 3, 14, 30, 0, 0, time.UTC), Open: math.NaN(), High: 2, Low: 1, Close: 1.8, AdjClose: 1.8, Volume: 0, Dividends: 0.2},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBarsCSV(&buf, bars); err != nil {
+		t.Fatalf("WriteBarsCSV() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	if lines[0] != strings.Join(CSVHeader, ",") {
+		t.Errorf("unexpected header: %s", lines[0])
+	}
+	if lines[1] != "2024-01-02T14:30:00Z,1.5,2,1,1.75,1.7,100,0,0,0" {
+		t.Errorf("unexpected row: %s", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "2024-01-03T14:30:00Z,,2,") {
+		t.Errorf("expected NaN open to be empty: %s", lines[2])
+	}
+}
+
+func TestHistoryToCSV(t *testing.T) {
+	dir := t.TempDir()
+	fetch := func(symbol string, params models.HistoryParams) ([]models.Bar, error) {
+		if symbol == "BAD" {
+			return nil, fmt.Errorf("not found")
+		}
+		return []models.Bar{{Date: time.Unix(0, 0).UTC(), Close: 1}}, nil
+	}
+
+	result, err := historyToCSV(context.Background(), []string{"aapl", "BRK/B", "bad", "AAPL"}, &models.DownloadParams{Threads: 2}, dir, fetch)
+	if err != nil {
+		t.Fatalf("historyToCSV() error: %v", err)
+	}
+
+	if len(result.Files) != 2 {
+		t.Fatalf("expected 2 files, got %v", result.Files)
+	}
+	if _, ok := result.Errors["BAD"]; !ok {
+		t.Error("expected error for BAD")
+	}
+	if result.Files["BRK/B"] != filepath.Join(dir, "BRK_B.csv") {
+		t.Errorf("unexpected path for BRK/B: %s", result.Files["BRK/B"])
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 2 {
+		t.Errorf("expected only final CSV files in dir, got %d entries", len(entries))
+	}
+	if info, err := os.Stat(result.Files["AAPL"]); err != nil {
+		t.Error(err)
+	} else if info.Mode().Perm() != 0o644 {
+		t.Errorf("expected mode 0644, got %v", info.Mode().Perm())
+	}
+}
+
+func TestHistoryToCSVCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	fetch := func(string, models.HistoryParams) ([]models.Bar, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, nil
+	}
+
+	result, err := historyToCSV(ctx, []string{"A", "B", "C"}, nil, t.TempDir(), fetch)
+	if err != nil {
+		t.Fatalf("historyToCSV() error: %v", err)
+	}
+	if len(result.Errors)+len(result.Files) != 3 {
+		t.Fatalf("expected every symbol to be reported, got files=%v errors=%v", result.Files, result.Errors)
+	}
+	for sym, err := range result.Errors {
+		if err != context.Canceled {
+			t.Errorf("%s: expected context.Canceled, got %v", sym, err)
+		}
+	}
+}
+
+func TestHistory(t *testing.T) {
+	fetch := func(symbol string, params models.HistoryParams) ([]models.Bar, error) {
+		if symbol == "BAD" {
+			return nil, fmt.Errorf("not found")
+		}
+		return []models.Bar{{Date: time.Unix(0, 0).UTC(), Close: float64(len(symbol))}}, nil
+	}
+
+	result, err := history(context.Background(), []string{"msft", "bad", "AAPL", "7203.T", "MSFT"}, &models.DownloadParams{Threads: 3}, fetch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"MSFT", "AAPL", "7203.T"}
+	if strings.Join(result.Symbols, ",") != strings.Join(want, ",") {
+		t.Errorf("expected symbols %v, got %v", want, result.Symbols)
+	}
+	if bars := result.Get("7203.T"); len(bars) != 1 || bars[0].Close != 6 {
+		t.Errorf("unexpected bars for 7203.T: %v", bars)
+	}
+	if _, ok := result.Errors["BAD"]; !ok || len(result.Errors) != 1 {
+		t.Errorf("expected a single error for BAD, got %v", result.Errors)
+	}
+}
+
+func TestHistoryCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fetch := func(string, models.HistoryParams) ([]models.Bar, error) {
+		return []models.Bar{{Close: 1}}, nil
+	}
+
+	result, err := history(ctx, []string{"A", "B", "C"}, nil, fetch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Errors)+len(result.Symbols) != 3 {
+		t.Fatalf("expected every symbol to be reported, got symbols=%v errors=%v", result.Symbols, result.Errors)
+	}
+	for sym, err := range result.Errors {
+		if err != context.Canceled {
+			t.Errorf("%s: expected context.Canceled, got %v", sym, err)
+		}
+	}
+}
+
+func TestHistoryInvalidParams(t *testing.T) {
+	fetch := func(string, models.HistoryParams) ([]models.Bar, error) {
+		t.Fatal("fetch should not be called")
+		return nil, nil
+	}
+
+	if _, err := history(context.Background(), []string{"AAPL"}, &models.DownloadParams{Interval: "7m"}, fetch); err == nil {
+		t.Error("expected error for invalid interval")
+	}
+
+	dir := filepath.Join(t.TempDir(), "out")
+	if _, err := historyToCSV(context.Background(), []string{"AAPL", "MSFT"}, &models.DownloadParams{Interval: "7m"}, dir, fetch); err == nil {
+		t.Error("expected one up-front error for invalid interval in historyToCSV")
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("output directory should not be created for invalid params")
+	}
+}
+
+func TestBarsNDJSONRoundTrip(t *testing.T) {
+	bars := []models.Bar{
+		{Date: time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC), Open: 1.5, High: 2, Low: 1, Close: 1.75, AdjClose: 1.7, Volume: 100},
+		{Date: time.Date(2024, 1, 3, 14, 30, 0, 0, time.UTC), Open: math.NaN(), High: 2, Low: 1, Close: 1.8, AdjClose: 1.8, Dividends: 0.2},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBarsNDJSON(&buf, bars); err != nil {
+		t.Fatalf("WriteBarsNDJSON() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[1], `"open":null`) {
+		t.Errorf("NaN open should encode as null: %s", lines[1])
+	}
+
+	got, err := ReadBarsNDJSON(&buf)
+	if err != nil {
+		t.Fatalf("ReadBarsNDJSON() error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 bars, got %d", len(got))
+	}
+	if !got[0].Date.Equal(bars[0].Date) || got[0].Close != 1.75 || got[0].Volume != 100 {
+		t.Errorf("bar 0 = %+v", got[0])
+	}
+	if !math.IsNaN(got[1].Open) || got[1].Dividends != 0.2 {
+		t.Errorf("bar 1 = %+v", got[1])
+	}
+}
+
+func TestBarDecoderInvalid(t *testing.T) {
+	dec := NewBarDecoder(strings.NewReader("{\"close\":1}\nnot json\n"))
+	if _, err := dec.Decode(); err != nil {
+		t.Fatalf("first Decode() error: %v", err)
+	}
+	if _, err := dec.Decode(); err == nil || !strings.Contains(err.Error(), "bar 2") {
+		t.Errorf("expected error for bar 2, got %v", err)
+	}
+}
+
+func TestHistoryNDJSON(t *testing.T) {
+	fetch := func(symbol string, _ models.HistoryParams) ([]models.Bar, error) {
+		return []models.Bar{{Close: 1}, {Close: 2}}, nil
+	}
+
+	r := historyNDJSON("AAPL", models.HistoryParams{}, fetch)
+	defer r.Close()
+	bars, err := ReadBarsNDJSON(r)
+	if err != nil {
+		t.Fatalf("ReadBarsNDJSON() error: %v", err)
+	}
+	if len(bars) != 2 || bars[1].Close != 2 {
+		t.Errorf("bars = %+v", bars)
+	}
+
+	errFetch := errors.New("boom")
+	fail := func(string, models.HistoryParams) ([]models.Bar, error) {
+		return nil, errFetch
+	}
+	r = historyNDJSON("AAPL", models.HistoryParams{}, fail)
+	defer r.Close()
+	if _, err := io.ReadAll(r); !errors.Is(err, errFetch) {
+		t.Errorf("expected fetch error, got %v", err)
+	}
+}
+
+func TestCorrelationMatrix(t *testing.T) {
+	cache.ClearGlobal()
+	defer cache.ClearGlobal()
+	cache.SetSymbolTimezone("7203.T", "Asia/Tokyo")
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 14, 30, 0, 0, time.UTC) }
+	bars := func(dates func(int) time.Time, closes ...float64) []models.Bar {
+		out := make([]models.Bar, len(closes))
+		for i, c := range closes {
+			out[i] = models.Bar{Date: dates(i + 1), Close: c}
+		}
+		return out
+	}
+
+	series := map[string][]models.Bar{
+		"UP":   bars(day, 100, 101, 100, 102, 101, 103),
+		"SAME": bars(day, 50, 50.5, 50, 51, 50.5, 51.5),
+		"INV":  bars(day, 10, 9.9, 10, 9.8, 9.9, 9.7),
+		// Tokyo bars open at 00:00 UTC; aligned by local trading day
+		"7203.T": bars(func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }, 100, 101, 100, 102, 101, 103),
+	}
+	fetch := func(symbol string, params models.HistoryParams) ([]models.Bar, error) {
+		if s, ok := series[symbol]; ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("not found")
+	}
+
+	ctx := context.Background()
+	m, err := correlationMatrix(ctx, []string{"up", "same", "inv", "7203.T", "bad"}, &models.DownloadParams{Interval: "1d", Threads: 3}, fetch)
+	if err != nil {
+		t.Fatalf("correlationMatrix() error: %v", err)
+	}
+	if strings.Join(m.Symbols, ",") != "UP,SAME,INV,7203.T" {
+		t.Errorf("unexpected symbols %v", m.Symbols)
+	}
+	if _, ok := m.Errors["BAD"]; !ok {
+		t.Error("expected error for BAD")
+	}
+
+	for _, tt := range []struct {
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{"UP", "UP", 0.999, 1.001},
+		{"UP", "SAME", 0.99, 1.001},
+		{"UP", "INV", -1.001, -0.9},
+		{"UP", "7203.T", 0.999, 1.001},
+	} {
+		got, ok := m.Get(tt.a, tt.b)
+		if !ok || got < tt.min || got > tt.max {
+			t.Errorf("Get(%s, %s) = %v, %v, want in [%v, %v]", tt.a, tt.b, got, ok, tt.min, tt.max)
+		}
+	}
+	if m.Observations[0][3] != 5 {
+		t.Errorf("expected 5 paired returns across exchanges, got %d", m.Observations[0][3])
+	}
+	if _, ok := m.Get("UP", "BAD"); ok {
+		t.Error("failed symbols should not be in the matrix")
+	}
+
+	if _, err := correlationMatrix(ctx, []string{"UP", "up"}, nil, fetch); err == nil {
+		t.Error("expected error for fewer than two symbols")
+	}
+	if _, err := correlationMatrix(ctx, []string{"UP", "SAME"}, &models.DownloadParams{Period: "1y", Interval: "1m"}, fetch); err == nil {
+		t.Error("expected error for invalid params")
+	}
+}
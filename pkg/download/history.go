@@ -0,0 +1,310 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/wnjoon/go-yfinance/internal/parallel"
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+)
+
+// CSVResult reports the outcome of HistoryToCSV.
+type CSVResult struct {
+	// Files maps each successfully written symbol to its CSV path.
+	Files map[string]string
+
+	// Errors contains per-symbol errors.
+	Errors map[string]error
+}
+
+// HasErrors returns true if any symbol failed.
+func (r *CSVResult) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+// historyFetcher fetches bars for one symbol; overridable in tests.
+type historyFetcher func(symbol string, params models.HistoryParams) ([]models.Bar, error)
+
+// Option configures History.
+type Option func(*options)
+
+type options struct {
+	client *client.Client
+}
+
+// WithClient makes History use c instead of the shared default client.
+func WithClient(c *client.Client) Option {
+	return func(o *options) {
+		o.client = c
+	}
+}
+
+// HistoryToCSV downloads history for each symbol and writes it straight to
+// dir/<SYMBOL>.csv as soon as it arrives. Files are created with mode
+// 0644.
+//
+// Bars are never accumulated across symbols, so memory use is bounded by
+// params.Threads even for multi-thousand-symbol exports. The directory is
+// created if needed. Each file is written to a temporary name and renamed
+// once complete, so partially written files are never left behind.
+//
+// Transient failures are retried with the configured retry policy; a
+// symbol that still fails after retries records a *client.RetryError. A
+// symbol whose first attempt fails with a non-retryable error, such as an
+// unknown symbol, records that error as is.
+//
+// The returned error is non-nil only for invalid params and setup failures
+// (e.g. the directory cannot be created); per-symbol failures are reported
+// in CSVResult.Errors.
+//
+// Example:
+//
+//	result, err := download.HistoryToCSV(ctx, symbols, &models.DownloadParams{
+//	    Period:  "max",
+//	    Threads: 8,
+//	}, "./history")
+func HistoryToCSV(ctx context.Context, symbols []string, params *models.DownloadParams, dir string) (*CSVResult, error) {
+	fetch, release, err := tickerHistory(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return historyToCSV(ctx, symbols, params, dir, fetch)
+}
+
+// History downloads history for many symbols concurrently, like Python
+// yfinance's yf.download, and returns the bars of each symbol in memory.
+//
+// Up to params.Threads symbols are fetched at once (sequentially if
+// Threads is 0 or 1). Transient failures are retried; symbols that still
+// fail, or that were not started before ctx was cancelled, are reported in
+// [models.MultiTickerResult.Errors]. Result Symbols keeps the input order.
+//
+// The returned error is non-nil only for invalid params. Use
+// [HistoryToCSV] instead when the bars of all symbols do not fit in memory.
+// multi.Download and multi.Tickers.History are built on History.
+//
+// Example:
+//
+//	result, err := download.History(ctx, []string{"AAPL", "MSFT", "7203.T"}, &models.DownloadParams{
+//	    Period:     "1y",
+//	    Interval:   "1d",
+//	    AutoAdjust: true,
+//	    Threads:    4,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, symbol := range result.Symbols {
+//	    fmt.Printf("%s: %d bars\n", symbol, len(result.Get(symbol)))
+//	}
+//	for symbol, err := range result.Errors {
+//	    log.Printf("%s: %v", symbol, err)
+//	}
+func History(ctx context.Context, symbols []string, params *models.DownloadParams, opts ...Option) (*models.MultiTickerResult, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	fetch, release, err := tickerHistory(ctx, o.client)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return history(ctx, symbols, params, fetch)
+}
+
+func history(ctx context.Context, symbols []string, params *models.DownloadParams, fetch historyFetcher) (*models.MultiTickerResult, error) {
+	if params == nil {
+		defaultParams := models.DefaultDownloadParams()
+		params = &defaultParams
+	}
+
+	histParams := historyParams(params)
+	if err := histParams.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid history params: %w", err)
+	}
+	symbols = utils.NormalizeSymbols(symbols)
+
+	result := &models.MultiTickerResult{
+		Data:    make(map[string][]models.Bar),
+		Errors:  make(map[string]error),
+		Symbols: make([]string, 0, len(symbols)),
+	}
+
+	var mu sync.Mutex
+	record := func(symbol string, bars []models.Bar, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Errors[symbol] = err
+			return
+		}
+		result.Data[symbol] = bars
+	}
+
+	eachSymbol(ctx, symbols, params.Threads, func(symbol string) {
+		bars, err := fetch(symbol, histParams)
+		record(symbol, bars, err)
+	}, func(symbol string, err error) {
+		record(symbol, nil, err)
+	})
+
+	for _, symbol := range symbols {
+		if _, ok := result.Data[symbol]; ok {
+			result.Symbols = append(result.Symbols, symbol)
+		}
+	}
+	return result, nil
+}
+
+// tickerHistory returns a fetcher that loads history with a Ticker on c,
+// or on the shared client if c is nil, retrying transient failures until
+// ctx is done. The caller calls release once done with the fetcher.
+func tickerHistory(ctx context.Context, c *client.Client) (fetch historyFetcher, release func(), err error) {
+	release = func() {}
+	if c == nil {
+		if c, err = client.AcquireDefault(); err != nil {
+			return nil, nil, fmt.Errorf("failed to create client: %w", err)
+		}
+		release = c.Release
+	}
+
+	policy := client.DefaultRetryPolicy()
+	return func(symbol string, hp models.HistoryParams) ([]models.Bar, error) {
+		tkr, err := ticker.New(symbol, ticker.WithClient(c))
+		if err != nil {
+			return nil, err
+		}
+		defer tkr.Close()
+
+		var bars []models.Bar
+		err = client.Retry(ctx, policy, func() error {
+			bars, err = tkr.HistoryContext(ctx, hp)
+			return err
+		})
+		return bars, err
+	}, release, nil
+}
+
+func historyToCSV(ctx context.Context, symbols []string, params *models.DownloadParams, dir string, fetch historyFetcher) (*CSVResult, error) {
+	if params == nil {
+		defaultParams := models.DefaultDownloadParams()
+		params = &defaultParams
+	}
+
+	histParams := historyParams(params)
+	if err := histParams.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid history params: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+	symbols = utils.NormalizeSymbols(symbols)
+
+	result := &CSVResult{
+		Files:  make(map[string]string),
+		Errors: make(map[string]error),
+	}
+
+	var mu sync.Mutex
+	record := func(symbol, path string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Errors[symbol] = err
+			return
+		}
+		result.Files[symbol] = path
+	}
+
+	eachSymbol(ctx, symbols, params.Threads, func(symbol string) {
+		path, err := writeSymbolCSV(symbol, histParams, dir, fetch)
+		record(symbol, path, err)
+	}, func(symbol string, err error) {
+		record(symbol, "", err)
+	})
+
+	return result, nil
+}
+
+// eachSymbol calls work for each symbol on up to threads workers. Once ctx
+// is cancelled, symbols not yet started are passed to skip with the context
+// error instead.
+func eachSymbol(ctx context.Context, symbols []string, threads int, work func(symbol string), skip func(symbol string, err error)) {
+	parallel.For(ctx, len(symbols), threads, func(i int) {
+		work(symbols[i])
+	}, func(i int, err error) {
+		skip(symbols[i], err)
+	})
+}
+
+// csvFileMode is the mode of the CSV files written by HistoryToCSV, the
+// mode os.Create gives new files before the umask.
+const csvFileMode = 0o644
+
+// writeSymbolCSV fetches one symbol and writes its CSV file.
+func writeSymbolCSV(symbol string, params models.HistoryParams, dir string, fetch historyFetcher) (string, error) {
+	bars, err := fetch(symbol, params)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, fileName(symbol))
+	tmp, err := os.CreateTemp(dir, ".download-*.csv")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	tmpName := tmp.Name()
+
+	// CreateTemp creates the file readable by its owner only
+	if err := tmp.Chmod(csvFileMode); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := WriteBarsCSV(tmp, bars); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return path, nil
+}
+
+// historyParams converts download parameters to per-ticker history parameters.
+func historyParams(params *models.DownloadParams) models.HistoryParams {
+	return models.HistoryParams{
+		Period:     params.Period,
+		Interval:   params.Interval,
+		Start:      params.Start,
+		End:        params.End,
+		PrePost:    params.PrePost,
+		AutoAdjust: params.AutoAdjust,
+		BackAdjust: params.BackAdjust,
+		Actions:    params.Actions,
+		Currency:   params.Currency,
+	}
+}
+
+// fileName returns a filesystem-safe CSV file name for a symbol.
+func fileName(symbol string) string {
+	replacer := strings.NewReplacer("/", "_", "\\", "_", ":", "_")
+	return replacer.Replace(symbol) + ".csv"
+}
@@ -0,0 +1,150 @@
+package download
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// BarEncoder writes bars as newline-delimited JSON (NDJSON), one object
+// per line.
+//
+// Each line uses the models.Bar JSON encoding, so missing (NaN) prices are
+// written as null and decode back to NaN.
+type BarEncoder struct {
+	enc *json.Encoder
+}
+
+// NewBarEncoder returns an encoder that writes to w.
+//
+// Bars are written as they are encoded; nothing is buffered beyond the
+// current line.
+//
+// Example:
+//
+//	enc := download.NewBarEncoder(os.Stdout)
+//	for _, bar := range bars {
+//	    if err := enc.Encode(bar); err != nil {
+//	        return err
+//	    }
+//	}
+func NewBarEncoder(w io.Writer) *BarEncoder {
+	return &BarEncoder{enc: json.NewEncoder(w)}
+}
+
+// Encode writes bar as a single JSON line.
+func (e *BarEncoder) Encode(bar models.Bar) error {
+	return e.enc.Encode(bar)
+}
+
+// BarDecoder reads bars written by BarEncoder.
+type BarDecoder struct {
+	dec  *json.Decoder
+	line int
+}
+
+// NewBarDecoder returns a decoder that reads from r.
+//
+// Example:
+//
+//	dec := download.NewBarDecoder(os.Stdin)
+//	for {
+//	    bar, err := dec.Decode()
+//	    if err == io.EOF {
+//	        break
+//	    }
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(bar.Date, bar.Close)
+//	}
+func NewBarDecoder(r io.Reader) *BarDecoder {
+	return &BarDecoder{dec: json.NewDecoder(r)}
+}
+
+// Decode reads the next bar. It returns io.EOF when the input is exhausted.
+func (d *BarDecoder) Decode() (models.Bar, error) {
+	var bar models.Bar
+	if !d.dec.More() {
+		return bar, io.EOF
+	}
+	d.line++
+	if err := d.dec.Decode(&bar); err != nil {
+		return models.Bar{}, fmt.Errorf("failed to decode bar %d: %w", d.line, err)
+	}
+	return bar, nil
+}
+
+// WriteBarsNDJSON writes bars as newline-delimited JSON, one bar per line.
+//
+// Example:
+//
+//	bars, _ := t.History(models.HistoryParams{Period: "1mo"})
+//	err := download.WriteBarsNDJSON(os.Stdout, bars)
+func WriteBarsNDJSON(w io.Writer, bars []models.Bar) error {
+	enc := NewBarEncoder(w)
+	for _, bar := range bars {
+		if err := enc.Encode(bar); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadBarsNDJSON reads all bars from newline-delimited JSON.
+func ReadBarsNDJSON(r io.Reader) ([]models.Bar, error) {
+	dec := NewBarDecoder(r)
+	var bars []models.Bar
+	for {
+		bar, err := dec.Decode()
+		if err == io.EOF {
+			return bars, nil
+		}
+		if err != nil {
+			return bars, err
+		}
+		bars = append(bars, bar)
+	}
+}
+
+// HistoryNDJSON fetches history for symbol and returns it as a stream of
+// newline-delimited JSON bars.
+//
+// The fetch starts immediately in the background and bars are written to
+// the reader as it is consumed. Fetch errors, including cancellation of
+// ctx, are returned from Read. Transient failures are retried with the
+// default retry policy. Close the reader to abandon the stream early.
+//
+// Example:
+//
+//	r := download.HistoryNDJSON(ctx, "AAPL", models.HistoryParams{Period: "1y"})
+//	defer r.Close()
+//	_, err := io.Copy(os.Stdout, r)
+func HistoryNDJSON(ctx context.Context, symbol string, params models.HistoryParams) io.ReadCloser {
+	fetch, release, err := tickerHistory(ctx, nil)
+	if err != nil {
+		pr, pw := io.Pipe()
+		pw.CloseWithError(err)
+		return pr
+	}
+	return historyNDJSON(symbol, params, func(symbol string, hp models.HistoryParams) ([]models.Bar, error) {
+		defer release()
+		return fetch(symbol, hp)
+	})
+}
+
+func historyNDJSON(symbol string, params models.HistoryParams, fetch historyFetcher) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		bars, err := fetch(symbol, params)
+		if err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to fetch %s: %w", symbol, err))
+			return
+		}
+		pw.CloseWithError(WriteBarsNDJSON(pw, bars))
+	}()
+	return pr
+}
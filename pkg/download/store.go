@@ -0,0 +1,237 @@
+package download
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+)
+
+// historyStoreKey returns the store key of symbol's history at interval,
+// e.g. "history/AAPL/1d".
+func historyStoreKey(symbol, interval string) string {
+	return store.Key("history", symbol, interval)
+}
+
+// HistoryToStore downloads history for each symbol into st incrementally.
+// The first run fetches params' Period or Start/End range; later runs fetch
+// only from the newest stored bar onwards. Each run saves its bars as one
+// record at the time of its last bar, and the newest stored bar is
+// refetched so a bar that was still forming is updated, in place when it is
+// still the newest. Read the stitched history back with [StoredHistory].
+//
+// Adjusted prices depend on the dividends and splits after each bar, so
+// when a newly fetched bar carries one, the whole stored range is fetched
+// again rather than mixing bars adjusted before and after it.
+//
+// The returned result holds the bars fetched by this run; a symbol with
+// nothing new has no bars. Per-symbol failures are reported in
+// result.Errors.
+//
+// Example:
+//
+//	st, _ := store.NewFileStore("./history")
+//	result, err := download.HistoryToStore(ctx, st, []string{"AAPL", "MSFT"}, &models.DownloadParams{
+//	    Period:   "5y",
+//	    Interval: "1d",
+//	})
+//	bars, _ := download.StoredHistory(st, "AAPL", "1d")
+func HistoryToStore(ctx context.Context, st store.Store, symbols []string, params *models.DownloadParams) (*models.MultiTickerResult, error) {
+	fetch, release, err := tickerHistory(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return historyToStore(ctx, st, symbols, params, fetch)
+}
+
+func historyToStore(ctx context.Context, st store.Store, symbols []string, params *models.DownloadParams, fetch historyFetcher) (*models.MultiTickerResult, error) {
+	if st == nil {
+		return nil, fmt.Errorf("store is required")
+	}
+	if params == nil {
+		defaultParams := models.DefaultDownloadParams()
+		params = &defaultParams
+	}
+
+	histParams := historyParams(params)
+	if histParams.Interval == "" {
+		histParams.Interval = "1d"
+	}
+	if err := histParams.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid history params: %w", err)
+	}
+	symbols = utils.NormalizeSymbols(symbols)
+
+	result := &models.MultiTickerResult{
+		Data:    make(map[string][]models.Bar),
+		Errors:  make(map[string]error),
+		Symbols: make([]string, 0, len(symbols)),
+	}
+
+	var mu sync.Mutex
+	record := func(symbol string, bars []models.Bar, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Errors[symbol] = err
+			return
+		}
+		result.Data[symbol] = bars
+	}
+
+	eachSymbol(ctx, symbols, params.Threads, func(symbol string) {
+		bars, err := storeSymbolHistory(st, symbol, histParams, fetch)
+		record(symbol, bars, err)
+	}, func(symbol string, err error) {
+		record(symbol, nil, err)
+	})
+
+	for _, symbol := range symbols {
+		if _, ok := result.Data[symbol]; ok {
+			result.Symbols = append(result.Symbols, symbol)
+		}
+	}
+	return result, nil
+}
+
+// storeSymbolHistory fetches symbol's bars since its newest stored bar and
+// saves them, returning the fetched bars.
+func storeSymbolHistory(st store.Store, symbol string, params models.HistoryParams, fetch historyFetcher) ([]models.Bar, error) {
+	key := historyStoreKey(symbol, params.Interval)
+
+	latest, err := st.Get(key, time.Now())
+	stored := err == nil
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read stored history: %w", err)
+	default:
+		start := latest.Time
+		params.Period, params.Start, params.End = "", &start, nil
+	}
+
+	// Actions are needed to tell whether stored adjusted prices went stale
+	keepActions := params.Actions
+	params.Actions = true
+	bars, err := fetch(symbol, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch []models.Bar
+	switch {
+	case !stored:
+		batch = bars
+	case hasActionAfter(bars, latest.Time):
+		batch, err = refetchStoredRange(st, symbol, params, fetch)
+		if err != nil {
+			return nil, err
+		}
+		bars = batch
+	default:
+		bars = barsFrom(bars, latest.Time)
+		if len(bars) == 0 {
+			return nil, nil
+		}
+		batch = bars
+		if bars[len(bars)-1].Date.Equal(latest.Time) {
+			// Saving at the newest stored time replaces that record, so
+			// fold the updated bar into it
+			var prev []models.Bar
+			if err := json.Unmarshal(latest.Data, &prev); err != nil {
+				return nil, fmt.Errorf("failed to decode stored history at %s: %w", latest.Time, err)
+			}
+			batch = models.MergeBars(prev, bars, models.PreferNew)
+		}
+	}
+	if len(batch) == 0 {
+		return nil, nil
+	}
+	if !keepActions {
+		bars = withoutActions(bars)
+		batch = withoutActions(batch)
+	}
+
+	if err := store.PutJSON(st, key, batch[len(batch)-1].Date, batch); err != nil {
+		return nil, fmt.Errorf("failed to save history: %w", err)
+	}
+	return bars, nil
+}
+
+// refetchStoredRange fetches symbol's bars again from the oldest stored
+// bar onwards, so that they share one adjustment basis.
+func refetchStoredRange(st store.Store, symbol string, params models.HistoryParams, fetch historyFetcher) ([]models.Bar, error) {
+	stored, err := StoredHistory(st, symbol, params.Interval)
+	if err != nil {
+		return nil, err
+	}
+	if len(stored) > 0 {
+		start := stored[0].Date
+		params.Start = &start
+	}
+	return fetch(symbol, params)
+}
+
+// hasActionAfter reports whether a bar dated after t carries a dividend,
+// split or capital gain.
+func hasActionAfter(bars []models.Bar, t time.Time) bool {
+	for _, b := range bars {
+		if b.Date.After(t) && (b.Dividends != 0 || b.Splits != 0 || b.CapitalGains != 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutActions returns a copy of bars with the action columns cleared.
+func withoutActions(bars []models.Bar) []models.Bar {
+	out := make([]models.Bar, len(bars))
+	for i, b := range bars {
+		b.Dividends, b.DividendCurrency, b.Splits, b.CapitalGains = 0, "", 0, 0
+		out[i] = b
+	}
+	return out
+}
+
+// barsFrom returns the bars dated at or after t.
+func barsFrom(bars []models.Bar, t time.Time) []models.Bar {
+	for i, b := range bars {
+		if !b.Date.Before(t) {
+			return bars[i:]
+		}
+	}
+	return nil
+}
+
+// StoredHistory returns the history of symbol at interval saved by
+// [HistoryToStore], stitched oldest first. Where runs overlap, the bar
+// from the later run wins.
+//
+// Example:
+//
+//	bars, err := download.StoredHistory(st, "AAPL", "1d")
+func StoredHistory(st store.Store, symbol, interval string) ([]models.Bar, error) {
+	symbol = strings.TrimSpace(strings.ToUpper(symbol))
+	records, err := st.List(historyStoreKey(symbol, interval), time.Time{}, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stored history: %w", err)
+	}
+
+	var bars []models.Bar
+	for _, rec := range records {
+		var batch []models.Bar
+		if err := json.Unmarshal(rec.Data, &batch); err != nil {
+			return nil, fmt.Errorf("failed to decode stored history at %s: %w", rec.Time, err)
+		}
+		bars = models.MergeBars(bars, batch, models.PreferNew)
+	}
+	return bars, nil
+}
@@ -0,0 +1,111 @@
+package download
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
+)
+
+func TestHistoryToStore(t *testing.T) {
+	st, err := store.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.UTC) }
+
+	var (
+		last     int
+		dividend int
+		starts   []*time.Time
+	)
+	fetch := func(symbol string, p models.HistoryParams) ([]models.Bar, error) {
+		if !p.Actions {
+			t.Error("fetch should request actions")
+		}
+		starts = append(starts, p.Start)
+		var bars []models.Bar
+		for d := 1; d <= last; d++ {
+			if p.Start == nil || !day(d).Before(*p.Start) {
+				bar := models.Bar{Date: day(d), Close: float64(d*10 + len(starts))}
+				if d == dividend {
+					bar.Dividends = 0.5
+				}
+				bars = append(bars, bar)
+			}
+		}
+		return bars, nil
+	}
+	params := &models.DownloadParams{Period: "1mo", Interval: "1d"}
+
+	last = 3
+	result, err := historyToStore(context.Background(), st, []string{"aapl"}, params, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Get("AAPL")) != 3 || starts[0] != nil {
+		t.Fatalf("first run fetched %d bars from %v", len(result.Get("AAPL")), starts[0])
+	}
+
+	// Same-day rerun: the newest bar is updated in place and the rest of
+	// the stored batch survives
+	result, err = historyToStore(context.Background(), st, []string{"AAPL"}, params, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if starts[1] == nil || !starts[1].Equal(day(3)) {
+		t.Errorf("second run started at %v, want day 3", starts[1])
+	}
+	if got := result.Get("AAPL"); len(got) != 1 || got[0].Close != 32 {
+		t.Errorf("second run fetched %+v, want the updated day 3 bar", got)
+	}
+	bars, err := StoredHistory(st, "AAPL", "1d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bars) != 3 || bars[0].Close != 11 || bars[2].Close != 32 {
+		t.Errorf("stored after rerun %+v, want days 1-3 with day 3 updated", bars)
+	}
+
+	last = 5
+	result, err = historyToStore(context.Background(), st, []string{"AAPL"}, params, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := result.Get("AAPL"); len(got) != 3 || !got[0].Date.Equal(day(3)) {
+		t.Errorf("third run fetched %+v, want days 3-5", got)
+	}
+
+	bars, err = StoredHistory(st, "aapl", "1d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bars) != 5 {
+		t.Fatalf("stored %d bars, want 5", len(bars))
+	}
+	if bars[2].Close != 33 || bars[0].Close != 11 {
+		t.Errorf("stored closes %v, %v; want the refetched day 3 bar", bars[0].Close, bars[2].Close)
+	}
+
+	// A dividend in the new bars makes the stored adjusted prices stale:
+	// the whole stored range is fetched again
+	last, dividend = 7, 6
+	if _, err := historyToStore(context.Background(), st, []string{"AAPL"}, params, fetch); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(starts); n != 5 || starts[4] == nil || !starts[4].Equal(day(1)) {
+		t.Fatalf("refetch started at %v, want day 1", starts[n-1])
+	}
+	bars, err = StoredHistory(st, "AAPL", "1d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bars) != 7 || bars[0].Close != 15 || bars[4].Close != 55 {
+		t.Errorf("stored after refetch %+v, want every bar from the refetch", bars)
+	}
+	if bars[5].Dividends != 0 {
+		t.Error("actions should not be stored unless requested")
+	}
+}
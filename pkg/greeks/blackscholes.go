@@ -0,0 +1,191 @@
+package greeks
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Right is the type of an option contract.
+type Right int
+
+const (
+	// Call is the right to buy.
+	Call Right = iota
+
+	// Put is the right to sell.
+	Put
+)
+
+// String returns "call" or "put".
+func (r Right) String() string {
+	if r == Put {
+		return "put"
+	}
+	return "call"
+}
+
+// Inputs are the Black-Scholes model inputs of one contract.
+type Inputs struct {
+	Right  Right
+	Spot   float64
+	Strike float64
+
+	// Years is the time to expiration in years.
+	Years float64
+
+	// Rate is the continuously compounded risk-free rate (0.045 = 4.5%).
+	Rate float64
+
+	// DividendYield is the continuous dividend yield of the underlying.
+	DividendYield float64
+
+	// Volatility is the annualized volatility (0.25 = 25%). It is ignored
+	// by [ImpliedVolatility].
+	Volatility float64
+}
+
+// Greeks are the sensitivities of an option's value. See the package
+// documentation for units.
+type Greeks struct {
+	Delta float64 `json:"delta"`
+	Gamma float64 `json:"gamma"`
+	Theta float64 `json:"theta"`
+	Vega  float64 `json:"vega"`
+	Rho   float64 `json:"rho"`
+}
+
+// Errors returned by [ImpliedVolatility].
+var (
+	// ErrInvalidInputs means spot, strike or time to expiration is not
+	// positive.
+	ErrInvalidInputs = errors.New("spot, strike and time to expiration must be positive")
+
+	// ErrNoSolution means the price is outside the no-arbitrage bounds,
+	// so no volatility reproduces it.
+	ErrNoSolution = errors.New("price outside no-arbitrage bounds")
+)
+
+// Volatility search range for ImpliedVolatility.
+const (
+	minVolatility = 1e-6
+	maxVolatility = 10.0
+)
+
+// Price returns the Black-Scholes value of the contract, or NaN for
+// invalid inputs.
+func Price(in Inputs) float64 {
+	if !in.valid() || in.Volatility <= 0 {
+		return math.NaN()
+	}
+	d1, d2 := in.d()
+	spotDisc := in.Spot * math.Exp(-in.DividendYield*in.Years)
+	strikeDisc := in.Strike * math.Exp(-in.Rate*in.Years)
+	if in.Right == Put {
+		return strikeDisc*normCDF(-d2) - spotDisc*normCDF(-d1)
+	}
+	return spotDisc*normCDF(d1) - strikeDisc*normCDF(d2)
+}
+
+// Compute returns the greeks of the contract. Every greek is NaN for
+// invalid inputs.
+func Compute(in Inputs) Greeks {
+	if !in.valid() || in.Volatility <= 0 {
+		nan := math.NaN()
+		return Greeks{Delta: nan, Gamma: nan, Theta: nan, Vega: nan, Rho: nan}
+	}
+
+	d1, d2 := in.d()
+	sqrtT := math.Sqrt(in.Years)
+	divDisc := math.Exp(-in.DividendYield * in.Years)
+	rateDisc := math.Exp(-in.Rate * in.Years)
+	pdf := normPDF(d1)
+
+	g := Greeks{
+		Gamma: divDisc * pdf / (in.Spot * in.Volatility * sqrtT),
+		Vega:  in.Spot * divDisc * pdf * sqrtT / 100,
+	}
+	decay := -in.Spot * divDisc * pdf * in.Volatility / (2 * sqrtT)
+	if in.Right == Put {
+		g.Delta = divDisc * (normCDF(d1) - 1)
+		g.Theta = (decay + in.Rate*in.Strike*rateDisc*normCDF(-d2) - in.DividendYield*in.Spot*divDisc*normCDF(-d1)) / 365
+		g.Rho = -in.Strike * in.Years * rateDisc * normCDF(-d2) / 100
+	} else {
+		g.Delta = divDisc * normCDF(d1)
+		g.Theta = (decay - in.Rate*in.Strike*rateDisc*normCDF(d2) + in.DividendYield*in.Spot*divDisc*normCDF(d1)) / 365
+		g.Rho = in.Strike * in.Years * rateDisc * normCDF(d2) / 100
+	}
+	return g
+}
+
+// ImpliedVolatility returns the volatility at which the Black-Scholes
+// value of the contract equals price, searched between 0% and 1000%.
+//
+// Example:
+//
+//	iv, err := greeks.ImpliedVolatility(greeks.Inputs{
+//	    Right: greeks.Put, Spot: 190, Strike: 180, Years: 0.25, Rate: 0.045,
+//	}, 4.20)
+func ImpliedVolatility(in Inputs, price float64) (float64, error) {
+	if !in.valid() {
+		return 0, ErrInvalidInputs
+	}
+
+	lo, hi := minVolatility, maxVolatility
+	in.Volatility = lo
+	low := Price(in)
+	in.Volatility = hi
+	high := Price(in)
+	if !(price >= low && price <= high) {
+		return 0, fmt.Errorf("%w: %g not in [%g, %g]", ErrNoSolution, price, low, high)
+	}
+
+	// Newton's method, falling back to bisection whenever a step leaves
+	// the bracket.
+	vol := 0.3
+	for i := 0; i < 100; i++ {
+		in.Volatility = vol
+		diff := Price(in) - price
+		if math.Abs(diff) < 1e-10 {
+			return vol, nil
+		}
+		if diff > 0 {
+			hi = vol
+		} else {
+			lo = vol
+		}
+		if hi-lo < 1e-12 {
+			break
+		}
+
+		vega := in.Spot * math.Exp(-in.DividendYield*in.Years) * normPDF(in.d1()) * math.Sqrt(in.Years)
+		next := vol - diff/vega
+		if vega <= 0 || math.IsNaN(next) || next <= lo || next >= hi {
+			next = (lo + hi) / 2
+		}
+		vol = next
+	}
+	return vol, nil
+}
+
+func (in Inputs) valid() bool {
+	return in.Spot > 0 && in.Strike > 0 && in.Years > 0
+}
+
+func (in Inputs) d1() float64 {
+	return (math.Log(in.Spot/in.Strike) + (in.Rate-in.DividendYield+in.Volatility*in.Volatility/2)*in.Years) /
+		(in.Volatility * math.Sqrt(in.Years))
+}
+
+func (in Inputs) d() (d1, d2 float64) {
+	d1 = in.d1()
+	return d1, d1 - in.Volatility*math.Sqrt(in.Years)
+}
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
@@ -0,0 +1,107 @@
+package greeks
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// expiryClose approximates the 4pm New York close on the expiration day.
+// Yahoo timestamps expirations at midnight UTC of that day.
+const expiryClose = 20 * time.Hour
+
+// Options configures [ForChain].
+type Options struct {
+	// RiskFreeRate is the continuously compounded risk-free rate, e.g. the
+	// 13-week T-bill yield (^IRX / 100).
+	RiskFreeRate float64
+
+	// DividendYield is the continuous dividend yield of the underlying.
+	DividendYield float64
+
+	// Now is the valuation time. Zero means time.Now().
+	Now time.Time
+}
+
+// ContractGreeks is one contract with its implied volatility and greeks.
+type ContractGreeks struct {
+	Option models.Option `json:"option"`
+	Right  Right         `json:"right"`
+
+	// Premium is the price the volatility was solved from: the bid/ask
+	// midpoint, or the last price when there is no two-sided quote.
+	Premium float64 `json:"premium"`
+
+	// ImpliedVolatility is the solved volatility. When the premium is
+	// outside the no-arbitrage bounds, Yahoo's ImpliedVolatility is used
+	// instead and Err records why.
+	ImpliedVolatility float64 `json:"impliedVolatility"`
+
+	Greeks
+
+	// Err is set when no volatility was available; the greeks are then
+	// zero.
+	Err error `json:"-"`
+}
+
+// ForChain computes implied volatility and greeks for every call and put
+// of chain, in chain order. It fails only when the chain has no underlying
+// price.
+func ForChain(chain *models.OptionChain, opts Options) (calls, puts []ContractGreeks, err error) {
+	if chain == nil || chain.Underlying == nil || chain.Underlying.RegularMarketPrice <= 0 {
+		return nil, nil, errors.New("option chain has no underlying price")
+	}
+	spot := chain.Underlying.RegularMarketPrice
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	calls = make([]ContractGreeks, len(chain.Calls))
+	for i, o := range chain.Calls {
+		calls[i] = forContract(o, Call, spot, now, opts)
+	}
+	puts = make([]ContractGreeks, len(chain.Puts))
+	for i, o := range chain.Puts {
+		puts[i] = forContract(o, Put, spot, now, opts)
+	}
+	return calls, puts, nil
+}
+
+func forContract(o models.Option, right Right, spot float64, now time.Time, opts Options) ContractGreeks {
+	expiry := time.Unix(o.Expiration, 0).Add(expiryClose)
+	in := Inputs{
+		Right:         right,
+		Spot:          spot,
+		Strike:        o.Strike,
+		Years:         expiry.Sub(now).Hours() / (365 * 24),
+		Rate:          opts.RiskFreeRate,
+		DividendYield: opts.DividendYield,
+	}
+	c := ContractGreeks{Option: o, Right: right, Premium: premium(o)}
+
+	vol, err := ImpliedVolatility(in, c.Premium)
+	if err != nil {
+		c.Err = err
+		if o.ImpliedVolatility <= 0 || errors.Is(err, ErrInvalidInputs) {
+			return c
+		}
+		vol = o.ImpliedVolatility
+	}
+	c.ImpliedVolatility = vol
+	in.Volatility = vol
+	c.Greeks = Compute(in)
+	return c
+}
+
+func premium(o models.Option) float64 {
+	if o.Bid > 0 && o.Ask >= o.Bid {
+		return (o.Bid + o.Ask) / 2
+	}
+	if o.LastPrice > 0 {
+		return o.LastPrice
+	}
+	return math.NaN()
+}
@@ -0,0 +1,42 @@
+// Package greeks computes Black-Scholes prices, implied volatility and
+// greeks for option contracts.
+//
+// # Overview
+//
+// The model is Black-Scholes-Merton for European options with a
+// continuously compounded risk-free rate and dividend yield. US equity
+// options are American, so values for deep in-the-money puts and calls
+// before ex-dividend dates are approximations.
+//
+// # Single Contract
+//
+//	in := greeks.Inputs{
+//	    Right:      greeks.Call,
+//	    Spot:       190,
+//	    Strike:     200,
+//	    Years:      30.0 / 365,
+//	    Rate:       0.045,
+//	    Volatility: 0.25,
+//	}
+//	price := greeks.Price(in)
+//	g := greeks.Compute(in)
+//	iv, err := greeks.ImpliedVolatility(in, 3.10)
+//
+// # Option Chains
+//
+// [ForChain] solves the implied volatility of every contract of a
+// models.OptionChain from its bid/ask midpoint, using the chain's
+// underlying price, and computes its greeks:
+//
+//	chain, _ := t.OptionChainWithParams(models.OptionChainParams{MinOpenInterest: 100})
+//	calls, puts, err := greeks.ForChain(chain, greeks.Options{RiskFreeRate: 0.045})
+//	for _, c := range calls {
+//	    fmt.Printf("%s iv=%.1f%% delta=%.2f\n", c.Option.ContractSymbol, c.ImpliedVolatility*100, c.Delta)
+//	}
+//
+// # Units
+//
+// Theta is the change in value per calendar day, Vega per 1 percentage
+// point of volatility and Rho per 1 percentage point of the rate, as most
+// brokers quote them.
+package greeks
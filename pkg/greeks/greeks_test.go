@@ -0,0 +1,105 @@
+package greeks
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func approx(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func TestPriceAndGreeks(t *testing.T) {
+	call := Inputs{Right: Call, Spot: 100, Strike: 100, Years: 1, Rate: 0.05, Volatility: 0.2}
+	put := call
+	put.Right = Put
+
+	if p := Price(call); !approx(p, 10.4506, 1e-4) {
+		t.Errorf("call price = %.4f, want 10.4506", p)
+	}
+	if p := Price(put); !approx(p, 5.5735, 1e-4) {
+		t.Errorf("put price = %.4f, want 5.5735", p)
+	}
+
+	g := Compute(call)
+	want := Greeks{Delta: 0.6368, Gamma: 0.01876, Theta: -6.4140 / 365, Vega: 0.37524, Rho: 0.53232}
+	if !approx(g.Delta, want.Delta, 1e-4) || !approx(g.Gamma, want.Gamma, 1e-5) ||
+		!approx(g.Theta, want.Theta, 1e-5) || !approx(g.Vega, want.Vega, 1e-4) || !approx(g.Rho, want.Rho, 1e-4) {
+		t.Errorf("call greeks = %+v, want %+v", g, want)
+	}
+
+	pg := Compute(put)
+	if !approx(pg.Delta, g.Delta-1, 1e-9) || !approx(pg.Gamma, g.Gamma, 1e-12) || !approx(pg.Vega, g.Vega, 1e-12) {
+		t.Errorf("put greeks = %+v inconsistent with call %+v", pg, g)
+	}
+
+	if p := Price(Inputs{Spot: 100, Strike: 100}); !math.IsNaN(p) {
+		t.Errorf("price with no time = %v, want NaN", p)
+	}
+}
+
+func TestImpliedVolatility(t *testing.T) {
+	for _, in := range []Inputs{
+		{Right: Call, Spot: 100, Strike: 100, Years: 1, Rate: 0.05, Volatility: 0.2},
+		{Right: Put, Spot: 190, Strike: 150, Years: 0.1, Rate: 0.045, DividendYield: 0.01, Volatility: 0.65},
+		{Right: Call, Spot: 50, Strike: 80, Years: 2, Rate: 0.03, Volatility: 1.5},
+	} {
+		iv, err := ImpliedVolatility(in, Price(in))
+		if err != nil {
+			t.Fatalf("ImpliedVolatility(%+v): %v", in, err)
+		}
+		if !approx(iv, in.Volatility, 1e-6) {
+			t.Errorf("ImpliedVolatility(%+v) = %v", in, iv)
+		}
+	}
+
+	in := Inputs{Right: Call, Spot: 100, Strike: 50, Years: 1}
+	if _, err := ImpliedVolatility(in, 10); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("below intrinsic: err = %v, want ErrNoSolution", err)
+	}
+	if _, err := ImpliedVolatility(Inputs{Spot: 100, Strike: 100}, 5); !errors.Is(err, ErrInvalidInputs) {
+		t.Errorf("no time: err = %v, want ErrInvalidInputs", err)
+	}
+}
+
+func TestForChain(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	expiry := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	years := expiry.Add(expiryClose).Sub(now).Hours() / (365 * 24)
+	in := Inputs{Right: Call, Spot: 100, Strike: 105, Years: years, Rate: 0.04, Volatility: 0.3}
+	mid := Price(in)
+
+	chain := &models.OptionChain{
+		Calls: []models.Option{
+			{Strike: 105, Bid: mid - 0.05, Ask: mid + 0.05, Expiration: expiry.Unix()},
+			{Strike: 50, LastPrice: 1, ImpliedVolatility: 0.4, Expiration: expiry.Unix()},
+			{Strike: 60, Expiration: expiry.Unix()},
+		},
+		Underlying: &models.OptionQuote{RegularMarketPrice: 100},
+	}
+
+	calls, puts, err := ForChain(chain, Options{RiskFreeRate: 0.04, Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 3 || len(puts) != 0 {
+		t.Fatalf("got %d calls, %d puts", len(calls), len(puts))
+	}
+	if c := calls[0]; c.Err != nil || !approx(c.ImpliedVolatility, 0.3, 1e-6) || !approx(c.Delta, Compute(in).Delta, 1e-6) {
+		t.Errorf("calls[0] = %+v", c)
+	}
+	if c := calls[1]; c.Err == nil || c.ImpliedVolatility != 0.4 || c.Delta == 0 {
+		t.Errorf("calls[1] should fall back to Yahoo's volatility: %+v", c)
+	}
+	if c := calls[2]; c.Err == nil || c.Delta != 0 {
+		t.Errorf("calls[2] without a price should have no greeks: %+v", c)
+	}
+
+	if _, _, err := ForChain(&models.OptionChain{}, Options{}); err == nil {
+		t.Error("expected error without underlying price")
+	}
+}
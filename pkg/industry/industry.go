@@ -1,6 +1,7 @@
 package industry
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -11,6 +12,7 @@ import (
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	yfconfig "github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Industry provides access to financial industry data from Yahoo Finance.
@@ -37,7 +39,6 @@ type Option func(*Industry)
 func WithClient(c *client.Client) Option {
 	return func(i *Industry) {
 		i.client = c
-		i.ownsClient = false
 	}
 }
 
@@ -69,9 +70,8 @@ func New(key string, opts ...Option) (*Industry, error) {
 	}
 
 	i := &Industry{
-		key:        key,
-		region:     "US",
-		ownsClient: true,
+		key:    key,
+		region: "US",
 	}
 
 	for _, opt := range opts {
@@ -79,14 +79,15 @@ func New(key string, opts ...Option) (*Industry, error) {
 	}
 
 	if i.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		i.client = c
+		i.ownsClient = true
 	}
 
-	i.auth = client.NewAuthManager(i.client)
+	i.auth = i.client.Auth()
 
 	return i, nil
 }
@@ -103,10 +104,12 @@ func NewWithPredefined(ind models.PredefinedIndustry, opts ...Option) (*Industry
 	return New(string(ind), opts...)
 }
 
-// Close releases resources used by the Industry instance.
+// Close releases the shared [client.Default] client if the Industry acquired
+// it. A client passed with WithClient is left open.
 func (i *Industry) Close() {
-	if i.ownsClient && i.client != nil {
-		i.client.Close()
+	if i.ownsClient {
+		i.ownsClient = false
+		i.client.Release()
 	}
 }
 
@@ -121,7 +124,7 @@ func (i *Industry) Region() string {
 }
 
 // fetchData fetches industry data from Yahoo Finance API.
-func (i *Industry) fetchData() error {
+func (i *Industry) fetchData(ctx context.Context) error {
 	i.mu.RLock()
 	if i.dataCache != nil {
 		i.mu.RUnlock()
@@ -141,10 +144,10 @@ func (i *Industry) fetchData() error {
 	// Add crumb authentication
 	params, err := i.auth.AddCrumbToParams(params)
 	if err != nil {
-		return fmt.Errorf("failed to add crumb: %w", err)
+		return yferr.Wrap(yferr.ErrAuth, "failed to add crumb", err)
 	}
 
-	resp, err := i.client.Get(queryURL, params)
+	resp, err := i.client.GetContext(ctx, queryURL, params)
 	if err != nil {
 		return fmt.Errorf("failed to fetch industry data: %w", err)
 	}
@@ -155,11 +158,11 @@ func (i *Industry) fetchData() error {
 
 	var raw models.IndustryResponse
 	if err := json.Unmarshal([]byte(resp.Body), &raw); err != nil {
-		return fmt.Errorf("failed to parse industry data: %w", err)
+		return yferr.Parse("industry data", err)
 	}
 
 	if raw.Error != nil {
-		return fmt.Errorf("industry API error: %s - %s", raw.Error.Code, raw.Error.Description)
+		return yferr.API("industry", raw.Error.Code, raw.Error.Description)
 	}
 
 	data := i.parseData(&raw)
@@ -183,23 +186,24 @@ func (i *Industry) parseData(raw *models.IndustryResponse) *models.IndustryData
 
 	// Parse overview
 	if raw.Data.Overview != nil {
+		o := raw.Data.Overview
 		data.Overview = models.IndustryOverview{
-			CompaniesCount: getInt(raw.Data.Overview, "companiesCount"),
-			MarketCap:      getRawFloat(raw.Data.Overview, "marketCap"),
-			MessageBoardID: getString(raw.Data.Overview, "messageBoardId"),
-			Description:    getString(raw.Data.Overview, "description"),
-			MarketWeight:   getRawFloat(raw.Data.Overview, "marketWeight"),
-			EmployeeCount:  getInt64(raw.Data.Overview, "employeeCount"),
+			CompaniesCount: o.CompaniesCount.Int(),
+			MarketCap:      o.MarketCap.Float64(),
+			MessageBoardID: o.MessageBoardID,
+			Description:    o.Description,
+			MarketWeight:   o.MarketWeight.Float64(),
+			EmployeeCount:  o.EmployeeCount.Int64(),
 		}
 	}
 
 	// Parse top companies
 	for _, c := range raw.Data.TopCompanies {
 		company := models.IndustryTopCompany{
-			Symbol:       getString(c, "symbol"),
-			Name:         getString(c, "name"),
-			Rating:       getString(c, "rating"),
-			MarketWeight: getRawFloat(c, "marketWeight"),
+			Symbol:       c.Symbol,
+			Name:         c.Name,
+			Rating:       c.Rating,
+			MarketWeight: c.MarketWeight.Float64(),
 		}
 		if company.Symbol != "" {
 			data.TopCompanies = append(data.TopCompanies, company)
@@ -209,11 +213,11 @@ func (i *Industry) parseData(raw *models.IndustryResponse) *models.IndustryData
 	// Parse top performing companies
 	for _, c := range raw.Data.TopPerformingCompanies {
 		company := models.PerformingCompany{
-			Symbol:      getString(c, "symbol"),
-			Name:        getString(c, "name"),
-			YTDReturn:   getRawFloat(c, "ytdReturn"),
-			LastPrice:   getRawFloat(c, "lastPrice"),
-			TargetPrice: getRawFloat(c, "targetPrice"),
+			Symbol:      c.Symbol,
+			Name:        c.Name,
+			YTDReturn:   c.YTDReturn.Float64(),
+			LastPrice:   c.LastPrice.Float64(),
+			TargetPrice: c.TargetPrice.Float64(),
 		}
 		if company.Symbol != "" {
 			data.TopPerformingCompanies = append(data.TopPerformingCompanies, company)
@@ -223,10 +227,10 @@ func (i *Industry) parseData(raw *models.IndustryResponse) *models.IndustryData
 	// Parse top growth companies
 	for _, c := range raw.Data.TopGrowthCompanies {
 		company := models.GrowthCompany{
-			Symbol:         getString(c, "symbol"),
-			Name:           getString(c, "name"),
-			YTDReturn:      getRawFloat(c, "ytdReturn"),
-			GrowthEstimate: getRawFloat(c, "growthEstimate"),
+			Symbol:         c.Symbol,
+			Name:           c.Name,
+			YTDReturn:      c.YTDReturn.Float64(),
+			GrowthEstimate: c.GrowthEstimate.Float64(),
 		}
 		if company.Symbol != "" {
 			data.TopGrowthCompanies = append(data.TopGrowthCompanies, company)
@@ -236,11 +240,11 @@ func (i *Industry) parseData(raw *models.IndustryResponse) *models.IndustryData
 	// Parse research reports
 	for _, r := range raw.Data.ResearchReports {
 		report := models.ResearchReport{
-			ID:          getString(r, "id"),
-			Title:       getString(r, "title"),
-			Provider:    getString(r, "provider"),
-			PublishDate: getString(r, "publishDate"),
-			Summary:     getString(r, "summary"),
+			ID:          r.ID,
+			Title:       r.Title,
+			Provider:    r.Provider,
+			PublishDate: r.PublishDate,
+			Summary:     r.Summary,
 		}
 		if report.ID != "" || report.Title != "" {
 			data.ResearchReports = append(data.ResearchReports, report)
@@ -263,7 +267,14 @@ func (i *Industry) parseData(raw *models.IndustryResponse) *models.IndustryData
 //	}
 //	fmt.Printf("Industry: %s in Sector: %s\n", data.Name, data.SectorName)
 func (i *Industry) Data() (*models.IndustryData, error) {
-	if err := i.fetchData(); err != nil {
+	return i.DataContext(context.Background())
+}
+
+// DataContext is like [Industry.Data] but returns ctx's error as soon as ctx is
+// done. Once it succeeds, the other accessors are served from the cache
+// without further requests.
+func (i *Industry) DataContext(ctx context.Context) (*models.IndustryData, error) {
+	if err := i.fetchData(ctx); err != nil {
 		return nil, err
 	}
 
@@ -279,7 +290,7 @@ func (i *Industry) Data() (*models.IndustryData, error) {
 //	name, err := i.Name()
 //	fmt.Println(name) // "Semiconductors"
 func (i *Industry) Name() (string, error) {
-	if err := i.fetchData(); err != nil {
+	if err := i.fetchData(context.Background()); err != nil {
 		return "", err
 	}
 
@@ -290,7 +301,7 @@ func (i *Industry) Name() (string, error) {
 
 // Symbol returns the industry symbol.
 func (i *Industry) Symbol() (string, error) {
-	if err := i.fetchData(); err != nil {
+	if err := i.fetchData(context.Background()); err != nil {
 		return "", err
 	}
 
@@ -306,7 +317,7 @@ func (i *Industry) Symbol() (string, error) {
 //	sectorKey, err := i.SectorKey()
 //	fmt.Println(sectorKey) // "technology"
 func (i *Industry) SectorKey() (string, error) {
-	if err := i.fetchData(); err != nil {
+	if err := i.fetchData(context.Background()); err != nil {
 		return "", err
 	}
 
@@ -322,7 +333,7 @@ func (i *Industry) SectorKey() (string, error) {
 //	sectorName, err := i.SectorName()
 //	fmt.Println(sectorName) // "Technology"
 func (i *Industry) SectorName() (string, error) {
-	if err := i.fetchData(); err != nil {
+	if err := i.fetchData(context.Background()); err != nil {
 		return "", err
 	}
 
@@ -342,7 +353,13 @@ func (i *Industry) SectorName() (string, error) {
 //	fmt.Printf("Companies: %d\n", overview.CompaniesCount)
 //	fmt.Printf("Market Cap: $%.2f\n", overview.MarketCap)
 func (i *Industry) Overview() (models.IndustryOverview, error) {
-	if err := i.fetchData(); err != nil {
+	return i.OverviewContext(context.Background())
+}
+
+// OverviewContext is like [Industry.Overview] but returns ctx's error as soon as
+// ctx is done.
+func (i *Industry) OverviewContext(ctx context.Context) (models.IndustryOverview, error) {
+	if err := i.fetchData(ctx); err != nil {
 		return models.IndustryOverview{}, err
 	}
 
@@ -364,7 +381,13 @@ func (i *Industry) Overview() (models.IndustryOverview, error) {
 //	        c.Symbol, c.Name, c.MarketWeight*100)
 //	}
 func (i *Industry) TopCompanies() ([]models.IndustryTopCompany, error) {
-	if err := i.fetchData(); err != nil {
+	return i.TopCompaniesContext(context.Background())
+}
+
+// TopCompaniesContext is like [Industry.TopCompanies] but returns ctx's error as
+// soon as ctx is done.
+func (i *Industry) TopCompaniesContext(ctx context.Context) ([]models.IndustryTopCompany, error) {
+	if err := i.fetchData(ctx); err != nil {
 		return nil, err
 	}
 
@@ -388,7 +411,7 @@ func (i *Industry) TopCompanies() ([]models.IndustryTopCompany, error) {
 //	        c.Symbol, c.YTDReturn*100, c.LastPrice, c.TargetPrice)
 //	}
 func (i *Industry) TopPerformingCompanies() ([]models.PerformingCompany, error) {
-	if err := i.fetchData(); err != nil {
+	if err := i.fetchData(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -412,7 +435,7 @@ func (i *Industry) TopPerformingCompanies() ([]models.PerformingCompany, error)
 //	        c.Symbol, c.YTDReturn*100, c.GrowthEstimate*100)
 //	}
 func (i *Industry) TopGrowthCompanies() ([]models.GrowthCompany, error) {
-	if err := i.fetchData(); err != nil {
+	if err := i.fetchData(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -433,7 +456,7 @@ func (i *Industry) TopGrowthCompanies() ([]models.GrowthCompany, error) {
 //	    fmt.Printf("%s: %s\n", r.Provider, r.Title)
 //	}
 func (i *Industry) ResearchReports() ([]models.ResearchReport, error) {
-	if err := i.fetchData(); err != nil {
+	if err := i.fetchData(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -450,39 +473,6 @@ func (i *Industry) ClearCache() {
 	i.dataCache = nil
 }
 
-// Helper functions for parsing map values
-
-func getString(m map[string]interface{}, key string) string {
-	if v, ok := m[key].(string); ok {
-		return v
-	}
-	return ""
-}
-
-func getInt(m map[string]interface{}, key string) int {
-	switch v := m[key].(type) {
-	case float64:
-		return int(v)
-	case int:
-		return v
-	case int64:
-		return int(v)
-	}
-	return 0
-}
-
-func getInt64(m map[string]interface{}, key string) int64 {
-	switch v := m[key].(type) {
-	case float64:
-		return int64(v)
-	case int:
-		return int64(v)
-	case int64:
-		return v
-	}
-	return 0
-}
-
 func normalizeRegion(region string) string {
 	region = strings.TrimSpace(strings.ToUpper(region))
 	if region == "" {
@@ -490,23 +480,3 @@ func normalizeRegion(region string) string {
 	}
 	return region
 }
-
-func getFloat(m map[string]interface{}, key string) float64 {
-	switch v := m[key].(type) {
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	}
-	return 0
-}
-
-// getRawFloat extracts the "raw" value from a nested object like {"raw": 123.45, "fmt": "123.45"}
-func getRawFloat(m map[string]interface{}, key string) float64 {
-	if v, ok := m[key].(map[string]interface{}); ok {
-		return getFloat(v, "raw")
-	}
-	return getFloat(m, key)
-}
@@ -1,8 +1,10 @@
 package industry
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -24,8 +26,16 @@ func TestNew(t *testing.T) {
 		t.Errorf("Expected default region 'US', got '%s'", i.Region())
 	}
 
-	if i.ownsClient != true {
-		t.Error("ownsClient should be true when no custom client is provided")
+	if !i.ownsClient {
+		t.Error("ownsClient should be true when the shared default client is acquired")
+	}
+
+	def, err := client.Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	if i.client != def {
+		t.Error("Client should be the shared default client")
 	}
 }
 
@@ -83,7 +93,7 @@ func TestNewWithClient(t *testing.T) {
 		t.Fatalf("Failed to create second Industry: %v", err)
 	}
 
-	if i2.ownsClient != false {
+	if i2.ownsClient {
 		t.Error("ownsClient should be false when custom client is provided")
 	}
 
@@ -168,61 +178,56 @@ func TestClearCache(t *testing.T) {
 	}
 }
 
-func TestHelperFunctions(t *testing.T) {
-	m := map[string]interface{}{
-		"str":   "hello",
-		"int":   42,
-		"float": 3.14,
-		"int64": int64(1234567890),
-		"nested": map[string]interface{}{
-			"raw": 99.99,
-			"fmt": "99.99",
+func TestParseData(t *testing.T) {
+	body := `{"data": {
+		"name": "Semiconductors",
+		"symbol": "^YH31130020",
+		"sectorKey": "technology",
+		"sectorName": "Technology",
+		"overview": {
+			"companiesCount": {"raw": 42, "fmt": "42"},
+			"marketCap": {"raw": 6.5e12, "fmt": "6.5T"},
+			"marketWeight": 3.14,
+			"employeeCount": 1234567890
 		},
-	}
-
-	// Test getString
-	if got := getString(m, "str"); got != "hello" {
-		t.Errorf("getString expected 'hello', got '%s'", got)
-	}
-
-	if got := getString(m, "missing"); got != "" {
-		t.Errorf("getString for missing key expected '', got '%s'", got)
-	}
+		"topCompanies": [{"symbol": "NVDA", "name": "NVIDIA", "rating": "Strong Buy", "marketWeight": {"raw": 99.99, "fmt": "99.99"}}],
+		"topPerformingCompanies": [{"symbol": "AVGO", "name": "Broadcom", "ytdReturn": {"raw": 0.45}, "lastPrice": "1,650.5", "targetPrice": {"fmt": "1.8k"}}],
+		"topGrowthCompanies": [{"symbol": "AMD", "name": "AMD", "ytdReturn": -0.1, "growthEstimate": {"raw": 0.5, "fmt": "50%"}}, {"name": "No symbol"}],
+		"researchReports": [{"title": "Chips", "provider": "Morningstar"}]
+	}}`
 
-	// Test getInt
-	if got := getInt(m, "int"); got != 42 {
-		t.Errorf("getInt expected 42, got %d", got)
+	var raw models.IndustryResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
+	ind := &Industry{key: "semiconductors"}
+	data := ind.parseData(&raw)
 
-	if got := getInt(m, "missing"); got != 0 {
-		t.Errorf("getInt for missing key expected 0, got %d", got)
+	if data.SectorKey != "technology" || data.SectorName != "Technology" || data.Name != "Semiconductors" {
+		t.Errorf("unexpected identity %+v", data)
 	}
 
-	// Test getFloat
-	if got := getFloat(m, "float"); got != 3.14 {
-		t.Errorf("getFloat expected 3.14, got %f", got)
+	o := data.Overview
+	if o.CompaniesCount != 42 || o.MarketCap != 6.5e12 || o.MarketWeight != 3.14 || o.EmployeeCount != 1234567890 {
+		t.Errorf("unexpected overview %+v", o)
 	}
-
-	if got := getFloat(m, "int"); got != 42.0 {
-		t.Errorf("getFloat for int expected 42.0, got %f", got)
+	if len(data.TopCompanies) != 1 || data.TopCompanies[0].MarketWeight != 99.99 {
+		t.Errorf("expected raw market weight 99.99, got %+v", data.TopCompanies)
 	}
 
-	// Test getInt64
-	if got := getInt64(m, "int64"); got != 1234567890 {
-		t.Errorf("getInt64 expected 1234567890, got %d", got)
+	if len(data.TopPerformingCompanies) != 1 {
+		t.Fatalf("expected one performing company, got %+v", data.TopPerformingCompanies)
 	}
-
-	if got := getInt64(m, "float"); got != 3 {
-		t.Errorf("getInt64 for float expected 3, got %d", got)
+	p := data.TopPerformingCompanies[0]
+	if p.YTDReturn != 0.45 || p.LastPrice != 1650.5 || p.TargetPrice != 1800 {
+		t.Errorf("unexpected performing company %+v", p)
 	}
 
-	// Test getRawFloat
-	if got := getRawFloat(m, "nested"); got != 99.99 {
-		t.Errorf("getRawFloat expected 99.99, got %f", got)
+	if len(data.TopGrowthCompanies) != 1 || data.TopGrowthCompanies[0].GrowthEstimate != 0.5 || data.TopGrowthCompanies[0].YTDReturn != -0.1 {
+		t.Errorf("unexpected growth companies %+v", data.TopGrowthCompanies)
 	}
-
-	if got := getRawFloat(m, "float"); got != 3.14 {
-		t.Errorf("getRawFloat for simple float expected 3.14, got %f", got)
+	if len(data.ResearchReports) != 1 || data.ResearchReports[0].Provider != "Morningstar" {
+		t.Errorf("unexpected research reports %+v", data.ResearchReports)
 	}
 }
 
@@ -0,0 +1,158 @@
+package live
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+// BackfillFunc fetches the bars of each symbol between start and end.
+// Symbols that fail may be omitted from the result; the returned error is
+// reported to the error handler.
+type BackfillFunc func(symbols []string, start, end time.Time) (map[string][]models.Bar, error)
+
+// WithBackfill enables gap backfill after a reconnect.
+//
+// When the connection drops and is re-established, chart bars of the
+// subscribed symbols covering the outage are fetched and passed to the
+// handlers as messages flagged with [models.PricingData.IsBackfill], before
+// live data resumes. A nil fetch uses [DefaultBackfill].
+//
+// Example:
+//
+//	ws, _ := live.New(live.WithBackfill(nil))
+//	ws.Listen(func(d *models.PricingData) {
+//	    if d.IsBackfill() {
+//	        candles.AddBar(d.ID, *d.BackfillBar)
+//	        return
+//	    }
+//	    candles.AddTick(d)
+//	})
+func WithBackfill(fetch BackfillFunc) Option {
+	return func(ws *WebSocket) {
+		if fetch == nil {
+			fetch = DefaultBackfill
+		}
+		ws.backfill = fetch
+	}
+}
+
+// backfillIntervals are the intervals DefaultBackfill may use, finest first.
+var backfillIntervals = []string{"1m", "5m", "1h"}
+
+// backfillLookbackMargin keeps the chosen interval clear of Yahoo's lookback
+// limit while the request is on its way.
+const backfillLookbackMargin = time.Hour
+
+// DefaultBackfill fetches regular-session bars with the ticker package,
+// using the shared [client.Default] client. Gaps are fetched as 1-minute
+// bars, in several requests when longer than one request allows. Gaps
+// starting before Yahoo keeps 1-minute bars (30 days) use the finest
+// coarser interval that still reaches back far enough: 5-minute, hourly,
+// then daily bars.
+func DefaultBackfill(symbols []string, start, end time.Time) (map[string][]models.Bar, error) {
+	c, err := client.AcquireDefault()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	defer c.Release()
+
+	result := make(map[string][]models.Bar, len(symbols))
+	var lastErr error
+	for _, symbol := range symbols {
+		tkr, err := ticker.New(symbol, ticker.WithClient(c))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		bars, err := tkr.History(backfillParams(start, end, time.Now()))
+		tkr.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("backfill %s: %w", symbol, err)
+			continue
+		}
+		result[symbol] = bars
+	}
+	return result, lastErr
+}
+
+// backfillParams returns the history params for the gap from start to end
+// as of now, using the finest interval whose lookback reaches start.
+func backfillParams(start, end, now time.Time) models.HistoryParams {
+	params := models.HistoryParams{
+		Interval:   "1d",
+		Start:      &start,
+		End:        &end,
+		AutoAdjust: true,
+	}
+	for _, interval := range backfillIntervals {
+		_, lookback, _ := models.IntradayLimits(interval)
+		if now.Sub(start) <= lookback-backfillLookbackMargin {
+			params.Interval = interval
+			params.Chunked = true
+			break
+		}
+	}
+	return params
+}
+
+// markReceived records the time of the latest stream message.
+func (ws *WebSocket) markReceived(t time.Time) {
+	ws.mu.Lock()
+	ws.lastReceived = t
+	ws.mu.Unlock()
+}
+
+// backfillGap emits bars covering the outage from the last received message
+// until now. It does nothing if backfill is disabled or no message was ever
+// received.
+func (ws *WebSocket) backfillGap(symbols []string, now time.Time) {
+	ws.mu.RLock()
+	fetch := ws.backfill
+	since := ws.lastReceived
+	handler := ws.messageHandler
+	errHandler := ws.errorHandler
+	ws.mu.RUnlock()
+
+	if fetch == nil || since.IsZero() || len(symbols) == 0 {
+		return
+	}
+
+	start := since.Truncate(time.Minute)
+	barsBySymbol, err := fetch(symbols, start, now)
+	if err != nil && errHandler != nil {
+		errHandler(err)
+	}
+
+	for _, symbol := range symbols {
+		for _, bar := range barsBySymbol[symbol] {
+			if bar.Date.Before(start) || !bar.Date.Before(now) {
+				continue
+			}
+			data := backfillMessage(symbol, bar)
+			if !ws.accept(data) {
+				continue
+			}
+			if handler != nil {
+				handler(data)
+			}
+			ws.dispatchGroups(data)
+		}
+	}
+}
+
+// backfillMessage converts a chart bar to a pricing message.
+func backfillMessage(symbol string, bar models.Bar) *models.PricingData {
+	b := bar
+	return &models.PricingData{
+		ID:          symbol,
+		Price:       float32(bar.Close),
+		Time:        bar.Date.Unix(),
+		MarketHours: int32(models.MarketStateRegular),
+		LastSize:    bar.Volume,
+		BackfillBar: &b,
+	}
+}
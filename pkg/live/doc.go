@@ -46,12 +46,74 @@
 //	    }),
 //	)
 //
+// # Subscription Groups
+//
+// Groups manage separate watchlists with their own handlers over one
+// connection. Each group can be paused or unsubscribed independently:
+//
+//	ws.Group("crypto").Subscribe([]string{"BTC-USD"}).OnData(handleCrypto)
+//	ws.Group("indices").Subscribe([]string{"^GSPC", "^DJI"}).OnData(handleIndices)
+//	ws.ListenAsync(nil)
+//
+//	ws.Group("crypto").Pause()
+//	ws.Group("indices").Close()
+//
+// Subscriptions are reference counted per symbol across direct
+// [WebSocket.Subscribe] calls, groups and summary streams, so a symbol is
+// only unsubscribed from the connection once every holder has released it.
+//
+// # Market Summary Stream
+//
+// SubscribeMarketSummary subscribes to the index symbols returned by
+// market.Summary and keeps a [models.MarketSummary] updated in real time:
+//
+//	m, _ := market.New("US")
+//	stream, _ := live.SubscribeMarketSummary(ws, m, func(s models.MarketSummary) {
+//	    fmt.Printf("S&P 500: %.2f\n", s["SNP"].RegularMarketPrice)
+//	})
+//	stream.Listen()
+//
+// # Recording
+//
+// A [Sink] persists ticks and candles. [Record] turns a sink into a message
+// handler; backfilled messages are stored as bars. Three sinks are provided:
+//
+//   - [LineProtocolSink]: InfluxDB line protocol written to any io.Writer
+//   - [SQLSink]: SQLite tables through database/sql, with a driver of your choice
+//   - [StoreSink]: JSON records in a [store.Store], shared with other snapshot data
+//
+// Bars from ticker history can be stored in the same sink with WriteBar.
+// Recording a stream into SQLite:
+//
+//	db, _ := sql.Open("sqlite", "market.db")
+//	sink, _ := live.NewSQLSink(db)
+//	defer sink.Close()
+//	ws.ListenAsync(live.Record(sink, func(err error) { log.Println(err) }))
+//
 // # Configuration Options
 //
 //   - [WithURL]: Set custom WebSocket URL
 //   - [WithHeartbeatInterval]: Set heartbeat interval (default 15s)
 //   - [WithReconnectDelay]: Set reconnection delay (default 3s)
 //   - [WithErrorHandler]: Set error callback
+//   - [WithMarketHoursOnly]: Drop pre-market, post-market and closed-session messages
+//   - [WithBackfill]: Emit chart bars covering the gap after a reconnect
+//   - [WithPriceCheck], [WithSymbolPriceCheck]: Flag or drop implausible prices
+//
+// # Price Checks
+//
+// Streams occasionally carry fat-finger prints or corrupt packets. With a
+// [PriceCheck], each tick is compared with the symbol's last accepted price;
+// zero prices, 100x unit mixups and moves beyond MaxDeviation are flagged in
+// [models.PricingData.Anomaly] or dropped:
+//
+//	ws, _ := live.New(live.WithPriceCheck(live.PriceCheck{
+//	    MaxDeviation: 0.2,
+//	    Action:       live.PriceCheckDrop,
+//	}))
+//
+// A run of consistent out-of-range ticks (see [PriceCheck.Confirmations]) is
+// accepted as a genuine new level.
 //
 // # Data Fields
 //
@@ -0,0 +1,228 @@
+package live
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// Group is a named set of symbols with its own handler, sharing the
+// connection of its [WebSocket]. Groups let logically separate watchlists
+// (e.g. "crypto" and "indices") be paused and unsubscribed independently.
+//
+// Methods that change the subscription return the group for chaining; the
+// last subscription error is available from [Group.Err] and is also passed
+// to the WebSocket's error handler.
+type Group struct {
+	ws   *WebSocket
+	name string
+
+	mu      sync.RWMutex
+	symbols map[string]struct{}
+	handler MessageHandler
+	paused  bool
+	err     error
+}
+
+// Group returns the subscription group with the given name, creating it on
+// first use.
+//
+// Example:
+//
+//	ws.Group("crypto").Subscribe([]string{"BTC-USD", "ETH-USD"}).OnData(func(d *models.PricingData) {
+//	    fmt.Printf("[crypto] %s: %.2f\n", d.ID, d.Price)
+//	})
+//	ws.Group("indices").Subscribe([]string{"^GSPC", "^DJI"}).OnData(func(d *models.PricingData) {
+//	    fmt.Printf("[indices] %s: %.2f\n", d.ID, d.Price)
+//	})
+//	ws.ListenAsync(nil)
+//
+//	ws.Group("crypto").Pause()
+func (ws *WebSocket) Group(name string) *Group {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if ws.groups == nil {
+		ws.groups = make(map[string]*Group)
+	}
+	g, ok := ws.groups[name]
+	if !ok {
+		g = &Group{
+			ws:      ws,
+			name:    name,
+			symbols: make(map[string]struct{}),
+		}
+		ws.groups[name] = g
+	}
+	return g
+}
+
+// Groups returns the sorted names of all groups.
+func (ws *WebSocket) Groups() []string {
+	ws.mu.RLock()
+	defer ws.mu.RUnlock()
+
+	names := make([]string, 0, len(ws.groups))
+	for name := range ws.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Name returns the group name.
+func (g *Group) Name() string {
+	return g.name
+}
+
+// Symbols returns the sorted symbols of the group.
+func (g *Group) Symbols() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	symbols := make([]string, 0, len(g.symbols))
+	for sym := range g.symbols {
+		symbols = append(symbols, sym)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Subscribe adds symbols to the group and subscribes them on the connection.
+// The group takes one subscription reference per symbol it did not hold.
+func (g *Group) Subscribe(symbols []string) *Group {
+	added := make([]string, 0, len(symbols))
+	g.mu.Lock()
+	for _, sym := range symbols {
+		sym = strings.ToUpper(sym)
+		if _, ok := g.symbols[sym]; !ok {
+			g.symbols[sym] = struct{}{}
+			added = append(added, sym)
+		}
+	}
+	g.mu.Unlock()
+
+	if len(added) > 0 {
+		g.setErr(g.ws.Subscribe(added))
+	}
+	return g
+}
+
+// OnData sets the handler called for messages of the group's symbols.
+func (g *Group) OnData(handler MessageHandler) *Group {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.handler = handler
+	return g
+}
+
+// Pause stops delivering messages to the group handler. The symbols stay
+// subscribed, so Resume takes effect immediately.
+func (g *Group) Pause() *Group {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.paused = true
+	return g
+}
+
+// Resume restarts delivering messages to the group handler.
+func (g *Group) Resume() *Group {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.paused = false
+	return g
+}
+
+// Paused reports whether the group is paused.
+func (g *Group) Paused() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.paused
+}
+
+// Unsubscribe removes symbols from the group and releases its subscription
+// references. Symbols still held by another group, a summary stream or a
+// direct [WebSocket.Subscribe] stay subscribed on the connection.
+func (g *Group) Unsubscribe(symbols []string) *Group {
+	removed := make([]string, 0, len(symbols))
+	g.mu.Lock()
+	for _, sym := range symbols {
+		sym = strings.ToUpper(sym)
+		if _, ok := g.symbols[sym]; ok {
+			delete(g.symbols, sym)
+			removed = append(removed, sym)
+		}
+	}
+	g.mu.Unlock()
+
+	if len(removed) > 0 {
+		g.setErr(g.ws.Unsubscribe(removed))
+	}
+	return g
+}
+
+// Close unsubscribes all symbols of the group and removes it from the WebSocket.
+func (g *Group) Close() error {
+	g.Unsubscribe(g.Symbols())
+
+	g.ws.mu.Lock()
+	if g.ws.groups[g.name] == g {
+		delete(g.ws.groups, g.name)
+	}
+	g.ws.mu.Unlock()
+
+	return g.Err()
+}
+
+// Err returns the last subscription error of the group, if any.
+func (g *Group) Err() error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.err
+}
+
+func (g *Group) setErr(err error) {
+	if err == nil {
+		return
+	}
+
+	g.mu.Lock()
+	g.err = err
+	g.mu.Unlock()
+
+	g.ws.mu.RLock()
+	handler := g.ws.errorHandler
+	g.ws.mu.RUnlock()
+	if handler != nil {
+		handler(err)
+	}
+}
+
+// deliver calls the group handler if the group holds the symbol and is not paused.
+func (g *Group) deliver(data *models.PricingData) {
+	g.mu.RLock()
+	_, ok := g.symbols[strings.ToUpper(data.ID)]
+	handler := g.handler
+	paused := g.paused
+	g.mu.RUnlock()
+
+	if ok && !paused && handler != nil {
+		handler(data)
+	}
+}
+
+// dispatchGroups delivers a message to every group.
+func (ws *WebSocket) dispatchGroups(data *models.PricingData) {
+	ws.mu.RLock()
+	groups := make([]*Group, 0, len(ws.groups))
+	for _, g := range ws.groups {
+		groups = append(groups, g)
+	}
+	ws.mu.RUnlock()
+
+	for _, g := range groups {
+		g.deliver(data)
+	}
+}
@@ -0,0 +1,138 @@
+package live
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// lineProtocolEscaper escapes tag keys and values; measurements need only
+// commas and spaces escaped, which it covers as well.
+var lineProtocolEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+
+// LineProtocolSink writes ticks and bars as InfluxDB line protocol, one
+// point per line with nanosecond timestamps. The output can be sent to the
+// InfluxDB write API or loaded with the influx CLI.
+//
+// Ticks are tagged with symbol, exchange and currency; bars with symbol.
+type LineProtocolSink struct {
+	cfg sinkConfig
+	now func() time.Time
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewLineProtocolSink creates a sink writing to w. If w has a Flush method
+// (e.g. [bufio.Writer]), Close calls it.
+//
+// Example:
+//
+//	sink, err := live.NewLineProtocolSink(os.Stdout, live.WithTickTable("quotes"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	ws.ListenAsync(live.Record(sink, nil))
+func NewLineProtocolSink(w io.Writer, opts ...SinkOption) (*LineProtocolSink, error) {
+	if w == nil {
+		return nil, fmt.Errorf("writer is required")
+	}
+	cfg, err := newSinkConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &LineProtocolSink{cfg: cfg, now: time.Now, w: w}, nil
+}
+
+// WriteTick writes data as a point of the tick measurement.
+func (s *LineProtocolSink) WriteTick(data *models.PricingData) error {
+	var b strings.Builder
+	b.WriteString(lineProtocolEscaper.Replace(s.cfg.tickTable))
+	writeTag(&b, "symbol", data.ID)
+	writeTag(&b, "exchange", data.Exchange)
+	writeTag(&b, "currency", data.Currency)
+
+	fields := []string{"price=" + formatFloat32(data.Price)}
+	if data.Change != 0 {
+		fields = append(fields, "change="+formatFloat32(data.Change))
+	}
+	if data.ChangePercent != 0 {
+		fields = append(fields, "change_percent="+formatFloat32(data.ChangePercent))
+	}
+	if data.DayVolume != 0 {
+		fields = append(fields, fmt.Sprintf("day_volume=%di", data.DayVolume))
+	}
+	if data.LastSize != 0 {
+		fields = append(fields, fmt.Sprintf("last_size=%di", data.LastSize))
+	}
+	fields = append(fields, fmt.Sprintf("market_hours=%di", data.MarketHours))
+
+	return s.writeLine(&b, fields, tickTime(data, s.now()))
+}
+
+// WriteBar writes bar as a point of the bar measurement.
+func (s *LineProtocolSink) WriteBar(symbol string, bar models.Bar) error {
+	var b strings.Builder
+	b.WriteString(lineProtocolEscaper.Replace(s.cfg.barTable))
+	writeTag(&b, "symbol", symbol)
+
+	// Line protocol has no NaN; missing prices are left out
+	var fields []string
+	for _, f := range []struct {
+		key   string
+		value float64
+	}{{"open", bar.Open}, {"high", bar.High}, {"low", bar.Low}, {"close", bar.Close}, {"adj_close", bar.AdjClose}} {
+		if !math.IsNaN(f.value) && !math.IsInf(f.value, 0) && f.value != 0 {
+			fields = append(fields, f.key+"="+strconv.FormatFloat(f.value, 'f', -1, 64))
+		}
+	}
+	fields = append(fields, fmt.Sprintf("volume=%di", bar.Volume))
+	return s.writeLine(&b, fields, bar.Date)
+}
+
+// Close flushes the writer if it supports flushing.
+func (s *LineProtocolSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f, ok := s.w.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// writeLine completes a point and writes it as one line.
+func (s *LineProtocolSink) writeLine(b *strings.Builder, fields []string, t time.Time) error {
+	b.WriteByte(' ')
+	b.WriteString(strings.Join(fields, ","))
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatInt(t.UnixNano(), 10))
+	b.WriteByte('\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := io.WriteString(s.w, b.String())
+	return err
+}
+
+// writeTag appends a tag, skipping empty values which line protocol
+// does not allow.
+func writeTag(b *strings.Builder, key, value string) {
+	if value == "" {
+		return
+	}
+	b.WriteByte(',')
+	b.WriteString(key)
+	b.WriteByte('=')
+	b.WriteString(lineProtocolEscaper.Replace(value))
+}
+
+// formatFloat32 formats streamed prices without float32 rounding noise.
+func formatFloat32(v float32) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -51,6 +52,74 @@ func TestNewWithOptions(t *testing.T) {
 	}
 }
 
+func TestWithMarketHoursOnly(t *testing.T) {
+	ws, _ := New()
+	if !ws.accept(&models.PricingData{MarketHours: 2}) {
+		t.Error("Expected post-market message to pass without filter")
+	}
+
+	ws, _ = New(WithMarketHoursOnly(true))
+	if !ws.accept(&models.PricingData{MarketHours: 1}) {
+		t.Error("Expected regular-market message to pass")
+	}
+	for _, hours := range []int32{0, 2, 3} {
+		if ws.accept(&models.PricingData{MarketHours: hours}) {
+			t.Errorf("Expected market hours %d to be dropped", hours)
+		}
+	}
+}
+
+func TestPriceCheckDrop(t *testing.T) {
+	ws, _ := New(WithPriceCheck(PriceCheck{MaxDeviation: 0.2, Action: PriceCheckDrop}))
+
+	tests := []struct {
+		price float32
+		want  bool
+	}{
+		{100, true},
+		{101, true},
+		{10100, false}, // 100x unit mixup
+		{0, false},
+		{150, false}, // >20% move
+		{102, true},  // bad ticks did not move the reference
+		{150, false},
+		{151, false},
+		{150, true}, // third consistent tick confirms the new level
+		{152, true},
+	}
+	for i, tc := range tests {
+		if got := ws.accept(&models.PricingData{ID: "AAPL", Price: tc.price}); got != tc.want {
+			t.Errorf("tick %d (%v): accept = %v, want %v", i, tc.price, got, tc.want)
+		}
+	}
+}
+
+func TestSymbolPriceCheckFlag(t *testing.T) {
+	ws, _ := New(
+		WithPriceCheck(PriceCheck{MaxDeviation: 0.1, Action: PriceCheckDrop}),
+		WithSymbolPriceCheck("btc-usd", PriceCheck{MaxDeviation: 0.3}),
+	)
+
+	ws.accept(&models.PricingData{ID: "BTC-USD", Price: 100})
+	move := &models.PricingData{ID: "BTC-USD", Price: 125}
+	if !ws.accept(move) || move.Anomaly != "" {
+		t.Errorf("25%% move should pass the per-symbol limit, anomaly %q", move.Anomaly)
+	}
+	jump := &models.PricingData{ID: "BTC-USD", Price: 200}
+	if !ws.accept(jump) || jump.Anomaly != models.PriceAnomalyDeviation {
+		t.Errorf("flagged tick should be delivered with deviation anomaly, got %q", jump.Anomaly)
+	}
+	mixup := &models.PricingData{ID: "BTC-USD", Price: 1.25}
+	if !ws.accept(mixup) || mixup.Anomaly != models.PriceAnomalyUnitMixup {
+		t.Errorf("expected unit mixup anomaly, got %q", mixup.Anomaly)
+	}
+
+	ws.accept(&models.PricingData{ID: "AAPL", Price: 100})
+	if ws.accept(&models.PricingData{ID: "AAPL", Price: 115}) {
+		t.Error("default check should drop a 15% move")
+	}
+}
+
 func TestSubscriptions(t *testing.T) {
 	ws, _ := New()
 
@@ -60,8 +129,8 @@ func TestSubscriptions(t *testing.T) {
 	}
 
 	// Add to subscriptions map directly (without connecting)
-	ws.subscriptions["AAPL"] = struct{}{}
-	ws.subscriptions["MSFT"] = struct{}{}
+	ws.subscriptions["AAPL"] = 1
+	ws.subscriptions["MSFT"] = 1
 
 	subs := ws.Subscriptions()
 	if len(subs) != 2 {
@@ -330,7 +399,7 @@ func TestSendSubscribeNilConn(t *testing.T) {
 		t.Fatalf("New() error: %v", err)
 	}
 	// Populate subscriptions without connecting, so conn stays nil.
-	ws.subscriptions["AAPL"] = struct{}{}
+	ws.subscriptions["AAPL"] = 1
 
 	// Without the fix this panics with a nil pointer dereference.
 	// The recover() turns the panic into a test failure with a clear message.
@@ -422,3 +491,202 @@ func TestSendSubscribeConcurrent(t *testing.T) {
 		t.Errorf("sendSubscribe panicked: %v", p)
 	}
 }
+
+func TestSummaryStreamHandle(t *testing.T) {
+	seed := models.MarketSummary{
+		"SNP": {Exchange: "SNP", Symbol: "^GSPC", ShortName: "S&P 500", RegularMarketPrice: 5000},
+		"DJI": {Exchange: "DJI", Symbol: "^DJI", ShortName: "Dow 30", RegularMarketPrice: 39000},
+	}
+
+	var got models.MarketSummary
+	s := NewSummaryStream(nil, seed, func(summary models.MarketSummary) {
+		got = summary
+	})
+
+	if syms := s.Symbols(); len(syms) != 2 || syms[0] != "^DJI" || syms[1] != "^GSPC" {
+		t.Fatalf("unexpected symbols: %v", syms)
+	}
+
+	s.Handle(&models.PricingData{ID: "^gspc", Price: 5050, Change: 50, ChangePercent: 1, MarketHours: 1, Time: 1700000000})
+	if got == nil {
+		t.Fatal("expected handler to be called")
+	}
+	item := got["SNP"]
+	if item.RegularMarketPrice != 5050 || item.RegularMarketChange != 50 {
+		t.Errorf("unexpected item after update: %+v", item)
+	}
+	if item.MarketState != "REGULAR" || item.RegularMarketTime != 1700000000 {
+		t.Errorf("unexpected state/time: %q %d", item.MarketState, item.RegularMarketTime)
+	}
+	if item.ShortName != "S&P 500" {
+		t.Errorf("expected static fields to be kept, got %q", item.ShortName)
+	}
+
+	// Unknown symbols are ignored
+	got = nil
+	s.Handle(&models.PricingData{ID: "AAPL", Price: 1})
+	if got != nil {
+		t.Error("expected handler not to be called for unknown symbol")
+	}
+
+	// The seed map is not mutated
+	if seed["SNP"].RegularMarketPrice != 5000 {
+		t.Error("expected seed summary to be left untouched")
+	}
+}
+
+func TestGroups(t *testing.T) {
+	ws, _ := New(WithURL("ws://127.0.0.1:0"))
+
+	var crypto, indices []string
+	ws.Group("crypto").Subscribe([]string{"btc-usd", "ETH-USD"}).OnData(func(d *models.PricingData) {
+		crypto = append(crypto, d.ID)
+	})
+	ws.Group("indices").Subscribe([]string{"^GSPC", "BTC-USD"}).OnData(func(d *models.PricingData) {
+		indices = append(indices, d.ID)
+	})
+
+	if ws.Group("crypto").Err() == nil {
+		t.Error("Expected subscription error without a server")
+	}
+	if got := ws.Groups(); len(got) != 2 || got[0] != "crypto" || got[1] != "indices" {
+		t.Errorf("unexpected groups: %v", got)
+	}
+	if got := ws.Group("crypto").Symbols(); len(got) != 2 || got[0] != "BTC-USD" {
+		t.Errorf("unexpected crypto symbols: %v", got)
+	}
+
+	ws.dispatchGroups(&models.PricingData{ID: "BTC-USD"})
+	ws.dispatchGroups(&models.PricingData{ID: "^GSPC"})
+	if len(crypto) != 1 || len(indices) != 2 {
+		t.Errorf("unexpected deliveries: crypto=%v indices=%v", crypto, indices)
+	}
+
+	ws.Group("crypto").Pause()
+	ws.dispatchGroups(&models.PricingData{ID: "ETH-USD"})
+	if len(crypto) != 1 {
+		t.Error("Expected paused group not to receive messages")
+	}
+	ws.Group("crypto").Resume()
+	ws.dispatchGroups(&models.PricingData{ID: "ETH-USD"})
+	if len(crypto) != 2 {
+		t.Error("Expected resumed group to receive messages")
+	}
+
+	ws.Group("crypto").Close()
+
+	// BTC-USD is still held by "indices", ETH-USD is not held by anyone
+	if got := sortedSubscriptions(ws); len(got) != 2 || got[0] != "BTC-USD" || got[1] != "^GSPC" {
+		t.Errorf("unexpected subscriptions: %v", got)
+	}
+	if got := ws.Groups(); len(got) != 1 || got[0] != "indices" {
+		t.Errorf("Expected crypto group to be removed, got %v", got)
+	}
+}
+
+func TestSubscriptionRefCounts(t *testing.T) {
+	ws, _ := New(WithURL("ws://127.0.0.1:0"))
+
+	// Direct and group subscriptions of AAPL are separate references
+	ws.Subscribe([]string{"AAPL"})
+	ws.Group("watch").Subscribe([]string{"aapl", "MSFT"})
+	ws.Group("watch").Subscribe([]string{"MSFT"}) // already held by the group
+
+	ws.Group("watch").Close()
+	if got := sortedSubscriptions(ws); len(got) != 1 || got[0] != "AAPL" {
+		t.Errorf("after group close: %v, want the direct AAPL subscription kept", got)
+	}
+
+	ws.Unsubscribe([]string{"AAPL"})
+	if got := ws.Subscriptions(); len(got) != 0 {
+		t.Errorf("after direct unsubscribe: %v, want none", got)
+	}
+
+	// A direct unsubscribe does not drop a group's reference
+	ws.Group("watch").Subscribe([]string{"AAPL"})
+	ws.Subscribe([]string{"AAPL"})
+	ws.Unsubscribe([]string{"AAPL"})
+	if got := ws.Subscriptions(); len(got) != 1 {
+		t.Errorf("after direct unsubscribe: %v, want the group's AAPL kept", got)
+	}
+}
+
+func sortedSubscriptions(ws *WebSocket) []string {
+	subs := ws.Subscriptions()
+	sort.Strings(subs)
+	return subs
+}
+
+func TestBackfillGap(t *testing.T) {
+	last := time.Date(2024, 6, 3, 14, 30, 40, 0, time.UTC)
+	now := last.Add(3 * time.Minute)
+
+	var gotStart, gotEnd time.Time
+	fetch := func(symbols []string, start, end time.Time) (map[string][]models.Bar, error) {
+		gotStart, gotEnd = start, end
+		return map[string][]models.Bar{
+			"AAPL": {
+				{Date: last.Add(-2 * time.Minute).Truncate(time.Minute), Close: 99},
+				{Date: last.Truncate(time.Minute), Close: 100, Volume: 10},
+				{Date: last.Truncate(time.Minute).Add(time.Minute), Close: 101, Volume: 20},
+				{Date: now.Add(time.Minute), Close: 102},
+			},
+		}, nil
+	}
+
+	ws, _ := New(WithBackfill(fetch))
+	var received []*models.PricingData
+	ws.messageHandler = func(d *models.PricingData) {
+		received = append(received, d)
+	}
+
+	ws.backfillGap([]string{"AAPL"}, now)
+	if len(received) != 0 {
+		t.Fatal("Expected no backfill before any message was received")
+	}
+
+	ws.markReceived(last)
+	ws.backfillGap([]string{"AAPL"}, now)
+
+	if !gotStart.Equal(last.Truncate(time.Minute)) || !gotEnd.Equal(now) {
+		t.Errorf("unexpected gap window: %s - %s", gotStart, gotEnd)
+	}
+	if len(received) != 2 {
+		t.Fatalf("Expected 2 backfill messages, got %d", len(received))
+	}
+	if !received[0].IsBackfill() || received[0].Price != 100 || received[0].BackfillBar.Volume != 10 {
+		t.Errorf("unexpected first backfill message: %+v", received[0])
+	}
+	if received[1].Time != last.Truncate(time.Minute).Add(time.Minute).Unix() {
+		t.Errorf("unexpected second backfill time: %d", received[1].Time)
+	}
+}
+
+func TestBackfillParams(t *testing.T) {
+	now := time.Now()
+	end := now.Add(-time.Minute)
+
+	tests := []struct {
+		gap      time.Duration
+		interval string
+	}{
+		{3 * time.Minute, "1m"},
+		{10 * 24 * time.Hour, "1m"}, // longer than one 1m request
+		{45 * 24 * time.Hour, "5m"},
+		{200 * 24 * time.Hour, "1h"},
+		{1000 * 24 * time.Hour, "1d"},
+	}
+	for _, tt := range tests {
+		start := now.Add(-tt.gap)
+		params := backfillParams(start, end, now)
+		if params.Interval != tt.interval {
+			t.Errorf("gap %s: interval %s, want %s", tt.gap, params.Interval, tt.interval)
+		}
+		if err := params.Validate(); err != nil {
+			t.Errorf("gap %s: %v", tt.gap, err)
+		}
+		if !params.Start.Equal(start) || !params.End.Equal(end) {
+			t.Errorf("gap %s: range %s - %s", tt.gap, params.Start, params.End)
+		}
+	}
+}
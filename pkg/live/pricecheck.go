@@ -0,0 +1,187 @@
+package live
+
+import (
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/repair"
+)
+
+// DefaultPriceCheckConfirmations is the number of consecutive, mutually
+// consistent anomalous ticks after which the new level is accepted.
+const DefaultPriceCheckConfirmations = 3
+
+// PriceCheckAction selects what happens to a tick that fails a price check.
+type PriceCheckAction int
+
+const (
+	// PriceCheckFlag delivers the tick with [models.PricingData.Anomaly] set.
+	PriceCheckFlag PriceCheckAction = iota
+
+	// PriceCheckDrop discards the tick before it reaches any handler.
+	PriceCheckDrop
+)
+
+// PriceCheck configures the sanity check of streamed prices.
+//
+// Each tick is compared with the last accepted price of its symbol. Ticks
+// with a zero or non-finite price, ticks about 100x off (the same currency
+// unit heuristic as [repair.UnitMixupCorrection]) and ticks moving more than
+// MaxDeviation are anomalous. Anomalous ticks never become the reference
+// price, so one bad packet cannot poison later checks.
+type PriceCheck struct {
+	// MaxDeviation is the largest accepted move relative to the last
+	// accepted price, as a fraction (0.2 = 20%). Zero only checks for zero
+	// prices and unit mixups.
+	MaxDeviation float64
+
+	// Action is applied to anomalous ticks (default PriceCheckFlag).
+	Action PriceCheckAction
+
+	// Confirmations is the number of consecutive anomalous ticks within
+	// MaxDeviation of each other after which their level is accepted as
+	// genuine, e.g. after a trading halt (default
+	// [DefaultPriceCheckConfirmations]).
+	Confirmations int
+}
+
+// WithPriceCheck enables the price check for every subscribed symbol.
+//
+// Example:
+//
+//	ws, _ := live.New(live.WithPriceCheck(live.PriceCheck{
+//	    MaxDeviation: 0.2,
+//	    Action:       live.PriceCheckDrop,
+//	}))
+func WithPriceCheck(check PriceCheck) Option {
+	return func(ws *WebSocket) {
+		ws.priceChecker().defaultCheck = &check
+	}
+}
+
+// WithSymbolPriceCheck sets the price check for one symbol, overriding
+// [WithPriceCheck]. It can be given several times.
+//
+// Example:
+//
+//	ws, _ := live.New(
+//	    live.WithPriceCheck(live.PriceCheck{MaxDeviation: 0.1}),
+//	    live.WithSymbolPriceCheck("BTC-USD", live.PriceCheck{MaxDeviation: 0.3}),
+//	)
+func WithSymbolPriceCheck(symbol string, check PriceCheck) Option {
+	return func(ws *WebSocket) {
+		ws.priceChecker().symbolChecks[strings.ToUpper(symbol)] = check
+	}
+}
+
+// priceChecker returns the WebSocket's price checker, creating it if needed.
+// It is only called while applying options.
+func (ws *WebSocket) priceChecker() *priceChecker {
+	if ws.priceCheck == nil {
+		ws.priceCheck = &priceChecker{
+			symbolChecks: make(map[string]PriceCheck),
+			states:       make(map[string]*priceState),
+		}
+	}
+	return ws.priceCheck
+}
+
+// priceChecker tracks the last accepted price of each symbol.
+type priceChecker struct {
+	defaultCheck *PriceCheck
+	symbolChecks map[string]PriceCheck
+
+	mu     sync.Mutex
+	states map[string]*priceState
+}
+
+// priceState is the reference price of a symbol and the run of anomalous
+// ticks that may replace it.
+type priceState struct {
+	last    float64
+	pending float64
+	count   int
+}
+
+// check classifies data and reports whether it should be delivered.
+func (c *priceChecker) check(data *models.PricingData) bool {
+	if data == nil || data.ID == "" {
+		return true
+	}
+	symbol := strings.ToUpper(data.ID)
+	check, ok := c.symbolChecks[symbol]
+	if !ok {
+		if c.defaultCheck == nil {
+			return true
+		}
+		check = *c.defaultCheck
+	}
+
+	c.mu.Lock()
+	state := c.states[symbol]
+	if state == nil {
+		state = &priceState{}
+		c.states[symbol] = state
+	}
+	anomaly := state.observe(float64(data.Price), check)
+	c.mu.Unlock()
+
+	if anomaly == "" {
+		return true
+	}
+	if check.Action == PriceCheckDrop {
+		return false
+	}
+	data.Anomaly = anomaly
+	return true
+}
+
+// observe classifies price against the reference and updates the state.
+func (s *priceState) observe(price float64, check PriceCheck) models.PriceAnomaly {
+	if price <= 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return models.PriceAnomalyZero
+	}
+	if s.last == 0 {
+		s.last = price
+		return ""
+	}
+
+	anomaly := classifyPrice(price, s.last, check.MaxDeviation)
+	if anomaly == "" {
+		s.last = price
+		s.count = 0
+		return ""
+	}
+
+	// A run of consistent anomalies is a genuine new level.
+	if s.count > 0 && classifyPrice(price, s.pending, check.MaxDeviation) == "" {
+		s.count++
+	} else {
+		s.count = 1
+	}
+	s.pending = price
+
+	confirmations := check.Confirmations
+	if confirmations <= 0 {
+		confirmations = DefaultPriceCheckConfirmations
+	}
+	if s.count >= confirmations {
+		s.last = price
+		s.count = 0
+		return ""
+	}
+	return anomaly
+}
+
+// classifyPrice compares a positive price with a positive reference.
+func classifyPrice(price, reference, maxDeviation float64) models.PriceAnomaly {
+	if repair.UnitMixupCorrection(price, reference) != 1 {
+		return models.PriceAnomalyUnitMixup
+	}
+	if maxDeviation > 0 && math.Abs(price/reference-1) > maxDeviation {
+		return models.PriceAnomalyDeviation
+	}
+	return ""
+}
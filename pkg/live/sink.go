@@ -0,0 +1,112 @@
+package live
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+const (
+	// DefaultTickTable is the table or measurement ticks are written to.
+	DefaultTickTable = "ticks"
+
+	// DefaultBarTable is the table or measurement bars are written to.
+	DefaultBarTable = "bars"
+)
+
+// identifierPattern matches table and measurement names usable without
+// quoting.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Sink persists streamed ticks and candles, e.g. to a time-series database.
+// Implementations must be safe for concurrent use.
+type Sink interface {
+	// WriteTick stores one streamed pricing message.
+	WriteTick(data *models.PricingData) error
+
+	// WriteBar stores one candle of symbol.
+	WriteBar(symbol string, bar models.Bar) error
+
+	// Close flushes pending writes and releases the sink's resources. It
+	// does not close the underlying writer or database.
+	Close() error
+}
+
+// SinkOption is a function that configures a sink.
+type SinkOption func(*sinkConfig)
+
+type sinkConfig struct {
+	tickTable string
+	barTable  string
+}
+
+// WithTickTable sets the table or measurement ticks are written to
+// (default "ticks").
+func WithTickTable(name string) SinkOption {
+	return func(c *sinkConfig) {
+		c.tickTable = name
+	}
+}
+
+// WithBarTable sets the table or measurement bars are written to (default
+// "bars").
+func WithBarTable(name string) SinkOption {
+	return func(c *sinkConfig) {
+		c.barTable = name
+	}
+}
+
+// newSinkConfig applies opts to the defaults and validates the names.
+func newSinkConfig(opts []SinkOption) (sinkConfig, error) {
+	cfg := sinkConfig{tickTable: DefaultTickTable, barTable: DefaultBarTable}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	for _, name := range []string{cfg.tickTable, cfg.barTable} {
+		if !identifierPattern.MatchString(name) {
+			return cfg, fmt.Errorf("invalid sink table name %q", name)
+		}
+	}
+	return cfg, nil
+}
+
+// Record returns a handler that writes every message to sink, so a stream
+// can be recorded with ListenAsync or [Group.OnData]. Messages synthesized
+// by [WithBackfill] are written as bars. Write errors are passed to onError
+// when it is non-nil.
+//
+// Example:
+//
+//	f, _ := os.OpenFile("ticks.lp", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+//	defer f.Close()
+//	sink, _ := live.NewLineProtocolSink(f)
+//	defer sink.Close()
+//
+//	ws.Subscribe([]string{"AAPL", "MSFT"})
+//	ws.ListenAsync(live.Record(sink, func(err error) { log.Println(err) }))
+func Record(sink Sink, onError ErrorHandler) MessageHandler {
+	return func(data *models.PricingData) {
+		if data == nil || data.ID == "" {
+			return
+		}
+		var err error
+		if data.IsBackfill() {
+			err = sink.WriteBar(data.ID, *data.BackfillBar)
+		} else {
+			err = sink.WriteTick(data)
+		}
+		if err != nil && onError != nil {
+			onError(fmt.Errorf("failed to record %s: %w", data.ID, err))
+		}
+	}
+}
+
+// tickTime returns the time of a message, or now when it has none.
+func tickTime(data *models.PricingData, now time.Time) time.Time {
+	if data.Time == 0 {
+		return now
+	}
+	return data.Timestamp()
+}
@@ -0,0 +1,235 @@
+package live
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
+)
+
+// recordingSink captures what Record writes.
+type recordingSink struct {
+	ticks []string
+	bars  []string
+	err   error
+}
+
+func (s *recordingSink) WriteTick(data *models.PricingData) error {
+	s.ticks = append(s.ticks, data.ID)
+	return s.err
+}
+
+func (s *recordingSink) WriteBar(symbol string, bar models.Bar) error {
+	s.bars = append(s.bars, symbol)
+	return s.err
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+func TestRecord(t *testing.T) {
+	sink := &recordingSink{}
+	handler := Record(sink, nil)
+	handler(&models.PricingData{ID: "AAPL", Price: 190})
+	handler(backfillMessage("MSFT", models.Bar{Date: time.Unix(1700000000, 0), Close: 370}))
+	handler(&models.PricingData{})
+	handler(nil)
+
+	if len(sink.ticks) != 1 || sink.ticks[0] != "AAPL" {
+		t.Errorf("expected one tick for AAPL, got %v", sink.ticks)
+	}
+	if len(sink.bars) != 1 || sink.bars[0] != "MSFT" {
+		t.Errorf("expected backfill recorded as bar, got %v", sink.bars)
+	}
+
+	sink.err = errors.New("disk full")
+	var got error
+	Record(sink, func(err error) { got = err })(&models.PricingData{ID: "AAPL"})
+	if got == nil || !strings.Contains(got.Error(), "AAPL") || !errors.Is(got, sink.err) {
+		t.Errorf("expected wrapped write error, got %v", got)
+	}
+}
+
+func TestSinkTableNames(t *testing.T) {
+	if _, err := NewLineProtocolSink(&bytes.Buffer{}, WithTickTable("bad name")); err == nil {
+		t.Error("expected error for invalid table name")
+	}
+	if _, err := NewLineProtocolSink(nil); err == nil {
+		t.Error("expected error for nil writer")
+	}
+	if _, err := NewSQLSink(nil); err == nil {
+		t.Error("expected error for nil database")
+	}
+}
+
+func TestLineProtocolSink(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	sink, err := NewLineProtocolSink(w, WithBarTable("candles"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000500, 0)
+	sink.now = func() time.Time { return now }
+
+	if err := sink.WriteTick(&models.PricingData{
+		ID: "BRK-B", Exchange: "NYQ", Currency: "USD", Price: 412.35, Change: 1.5,
+		DayVolume: 1200, MarketHours: 1, Time: 1700000000,
+	}); err != nil {
+		t.Fatalf("WriteTick() error: %v", err)
+	}
+	// No time: falls back to now; tag values with spaces are escaped
+	if err := sink.WriteTick(&models.PricingData{ID: "EUR USD", Price: 1.1}); err != nil {
+		t.Fatalf("WriteTick() error: %v", err)
+	}
+	if err := sink.WriteBar("AAPL", models.Bar{
+		Date: time.Unix(1700000000, 0), Open: 189.5, High: 190, Low: 189, Close: 189.75,
+		AdjClose: math.NaN(), Volume: 5000,
+	}); err != nil {
+		t.Fatalf("WriteBar() error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Error("expected output to stay buffered until Close")
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	want := []string{
+		"ticks,symbol=BRK-B,exchange=NYQ,currency=USD price=412.35,change=1.5,day_volume=1200i,market_hours=1i 1700000000000000000",
+		`ticks,symbol=EUR\ USD price=1.1,market_hours=0i 1700000500000000000`,
+		"candles,symbol=AAPL open=189.5,high=190,low=189,close=189.75,volume=5000i 1700000000000000000",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %q", len(want), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d:\n got  %s\n want %s", i, lines[i], want[i])
+		}
+	}
+}
+
+// fakeDB is a database/sql driver recording executed statements.
+type fakeDB struct {
+	mu    sync.Mutex
+	execs []fakeExec
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+func (d *fakeDB) Open(string) (driver.Conn, error) { return &fakeConn{db: d}, nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	s.db.execs = append(s.db.execs, fakeExec{query: s.query, args: args})
+	return driver.RowsAffected(1), nil
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestSQLSink(t *testing.T) {
+	fake := &fakeDB{}
+	name := fmt.Sprintf("fake-%s", t.Name())
+	sql.Register(name, fake)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	sink, err := NewSQLSink(db, WithTickTable("quotes"))
+	if err != nil {
+		t.Fatalf("NewSQLSink() error: %v", err)
+	}
+	if len(fake.execs) != 3 ||
+		!strings.Contains(fake.execs[0].query, "CREATE TABLE IF NOT EXISTS quotes") ||
+		!strings.Contains(fake.execs[2].query, "CREATE TABLE IF NOT EXISTS bars") {
+		t.Fatalf("expected schema creation, got %+v", fake.execs)
+	}
+
+	if err := sink.WriteTick(&models.PricingData{ID: "AAPL", Price: 190.5, Time: 1700000000, Currency: "USD"}); err != nil {
+		t.Fatalf("WriteTick() error: %v", err)
+	}
+	if err := sink.WriteBar("AAPL", models.Bar{Date: time.Unix(1700000060, 0), Open: 190, Close: 191, Volume: 10}); err != nil {
+		t.Fatalf("WriteBar() error: %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+
+	tick := fake.execs[3]
+	if !strings.HasPrefix(tick.query, "INSERT INTO quotes") || tick.args[0] != "AAPL" || tick.args[1] != int64(1700000000000) {
+		t.Errorf("unexpected tick insert %+v", tick)
+	}
+	if tick.args[2] != 190.5 || tick.args[8] != "USD" {
+		t.Errorf("unexpected tick values %v", tick.args)
+	}
+
+	bar := fake.execs[4]
+	if !strings.HasPrefix(bar.query, "INSERT OR REPLACE INTO bars") || bar.args[1] != int64(1700000060000) {
+		t.Errorf("unexpected bar insert %+v", bar)
+	}
+	if bar.args[2] != 190.0 || bar.args[3] != nil || bar.args[5] != 191.0 {
+		t.Errorf("expected missing prices stored as NULL, got %v", bar.args)
+	}
+}
+
+func TestStoreSink(t *testing.T) {
+	st, err := store.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, err := NewStoreSink(st, WithBarTable("candles"))
+	if err != nil {
+		t.Fatalf("NewStoreSink() error: %v", err)
+	}
+
+	if err := sink.WriteTick(&models.PricingData{ID: "AAPL", Price: 190.5, Time: 1700000000}); err != nil {
+		t.Fatalf("WriteTick() error: %v", err)
+	}
+	at := time.Unix(1700000060, 0)
+	if err := sink.WriteBar("AAPL", models.Bar{Date: at, Close: 191}); err != nil {
+		t.Fatalf("WriteBar() error: %v", err)
+	}
+
+	var tick models.PricingData
+	taken, err := store.GetJSON(st, "ticks/AAPL", at, &tick)
+	if err != nil || tick.Price != 190.5 || taken.Unix() != 1700000000 {
+		t.Errorf("stored tick = %+v at %s, %v", tick, taken, err)
+	}
+	var bar models.Bar
+	if _, err := store.GetJSON(st, "candles/AAPL", at, &bar); err != nil || bar.Close != 191 {
+		t.Errorf("stored bar = %+v, %v", bar, err)
+	}
+}
@@ -0,0 +1,147 @@
+package live
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// SQLSink writes ticks and bars to SQL tables, created if missing. The SQL
+// targets SQLite; bring any SQLite driver (e.g. modernc.org/sqlite or
+// github.com/mattn/go-sqlite3) and pass the opened database.
+//
+// Ticks are appended; bars are keyed by symbol and time, so re-recording a
+// bar replaces it. Times are stored as Unix milliseconds.
+type SQLSink struct {
+	db   *sql.DB
+	cfg  sinkConfig
+	now  func() time.Time
+	tick *sql.Stmt
+	bar  *sql.Stmt
+}
+
+// NewSQLSink creates the sink's tables in db if they do not exist and
+// prepares its statements. The caller keeps ownership of db.
+//
+// Example:
+//
+//	import _ "modernc.org/sqlite"
+//
+//	db, _ := sql.Open("sqlite", "market.db")
+//	defer db.Close()
+//	sink, err := live.NewSQLSink(db)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer sink.Close()
+//	ws.ListenAsync(live.Record(sink, nil))
+func NewSQLSink(db *sql.DB, opts ...SinkOption) (*SQLSink, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database is required")
+	}
+	cfg, err := newSinkConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	symbol TEXT NOT NULL,
+	time INTEGER NOT NULL,
+	price REAL,
+	change REAL,
+	change_percent REAL,
+	day_volume INTEGER,
+	last_size INTEGER,
+	market_hours INTEGER,
+	currency TEXT,
+	exchange TEXT
+)`, cfg.tickTable),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %[1]s_symbol_time ON %[1]s (symbol, time)`, cfg.tickTable),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	symbol TEXT NOT NULL,
+	time INTEGER NOT NULL,
+	open REAL,
+	high REAL,
+	low REAL,
+	close REAL,
+	adj_close REAL,
+	volume INTEGER,
+	PRIMARY KEY (symbol, time)
+)`, cfg.barTable),
+	}
+	for _, stmt := range schema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("failed to create sink tables: %w", err)
+		}
+	}
+
+	s := &SQLSink{db: db, cfg: cfg, now: time.Now}
+	s.tick, err = db.Prepare(fmt.Sprintf(`INSERT INTO %s
+	(symbol, time, price, change, change_percent, day_volume, last_size, market_hours, currency, exchange)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, cfg.tickTable))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare tick insert: %w", err)
+	}
+	s.bar, err = db.Prepare(fmt.Sprintf(`INSERT OR REPLACE INTO %s
+	(symbol, time, open, high, low, close, adj_close, volume)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, cfg.barTable))
+	if err != nil {
+		s.tick.Close()
+		return nil, fmt.Errorf("failed to prepare bar insert: %w", err)
+	}
+	return s, nil
+}
+
+// WriteTick inserts data into the tick table.
+func (s *SQLSink) WriteTick(data *models.PricingData) error {
+	_, err := s.tick.Exec(
+		data.ID,
+		tickTime(data, s.now()).UnixMilli(),
+		float64(data.Price),
+		float64(data.Change),
+		float64(data.ChangePercent),
+		data.DayVolume,
+		data.LastSize,
+		data.MarketHours,
+		data.Currency,
+		data.Exchange,
+	)
+	return err
+}
+
+// WriteBar inserts or replaces bar in the bar table. Missing prices are
+// stored as NULL.
+func (s *SQLSink) WriteBar(symbol string, bar models.Bar) error {
+	_, err := s.bar.Exec(
+		symbol,
+		bar.Date.UnixMilli(),
+		nullFloat(bar.Open),
+		nullFloat(bar.High),
+		nullFloat(bar.Low),
+		nullFloat(bar.Close),
+		nullFloat(bar.AdjClose),
+		bar.Volume,
+	)
+	return err
+}
+
+// Close releases the prepared statements. The database stays open.
+func (s *SQLSink) Close() error {
+	err := s.tick.Close()
+	if berr := s.bar.Close(); err == nil {
+		err = berr
+	}
+	return err
+}
+
+// nullFloat maps missing (zero or NaN) prices to NULL.
+func nullFloat(v float64) sql.NullFloat64 {
+	if models.IsMissing(v) || math.IsInf(v, 0) {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: v, Valid: true}
+}
@@ -0,0 +1,56 @@
+package live
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
+)
+
+// StoreSink writes ticks and bars as JSON records to a [store.Store], keyed
+// "<table>/<SYMBOL>" (e.g. "ticks/AAPL") at the tick or bar time. A record
+// at the same time replaces the earlier one, so re-recording a bar is
+// harmless.
+type StoreSink struct {
+	st  store.Store
+	cfg sinkConfig
+	now func() time.Time
+}
+
+// NewStoreSink creates a sink writing to st. The caller keeps ownership
+// of st.
+//
+// Example:
+//
+//	st, _ := store.NewFileStore("./recordings")
+//	sink, err := live.NewStoreSink(st)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	ws.ListenAsync(live.Record(sink, nil))
+func NewStoreSink(st store.Store, opts ...SinkOption) (*StoreSink, error) {
+	if st == nil {
+		return nil, fmt.Errorf("store is required")
+	}
+	cfg, err := newSinkConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &StoreSink{st: st, cfg: cfg, now: time.Now}, nil
+}
+
+// WriteTick stores data under the symbol's tick key.
+func (s *StoreSink) WriteTick(data *models.PricingData) error {
+	return store.PutJSON(s.st, store.Key(s.cfg.tickTable, data.ID), tickTime(data, s.now()), data)
+}
+
+// WriteBar stores bar under the symbol's bar key.
+func (s *StoreSink) WriteBar(symbol string, bar models.Bar) error {
+	return store.PutJSON(s.st, store.Key(s.cfg.barTable, symbol), bar.Date, bar)
+}
+
+// Close does nothing; the store stays open.
+func (s *StoreSink) Close() error {
+	return nil
+}
@@ -0,0 +1,205 @@
+package live
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/wnjoon/go-yfinance/pkg/market"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// SummaryHandler is a callback function that receives the updated market summary.
+type SummaryHandler func(models.MarketSummary)
+
+// SummaryStream keeps a [models.MarketSummary] up to date from live pricing data.
+//
+// The stream is seeded from a market summary snapshot (e.g. [market.Market.Summary])
+// and every incoming [models.PricingData] for one of the summary symbols updates
+// the matching item in place. Updates for unknown symbols are ignored.
+type SummaryStream struct {
+	ws      *WebSocket
+	handler SummaryHandler
+
+	mu         sync.RWMutex
+	summary    models.MarketSummary
+	bySymbol   map[string]string // symbol -> summary key (exchange)
+	subscribed bool              // holds subscriptions taken by SubscribeMarketSummary
+}
+
+// NewSummaryStream creates a SummaryStream seeded with the given summary.
+//
+// It does not subscribe; use [SubscribeMarketSummary] or call
+// ws.Subscribe(stream.Symbols()) before listening.
+func NewSummaryStream(ws *WebSocket, summary models.MarketSummary, handler SummaryHandler) *SummaryStream {
+	s := &SummaryStream{
+		ws:       ws,
+		handler:  handler,
+		summary:  make(models.MarketSummary, len(summary)),
+		bySymbol: make(map[string]string, len(summary)),
+	}
+
+	for key, item := range summary {
+		s.summary[key] = item
+		if item.Symbol != "" {
+			s.bySymbol[strings.ToUpper(item.Symbol)] = key
+		}
+	}
+
+	return s
+}
+
+// SubscribeMarketSummary subscribes the WebSocket to every index symbol in the
+// market summary (^GSPC, ^DJI, ^IXIC, ...) and returns a stream that keeps the
+// summary updated as prices arrive.
+//
+// Example:
+//
+//	m, _ := market.New("US")
+//	defer m.Close()
+//	ws, _ := live.New()
+//	defer ws.Close()
+//
+//	stream, err := live.SubscribeMarketSummary(ws, m, func(s models.MarketSummary) {
+//	    for _, item := range s {
+//	        fmt.Printf("%s: %.2f\n", item.ShortName, item.RegularMarketPrice)
+//	    }
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	stream.Listen()
+func SubscribeMarketSummary(ws *WebSocket, m *market.Market, handler SummaryHandler) (*SummaryStream, error) {
+	summary, err := m.Summary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch market summary: %w", err)
+	}
+
+	s := NewSummaryStream(ws, summary, handler)
+	symbols := s.Symbols()
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("market summary has no symbols to subscribe")
+	}
+
+	if err := ws.Subscribe(symbols); err != nil {
+		ws.Unsubscribe(symbols)
+		return nil, err
+	}
+	s.subscribed = true
+	return s, nil
+}
+
+// Close unsubscribes the symbols of a stream created by
+// [SubscribeMarketSummary], releasing its subscription references. Symbols
+// subscribed by others stay subscribed.
+func (s *SummaryStream) Close() error {
+	s.mu.Lock()
+	subscribed := s.subscribed
+	s.subscribed = false
+	s.mu.Unlock()
+
+	if !subscribed {
+		return nil
+	}
+	return s.ws.Unsubscribe(s.Symbols())
+}
+
+// Symbols returns the sorted list of symbols tracked by the stream.
+func (s *SummaryStream) Symbols() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	symbols := make([]string, 0, len(s.bySymbol))
+	for sym := range s.bySymbol {
+		symbols = append(symbols, sym)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Summary returns a snapshot copy of the current market summary.
+func (s *SummaryStream) Summary() models.MarketSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.snapshot()
+}
+
+// Handle applies a pricing update to the summary and invokes the handler.
+// It satisfies [MessageHandler], so it can be passed to [WebSocket.Listen].
+func (s *SummaryStream) Handle(data *models.PricingData) {
+	if data == nil {
+		return
+	}
+
+	s.mu.Lock()
+	key, ok := s.bySymbol[strings.ToUpper(data.ID)]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+
+	item := s.summary[key]
+	applyPricingData(&item, data)
+	s.summary[key] = item
+
+	handler := s.handler
+	var snapshot models.MarketSummary
+	if handler != nil {
+		snapshot = s.snapshot()
+	}
+	s.mu.Unlock()
+
+	if handler != nil {
+		handler(snapshot)
+	}
+}
+
+// Listen blocks, feeding live updates into the summary. See [WebSocket.Listen].
+func (s *SummaryStream) Listen() error {
+	return s.ws.Listen(s.Handle)
+}
+
+// ListenAsync starts listening in a separate goroutine. See [WebSocket.ListenAsync].
+func (s *SummaryStream) ListenAsync() error {
+	return s.ws.ListenAsync(s.Handle)
+}
+
+// snapshot copies the summary (must be called with lock held).
+func (s *SummaryStream) snapshot() models.MarketSummary {
+	out := make(models.MarketSummary, len(s.summary))
+	for k, v := range s.summary {
+		out[k] = v
+	}
+	return out
+}
+
+// applyPricingData copies the non-zero live fields onto a summary item.
+func applyPricingData(item *models.MarketSummaryItem, data *models.PricingData) {
+	if data.Price != 0 {
+		item.RegularMarketPrice = float64(data.Price)
+	}
+	if data.Change != 0 {
+		item.RegularMarketChange = float64(data.Change)
+	}
+	if data.ChangePercent != 0 {
+		item.RegularMarketChangePercent = float64(data.ChangePercent)
+	}
+	if data.PreviousClose != 0 {
+		item.RegularMarketPreviousClose = float64(data.PreviousClose)
+	}
+	if data.Time != 0 {
+		item.RegularMarketTime = data.Time
+	}
+
+	switch models.MarketState(data.MarketHours) {
+	case models.MarketStatePreMarket:
+		item.MarketState = "PRE"
+	case models.MarketStateRegular:
+		item.MarketState = "REGULAR"
+	case models.MarketStatePostMarket:
+		item.MarketState = "POST"
+	case models.MarketStateClosed:
+		item.MarketState = "CLOSED"
+	}
+}
@@ -32,11 +32,16 @@ type ErrorHandler func(error)
 type WebSocket struct {
 	url               string
 	conn              *websocket.Conn
-	subscriptions     map[string]struct{}
+	subscriptions     map[string]int // symbol -> number of holders
 	messageHandler    MessageHandler
 	errorHandler      ErrorHandler
 	heartbeatInterval time.Duration
 	reconnectDelay    time.Duration
+	marketHoursOnly   bool
+	groups            map[string]*Group
+	backfill          BackfillFunc
+	priceCheck        *priceChecker
+	lastReceived      time.Time
 
 	mu            sync.RWMutex
 	writeMu       sync.Mutex // serializes all conn.WriteMessage calls
@@ -77,6 +82,14 @@ func WithErrorHandler(handler ErrorHandler) Option {
 	}
 }
 
+// WithMarketHoursOnly drops messages received outside the regular trading
+// session (pre-market, post-market and closed) when enabled.
+func WithMarketHoursOnly(enabled bool) Option {
+	return func(ws *WebSocket) {
+		ws.marketHoursOnly = enabled
+	}
+}
+
 // New creates a new WebSocket client.
 //
 // Example:
@@ -89,7 +102,7 @@ func WithErrorHandler(handler ErrorHandler) Option {
 func New(opts ...Option) (*WebSocket, error) {
 	ws := &WebSocket{
 		url:               DefaultURL,
-		subscriptions:     make(map[string]struct{}),
+		subscriptions:     make(map[string]int),
 		heartbeatInterval: DefaultHeartbeatInterval,
 		reconnectDelay:    DefaultReconnectDelay,
 		done:              make(chan struct{}),
@@ -123,42 +136,50 @@ func (ws *WebSocket) Connect() error {
 
 // Subscribe adds symbols to the subscription list and sends subscribe message.
 //
+// Subscriptions are reference counted: each call takes one reference on
+// each symbol, as do [Group.Subscribe] and [SubscribeMarketSummary], and a
+// symbol stays subscribed until every reference is released with
+// [WebSocket.Unsubscribe]. Symbols are added even if connecting fails and
+// are sent with the next successful subscription or reconnect.
+//
 // Example:
 //
 //	ws.Subscribe([]string{"AAPL", "MSFT"})
 func (ws *WebSocket) Subscribe(symbols []string) error {
+	ws.mu.Lock()
+	for _, sym := range symbols {
+		ws.subscriptions[strings.ToUpper(sym)]++
+	}
+	ws.mu.Unlock()
+
 	if err := ws.Connect(); err != nil {
 		return err
 	}
 
-	ws.mu.Lock()
-	for _, sym := range symbols {
-		ws.subscriptions[strings.ToUpper(sym)] = struct{}{}
-	}
+	ws.mu.RLock()
 	symbolList := ws.getSubscriptionList()
-	ws.mu.Unlock()
+	ws.mu.RUnlock()
 
 	return ws.sendSubscribe(symbolList)
 }
 
-// Unsubscribe removes symbols from the subscription list.
+// Unsubscribe releases one reference on each of symbols taken by
+// [WebSocket.Subscribe], and unsubscribes the symbols left without any.
 //
 // Example:
 //
 //	ws.Unsubscribe([]string{"AAPL"})
 func (ws *WebSocket) Unsubscribe(symbols []string) error {
+	unused := ws.release(symbols)
+	if len(unused) == 0 {
+		return nil
+	}
 	if err := ws.Connect(); err != nil {
 		return err
 	}
 
-	ws.mu.Lock()
-	for _, sym := range symbols {
-		delete(ws.subscriptions, strings.ToUpper(sym))
-	}
-	ws.mu.Unlock()
-
 	msg := map[string]interface{}{
-		"unsubscribe": symbols,
+		"unsubscribe": unused,
 	}
 	data, err := json.Marshal(msg)
 	if err != nil {
@@ -177,6 +198,7 @@ func (ws *WebSocket) Unsubscribe(symbols []string) error {
 
 // Listen starts listening for messages and calls the handler for each message.
 // This method blocks until Close() is called or an unrecoverable error occurs.
+// The handler may be nil when only [Group] handlers are used.
 //
 // Example:
 //
@@ -276,6 +298,29 @@ func (ws *WebSocket) Subscriptions() []string {
 	return ws.getSubscriptionList()
 }
 
+// release drops one reference on each of symbols and returns those left
+// without any.
+func (ws *WebSocket) release(symbols []string) []string {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	var unused []string
+	for _, sym := range symbols {
+		sym = strings.ToUpper(sym)
+		n, ok := ws.subscriptions[sym]
+		if !ok {
+			continue
+		}
+		if n <= 1 {
+			delete(ws.subscriptions, sym)
+			unused = append(unused, sym)
+			continue
+		}
+		ws.subscriptions[sym] = n - 1
+	}
+	return unused
+}
+
 // getSubscriptionList returns the subscription list (must be called with lock held).
 func (ws *WebSocket) getSubscriptionList() []string {
 	list := make([]string, 0, len(ws.subscriptions))
@@ -336,6 +381,7 @@ func (ws *WebSocket) readMessage() error {
 	if wrapper.Message == "" {
 		return nil // Empty message, skip
 	}
+	ws.markReceived(time.Now())
 
 	// Decode protobuf
 	pricingData, err := decodeBase64Message(wrapper.Message)
@@ -343,14 +389,30 @@ func (ws *WebSocket) readMessage() error {
 		return fmt.Errorf("failed to decode pricing data: %w", err)
 	}
 
+	if !ws.accept(pricingData) {
+		return nil
+	}
+
 	// Call handler
 	if handler != nil {
 		handler(pricingData)
 	}
+	ws.dispatchGroups(pricingData)
 
 	return nil
 }
 
+// accept reports whether a message passes the configured filters.
+func (ws *WebSocket) accept(data *models.PricingData) bool {
+	if ws.marketHoursOnly && !data.IsRegularMarket() {
+		return false
+	}
+	if ws.priceCheck != nil {
+		return ws.priceCheck.check(data)
+	}
+	return true
+}
+
 // heartbeatLoop sends periodic subscription messages to keep connection alive.
 func (ws *WebSocket) heartbeatLoop() {
 	ticker := time.NewTicker(ws.heartbeatInterval)
@@ -393,7 +455,12 @@ func (ws *WebSocket) reconnect() error {
 
 	// Re-subscribe
 	if len(subscriptions) > 0 {
-		return ws.sendSubscribe(subscriptions)
+		if err := ws.sendSubscribe(subscriptions); err != nil {
+			return err
+		}
 	}
+
+	// Fill the outage before live messages are read again
+	ws.backfillGap(subscriptions, time.Now())
 	return nil
 }
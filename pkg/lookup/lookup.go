@@ -35,7 +35,6 @@ type Option func(*Lookup)
 func WithClient(c *client.Client) Option {
 	return func(l *Lookup) {
 		l.client = c
-		l.ownsClient = false
 	}
 }
 
@@ -61,9 +60,8 @@ func New(query string, opts ...Option) (*Lookup, error) {
 	}
 
 	l := &Lookup{
-		query:      query,
-		ownsClient: true,
-		cache:      make(map[string]*models.LookupResult),
+		query: query,
+		cache: make(map[string]*models.LookupResult),
 	}
 
 	for _, opt := range opts {
@@ -71,20 +69,23 @@ func New(query string, opts ...Option) (*Lookup, error) {
 	}
 
 	if l.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		l.client = c
+		l.ownsClient = true
 	}
 
 	return l, nil
 }
 
-// Close releases resources used by the Lookup instance.
+// Close releases the shared [client.Default] client if the Lookup acquired
+// it. A client passed with WithClient is left open.
 func (l *Lookup) Close() {
-	if l.ownsClient && l.client != nil {
-		l.client.Close()
+	if l.ownsClient {
+		l.ownsClient = false
+		l.client.Release()
 	}
 }
 
@@ -164,29 +165,29 @@ func (l *Lookup) parseResponse(raw *models.LookupResponse) *models.LookupResult
 
 	for _, doc := range docs {
 		document := models.LookupDocument{
-			Symbol:                     getString(doc, "symbol"),
-			Name:                       getString(doc, "name"),
-			ShortName:                  getString(doc, "shortName"),
-			Exchange:                   getString(doc, "exchange"),
-			ExchangeDisplay:            getString(doc, "exchDisp"),
-			QuoteType:                  getString(doc, "quoteType"),
-			TypeDisplay:                getString(doc, "typeDisp"),
-			Industry:                   getString(doc, "industry"),
-			Sector:                     getString(doc, "sector"),
-			Score:                      getFloat(doc, "score"),
-			RegularMarketPrice:         getFloat(doc, "regularMarketPrice"),
-			RegularMarketChange:        getFloat(doc, "regularMarketChange"),
-			RegularMarketChangePercent: getFloat(doc, "regularMarketChangePercent"),
-			RegularMarketPreviousClose: getFloat(doc, "regularMarketPreviousClose"),
-			RegularMarketOpen:          getFloat(doc, "regularMarketOpen"),
-			RegularMarketDayHigh:       getFloat(doc, "regularMarketDayHigh"),
-			RegularMarketDayLow:        getFloat(doc, "regularMarketDayLow"),
-			RegularMarketVolume:        getInt64(doc, "regularMarketVolume"),
-			MarketCap:                  getInt64(doc, "marketCap"),
-			FiftyTwoWeekHigh:           getFloat(doc, "fiftyTwoWeekHigh"),
-			FiftyTwoWeekLow:            getFloat(doc, "fiftyTwoWeekLow"),
-			Currency:                   getString(doc, "currency"),
-			MarketState:                getString(doc, "marketState"),
+			Symbol:                     doc.Symbol,
+			Name:                       doc.Name,
+			ShortName:                  doc.ShortName,
+			Exchange:                   doc.Exchange,
+			ExchangeDisplay:            doc.ExchangeDisplay,
+			QuoteType:                  doc.QuoteType,
+			TypeDisplay:                doc.TypeDisplay,
+			Industry:                   doc.Industry,
+			Sector:                     doc.Sector,
+			Score:                      doc.Score.Float64(),
+			RegularMarketPrice:         doc.RegularMarketPrice.Float64(),
+			RegularMarketChange:        doc.RegularMarketChange.Float64(),
+			RegularMarketChangePercent: doc.RegularMarketChangePercent.Float64(),
+			RegularMarketPreviousClose: doc.RegularMarketPreviousClose.Float64(),
+			RegularMarketOpen:          doc.RegularMarketOpen.Float64(),
+			RegularMarketDayHigh:       doc.RegularMarketDayHigh.Float64(),
+			RegularMarketDayLow:        doc.RegularMarketDayLow.Float64(),
+			RegularMarketVolume:        doc.RegularMarketVolume.Int64(),
+			MarketCap:                  doc.MarketCap.Int64(),
+			FiftyTwoWeekHigh:           doc.FiftyTwoWeekHigh.Float64(),
+			FiftyTwoWeekLow:            doc.FiftyTwoWeekLow.Float64(),
+			Currency:                   doc.Currency,
+			MarketState:                doc.MarketState,
 		}
 
 		// Use shortName if name is empty
@@ -382,36 +383,3 @@ func (l *Lookup) ClearCache() {
 	defer l.mu.Unlock()
 	l.cache = make(map[string]*models.LookupResult)
 }
-
-// Helper functions for parsing map values
-
-func getString(m map[string]interface{}, key string) string {
-	if v, ok := m[key].(string); ok {
-		return v
-	}
-	return ""
-}
-
-func getFloat(m map[string]interface{}, key string) float64 {
-	switch v := m[key].(type) {
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	}
-	return 0
-}
-
-func getInt64(m map[string]interface{}, key string) int64 {
-	switch v := m[key].(type) {
-	case float64:
-		return int64(v)
-	case int:
-		return int64(v)
-	case int64:
-		return v
-	}
-	return 0
-}
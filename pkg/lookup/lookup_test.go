@@ -1,8 +1,10 @@
 package lookup
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -21,8 +23,16 @@ func TestNew(t *testing.T) {
 		t.Errorf("Expected query 'AAPL', got '%s'", l.query)
 	}
 
-	if l.ownsClient != true {
-		t.Error("ownsClient should be true when no custom client is provided")
+	if !l.ownsClient {
+		t.Error("ownsClient should be true when the shared default client is acquired")
+	}
+
+	def, err := client.Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	if l.client != def {
+		t.Error("Client should be the shared default client")
 	}
 }
 
@@ -46,7 +56,7 @@ func TestNewWithClient(t *testing.T) {
 		t.Fatalf("Failed to create second Lookup: %v", err)
 	}
 
-	if l2.ownsClient != false {
+	if l2.ownsClient {
 		t.Error("ownsClient should be false when custom client is provided")
 	}
 
@@ -112,59 +122,42 @@ func TestLookupTypes(t *testing.T) {
 	}
 }
 
-func TestHelperFunctions(t *testing.T) {
-	m := map[string]interface{}{
-		"str":   "hello",
-		"int":   42,
-		"float": 3.14,
-		"int64": int64(1234567890),
-	}
-
-	// Test getString
-	if got := getString(m, "str"); got != "hello" {
-		t.Errorf("getString expected 'hello', got '%s'", got)
-	}
-
-	if got := getString(m, "missing"); got != "" {
-		t.Errorf("getString for missing key expected '', got '%s'", got)
-	}
+func TestDecodeDocument(t *testing.T) {
+	body := `{"finance": {"result": [{"documents": [
+		{"symbol": "AAPL", "name": "Apple Inc.", "score": 3.14, "regularMarketVolume": 42,
+			"marketCap": 1234567890, "regularMarketPrice": {"raw": 150.25, "fmt": "150.25"},
+			"regularMarketChangePercent": "1.5%", "regularMarketOpen": null, "exchange": "NMS"}
+	]}]}}`
 
-	if got := getString(m, "int"); got != "" {
-		t.Errorf("getString for int expected '', got '%s'", got)
+	var raw models.LookupResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
+	doc := raw.Finance.Result[0].Documents[0]
 
-	// Test getFloat
-	if got := getFloat(m, "float"); got != 3.14 {
-		t.Errorf("getFloat expected 3.14, got %f", got)
+	if doc.Symbol != "AAPL" || doc.Exchange != "NMS" || doc.ShortName != "" {
+		t.Errorf("unexpected strings %+v", doc)
 	}
-
-	if got := getFloat(m, "int"); got != 42.0 {
-		t.Errorf("getFloat for int expected 42.0, got %f", got)
+	if got := doc.Score.Float64(); got != 3.14 {
+		t.Errorf("score expected 3.14, got %f", got)
 	}
-
-	if got := getFloat(m, "int64"); got != 1234567890.0 {
-		t.Errorf("getFloat for int64 expected 1234567890.0, got %f", got)
+	if got := doc.RegularMarketVolume.Int64(); got != 42 {
+		t.Errorf("volume expected 42, got %d", got)
 	}
-
-	if got := getFloat(m, "missing"); got != 0 {
-		t.Errorf("getFloat for missing key expected 0, got %f", got)
+	if got := doc.MarketCap.Int64(); got != 1234567890 {
+		t.Errorf("marketCap expected 1234567890, got %d", got)
 	}
-
-	// Test getInt64
-	if got := getInt64(m, "float"); got != 3 {
-		t.Errorf("getInt64 for float expected 3, got %d", got)
+	if got := doc.RegularMarketPrice.Float64(); got != 150.25 {
+		t.Errorf("raw price expected 150.25, got %f", got)
 	}
-
-	if got := getInt64(m, "int"); got != 42 {
-		t.Errorf("getInt64 for int expected 42, got %d", got)
+	if got := doc.RegularMarketChangePercent.Float64(); got != 1.5 {
+		t.Errorf("percent string expected 1.5, got %f", got)
 	}
-
-	if got := getInt64(m, "int64"); got != 1234567890 {
-		t.Errorf("getInt64 for int64 expected 1234567890, got %d", got)
+	if doc.RegularMarketOpen.Valid() || doc.FiftyTwoWeekHigh.Valid() {
+		t.Error("expected null and missing values to be invalid")
 	}
-
-	if got := getInt64(m, "missing"); got != 0 {
-		t.Errorf("getInt64 for missing key expected 0, got %d", got)
+	if got := doc.FiftyTwoWeekHigh.Float64(); got != 0 {
+		t.Errorf("missing value expected 0, got %f", got)
 	}
 }
 
@@ -220,25 +213,16 @@ func TestParseResponse(t *testing.T) {
 
 	// Test with valid response
 	validResp := &models.LookupResponse{}
-	validResp.Finance.Result = []struct {
-		Documents []map[string]interface{} `json:"documents"`
-		Count     int                      `json:"count,omitempty"`
-		Start     int                      `json:"start,omitempty"`
-		Total     int                      `json:"total,omitempty"`
-	}{
-		{
-			Documents: []map[string]interface{}{
-				{
-					"symbol":             "AAPL",
-					"name":               "Apple Inc.",
-					"exchange":           "NMS",
-					"quoteType":          "EQUITY",
-					"regularMarketPrice": 150.25,
-					"marketCap":          float64(2500000000000),
-				},
-			},
-			Count: 1,
-		},
+	body := `{"finance": {"result": [{"count": 1, "documents": [{
+		"symbol": "AAPL",
+		"name": "Apple Inc.",
+		"exchange": "NMS",
+		"quoteType": "EQUITY",
+		"regularMarketPrice": 150.25,
+		"marketCap": 2500000000000
+	}]}]}}`
+	if err := json.Unmarshal([]byte(body), validResp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
 
 	result = l.parseResponse(validResp)
@@ -286,22 +270,10 @@ func TestParseResponseWithShortName(t *testing.T) {
 
 	// Test that shortName is used when name is empty
 	validResp := &models.LookupResponse{}
-	validResp.Finance.Result = []struct {
-		Documents []map[string]interface{} `json:"documents"`
-		Count     int                      `json:"count,omitempty"`
-		Start     int                      `json:"start,omitempty"`
-		Total     int                      `json:"total,omitempty"`
-	}{
-		{
-			Documents: []map[string]interface{}{
-				{
-					"symbol":    "AAPL",
-					"shortName": "Apple Inc",
-					// name is missing
-				},
-			},
-			Count: 1,
-		},
+	// name is missing
+	body := `{"finance": {"result": [{"count": 1, "documents": [{"symbol": "AAPL", "shortName": "Apple Inc"}]}]}}`
+	if err := json.Unmarshal([]byte(body), validResp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
 
 	result := l.parseResponse(validResp)
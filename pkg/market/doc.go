@@ -39,6 +39,28 @@
 //	        item.RegularMarketChangePercent)
 //	}
 //
+// # Watching the Summary
+//
+// Where a WebSocket is not an option, [Market.Watch] polls the summary and
+// emits an event for each index whose price or percent change moved:
+//
+//	events, _ := m.Watch(ctx, 30*time.Second)
+//	for ev := range events {
+//	    if ev.Err == nil {
+//	        fmt.Printf("%s %+.2f%%\n", ev.Current.ShortName, ev.PercentDelta)
+//	    }
+//	}
+//
+// # Market Movers
+//
+// [Movers] returns the day's gainers, losers and most active stocks of any
+// screener region, not only the US:
+//
+//	movers, err := market.Movers("jp", 10)
+//	for _, q := range movers.Losers {
+//	    fmt.Printf("%s %.2f%%\n", q.Symbol, q.RegularMarketChangePercent)
+//	}
+//
 // # Predefined Markets
 //
 // Common market identifiers are available as constants:
@@ -11,6 +11,7 @@ import (
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Market provides access to market status and summary information.
@@ -37,7 +38,6 @@ type Option func(*Market)
 func WithClient(c *client.Client) Option {
 	return func(m *Market) {
 		m.client = c
-		m.ownsClient = false
 	}
 }
 
@@ -71,8 +71,7 @@ func New(market string, opts ...Option) (*Market, error) {
 	}
 
 	m := &Market{
-		market:     normalizedMarket,
-		ownsClient: true,
+		market: normalizedMarket,
 	}
 
 	for _, opt := range opts {
@@ -80,11 +79,12 @@ func New(market string, opts ...Option) (*Market, error) {
 	}
 
 	if m.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		m.client = c
+		m.ownsClient = true
 	}
 
 	return m, nil
@@ -107,10 +107,12 @@ func NewWithRegion(region models.MarketRegion, opts ...Option) (*Market, error)
 	return New(string(region), opts...)
 }
 
-// Close releases resources used by the Market instance.
+// Close releases the shared [client.Default] client if the Market acquired
+// it. A client passed with WithClient is left open.
 func (m *Market) Close() {
-	if m.ownsClient && m.client != nil {
-		m.client.Close()
+	if m.ownsClient {
+		m.ownsClient = false
+		m.client.Release()
 	}
 }
 
@@ -163,13 +165,11 @@ func (m *Market) fetchData() error {
 	// Parse summary
 	var summaryRaw models.MarketSummaryResponse
 	if err := json.Unmarshal([]byte(summaryResp.Body), &summaryRaw); err != nil {
-		return fmt.Errorf("failed to parse market summary: %w", err)
+		return yferr.Parse("market summary", err)
 	}
 
 	if summaryRaw.MarketSummaryResponse.Error != nil {
-		return fmt.Errorf("market summary API error: %s - %s",
-			summaryRaw.MarketSummaryResponse.Error.Code,
-			summaryRaw.MarketSummaryResponse.Error.Description)
+		return yferr.API("market summary", summaryRaw.MarketSummaryResponse.Error.Code, summaryRaw.MarketSummaryResponse.Error.Description)
 	}
 
 	summary := m.parseSummary(summaryRaw.MarketSummaryResponse.Result)
@@ -177,18 +177,16 @@ func (m *Market) fetchData() error {
 	// Parse status
 	var statusRaw models.MarketTimeResponse
 	if err := json.Unmarshal([]byte(statusResp.Body), &statusRaw); err != nil {
-		return fmt.Errorf("failed to parse market time: %w", err)
+		return yferr.Parse("market time", err)
 	}
 
 	if statusRaw.Finance.Error != nil {
-		return fmt.Errorf("market time API error: %s - %s",
-			statusRaw.Finance.Error.Code,
-			statusRaw.Finance.Error.Description)
+		return yferr.API("market time", statusRaw.Finance.Error.Code, statusRaw.Finance.Error.Description)
 	}
 
 	status, err := m.parseStatus(&statusRaw)
 	if err != nil {
-		return fmt.Errorf("failed to parse market status: %w", err)
+		return yferr.Parse("market status", err)
 	}
 
 	// Update cache
@@ -202,32 +200,31 @@ func (m *Market) fetchData() error {
 }
 
 // parseSummary converts raw API response to MarketSummary.
-func (m *Market) parseSummary(results []map[string]interface{}) models.MarketSummary {
+func (m *Market) parseSummary(results []models.RawMarketSummaryItem) models.MarketSummary {
 	summary := make(models.MarketSummary)
 
 	for _, result := range results {
-		exchange := getString(result, "exchange")
-		if exchange == "" {
+		if result.Exchange == "" {
 			continue
 		}
 
 		item := models.MarketSummaryItem{
-			Exchange:                   exchange,
-			Symbol:                     getString(result, "symbol"),
-			ShortName:                  getString(result, "shortName"),
-			FullExchangeName:           getString(result, "fullExchangeName"),
-			MarketState:                getString(result, "marketState"),
-			RegularMarketPrice:         getFloat(result, "regularMarketPrice"),
-			RegularMarketChange:        getFloat(result, "regularMarketChange"),
-			RegularMarketChangePercent: getFloat(result, "regularMarketChangePercent"),
-			RegularMarketPreviousClose: getFloat(result, "regularMarketPreviousClose"),
-			RegularMarketTime:          getInt64(result, "regularMarketTime"),
-			QuoteType:                  getString(result, "quoteType"),
-			SourceInterval:             int(getFloat(result, "sourceInterval")),
-			ExchangeDataDelayedBy:      int(getFloat(result, "exchangeDataDelayedBy")),
+			Exchange:                   result.Exchange,
+			Symbol:                     result.Symbol,
+			ShortName:                  result.ShortName,
+			FullExchangeName:           result.FullExchangeName,
+			MarketState:                result.MarketState,
+			RegularMarketPrice:         result.RegularMarketPrice.Float64(),
+			RegularMarketChange:        result.RegularMarketChange.Float64(),
+			RegularMarketChangePercent: result.RegularMarketChangePercent.Float64(),
+			RegularMarketPreviousClose: result.RegularMarketPreviousClose.Float64(),
+			RegularMarketTime:          result.RegularMarketTime.Int64(),
+			QuoteType:                  result.QuoteType,
+			SourceInterval:             result.SourceInterval.Int(),
+			ExchangeDataDelayedBy:      result.ExchangeDataDelayedBy.Int(),
 		}
 
-		summary[exchange] = item
+		summary[result.Exchange] = item
 	}
 
 	return summary
@@ -236,12 +233,12 @@ func (m *Market) parseSummary(results []map[string]interface{}) models.MarketSum
 // parseStatus converts raw API response to MarketStatus.
 func (m *Market) parseStatus(raw *models.MarketTimeResponse) (*models.MarketStatus, error) {
 	if len(raw.Finance.MarketTimes) == 0 {
-		return nil, fmt.Errorf("no market times in response")
+		return nil, yferr.NoData("no market times in response")
 	}
 
 	marketTimes := raw.Finance.MarketTimes[0]
 	if len(marketTimes.MarketTime) == 0 {
-		return nil, fmt.Errorf("no market time data in response")
+		return nil, yferr.NoData("no market time data in response")
 	}
 
 	mt := marketTimes.MarketTime[0]
@@ -259,9 +256,9 @@ func (m *Market) parseStatus(raw *models.MarketTimeResponse) (*models.MarketStat
 	if len(mt.Timezone) > 0 {
 		tz := mt.Timezone[0]
 		status.Timezone = &models.MarketTimezone{
-			GMTOffset: getInt64(tz, "gmtoffset"),
-			Short:     getString(tz, "short"),
-			Long:      getString(tz, "long"),
+			GMTOffset: tz.GMTOffset.Int64(),
+			Short:     tz.Short,
+			Long:      tz.Long,
 		}
 	}
 
@@ -399,36 +396,3 @@ func normalizeMarket(market string) (string, error) {
 
 	return "", fmt.Errorf("unknown market %q", market)
 }
-
-// Helper functions for parsing map values
-
-func getString(m map[string]interface{}, key string) string {
-	if v, ok := m[key].(string); ok {
-		return v
-	}
-	return ""
-}
-
-func getFloat(m map[string]interface{}, key string) float64 {
-	switch v := m[key].(type) {
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	}
-	return 0
-}
-
-func getInt64(m map[string]interface{}, key string) int64 {
-	switch v := m[key].(type) {
-	case float64:
-		return int64(v)
-	case int:
-		return int64(v)
-	case int64:
-		return v
-	}
-	return 0
-}
@@ -1,8 +1,14 @@
 package market
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -21,8 +27,16 @@ func TestNew(t *testing.T) {
 		t.Errorf("Expected market 'US', got '%s'", m.market)
 	}
 
-	if m.ownsClient != true {
-		t.Error("ownsClient should be true when no custom client is provided")
+	if !m.ownsClient {
+		t.Error("ownsClient should be true when the shared default client is acquired")
+	}
+
+	def, err := client.Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	if m.client != def {
+		t.Error("Client should be the shared default client")
 	}
 }
 
@@ -75,7 +89,7 @@ func TestNewWithClient(t *testing.T) {
 		t.Fatalf("Failed to create second Market: %v", err)
 	}
 
-	if m2.ownsClient != false {
+	if m2.ownsClient {
 		t.Error("ownsClient should be false when custom client is provided")
 	}
 
@@ -153,43 +167,36 @@ func TestMarketRegions(t *testing.T) {
 	}
 }
 
-func TestHelperFunctions(t *testing.T) {
-	m := map[string]interface{}{
-		"str":   "hello",
-		"int":   42,
-		"float": 3.14,
-		"int64": int64(1234567890),
-	}
-
-	// Test getString
-	if got := getString(m, "str"); got != "hello" {
-		t.Errorf("getString expected 'hello', got '%s'", got)
-	}
-
-	if got := getString(m, "missing"); got != "" {
-		t.Errorf("getString for missing key expected '', got '%s'", got)
+func TestParseStatusTimezone(t *testing.T) {
+	m, err := New("us_market")
+	if err != nil {
+		t.Fatalf("Failed to create Market: %v", err)
 	}
+	defer m.Close()
 
-	// Test getFloat
-	if got := getFloat(m, "float"); got != 3.14 {
-		t.Errorf("getFloat expected 3.14, got %f", got)
+	body := `{"finance": {"marketTimes": [{"id": "us", "marketTime": [{
+		"id": "us",
+		"open": "2024-01-02T09:30:00-05:00",
+		"close": "2024-01-02T16:00:00-05:00",
+		"timezone": [{"gmtoffset": "-18000000", "short": "EST", "long": "Eastern Standard Time"}]
+	}]}]}}`
+	var raw models.MarketTimeResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
 
-	if got := getFloat(m, "int"); got != 42.0 {
-		t.Errorf("getFloat for int expected 42.0, got %f", got)
+	status, err := m.parseStatus(&raw)
+	if err != nil {
+		t.Fatalf("parseStatus() error: %v", err)
 	}
-
-	if got := getFloat(m, "missing"); got != 0 {
-		t.Errorf("getFloat for missing key expected 0, got %f", got)
+	if status.Timezone == nil {
+		t.Fatal("expected timezone")
 	}
-
-	// Test getInt64
-	if got := getInt64(m, "int64"); got != 1234567890 {
-		t.Errorf("getInt64 expected 1234567890, got %d", got)
+	if tz := status.Timezone; tz.GMTOffset != -18000000 || tz.Short != "EST" || tz.Long != "Eastern Standard Time" {
+		t.Errorf("unexpected timezone %+v", tz)
 	}
-
-	if got := getInt64(m, "float"); got != 3 {
-		t.Errorf("getInt64 for float expected 3, got %d", got)
+	if status.Open == nil || status.Open.Hour() != 9 {
+		t.Errorf("expected parsed open time, got %v", status.Open)
 	}
 }
 
@@ -233,25 +240,17 @@ func TestParseSummary(t *testing.T) {
 	}
 	defer m.Close()
 
-	results := []map[string]interface{}{
-		{
-			"exchange":                   "SNP",
-			"symbol":                     "^GSPC",
-			"shortName":                  "S&P 500",
-			"regularMarketPrice":         4500.50,
-			"regularMarketChange":        25.75,
-			"regularMarketChangePercent": 0.57,
-			"marketState":                "REGULAR",
-		},
-		{
-			"exchange":                   "DJI",
-			"symbol":                     "^DJI",
-			"shortName":                  "Dow 30",
-			"regularMarketPrice":         35000.25,
-			"regularMarketChange":        150.30,
-			"regularMarketChangePercent": 0.43,
-		},
+	body := `{"marketSummaryResponse": {"result": [
+		{"exchange": "SNP", "symbol": "^GSPC", "shortName": "S&P 500", "regularMarketPrice": {"raw": 4500.50, "fmt": "4,500.50"},
+			"regularMarketChange": 25.75, "regularMarketChangePercent": 0.57, "marketState": "REGULAR", "sourceInterval": 15},
+		{"exchange": "DJI", "symbol": "^DJI", "shortName": "Dow 30", "regularMarketPrice": 35000.25,
+			"regularMarketChange": 150.30, "regularMarketChangePercent": 0.43}
+	]}}`
+	var raw models.MarketSummaryResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
+	results := raw.MarketSummaryResponse.Result
 
 	summary := m.parseSummary(results)
 
@@ -279,6 +278,10 @@ func TestParseSummary(t *testing.T) {
 	if snp.MarketState != "REGULAR" {
 		t.Errorf("Expected marketState 'REGULAR', got '%s'", snp.MarketState)
 	}
+
+	if snp.SourceInterval != 15 {
+		t.Errorf("Expected sourceInterval 15, got %d", snp.SourceInterval)
+	}
 }
 
 func TestParseSummaryEmpty(t *testing.T) {
@@ -295,8 +298,8 @@ func TestParseSummaryEmpty(t *testing.T) {
 	}
 
 	// Results without exchange
-	results := []map[string]interface{}{
-		{"symbol": "^GSPC", "shortName": "S&P 500"},
+	results := []models.RawMarketSummaryItem{
+		{Symbol: "^GSPC", ShortName: "S&P 500"},
 	}
 	summary = m.parseSummary(results)
 	if len(summary) != 0 {
@@ -460,3 +463,77 @@ func TestCacheIntegration(t *testing.T) {
 
 	t.Logf("Results: first=%d, cached=%d, after_clear=%d", len(summary1), len(summary2), len(summary3))
 }
+
+func TestDiffSummary(t *testing.T) {
+	now := time.Now()
+	prev := models.MarketSummary{
+		"SNP": {Symbol: "^GSPC", RegularMarketPrice: 5000, RegularMarketChangePercent: 0.5},
+		"DJI": {Symbol: "^DJI", RegularMarketPrice: 39000, RegularMarketChangePercent: 0.1},
+	}
+	cur := models.MarketSummary{
+		"SNP": {Symbol: "^GSPC", RegularMarketPrice: 5010, RegularMarketChangePercent: 0.7},
+		"DJI": {Symbol: "^DJI", RegularMarketPrice: 39000, RegularMarketChangePercent: 0.1},
+		"NIM": {Symbol: "^IXIC", RegularMarketPrice: 16000},
+	}
+
+	events := diffSummary(prev, cur, now)
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d: %+v", len(events), events)
+	}
+	if events[0].Exchange != "NIM" || events[0].Previous != nil {
+		t.Errorf("Expected new NIM event without previous, got %+v", events[0])
+	}
+	snp := events[1]
+	if snp.Exchange != "SNP" || snp.Previous == nil {
+		t.Fatalf("Expected SNP change event, got %+v", snp)
+	}
+	if snp.PriceDelta != 10 || math.Abs(snp.PercentDelta-0.2) > 1e-9 {
+		t.Errorf("Unexpected deltas: price %v, percent %v", snp.PriceDelta, snp.PercentDelta)
+	}
+}
+
+func TestWatchSummary(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	polls := 0
+	fetch := func() (models.MarketSummary, error) {
+		polls++
+		switch polls {
+		case 1:
+			return models.MarketSummary{"SNP": {RegularMarketPrice: 100}}, nil
+		case 2:
+			return nil, fmt.Errorf("temporary failure")
+		default:
+			return models.MarketSummary{"SNP": {RegularMarketPrice: 101}}, nil
+		}
+	}
+
+	out := make(chan models.MarketSummaryChange, watchBuffer)
+	go watchSummary(ctx, time.Millisecond, fetch, out)
+
+	first := <-out
+	if first.Previous != nil || first.Current.RegularMarketPrice != 100 {
+		t.Errorf("Unexpected first event: %+v", first)
+	}
+	if failed := <-out; failed.Err == nil {
+		t.Errorf("Expected error event, got %+v", failed)
+	}
+	if moved := <-out; moved.PriceDelta != 1 {
+		t.Errorf("Expected price delta 1, got %+v", moved)
+	}
+
+	cancel()
+	for range out {
+	}
+}
+
+func TestWatchInvalidInterval(t *testing.T) {
+	m, err := New("us_market")
+	if err != nil {
+		t.Fatalf("Failed to create Market: %v", err)
+	}
+	if _, err := m.Watch(context.Background(), 0); err == nil {
+		t.Error("Expected error for zero interval")
+	}
+}
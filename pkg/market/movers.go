@@ -0,0 +1,141 @@
+package market
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/screener"
+)
+
+// defaultMoversCount is the number of quotes per list when count is not set.
+const defaultMoversCount = 25
+
+// moversScreen runs one screen; overridable in tests.
+type moversScreen func(query models.ScreenerQueryBuilder, params *models.ScreenerParams) (*models.ScreenerResult, error)
+
+// moversList defines one movers list. Conditions follow Yahoo's day_gainers,
+// day_losers and most_actives screeners without their US-specific region,
+// price and market cap filters, which are in local currency elsewhere.
+type moversList struct {
+	name       string
+	sortField  string
+	sortAsc    bool
+	conditions []moversCondition
+	quotes     func(*models.MarketMovers) *[]models.ScreenerQuote
+}
+
+// moversCondition is one equity query clause.
+type moversCondition struct {
+	op       string
+	operands []any
+}
+
+var moversLists = []moversList{
+	{
+		name:      "gainers",
+		sortField: "percentchange",
+		conditions: []moversCondition{
+			{models.OpGT, []any{"percentchange", 3}},
+			{models.OpGT, []any{"dayvolume", 15000}},
+		},
+		quotes: func(m *models.MarketMovers) *[]models.ScreenerQuote { return &m.Gainers },
+	},
+	{
+		name:      "losers",
+		sortField: "percentchange",
+		sortAsc:   true,
+		conditions: []moversCondition{
+			{models.OpLT, []any{"percentchange", -2.5}},
+			{models.OpGT, []any{"dayvolume", 20000}},
+		},
+		quotes: func(m *models.MarketMovers) *[]models.ScreenerQuote { return &m.Losers },
+	},
+	{
+		name:      "actives",
+		sortField: "dayvolume",
+		conditions: []moversCondition{
+			{models.OpGT, []any{"dayvolume", 0}},
+		},
+		quotes: func(m *models.MarketMovers) *[]models.ScreenerQuote { return &m.Actives },
+	},
+}
+
+// Movers returns the day's top gainers, losers and most active stocks of a
+// screener region such as "de", "jp" or "in", up to count quotes each
+// (25 if count is not positive).
+//
+// Unlike the predefined day_gainers, day_losers and most_actives screeners,
+// which only cover US stocks, the lists are limited to the region and its
+// exchanges with [screener.RegionPreset].
+//
+// Example:
+//
+//	movers, err := market.Movers("de", 10)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, q := range movers.Gainers {
+//	    fmt.Printf("%s: %+.2f%%\n", q.Symbol, q.RegularMarketChangePercent)
+//	}
+func Movers(region string, count int, opts ...Option) (*models.MarketMovers, error) {
+	m := &Market{}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	var sopts []screener.Option
+	if m.client != nil {
+		sopts = append(sopts, screener.WithClient(m.client))
+	}
+	s, err := screener.New(sopts...)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+
+	return movers(region, count, s.ScreenWithQuery)
+}
+
+func movers(region string, count int, screen moversScreen) (*models.MarketMovers, error) {
+	region = strings.ToLower(strings.TrimSpace(region))
+	if count <= 0 {
+		count = defaultMoversCount
+	}
+	if count > models.MaxScreenerCount {
+		return nil, fmt.Errorf("yahoo limits query count to %d, reduce count", models.MaxScreenerCount)
+	}
+
+	result := &models.MarketMovers{Region: region}
+	for _, list := range moversLists {
+		query, err := moversQuery(region, list)
+		if err != nil {
+			return nil, err
+		}
+
+		params := models.DefaultScreenerParams()
+		params.Count = count
+		params.SortField = list.sortField
+		params.SortAsc = list.sortAsc
+
+		res, err := screen(query, &params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s %s: %w", region, list.name, err)
+		}
+		*list.quotes(result) = res.Quotes
+	}
+	return result, nil
+}
+
+// moversQuery builds the region-limited query of a movers list.
+func moversQuery(region string, list moversList) (*models.EquityQuery, error) {
+	conditions := make([]*models.EquityQuery, 0, len(list.conditions))
+	for _, c := range list.conditions {
+		q, err := models.NewEquityQuery(c.op, c.operands)
+		if err != nil {
+			return nil, fmt.Errorf("movers %s: %w", list.name, err)
+		}
+		conditions = append(conditions, q)
+	}
+	return screener.RegionPreset(region, conditions...)
+}
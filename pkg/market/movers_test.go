@@ -0,0 +1,78 @@
+package market
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestMovers(t *testing.T) {
+	var (
+		queries []string
+		params  []models.ScreenerParams
+	)
+	screen := func(q models.ScreenerQueryBuilder, p *models.ScreenerParams) (*models.ScreenerResult, error) {
+		data, err := json.Marshal(q.ToDict())
+		if err != nil {
+			t.Fatal(err)
+		}
+		queries = append(queries, string(data))
+		params = append(params, *p)
+		return &models.ScreenerResult{Quotes: []models.ScreenerQuote{{Symbol: p.SortField}}}, nil
+	}
+
+	result, err := movers(" DE ", 0, screen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Region != "de" {
+		t.Errorf("expected region de, got %q", result.Region)
+	}
+	if len(result.Gainers) != 1 || len(result.Losers) != 1 || len(result.Actives) != 1 {
+		t.Fatalf("expected one quote per list, got %+v", result)
+	}
+	if result.Actives[0].Symbol != "dayvolume" {
+		t.Errorf("actives were not screened by volume: %+v", result.Actives)
+	}
+
+	if len(queries) != 3 {
+		t.Fatalf("expected 3 screens, got %d", len(queries))
+	}
+	for i, q := range queries {
+		if !strings.Contains(q, `"region","de"`) || !strings.Contains(q, "GER") {
+			t.Errorf("query %d is not limited to de: %s", i, q)
+		}
+		if strings.Contains(q, `"us"`) || strings.Contains(q, "intradaymarketcap") {
+			t.Errorf("query %d has US-specific filters: %s", i, q)
+		}
+		if params[i].Count != defaultMoversCount {
+			t.Errorf("query %d: expected count %d, got %d", i, defaultMoversCount, params[i].Count)
+		}
+	}
+	if !strings.Contains(queries[0], `"percentchange",3`) || params[0].SortAsc {
+		t.Errorf("unexpected gainers screen %s %+v", queries[0], params[0])
+	}
+	if !strings.Contains(queries[1], `"percentchange",-2.5`) || !params[1].SortAsc {
+		t.Errorf("unexpected losers screen %s %+v", queries[1], params[1])
+	}
+}
+
+func TestMoversErrors(t *testing.T) {
+	failed := errors.New("boom")
+	screen := func(models.ScreenerQueryBuilder, *models.ScreenerParams) (*models.ScreenerResult, error) {
+		return nil, failed
+	}
+
+	if _, err := movers("zz", 10, screen); err == nil {
+		t.Error("expected error for unknown region")
+	}
+	if _, err := movers("de", models.MaxScreenerCount+1, screen); err == nil {
+		t.Error("expected error for count above the Yahoo limit")
+	}
+	if _, err := movers("jp", 10, screen); !errors.Is(err, failed) {
+		t.Errorf("expected wrapped screen error, got %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package market
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// watchBuffer is the capacity of the channel returned by Watch.
+const watchBuffer = 64
+
+// summaryFetcher returns a fresh market summary.
+type summaryFetcher func() (models.MarketSummary, error)
+
+// Watch polls the market summary every interval and emits a change event
+// per index whose price or percent change moved since the previous poll.
+// It is a polling fallback for dashboards that cannot use the WebSocket
+// streamer.
+//
+// The first poll runs immediately and emits every index with a nil
+// Previous. A failed poll emits a single event with Err set and watching
+// continues. The channel is closed when ctx is done.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	events, err := m.Watch(ctx, 30*time.Second)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for ev := range events {
+//	    if ev.Err != nil {
+//	        log.Println(ev.Err)
+//	        continue
+//	    }
+//	    fmt.Printf("%s %.2f (%+.2f)\n", ev.Current.ShortName, ev.Current.RegularMarketPrice, ev.PriceDelta)
+//	}
+func (m *Market) Watch(ctx context.Context, interval time.Duration) (<-chan models.MarketSummaryChange, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("watch interval must be positive, got %s", interval)
+	}
+
+	fetch := func() (models.MarketSummary, error) {
+		m.ClearCache()
+		return m.Summary()
+	}
+
+	out := make(chan models.MarketSummaryChange, watchBuffer)
+	go watchSummary(ctx, interval, fetch, out)
+	return out, nil
+}
+
+// watchSummary runs the polling loop of Watch and closes out when ctx is done.
+func watchSummary(ctx context.Context, interval time.Duration, fetch summaryFetcher, out chan<- models.MarketSummaryChange) {
+	defer close(out)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last models.MarketSummary
+	for {
+		summary, err := fetch()
+		now := time.Now()
+
+		var events []models.MarketSummaryChange
+		if err != nil {
+			events = []models.MarketSummaryChange{{Time: now, Err: err}}
+		} else {
+			events = diffSummary(last, summary, now)
+			last = summary
+		}
+
+		for _, ev := range events {
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// diffSummary returns change events for the items of cur that are new or
+// whose price or percent change differ from prev, ordered by exchange.
+func diffSummary(prev, cur models.MarketSummary, now time.Time) []models.MarketSummaryChange {
+	keys := make([]string, 0, len(cur))
+	for k := range cur {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var events []models.MarketSummaryChange
+	for _, k := range keys {
+		item := cur[k]
+		ev := models.MarketSummaryChange{Exchange: k, Current: item, Time: now}
+
+		if old, ok := prev[k]; ok {
+			if old.RegularMarketPrice == item.RegularMarketPrice &&
+				old.RegularMarketChangePercent == item.RegularMarketChangePercent {
+				continue
+			}
+			ev.Previous = &old
+			ev.PriceDelta = item.RegularMarketPrice - old.RegularMarketPrice
+			ev.PercentDelta = item.RegularMarketChangePercent - old.RegularMarketChangePercent
+		}
+		events = append(events, ev)
+	}
+	return events
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // RecommendationTrend represents analyst recommendations over time.
 type RecommendationTrend struct {
@@ -72,6 +75,111 @@ type EPSTrend struct {
 	NinetyDays float64 `json:"90daysAgo"`
 }
 
+// epsTrendDaysAgo lists the EPSTrend snapshots, oldest first.
+var epsTrendDaysAgo = []int{90, 60, 30, 7, 0}
+
+// value returns the estimate of the snapshot daysAgo days old.
+func (tr EPSTrend) value(daysAgo int) float64 {
+	switch daysAgo {
+	case 90:
+		return tr.NinetyDays
+	case 60:
+		return tr.SixtyDays
+	case 30:
+		return tr.ThirtyDays
+	case 7:
+		return tr.SevenDays
+	default:
+		return tr.Current
+	}
+}
+
+// Slope is the least-squares slope of the consensus EPS over the last 90
+// days, in EPS per day. Missing (zero) snapshots are skipped; with fewer
+// than two snapshots the slope is 0.
+func (tr EPSTrend) Slope() float64 {
+	var n, sumX, sumY, sumXY, sumXX float64
+	for _, daysAgo := range epsTrendDaysAgo {
+		y := tr.value(daysAgo)
+		if y == 0 {
+			continue
+		}
+		x := float64(-daysAgo)
+		n++
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	if n < 2 {
+		return 0
+	}
+
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denom
+}
+
+// Series returns the trend as dated points counted back from asOf, the
+// date of the Current estimate, for charting estimate drift.
+//
+// Example:
+//
+//	s := trend.Series(time.Now())
+//	for _, p := range s.Points {
+//	    fmt.Println(p.Date.Format("2006-01-02"), p.EPS)
+//	}
+//	fmt.Printf("drift %+.4f EPS/day\n", s.Slope)
+func (tr EPSTrend) Series(asOf time.Time) EPSTrendSeries {
+	s := EPSTrendSeries{
+		Period:   tr.Period,
+		Currency: tr.Currency,
+		Points:   make([]EPSTrendPoint, 0, len(epsTrendDaysAgo)),
+		Slope:    tr.Slope(),
+	}
+	for _, daysAgo := range epsTrendDaysAgo {
+		eps := tr.value(daysAgo)
+		if eps == 0 {
+			continue
+		}
+		s.Points = append(s.Points, EPSTrendPoint{
+			Date:    asOf.AddDate(0, 0, -daysAgo),
+			DaysAgo: daysAgo,
+			EPS:     eps,
+		})
+	}
+	if n := len(s.Points); n >= 2 && s.Points[0].EPS != 0 {
+		first, last := s.Points[0].EPS, s.Points[n-1].EPS
+		s.Change = (last - first) / math.Abs(first)
+	}
+	return s
+}
+
+// EPSTrendSeries is an EPSTrend as a dated series.
+type EPSTrendSeries struct {
+	Period   string `json:"period"`
+	Currency string `json:"currency,omitempty"`
+
+	// Points are the available snapshots, oldest first.
+	Points []EPSTrendPoint `json:"points"`
+
+	// Slope is the least-squares slope in EPS per day (see [EPSTrend.Slope]).
+	Slope float64 `json:"slope"`
+
+	// Change is the relative change from the oldest to the newest point
+	// (0.05 = +5%).
+	Change float64 `json:"change"`
+}
+
+// EPSTrendPoint is the consensus EPS estimate as of Date.
+type EPSTrendPoint struct {
+	Date    time.Time `json:"date"`
+	DaysAgo int       `json:"daysAgo"`
+	EPS     float64   `json:"eps"`
+}
+
 // EPSRevision represents EPS revision data for a period.
 type EPSRevision struct {
 	Period         string `json:"period"`
@@ -82,6 +190,34 @@ type EPSRevision struct {
 	DownLast30Days int    `json:"downLast30days"`
 }
 
+// RevisionMomentum combines EPS revisions and EPS trend into a single
+// estimate-revision momentum measure for a period.
+type RevisionMomentum struct {
+	Period string `json:"period"` // "0q", "+1q", "0y", "+1y"
+
+	// Analysts is the number of analysts covering the period.
+	Analysts int `json:"analysts"`
+
+	// NetRevisions7d and NetRevisions30d are up minus down revisions.
+	NetRevisions7d  int `json:"netRevisions7d"`
+	NetRevisions30d int `json:"netRevisions30d"`
+
+	// RevisionRatio is NetRevisions30d / Analysts, in [-1, 1].
+	RevisionRatio float64 `json:"revisionRatio"`
+
+	// TrendChange30d is the relative change of the consensus EPS over
+	// the last 30 days (0.05 = +5%).
+	TrendChange30d float64 `json:"trendChange30d"`
+
+	// TrendSlope is the least-squares slope of the consensus EPS over the
+	// last 90 days, in EPS per day.
+	TrendSlope float64 `json:"trendSlope"`
+
+	// Score averages RevisionRatio and TrendChange30d (clamped to [-1, 1]),
+	// giving a value in [-1, 1] where positive means upward momentum.
+	Score float64 `json:"score"`
+}
+
 // EarningsHistory represents historical earnings data.
 type EarningsHistory struct {
 	History []EarningsHistoryItem `json:"history"`
@@ -97,6 +233,31 @@ type EarningsHistoryItem struct {
 	SurprisePercent float64   `json:"surprisePercent"` // as decimal
 }
 
+// EarningsDate is one upcoming or past earnings announcement of a symbol,
+// as in Python yfinance's earnings_dates.
+type EarningsDate struct {
+	// Date is the announcement time in the exchange timezone.
+	Date time.Time `json:"date"`
+
+	// EventType is "Earnings", "Call" or "Meeting".
+	EventType string `json:"eventType"`
+
+	// EPSEstimate is the consensus EPS estimate; nil if not available.
+	EPSEstimate *float64 `json:"epsEstimate,omitempty"`
+
+	// EPSActual is the reported EPS; nil until the results are out.
+	EPSActual *float64 `json:"epsActual,omitempty"`
+
+	// SurprisePercent is the surprise versus the estimate in percent
+	// (4.5 = 4.5%); nil until the results are out.
+	SurprisePercent *float64 `json:"surprisePercent,omitempty"`
+}
+
+// IsReported reports whether the results of the announcement are out.
+func (d EarningsDate) IsReported() bool {
+	return d.EPSActual != nil
+}
+
 // GrowthEstimate represents growth estimates from various sources.
 type GrowthEstimate struct {
 	Period         string   `json:"period"`
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UpgradeDowngrade is one analyst rating change, as in Python yfinance's
+// upgrades_downgrades.
+type UpgradeDowngrade struct {
+	Date      time.Time `json:"date"`
+	Firm      string    `json:"firm"`
+	ToGrade   string    `json:"toGrade"`
+	FromGrade string    `json:"fromGrade,omitempty"`
+
+	// Action is Yahoo's action code: "up", "down", "main" (maintained),
+	// "init" or "reit" (reiterated).
+	Action string `json:"action"`
+
+	// PriceTargetAction describes the price target change, e.g. "Raises".
+	PriceTargetAction  string  `json:"priceTargetAction,omitempty"`
+	CurrentPriceTarget float64 `json:"currentPriceTarget,omitempty"`
+	PriorPriceTarget   float64 `json:"priorPriceTarget,omitempty"`
+}
+
+// PeriodStart returns the first day, in UTC, of the month a relative
+// recommendation period such as "0m" or "-2m" refers to, counted back from
+// the month of asOf. ok is false if the period cannot be parsed.
+func (r Recommendation) PeriodStart(asOf time.Time) (start time.Time, ok bool) {
+	months, err := strconv.Atoi(strings.TrimSuffix(r.Period, "m"))
+	if err != nil || !strings.HasSuffix(r.Period, "m") {
+		return time.Time{}, false
+	}
+	asOf = asOf.UTC()
+	return time.Date(asOf.Year(), asOf.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC), true
+}
+
+// AnalystEventKind tells the entries of an [AnalystTimeline] apart.
+type AnalystEventKind string
+
+const (
+	// AnalystEventTrend is a monthly recommendation count snapshot.
+	AnalystEventTrend AnalystEventKind = "trend"
+
+	// AnalystEventRatingChange is a single firm's upgrade, downgrade,
+	// initiation or reiteration.
+	AnalystEventRatingChange AnalystEventKind = "rating"
+)
+
+// AnalystEvent is one entry of an [AnalystTimeline]. Exactly one of
+// Recommendation and Change is set, according to Kind.
+type AnalystEvent struct {
+	Date time.Time        `json:"date"`
+	Kind AnalystEventKind `json:"kind"`
+
+	// Recommendation holds the counts of a trend event, dated at the start
+	// of its month.
+	Recommendation *Recommendation `json:"recommendation,omitempty"`
+
+	// Change holds the rating change of a rating event.
+	Change *UpgradeDowngrade `json:"change,omitempty"`
+}
+
+// AnalystTimeline merges the monthly recommendation trend with individual
+// rating changes into one chronological record of analyst sentiment.
+//
+// Example:
+//
+//	tl, err := ticker.AnalystTimeline()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, e := range tl.Events {
+//	    switch e.Kind {
+//	    case models.AnalystEventTrend:
+//	        fmt.Printf("%s  %d buy / %d hold / %d sell\n", e.Date.Format("2006-01"),
+//	            e.Recommendation.StrongBuy+e.Recommendation.Buy, e.Recommendation.Hold,
+//	            e.Recommendation.Sell+e.Recommendation.StrongSell)
+//	    case models.AnalystEventRatingChange:
+//	        fmt.Printf("%s  %s %s -> %s\n", e.Date.Format("2006-01-02"),
+//	            e.Change.Firm, e.Change.FromGrade, e.Change.ToGrade)
+//	    }
+//	}
+type AnalystTimeline struct {
+	Symbol string `json:"symbol"`
+
+	// Events are oldest first. A trend snapshot comes before the rating
+	// changes made on the first day of its month.
+	Events []AnalystEvent `json:"events"`
+}
+
+// MergeAnalystTimeline builds the events of an [AnalystTimeline] from a
+// recommendation trend, whose relative periods are resolved against asOf
+// (see [Recommendation.PeriodStart]), and rating changes. Either input may
+// be empty; trend periods that cannot be parsed are skipped.
+func MergeAnalystTimeline(trend *RecommendationTrend, changes []UpgradeDowngrade, asOf time.Time) []AnalystEvent {
+	events := make([]AnalystEvent, 0, len(changes)+4)
+	if trend != nil {
+		for i := range trend.Trend {
+			rec := trend.Trend[i]
+			start, ok := rec.PeriodStart(asOf)
+			if !ok {
+				continue
+			}
+			events = append(events, AnalystEvent{Date: start, Kind: AnalystEventTrend, Recommendation: &rec})
+		}
+	}
+	for i := range changes {
+		change := changes[i]
+		events = append(events, AnalystEvent{Date: change.Date, Kind: AnalystEventRatingChange, Change: &change})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Date.Equal(events[j].Date) {
+			return events[i].Date.Before(events[j].Date)
+		}
+		return events[i].Kind == AnalystEventTrend && events[j].Kind != AnalystEventTrend
+	})
+	return events
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// BarResolver chooses the bar to keep when two bars share a timestamp.
+// old comes from the earlier slice (or position), new from the later one.
+type BarResolver func(old, new Bar) Bar
+
+// PreferNew keeps the later bar. It is the default resolver.
+func PreferNew(_, new Bar) Bar { return new }
+
+// PreferOld keeps the earlier bar.
+func PreferOld(old, _ Bar) Bar { return old }
+
+// PreferComplete keeps the bar with all OHLC prices present, falling back
+// to the later bar when both or neither are complete.
+func PreferComplete(old, new Bar) Bar {
+	if old.Complete() && !new.Complete() {
+		return old
+	}
+	return new
+}
+
+// PreferRepaired keeps a repaired bar over an unrepaired one, falling back
+// to the later bar.
+func PreferRepaired(old, new Bar) Bar {
+	if old.Repaired && !new.Repaired {
+		return old
+	}
+	return new
+}
+
+// BarGap describes missing data between two consecutive bars, as reported
+// by utils.DetectGaps.
+type BarGap struct {
+	// After is the date of the last bar before the gap.
+	After time.Time `json:"after"`
+
+	// Before is the date of the first bar after the gap.
+	Before time.Time `json:"before"`
+
+	// Missing is the number of missing bars: missing intervals within a
+	// session for intraday data, or missing sessions for daily data.
+	Missing int `json:"missing"`
+
+	// Sessions lists the trading days, at midnight exchange time, that have
+	// no bars at all.
+	Sessions []time.Time `json:"sessions,omitempty"`
+}
+
+// SortBars sorts bars by date in place, keeping the relative order of bars
+// with equal timestamps.
+func SortBars(bars []Bar) {
+	sort.SliceStable(bars, func(i, j int) bool {
+		return bars[i].Date.Before(bars[j].Date)
+	})
+}
+
+// MergeBars merges two bar slices, such as stitched chunks or repaired and
+// raw data, into a new slice sorted by date with one bar per timestamp.
+// Conflicts are settled by resolve, bars of a counting as old; a nil resolve
+// means [PreferNew]. The inputs are not modified and need not be sorted.
+//
+// Example:
+//
+//	bars := models.MergeBars(stored, fetched, models.PreferComplete)
+func MergeBars(a, b []Bar, resolve BarResolver) []Bar {
+	merged := make([]Bar, 0, len(a)+len(b))
+	merged = append(merged, a...)
+	merged = append(merged, b...)
+	SortBars(merged)
+	return collapseBars(merged, resolve)
+}
+
+// DedupeBars returns bars sorted by date with one bar per timestamp,
+// settling duplicates with resolve in their original order. A nil resolve
+// means [PreferNew].
+func DedupeBars(bars []Bar, resolve BarResolver) []Bar {
+	return MergeBars(bars, nil, resolve)
+}
+
+// InsertBar inserts bar into bars, which must be sorted by date, keeping
+// the order. If a bar with the same timestamp exists, resolve(existing, bar)
+// replaces it; a nil resolve means [PreferNew].
+//
+// Example:
+//
+//	store = models.InsertBar(store, latest, nil)
+func InsertBar(bars []Bar, bar Bar, resolve BarResolver) []Bar {
+	if resolve == nil {
+		resolve = PreferNew
+	}
+
+	i := sort.Search(len(bars), func(i int) bool {
+		return !bars[i].Date.Before(bar.Date)
+	})
+	if i < len(bars) && bars[i].Date.Equal(bar.Date) {
+		bars[i] = resolve(bars[i], bar)
+		return bars
+	}
+
+	bars = append(bars, Bar{})
+	copy(bars[i+1:], bars[i:])
+	bars[i] = bar
+	return bars
+}
+
+// Scale returns a copy of the bar with every price, including adjusted
+// prices and dividends, multiplied by factor. Volume is unchanged and exact
+// Decimal prices are dropped. It is useful for currency conversion and unit
+// fixes such as pence to pounds.
+func (b Bar) Scale(factor float64) Bar {
+	b.Open *= factor
+	b.High *= factor
+	b.Low *= factor
+	b.Close *= factor
+	b.AdjClose *= factor
+	b.AdjOpen *= factor
+	b.AdjHigh *= factor
+	b.AdjLow *= factor
+	b.Dividends *= factor
+	b.CapitalGains *= factor
+	b.Decimal = nil
+	return b
+}
+
+// collapseBars merges runs of bars with equal timestamps in sorted bars.
+func collapseBars(sorted []Bar, resolve BarResolver) []Bar {
+	if resolve == nil {
+		resolve = PreferNew
+	}
+
+	out := sorted[:0]
+	for _, bar := range sorted {
+		if n := len(out); n > 0 && out[n-1].Date.Equal(bar.Date) {
+			out[n-1] = resolve(out[n-1], bar)
+			continue
+		}
+		out = append(out, bar)
+	}
+	return out
+}
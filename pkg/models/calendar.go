@@ -29,6 +29,9 @@ type Calendar struct {
 	// Often contains a range (start and end date).
 	EarningsDate []time.Time `json:"earningsDate,omitempty"`
 
+	// IsEarningsDateEstimate is true when the earnings date is not yet confirmed.
+	IsEarningsDateEstimate bool `json:"isEarningsDateEstimate,omitempty"`
+
 	// EarningsHigh is the highest earnings estimate.
 	EarningsHigh *float64 `json:"earningsHigh,omitempty"`
 
@@ -74,3 +77,71 @@ func (c *Calendar) NextEarningsDate() *time.Time {
 	}
 	return &earliest
 }
+
+// EarningsWindow is an upcoming earnings announcement window.
+//
+// Yahoo reports either a single confirmed date or a start/end range when
+// the date is still an estimate.
+type EarningsWindow struct {
+	// Start is the earliest expected announcement time.
+	Start time.Time `json:"start"`
+
+	// End is the latest expected announcement time (equal to Start when confirmed).
+	End time.Time `json:"end"`
+
+	// Estimated is true when the date has not been confirmed by the company.
+	Estimated bool `json:"estimated"`
+
+	// EPSAverage is the average EPS estimate, if available.
+	EPSAverage *float64 `json:"epsAverage,omitempty"`
+
+	// RevenueAverage is the average revenue estimate, if available.
+	RevenueAverage *float64 `json:"revenueAverage,omitempty"`
+}
+
+// Until returns the time remaining until the window starts (negative if it
+// has already started).
+func (w *EarningsWindow) Until(now time.Time) time.Duration {
+	return w.Start.Sub(now)
+}
+
+// DaysUntil returns the number of whole calendar days until the window starts,
+// counted in now's location.
+func (w *EarningsWindow) DaysUntil(now time.Time) int {
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	sy, sm, sd := w.Start.In(now.Location()).Date()
+	start := time.Date(sy, sm, sd, 0, 0, 0, 0, now.Location())
+	return int(start.Sub(today).Hours() / 24)
+}
+
+// NextEarningsWindow returns the earnings window that has not yet ended as
+// of now, or nil if no upcoming earnings date is known.
+func (c *Calendar) NextEarningsWindow(now time.Time) *EarningsWindow {
+	if len(c.EarningsDate) == 0 {
+		return nil
+	}
+
+	start, end := c.EarningsDate[0], c.EarningsDate[0]
+	for _, d := range c.EarningsDate[1:] {
+		if d.Before(start) {
+			start = d
+		}
+		if d.After(end) {
+			end = d
+		}
+	}
+
+	// Earnings dates are day-granular; keep the window until the end of its last day.
+	if now.After(end.Add(24 * time.Hour)) {
+		return nil
+	}
+
+	return &EarningsWindow{
+		Start:          start,
+		End:            end,
+		Estimated:      c.IsEarningsDateEstimate || !start.Equal(end),
+		EPSAverage:     c.EarningsAverage,
+		RevenueAverage: c.RevenueAverage,
+	}
+}
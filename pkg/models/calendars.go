@@ -33,7 +33,7 @@ type EarningsEvent struct {
 	// EventName is the name of the earnings event.
 	EventName string `json:"event_name,omitempty"`
 
-	// EventTime is the event start datetime.
+	// EventTime is the event start datetime in US market time (America/New_York).
 	EventTime *time.Time `json:"event_time,omitempty"`
 
 	// Timing indicates if the event is before/after market (BMO/AMC).
@@ -60,7 +60,7 @@ type IPOEvent struct {
 	// Exchange is the exchange short name.
 	Exchange string `json:"exchange,omitempty"`
 
-	// FilingDate is the SEC filing date.
+	// FilingDate is the SEC filing date. IPO dates are in the exchange's timezone.
 	FilingDate *time.Time `json:"filing_date,omitempty"`
 
 	// Date is the IPO date.
@@ -96,7 +96,8 @@ type EconomicEvent struct {
 	// Region is the country code.
 	Region string `json:"region,omitempty"`
 
-	// EventTime is the event start datetime.
+	// EventTime is the event start datetime in the region's market timezone,
+	// or UTC if the region is unknown.
 	EventTime *time.Time `json:"event_time,omitempty"`
 
 	// Period is the reporting period.
@@ -123,7 +124,7 @@ type CalendarSplitEvent struct {
 	// CompanyName is the company's short name.
 	CompanyName string `json:"company_name"`
 
-	// PayableDate is the split payable date.
+	// PayableDate is the split payable date in US market time (America/New_York).
 	PayableDate *time.Time `json:"payable_date,omitempty"`
 
 	// Optionable indicates if the stock is optionable.
@@ -135,8 +136,198 @@ type CalendarSplitEvent struct {
 	// NewShareWorth is the new share worth after split.
 	NewShareWorth float64 `json:"new_share_worth,omitempty"`
 
-	// Ratio represents the split ratio (e.g., "2:1").
-	Ratio string `json:"ratio,omitempty"`
+	// Ratio is the exact split ratio of new to old shares, e.g. 3:2 or 1:10
+	// for a reverse split. It is unknown if either share worth is missing.
+	Ratio SplitRatio `json:"ratio"`
+}
+
+// WatchlistEventKind identifies the type of a WatchlistEvent.
+type WatchlistEventKind string
+
+const (
+	// WatchlistEarnings is an earnings announcement.
+	WatchlistEarnings WatchlistEventKind = "earnings"
+
+	// WatchlistExDividend is an ex-dividend date.
+	WatchlistExDividend WatchlistEventKind = "ex_dividend"
+
+	// WatchlistDividend is a dividend payment date.
+	WatchlistDividend WatchlistEventKind = "dividend"
+)
+
+// WatchlistEvent is a dated corporate event for a symbol on a watchlist.
+type WatchlistEvent struct {
+	// Symbol is the ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// Kind is the event type.
+	Kind WatchlistEventKind `json:"kind"`
+
+	// Start is the event date (or start of an estimated earnings window).
+	Start time.Time `json:"start"`
+
+	// End is the last day of the event (equal to Start for single-day events).
+	End time.Time `json:"end"`
+
+	// Estimated is true for unconfirmed earnings dates.
+	Estimated bool `json:"estimated,omitempty"`
+}
+
+// DividendAnnouncement is the next dividend a company has announced.
+type DividendAnnouncement struct {
+	// ExDividendDate is the announced ex-dividend date.
+	ExDividendDate *time.Time `json:"ex_dividend_date,omitempty"`
+
+	// PaymentDate is the announced payment date.
+	PaymentDate *time.Time `json:"payment_date,omitempty"`
+
+	// Rate is the forward annual dividend per share.
+	Rate float64 `json:"rate,omitempty"`
+}
+
+// Equal reports whether a and b announce the same dates and rate.
+func (a DividendAnnouncement) Equal(b DividendAnnouncement) bool {
+	sameDate := func(x, y *time.Time) bool {
+		if x == nil || y == nil {
+			return x == y
+		}
+		return x.Equal(*y)
+	}
+	return sameDate(a.ExDividendDate, b.ExDividendDate) &&
+		sameDate(a.PaymentDate, b.PaymentDate) &&
+		a.Rate == b.Rate
+}
+
+// IsZero reports whether nothing has been announced.
+func (a DividendAnnouncement) IsZero() bool {
+	return a.ExDividendDate == nil && a.PaymentDate == nil && a.Rate == 0
+}
+
+// CorporateActionAlert notifies that a watched symbol announced a stock
+// split or a dividend change.
+type CorporateActionAlert struct {
+	// Symbol is the watched symbol, empty for a failed splits calendar poll.
+	Symbol string `json:"symbol,omitempty"`
+
+	// Kind is CorporateActionSplit for a newly announced split or
+	// CorporateActionDividend for a new or changed dividend announcement.
+	Kind CorporateActionKind `json:"kind,omitempty"`
+
+	// Split is the splits calendar event, set for split alerts.
+	Split *CalendarSplitEvent `json:"split,omitempty"`
+
+	// Dividend is the current announcement, set for dividend alerts.
+	Dividend *DividendAnnouncement `json:"dividend,omitempty"`
+
+	// Previous is the announcement seen on the last poll, nil on first
+	// observation.
+	Previous *DividendAnnouncement `json:"previous,omitempty"`
+
+	// Time is when the poll completed.
+	Time time.Time `json:"time"`
+
+	// Err is set when a poll failed; Symbol names the symbol whose dividend
+	// data could not be fetched.
+	Err error `json:"-"`
+}
+
+// EarningsMatch is an earnings calendar event of a watchlist symbol.
+type EarningsMatch struct {
+	// Event is the earnings calendar event.
+	Event EarningsEvent `json:"event"`
+
+	// Quote is the symbol's current quote, nil if it could not be fetched.
+	Quote *Quote `json:"quote,omitempty"`
+}
+
+// EarningsMatches is the earnings calendar intersected with a watchlist.
+type EarningsMatches struct {
+	// Matches contains the events of watchlist symbols, in calendar order.
+	Matches []EarningsMatch `json:"matches"`
+
+	// Missing lists watchlist symbols without an event in the date range.
+	Missing []string `json:"missing,omitempty"`
+
+	// Errors maps symbols whose quote could not be fetched to the error.
+	Errors map[string]error `json:"-"`
+}
+
+// Holding is a position of a portfolio.
+type Holding struct {
+	// Symbol is the ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// Shares is the number of shares held.
+	Shares float64 `json:"shares"`
+}
+
+// ProjectedDividend is an expected dividend payment of a holding.
+type ProjectedDividend struct {
+	// Symbol is the ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// ExDate is the expected ex-dividend date.
+	ExDate time.Time `json:"exDate"`
+
+	// PaymentDate is the payment date, when announced.
+	PaymentDate *time.Time `json:"paymentDate,omitempty"`
+
+	// PerShare is the expected dividend per share.
+	PerShare float64 `json:"perShare"`
+
+	// Amount is PerShare times the shares held.
+	Amount float64 `json:"amount"`
+
+	// Currency is the dividend currency.
+	Currency string `json:"currency,omitempty"`
+
+	// Confirmed is true for an announced ex-dividend date; other dates are
+	// extrapolated from the payment history.
+	Confirmed bool `json:"confirmed,omitempty"`
+}
+
+// PositionDividends is the projected dividend income of one holding.
+type PositionDividends struct {
+	// Symbol is the ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// Shares is the number of shares held.
+	Shares float64 `json:"shares"`
+
+	// Currency is the dividend currency.
+	Currency string `json:"currency,omitempty"`
+
+	// AnnualRate is the expected dividend per share over a year.
+	AnnualRate float64 `json:"annualRate"`
+
+	// Frequency is the number of payments per year: 1, 2, 4 or 12, or 0
+	// for non-payers.
+	Frequency int `json:"frequency"`
+
+	// Payments are the projected payments, by ex-date.
+	Payments []ProjectedDividend `json:"payments,omitempty"`
+
+	// Total is the projected income of the position.
+	Total float64 `json:"total"`
+}
+
+// DividendProjection is the projected dividend income of a portfolio.
+type DividendProjection struct {
+	// Start and End bound the projection window.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Positions holds one entry per holding, in input order.
+	Positions []PositionDividends `json:"positions"`
+
+	// Payments are the payments of all positions, by ex-date.
+	Payments []ProjectedDividend `json:"payments,omitempty"`
+
+	// Totals maps each dividend currency to the projected income in it.
+	Totals map[string]float64 `json:"totals"`
+
+	// Errors maps symbols whose data could not be fetched to the error.
+	Errors map[string]error `json:"-"`
 }
 
 // CalendarResponse represents the raw API response for calendar data.
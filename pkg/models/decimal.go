@@ -0,0 +1,252 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an arbitrary-precision fixed-point number.
+//
+// It keeps the exact digits Yahoo reports, avoiding float64 rounding when
+// summing or comparing prices. The zero value is 0.
+//
+// Example:
+//
+//	d, _ := models.ParseDecimal("187.44000244140625")
+//	fmt.Println(d.Round(2)) // 187.44
+type Decimal struct {
+	coef  *big.Int // nil means zero
+	scale int32    // number of fractional digits, always >= 0
+}
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalScale bounds the scale and exponent accepted by ParseDecimal, so
+// that inputs such as "1e300000000" cannot make it build huge coefficients.
+const maxDecimalScale = 1000
+
+// NewDecimal returns coef * 10^-scale.
+func NewDecimal(coef int64, scale int32) Decimal {
+	return normalizeDecimal(big.NewInt(coef), scale)
+}
+
+// ParseDecimal parses a decimal string such as "123.45", "-0.5" or "1.2e-3".
+// Numbers whose scale or exponent exceeds 1000 in magnitude are rejected.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa = s[:i]
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+		exp = e
+	}
+
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+
+	digits := intPart + fracPart
+	sign := ""
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		sign, digits = digits[:1], digits[1:]
+	}
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	coef, ok := new(big.Int).SetString(sign+digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("decimal %q out of range", s)
+	}
+	return normalizeDecimal(coef, int32(scale)), nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on error.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// normalizeDecimal folds a negative scale into the coefficient.
+func normalizeDecimal(coef *big.Int, scale int32) Decimal {
+	if scale < 0 {
+		mul := new(big.Int).Exp(bigTen, big.NewInt(int64(-scale)), nil)
+		coef = new(big.Int).Mul(coef, mul)
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+func (d Decimal) bigCoef() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale returns the coefficient expressed with the given (larger) scale.
+func (d Decimal) rescale(scale int32) *big.Int {
+	coef := d.bigCoef()
+	if scale <= d.scale {
+		return coef
+	}
+	mul := new(big.Int).Exp(bigTen, big.NewInt(int64(scale-d.scale)), nil)
+	return new(big.Int).Mul(coef, mul)
+}
+
+// Scale returns the number of fractional digits.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// IsZero reports whether d == 0.
+func (d Decimal) IsZero() bool {
+	return d.bigCoef().Sign() == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.bigCoef().Sign()
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	scale := maxScale(d.scale, o.scale)
+	return Decimal{coef: new(big.Int).Add(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	scale := maxScale(d.scale, o.scale)
+	return Decimal{coef: new(big.Int).Sub(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Mul returns d * o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.bigCoef(), o.bigCoef()), scale: d.scale + o.scale}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.bigCoef()), scale: d.scale}
+}
+
+// Cmp compares d and o and returns -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int {
+	scale := maxScale(d.scale, o.scale)
+	return d.rescale(scale).Cmp(o.rescale(scale))
+}
+
+// Equal reports whether d and o represent the same value.
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// Round rounds d to the given number of fractional digits, half away from zero.
+// A typical use is rounding to the chart's PriceHint.
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if places >= d.scale {
+		return d
+	}
+
+	div := new(big.Int).Exp(bigTen, big.NewInt(int64(d.scale-places)), nil)
+	q, r := new(big.Int).QuoRem(d.bigCoef(), div, new(big.Int))
+
+	// Half away from zero: |2r| >= div
+	r2 := new(big.Int).Abs(r)
+	r2.Mul(r2, big.NewInt(2))
+	if r2.Cmp(div) >= 0 {
+		if d.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return Decimal{coef: q, scale: places}
+}
+
+// Float64 returns the nearest float64 value.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns the decimal in plain notation, preserving trailing zeros.
+func (d Decimal) String() string {
+	coef := d.bigCoef()
+	digits := new(big.Int).Abs(coef).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		cut := len(digits) - int(d.scale)
+		digits = digits[:cut] + "." + digits[cut:]
+	}
+	if coef.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalJSON encodes the decimal as a JSON number with its exact digits.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number or numeric string. null decodes as zero.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*d = Decimal{}
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func maxScale(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// BarDecimal holds the exact OHLC prices of a bar as reported by Yahoo.
+//
+// Values are not auto-adjusted; missing prices are zero.
+type BarDecimal struct {
+	Open     Decimal `json:"open"`
+	High     Decimal `json:"high"`
+	Low      Decimal `json:"low"`
+	Close    Decimal `json:"close"`
+	AdjClose Decimal `json:"adjClose"`
+}
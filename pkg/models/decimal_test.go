@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"123.45", "123.45"},
+		{"-0.5", "-0.5"},
+		{"100", "100"},
+		{"1.2e-3", "0.0012"},
+		{"1.5E+2", "150"},
+		{"187.44000244140625", "187.44000244140625"},
+		{"0.10", "0.10"},
+		{"1e1000", "1" + strings.Repeat("0", 1000)},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q) error: %v", tt.in, err)
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", "abc", "1.2.3", "-", "1e", "1e300000000", "1e-300000000", "1e99999999999", "1e1001"} {
+		if _, err := ParseDecimal(bad); err == nil {
+			t.Errorf("ParseDecimal(%q) expected error", bad)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+
+	if got := a.Add(b); !got.Equal(MustParseDecimal("0.3")) {
+		t.Errorf("0.1 + 0.2 = %s, want 0.3", got)
+	}
+	if got := a.Sub(b); got.String() != "-0.1" {
+		t.Errorf("0.1 - 0.2 = %s, want -0.1", got)
+	}
+	if got := a.Mul(b); got.String() != "0.02" {
+		t.Errorf("0.1 * 0.2 = %s, want 0.02", got)
+	}
+	if a.Cmp(b) != -1 || b.Cmp(a) != 1 {
+		t.Error("unexpected Cmp result")
+	}
+	if !(Decimal{}).IsZero() || (Decimal{}).String() != "0" {
+		t.Error("expected zero value to be 0")
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int32
+		want   string
+	}{
+		{"187.44000244140625", 2, "187.44"},
+		{"1.005", 2, "1.01"},
+		{"-1.005", 2, "-1.01"},
+		{"2.5", 0, "3"},
+		{"1.2", 4, "1.2"},
+	}
+	for _, tt := range tests {
+		if got := MustParseDecimal(tt.in).Round(tt.places).String(); got != tt.want {
+			t.Errorf("Round(%s, %d) = %s, want %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		A Decimal `json:"a"`
+		B Decimal `json:"b"`
+		C Decimal `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":123.4500,"b":"0.01","c":null}`), &v); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if v.A.String() != "123.4500" || v.B.String() != "0.01" || !v.C.IsZero() {
+		t.Errorf("unexpected decoded values: %s %s %s", v.A, v.B, v.C)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(data) != `{"a":123.4500,"b":0.01,"c":0}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
@@ -0,0 +1,75 @@
+package models
+
+// AnnualDividend is the total dividend paid per share in one calendar year.
+type AnnualDividend struct {
+	// Year is the calendar year of the ex-dividend dates.
+	Year int `json:"year"`
+
+	// Amount is the split-adjusted total per share.
+	Amount float64 `json:"amount"`
+
+	// Payments is the number of dividends paid in the year.
+	Payments int `json:"payments"`
+
+	// Growth is the change from the previous year's Amount (0.1 = +10%),
+	// or 0 if the previous year paid nothing.
+	Growth float64 `json:"growth"`
+}
+
+// DividendStreak is the dividend growth record of a company: how many
+// consecutive calendar years its dividend per share has increased, with
+// annual totals and growth rates.
+//
+// Only complete calendar years count, so the current year is left out.
+//
+// Example:
+//
+//	streak, err := ticker.DividendStreak()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d years of increases (since %d), 5y CAGR %.1f%%\n",
+//	    streak.Years, streak.StartYear, streak.CAGR5Y*100)
+//	if streak.IsAristocrat() {
+//	    fmt.Println("Dividend aristocrat")
+//	}
+type DividendStreak struct {
+	// Symbol is the ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// Years is the number of consecutive years, ending with LastYear, in
+	// which the annual dividend increased.
+	Years int `json:"years"`
+
+	// StartYear is the first year of increase of the streak, 0 if Years is 0.
+	StartYear int `json:"startYear,omitempty"`
+
+	// LastYear is the last complete calendar year.
+	LastYear int `json:"lastYear"`
+
+	// Annual lists every year from the first payment to LastYear, oldest
+	// first, including years without payments.
+	Annual []AnnualDividend `json:"annual"`
+
+	// Growth1Y is the growth of the LastYear dividend over the year before.
+	Growth1Y float64 `json:"growth1y"`
+
+	// CAGR3Y, CAGR5Y and CAGR10Y are the compound annual growth rates of
+	// the dividend over 3, 5 and 10 years, 0 when the history is shorter
+	// or the base year paid nothing.
+	CAGR3Y  float64 `json:"cagr3y"`
+	CAGR5Y  float64 `json:"cagr5y"`
+	CAGR10Y float64 `json:"cagr10y"`
+}
+
+// IsAristocrat reports whether the dividend has increased for at least 25
+// consecutive years.
+func (s *DividendStreak) IsAristocrat() bool {
+	return s.Years >= 25
+}
+
+// IsKing reports whether the dividend has increased for at least 50
+// consecutive years.
+func (s *DividendStreak) IsKing() bool {
+	return s.Years >= 50
+}
@@ -20,7 +20,8 @@
 // Options:
 //   - [Option]: Single option contract (call or put)
 //   - [OptionChain]: Complete option chain with calls and puts
-//   - [OptionsData]: All expiration dates and strikes
+//   - [OptionsData]: All expiration dates and strikes, optionally with every chain
+//   - [OptionChainDiff]: Changes between two option chain snapshots (see [OptionChain.Diff])
 //
 // Financial Statements:
 //   - [FinancialStatement]: Income statement, balance sheet, or cash flow data
@@ -32,12 +33,15 @@
 //   - [EarningsEstimate]: Earnings estimates by period
 //   - [RevenueEstimate]: Revenue estimates by period
 //   - [EPSTrend]: EPS trend over time
+//   - [EPSTrendSeries]: EPS trend as dated points with slope
+//   - [UpgradeDowngrade]: Single analyst rating change
+//   - [AnalystTimeline]: Recommendation trend and rating changes merged chronologically
 //   - [EPSRevision]: EPS revision counts
 //   - [EarningsHistory]: Historical earnings vs estimates
 //   - [GrowthEstimate]: Growth estimates from various sources
 //
 // Holders:
-//   - [MajorHolders]: Major shareholders breakdown (insiders, institutions)
+//   - [MajorHolders]: Major shareholders breakdown (insiders, institutions); [MajorHolders.Rows] renders it like Python's table
 //   - [Holder]: Institutional or mutual fund holder information
 //   - [InsiderTransaction]: Insider purchase/sale transaction
 //   - [InsiderHolder]: Company insider with holdings
@@ -46,6 +50,7 @@
 //
 // Calendar:
 //   - [Calendar]: Upcoming events including earnings and dividend dates
+//   - [SplitRatio]: Exact new:old split ratio with reverse-split and factor helpers
 //
 // Search:
 //   - [SearchResult]: Complete search response with quotes, news, lists
@@ -85,4 +90,59 @@
 //	}
 //
 // Use [ValidPeriods] and [ValidIntervals] to get lists of valid values.
+// [HistoryParams.Validate] reports unsupported combinations, such as 1m
+// bars over more than 7 days. PrePost is ignored for daily and longer
+// intervals.
+// With PrePost, intraday bars carry their [Session] (pre, regular or post),
+// taken from the chart's [TradingPeriods]:
+//
+//	for _, b := range bars {
+//	    if b.Session == models.SessionRegular {
+//	        // regular-hours bar
+//	    }
+//	}
+//
+// AutoAdjust scales Open/High/Low/Close by AdjClose/Close and drops AdjClose,
+// matching Python yfinance. BackAdjust scales only Open/High/Low, like
+// Python's back_adjust. Set AdjustedColumns instead to keep the raw prices
+// alongside [Bar] AdjOpen/AdjHigh/AdjLow/AdjClose. [AdjustMode] documents
+// which columns each mode adjusts and can be set directly with Adjust.
+//
+// # Sectors and Industries
+//
+// [SectorIndustryMapping] lists the industries of each sector. Look it up by
+// display name or key, and convert between the two with [KeyFromName] and
+// [NameFromKey]:
+//
+//	industries := models.IndustriesForSector("technology")
+//	sector, _ := models.SectorForIndustry("software-infrastructure") // "Technology"
+//	key := models.KeyFromName("Software—Infrastructure")              // "software-infrastructure"
+//
+// # Bar Utilities
+//
+// [MergeBars], [DedupeBars] and [InsertBar] combine bar slices by timestamp,
+// e.g. stitched intraday chunks or an incremental store. A [BarResolver]
+// such as [PreferComplete] or [PreferRepaired] settles duplicates:
+//
+//	bars := models.MergeBars(stored, fetched, models.PreferComplete)
+//	bars = models.InsertBar(bars, latest, nil)
+//
+// [BarColumns] stores bars column-wise for analytics over long histories;
+// convert with [ColumnsFromBars] or [History.Columns] and back with
+// [BarColumns.Bars].
+//
+// # Raw Responses
+//
+// The *Response types and their Raw* element types mirror Yahoo's JSON.
+// Numeric fields use [Number], which decodes plain numbers, numeric strings
+// and {"raw", "fmt"} objects alike, so parsers read typed fields instead of
+// probing maps:
+//
+//	var raw models.ScreenerResponse
+//	json.Unmarshal(body, &raw)
+//	price := raw.Finance.Result[0].Quotes[0].RegularMarketPrice.Float64()
+//
+// [ChartQuote] and [ChartAdjClose] decode their arrays with the values of
+// each array in one backing slice, so decoding long histories does not
+// allocate per bar.
 package models
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 // Frequency represents the time frequency for financial data.
 type Frequency string
@@ -9,6 +13,10 @@ const (
 	FrequencyAnnual    Frequency = "annual"
 	FrequencyQuarterly Frequency = "quarterly"
 	FrequencyTrailing  Frequency = "trailing"
+
+	// FrequencyTTM is a trailing twelve month view computed client-side
+	// from the latest four quarterly periods.
+	FrequencyTTM Frequency = "ttm"
 )
 
 // FinancialItem represents a single financial data point.
@@ -77,6 +85,174 @@ func (fs *FinancialStatement) Fields() []string {
 	return fields
 }
 
+// FXRateFunc returns the rate converting one unit of currency from into
+// currency to, as of the close on date or the latest earlier quote.
+type FXRateFunc func(from, to string, date time.Time) (float64, error)
+
+// FXRoute is the chain of Yahoo FX pairs a conversion used: one pair such
+// as "GBPUSD=X" when Yahoo quotes the currencies directly, or two pairs
+// through USD (e.g. "NOKUSD=X", "USDKRW=X") when it does not.
+type FXRoute struct {
+	From  string   `json:"from"`
+	To    string   `json:"to"`
+	Pairs []string `json:"pairs"`
+}
+
+// Direct reports whether the conversion used a single pair.
+func (r FXRoute) Direct() bool {
+	return len(r.Pairs) <= 1
+}
+
+// String returns the route as currencies joined by "->", e.g.
+// "NOK->USD->KRW".
+func (r FXRoute) String() string {
+	codes := []string{r.From}
+	for _, p := range r.Pairs {
+		if len(p) >= 6 {
+			codes = append(codes, p[3:6])
+		}
+	}
+	return strings.Join(codes, "->")
+}
+
+// nonMonetaryFields are statement fields that are counts or ratios and are
+// not converted by ConvertTo.
+var nonMonetaryFields = map[string]bool{
+	"BasicAverageShares":    true,
+	"DilutedAverageShares":  true,
+	"OrdinarySharesNumber":  true,
+	"PreferredSharesNumber": true,
+	"ShareIssued":           true,
+	"TreasurySharesNumber":  true,
+	"TaxRateForCalcs":       true,
+}
+
+// ConvertTo returns a copy of the statement with every monetary item
+// converted to currency at the FX rate of the item's period end date.
+//
+// Items are converted from their own CurrencyCode, or the statement's
+// Currency when it is empty. Share counts and tax rates are copied as is,
+// and Formatted is cleared on converted items. Period-end rates suit balance
+// sheet items; flow items converted this way do not use the period's
+// average rate. Rates are requested once per currency and date.
+//
+// Example:
+//
+//	tsmc, _ := ticker.New("2330.TW")
+//	income, _ := tsmc.IncomeStatement("annual")
+//	usd, err := income.ConvertTo("USD", ticker.HistoricalFXRate(nil))
+func (fs *FinancialStatement) ConvertTo(currency string, rate FXRateFunc) (*FinancialStatement, error) {
+	if currency == "" {
+		return nil, fmt.Errorf("target currency is required")
+	}
+	if rate == nil {
+		return nil, fmt.Errorf("FX rate function is required")
+	}
+
+	type rateKey struct {
+		from string
+		date time.Time
+	}
+	rates := make(map[rateKey]float64)
+	lookup := func(from string, date time.Time) (float64, error) {
+		key := rateKey{from, date}
+		if r, ok := rates[key]; ok {
+			return r, nil
+		}
+		r, err := rate(from, currency, date)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get %s/%s rate for %s: %w", from, currency, date.Format("2006-01-02"), err)
+		}
+		rates[key] = r
+		return r, nil
+	}
+
+	out := &FinancialStatement{
+		Data:     make(map[string][]FinancialItem, len(fs.Data)),
+		Dates:    append([]time.Time{}, fs.Dates...),
+		Currency: currency,
+	}
+	for field, items := range fs.Data {
+		converted := make([]FinancialItem, len(items))
+		for i, item := range items {
+			converted[i] = item
+			if nonMonetaryFields[field] {
+				continue
+			}
+			from := item.CurrencyCode
+			if from == "" {
+				from = fs.Currency
+			}
+			if from == "" {
+				return nil, fmt.Errorf("%s has no currency", field)
+			}
+			if strings.EqualFold(from, currency) {
+				continue
+			}
+			r, err := lookup(from, item.AsOfDate)
+			if err != nil {
+				return nil, err
+			}
+			converted[i].Value = item.Value * r
+			converted[i].CurrencyCode = currency
+			converted[i].Formatted = ""
+		}
+		out.Data[field] = converted
+	}
+	return out, nil
+}
+
+// PeriodRatios holds the financial ratios of one reporting period.
+// Ratios that cannot be computed from the statements are nil.
+type PeriodRatios struct {
+	Date time.Time `json:"date"`
+
+	// Liquidity
+	CurrentRatio *float64 `json:"currentRatio,omitempty"` // CurrentAssets / CurrentLiabilities
+	QuickRatio   *float64 `json:"quickRatio,omitempty"`   // (CurrentAssets - Inventory) / CurrentLiabilities
+
+	// Leverage
+	DebtToEquity *float64 `json:"debtToEquity,omitempty"` // TotalDebt / StockholdersEquity (1.5 = 150%)
+
+	// Returns, not annualized for quarterly periods
+	ReturnOnEquity *float64 `json:"returnOnEquity,omitempty"` // NetIncome / StockholdersEquity
+	ReturnOnAssets *float64 `json:"returnOnAssets,omitempty"` // NetIncome / TotalAssets
+
+	// Margins
+	GrossMargin     *float64 `json:"grossMargin,omitempty"`     // GrossProfit / TotalRevenue
+	OperatingMargin *float64 `json:"operatingMargin,omitempty"` // OperatingIncome / TotalRevenue
+	NetMargin       *float64 `json:"netMargin,omitempty"`       // NetIncome / TotalRevenue
+}
+
+// RatioCheck compares a computed ratio of the latest period with the value
+// Yahoo reports in financialData.
+type RatioCheck struct {
+	Name       string  `json:"name"`
+	Computed   float64 `json:"computed"`
+	Reported   float64 `json:"reported"`
+	Difference float64 `json:"difference"` // Computed - Reported
+}
+
+// FinancialRatios holds ratios computed from the financial statements.
+type FinancialRatios struct {
+	// Frequency is "annual" or "quarterly".
+	Frequency string `json:"frequency"`
+
+	// Periods holds the ratios of each period, ordered by date ascending.
+	Periods []PeriodRatios `json:"periods"`
+
+	// Checks compares the latest period with financialData where available.
+	Checks []RatioCheck `json:"checks,omitempty"`
+}
+
+// Latest returns the ratios of the most recent period, or nil if there are none.
+func (r *FinancialRatios) Latest() *PeriodRatios {
+	if len(r.Periods) == 0 {
+		return nil
+	}
+	return &r.Periods[len(r.Periods)-1]
+}
+
 // Financials holds all financial statements for a ticker.
 type Financials struct {
 	// Income statements
@@ -127,3 +303,9 @@ type TimeseriesDataPoint struct {
 		Raw float64 `json:"raw"`
 	} `json:"reportedValue"`
 }
+
+// SharesCount is the number of shares outstanding reported on Date.
+type SharesCount struct {
+	Date   time.Time `json:"date"`
+	Shares int64     `json:"shares"`
+}
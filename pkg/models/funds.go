@@ -0,0 +1,105 @@
+package models
+
+// FundsData holds the profile and portfolio breakdown of an ETF or mutual
+// fund, as in Python yfinance's funds_data.
+//
+// Example:
+//
+//	fd, err := t.FundsData()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, h := range fd.TopHoldings {
+//	    fmt.Printf("%-6s %5.2f%%\n", h.Symbol, h.Percent*100)
+//	}
+type FundsData struct {
+	Symbol string `json:"symbol"`
+
+	// QuoteType is "ETF" or "MUTUALFUND".
+	QuoteType string `json:"quoteType"`
+
+	// Description is the fund's business summary.
+	Description string `json:"description,omitempty"`
+
+	Overview   FundOverview   `json:"overview"`
+	Operations FundOperations `json:"operations"`
+
+	// AssetClasses is the portfolio split by asset class.
+	AssetClasses FundAssetClasses `json:"assetClasses"`
+
+	// TopHoldings are the largest positions, by weight descending.
+	TopHoldings []FundHolding `json:"topHoldings,omitempty"`
+
+	EquityHoldings FundEquityHoldings `json:"equityHoldings"`
+	BondHoldings   FundBondHoldings   `json:"bondHoldings"`
+
+	// BondRatings maps credit ratings ("aaa", "bb", "us_government", ...)
+	// to their portfolio weight.
+	BondRatings map[string]float64 `json:"bondRatings,omitempty"`
+
+	// SectorWeightings maps sectors ("technology", "realestate", ...) to
+	// their portfolio weight.
+	SectorWeightings map[string]float64 `json:"sectorWeightings,omitempty"`
+}
+
+// FundOverview describes the fund's classification.
+type FundOverview struct {
+	CategoryName string `json:"categoryName,omitempty"`
+	Family       string `json:"family,omitempty"`
+	LegalType    string `json:"legalType,omitempty"`
+}
+
+// FundStat is a fund metric next to the average of its category. Either
+// value is nil when Yahoo does not report it.
+type FundStat struct {
+	Value           *float64 `json:"value,omitempty"`
+	CategoryAverage *float64 `json:"categoryAverage,omitempty"`
+}
+
+// FundOperations holds fees and size. Ratios are decimals (0.0009 = 0.09%).
+type FundOperations struct {
+	ExpenseRatio     FundStat `json:"expenseRatio"`
+	HoldingsTurnover FundStat `json:"holdingsTurnover"`
+	TotalNetAssets   FundStat `json:"totalNetAssets"`
+}
+
+// FundAssetClasses holds portfolio weights by asset class, as decimals.
+type FundAssetClasses struct {
+	Cash        float64 `json:"cash"`
+	Stock       float64 `json:"stock"`
+	Bond        float64 `json:"bond"`
+	Preferred   float64 `json:"preferred"`
+	Convertible float64 `json:"convertible"`
+	Other       float64 `json:"other"`
+}
+
+// FundHolding is one position of a fund.
+type FundHolding struct {
+	Symbol string `json:"symbol"`
+	Name   string `json:"name,omitempty"`
+
+	// Percent is the portfolio weight as a decimal (0.07 = 7%).
+	Percent float64 `json:"percent"`
+}
+
+// FundEquityHoldings holds valuation statistics of the equity portfolio.
+type FundEquityHoldings struct {
+	PriceToEarnings         FundStat `json:"priceToEarnings"`
+	PriceToBook             FundStat `json:"priceToBook"`
+	PriceToSales            FundStat `json:"priceToSales"`
+	PriceToCashflow         FundStat `json:"priceToCashflow"`
+	MedianMarketCap         FundStat `json:"medianMarketCap"`
+	ThreeYearEarningsGrowth FundStat `json:"threeYearEarningsGrowth"`
+}
+
+// FundBondHoldings holds statistics of the bond portfolio.
+type FundBondHoldings struct {
+	Duration      FundStat `json:"duration"`
+	Maturity      FundStat `json:"maturity"`
+	CreditQuality FundStat `json:"creditQuality"`
+}
+
+// IsFundQuoteType reports whether quoteType has fund data.
+func IsFundQuoteType(quoteType string) bool {
+	return quoteType == "ETF" || quoteType == "MUTUALFUND"
+}
@@ -1,6 +1,13 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Bar represents a single OHLCV bar (candlestick).
 type Bar struct {
@@ -17,6 +24,136 @@ type Bar struct {
 	Splits           float64 `json:"splits,omitempty"`
 	CapitalGains     float64 `json:"capitalGains,omitempty"` // Capital gains distribution (ETF/MutualFund)
 	Repaired         bool    `json:"repaired,omitempty"`     // True if this bar was repaired
+
+	// AdjCloseFallback is true when Yahoo returned no adjusted close for the
+	// bar, as for intraday intervals, and AdjClose was copied from Close. Such
+	// an AdjClose carries no dividend or split adjustment.
+	AdjCloseFallback bool `json:"adjCloseFallback,omitempty"`
+
+	// AdjOpen, AdjHigh and AdjLow hold the split/dividend-adjusted prices
+	// when HistoryParams.AdjustedColumns is set; AdjClose completes the set.
+	AdjOpen float64 `json:"adjOpen,omitempty"`
+	AdjHigh float64 `json:"adjHigh,omitempty"`
+	AdjLow  float64 `json:"adjLow,omitempty"`
+
+	// Session is the trading session of an intraday bar fetched with
+	// HistoryParams.PrePost, taken from the chart's trading periods. It is
+	// empty for other bars and for bars outside every period.
+	Session Session `json:"session,omitempty"`
+
+	// Decimal holds the exact reported prices when HistoryParams.Decimal is set.
+	Decimal *BarDecimal `json:"decimal,omitempty"`
+}
+
+// IsMissing reports whether a price value is missing (NaN) or unset (zero).
+func IsMissing(v float64) bool {
+	return v == 0 || math.IsNaN(v)
+}
+
+// Missing reports whether every OHLC value of the bar is missing.
+func (b Bar) Missing() bool {
+	return IsMissing(b.Open) && IsMissing(b.High) && IsMissing(b.Low) && IsMissing(b.Close)
+}
+
+// Complete reports whether every OHLC value of the bar is present.
+func (b Bar) Complete() bool {
+	return !IsMissing(b.Open) && !IsMissing(b.High) && !IsMissing(b.Low) && !IsMissing(b.Close)
+}
+
+// barJSON mirrors Bar with nullable prices so NaN can round-trip as null.
+type barJSON struct {
+	Date             time.Time   `json:"date"`
+	Open             *float64    `json:"open"`
+	High             *float64    `json:"high"`
+	Low              *float64    `json:"low"`
+	Close            *float64    `json:"close"`
+	AdjClose         *float64    `json:"adjClose"`
+	Volume           int64       `json:"volume"`
+	Dividends        float64     `json:"dividends,omitempty"`
+	DividendCurrency string      `json:"dividendCurrency,omitempty"`
+	Splits           float64     `json:"splits,omitempty"`
+	CapitalGains     float64     `json:"capitalGains,omitempty"`
+	Repaired         bool        `json:"repaired,omitempty"`
+	AdjCloseFallback bool        `json:"adjCloseFallback,omitempty"`
+	AdjOpen          float64     `json:"adjOpen,omitempty"`
+	AdjHigh          float64     `json:"adjHigh,omitempty"`
+	AdjLow           float64     `json:"adjLow,omitempty"`
+	Session          Session     `json:"session,omitempty"`
+	Decimal          *BarDecimal `json:"decimal,omitempty"`
+}
+
+// MarshalJSON encodes NaN prices as null, since encoding/json rejects NaN.
+func (b Bar) MarshalJSON() ([]byte, error) {
+	return json.Marshal(barJSON{
+		Date:             b.Date,
+		Open:             nullableFloat(b.Open),
+		High:             nullableFloat(b.High),
+		Low:              nullableFloat(b.Low),
+		Close:            nullableFloat(b.Close),
+		AdjClose:         nullableFloat(b.AdjClose),
+		Volume:           b.Volume,
+		Dividends:        b.Dividends,
+		DividendCurrency: b.DividendCurrency,
+		Splits:           b.Splits,
+		CapitalGains:     b.CapitalGains,
+		Repaired:         b.Repaired,
+		AdjCloseFallback: b.AdjCloseFallback,
+		AdjOpen:          finiteOrZero(b.AdjOpen),
+		AdjHigh:          finiteOrZero(b.AdjHigh),
+		AdjLow:           finiteOrZero(b.AdjLow),
+		Session:          b.Session,
+		Decimal:          b.Decimal,
+	})
+}
+
+// UnmarshalJSON decodes null prices as NaN.
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	var aux barJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*b = Bar{
+		Date:             aux.Date,
+		Open:             floatOrNaN(aux.Open),
+		High:             floatOrNaN(aux.High),
+		Low:              floatOrNaN(aux.Low),
+		Close:            floatOrNaN(aux.Close),
+		AdjClose:         floatOrNaN(aux.AdjClose),
+		Volume:           aux.Volume,
+		Dividends:        aux.Dividends,
+		DividendCurrency: aux.DividendCurrency,
+		Splits:           aux.Splits,
+		CapitalGains:     aux.CapitalGains,
+		Repaired:         aux.Repaired,
+		AdjCloseFallback: aux.AdjCloseFallback,
+		AdjOpen:          aux.AdjOpen,
+		AdjHigh:          aux.AdjHigh,
+		AdjLow:           aux.AdjLow,
+		Session:          aux.Session,
+		Decimal:          aux.Decimal,
+	}
+	return nil
+}
+
+func nullableFloat(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	return &v
+}
+
+func finiteOrZero(v float64) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0
+	}
+	return v
+}
+
+func floatOrNaN(v *float64) float64 {
+	if v == nil {
+		return math.NaN()
+	}
+	return *v
 }
 
 // History represents historical price data.
@@ -24,32 +161,165 @@ type History struct {
 	Symbol   string `json:"symbol"`
 	Currency string `json:"currency"`
 	Bars     []Bar  `json:"bars"`
+
+	// Actions holds the dividend, split and capital gain events returned
+	// with the bars, in the listing currency, so they need no separate
+	// request.
+	Actions *Actions `json:"actions,omitempty"`
+
+	// Repairs summarizes the repairs applied, nil unless
+	// HistoryParams.Repair was set.
+	Repairs *RepairSummary `json:"repairs,omitempty"`
+
+	// FXRoute is the route of the FX conversion, nil unless
+	// HistoryParams.Currency was set to another currency.
+	FXRoute *FXRoute `json:"fxRoute,omitempty"`
+
+	// AdjCloseFallbacks is the number of bars without an adjusted close
+	// from Yahoo (see [Bar.AdjCloseFallback]). When it equals len(Bars), the
+	// response had no adjusted prices at all and the adjustment (see
+	// [AdjustMode]) left the prices unadjusted.
+	AdjCloseFallbacks int `json:"adjCloseFallbacks,omitempty"`
+}
+
+// AdjustMode selects how history prices are adjusted for corporate
+// actions.
+//
+// Yahoo reports Open/High/Low/Close adjusted for splits only, and AdjClose
+// adjusted for splits and dividends. The modes differ in which columns
+// take on the dividend adjustment, applied as the ratio AdjClose/Close of
+// each bar:
+//
+//	mode     Open/High/Low     Close             AdjClose
+//	none     splits            splits            splits+dividends
+//	auto     splits+dividends  splits+dividends  dropped
+//	back     splits+dividends  splits            dropped
+//	columns  splits            splits            splits+dividends
+//
+// columns also fills AdjOpen/AdjHigh/AdjLow, adjusted for splits and
+// dividends. "dropped" means left missing (0, or NaN with MissingAsNaN).
+// Bars without a usable ratio keep their prices. There is no fully
+// unadjusted mode, as Yahoo does not report prices before splits.
+type AdjustMode string
+
+const (
+	// AdjustNone keeps prices as Yahoo reports them.
+	AdjustNone AdjustMode = "none"
+
+	// AdjustAuto adjusts all of OHLC for splits and dividends, like
+	// Python yfinance's auto_adjust=True.
+	AdjustAuto AdjustMode = "auto"
+
+	// AdjustBack adjusts Open/High/Low for splits and dividends but keeps
+	// Close adjusted for splits only, like Python yfinance's
+	// back_adjust=True.
+	AdjustBack AdjustMode = "back"
+
+	// AdjustColumns keeps OHLC as reported and adds the fully adjusted
+	// prices in AdjOpen/AdjHigh/AdjLow/AdjClose.
+	AdjustColumns AdjustMode = "columns"
+)
+
+// Adjustment returns the adjustment params select: Adjust when set, else
+// AdjustColumns for AdjustedColumns, AdjustAuto for AutoAdjust and
+// AdjustBack for BackAdjust, in that order, else AdjustNone.
+//
+// Example:
+//
+//	models.HistoryParams{AutoAdjust: true}.Adjustment() // AdjustAuto
+func (p HistoryParams) Adjustment() AdjustMode {
+	switch {
+	case p.Adjust != "":
+		return p.Adjust
+	case p.AdjustedColumns:
+		return AdjustColumns
+	case p.AutoAdjust:
+		return AdjustAuto
+	case p.BackAdjust:
+		return AdjustBack
+	}
+	return AdjustNone
+}
+
+// RepairCategory names a kind of history repair.
+type RepairCategory string
+
+const (
+	RepairDividends    RepairCategory = "dividends"
+	RepairUnitMixups   RepairCategory = "unit_mixups"
+	RepairSplits       RepairCategory = "splits"
+	RepairZeroes       RepairCategory = "zeroes"
+	RepairCapitalGains RepairCategory = "capital_gains"
+)
+
+// RepairSummary counts the bars changed by history repair.
+type RepairSummary struct {
+	// Repaired is the number of distinct bars changed by any repair.
+	Repaired int `json:"repaired"`
+
+	// ByCategory is the number of bars changed by each repair. A bar fixed
+	// by several repairs is counted in each of them.
+	ByCategory map[RepairCategory]int `json:"byCategory,omitempty"`
+}
+
+// Add accumulates the counts of other, such as the summary of another
+// chunk of the same history.
+func (s *RepairSummary) Add(other RepairSummary) {
+	s.Repaired += other.Repaired
+	for cat, n := range other.ByCategory {
+		if s.ByCategory == nil {
+			s.ByCategory = make(map[RepairCategory]int)
+		}
+		s.ByCategory[cat] += n
+	}
 }
 
 // HistoryParams represents parameters for fetching historical data.
 type HistoryParams struct {
-	// Period: 1d, 5d, 1mo, 3mo, 6mo, 1y, 2y, 5y, 10y, ytd, max
+	// Period: 1d, 5d, 1mo, 3mo, 6mo, 1y, 2y, 5y, 10y, ytd, max.
+	// Ignored when Start or End is set.
 	Period string `json:"period,omitempty"`
 
 	// Interval: 1m, 2m, 5m, 15m, 30m, 60m, 90m, 1h, 1d, 5d, 1wk, 1mo, 3mo
 	Interval string `json:"interval,omitempty"`
 
-	// Start date (YYYY-MM-DD or time.Time)
+	// Start is the first instant of an explicit range (Yahoo's period1),
+	// inclusive. When only End is set, Start defaults to one month before
+	// now.
 	Start *time.Time `json:"start,omitempty"`
 
-	// End date (YYYY-MM-DD or time.Time)
+	// End is the end of an explicit range (Yahoo's period2), exclusive.
+	// Defaults to now when only Start is set.
 	End *time.Time `json:"end,omitempty"`
 
-	// Include pre/post market data
+	// Include pre/post market data. Only intraday intervals have pre/post
+	// market bars; it is ignored for daily and longer intervals.
 	PrePost bool `json:"prepost,omitempty"`
 
-	// Automatically adjust OHLC for splits/dividends
+	// Automatically adjust OHLC for splits/dividends. Like Python yfinance,
+	// Open/High/Low/Close are scaled by AdjClose/Close after any repair and
+	// AdjClose is dropped (left missing). Same as Adjust [AdjustAuto].
 	AutoAdjust bool `json:"autoAdjust,omitempty"`
 
+	// BackAdjust applies Python yfinance's back_adjust when AutoAdjust is
+	// not set. Same as Adjust [AdjustBack].
+	BackAdjust bool `json:"backAdjust,omitempty"`
+
+	// AdjustedColumns keeps Open/High/Low/Close unadjusted and fills
+	// AdjOpen/AdjHigh/AdjLow/AdjClose with the adjusted prices, for users who
+	// need both. AutoAdjust is ignored when set. Same as Adjust
+	// [AdjustColumns].
+	AdjustedColumns bool `json:"adjustedColumns,omitempty"`
+
+	// Adjust selects the adjustment explicitly and takes precedence over
+	// AutoAdjust, BackAdjust and AdjustedColumns (see [AdjustMode]).
+	Adjust AdjustMode `json:"adjust,omitempty"`
+
 	// Include dividend and split events
 	Actions bool `json:"actions,omitempty"`
 
-	// Repair bad data (100x errors, missing data)
+	// Repair bad data (100x errors, missing data, bad splits and
+	// dividends) before adjustment, like Python yfinance's repair=True.
 	Repair bool `json:"repair,omitempty"`
 
 	// RepairOptions provides fine-grained control over repair operations.
@@ -58,6 +328,49 @@ type HistoryParams struct {
 
 	// Keep NaN rows
 	KeepNA bool `json:"keepna,omitempty"`
+
+	// MissingAsNaN parses prices Yahoo reports as null into NaN instead of 0,
+	// so missing data can be told apart from a genuine zero.
+	MissingAsNaN bool `json:"missingAsNaN,omitempty"`
+
+	// Decimal additionally parses prices into exact fixed-point values
+	// (Bar.Decimal), avoiding float64 rounding. These are the raw values
+	// reported by Yahoo, before auto-adjustment.
+	Decimal bool `json:"decimal,omitempty"`
+
+	// Currency converts prices into this currency (e.g. "USD") using daily
+	// FX closes, for comparing listings in different currencies. Empty keeps
+	// the listing's currency; minor units such as GBp are always converted
+	// to their major currency. Pairs Yahoo does not quote are triangulated
+	// through USD; History.FXRoute records the pairs used.
+	Currency string `json:"currency,omitempty"`
+
+	// Chunked fetches Period "max" daily history in decade-long requests
+	// and stitches them, applying Repair to each decade separately so bad
+	// early data does not skew later repairs. Chunking also happens
+	// automatically when a single "max" response is truncated.
+	//
+	// For intraday intervals, Chunked lifts the per-request range limit
+	// (e.g. 7 days of 1m bars): the range is fetched in windows Yahoo
+	// accepts and stitched (see [IntradayLimits]). Data older than Yahoo
+	// keeps (30 days for 1m, 60 days for 5m) is still unavailable; a Period
+	// reaching further back is shortened. Other periods and intervals
+	// ignore it.
+	Chunked bool `json:"chunked,omitempty"`
+}
+
+// HistoryChunk is one request window of a streamed history.
+type HistoryChunk struct {
+	// Start and End bound the window.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Bars are the window's bars, oldest first, without bars already sent
+	// in an earlier chunk.
+	Bars []Bar `json:"bars"`
+
+	// Err is set on the last chunk if fetching failed.
+	Err error `json:"-"`
 }
 
 // RepairOptions provides fine-grained control over which repairs to apply.
@@ -121,6 +434,10 @@ type ChartMeta struct {
 	DataGranularity      string   `json:"dataGranularity"`
 	Range                string   `json:"range"`
 	ValidRanges          []string `json:"validRanges"`
+
+	// TradingPeriods lists the sessions of each day of an intraday chart.
+	// Pre and Post are only filled when pre/post-market data is requested.
+	TradingPeriods *TradingPeriods `json:"tradingPeriods,omitempty"`
 }
 
 // Dividend represents a dividend payment.
@@ -151,6 +468,48 @@ type Actions struct {
 	CapitalGains []CapitalGain `json:"capitalGains,omitempty"`
 }
 
+// ActionsParams scopes the dividends, splits and capital gains fetched by
+// Ticker.DividendsWithParams, SplitsWithParams and ActionsWithParams. The
+// zero value fetches the full history, as Ticker.Dividends does.
+//
+// Example:
+//
+//	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+//	params := models.ActionsParams{Start: &start, Interval: "1mo"}
+type ActionsParams struct {
+	// Period: 1d, 5d, 1mo, 3mo, 6mo, 1y, 2y, 5y, 10y, ytd, max. Defaults to
+	// max. Ignored when Start or End is set.
+	Period string `json:"period,omitempty"`
+
+	// Start and End bound an explicit range as in [HistoryParams].
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+
+	// Interval is the chart interval the events are fetched with, 1d by
+	// default. Events keep their own dates whatever the interval, so a
+	// coarse interval such as 1mo or 3mo shrinks the response of
+	// long-history tickers without losing events.
+	Interval string `json:"interval,omitempty"`
+}
+
+// HistoryParams returns the chart parameters that fetch the actions.
+func (p ActionsParams) HistoryParams() HistoryParams {
+	params := HistoryParams{
+		Period:   p.Period,
+		Start:    p.Start,
+		End:      p.End,
+		Interval: p.Interval,
+		Actions:  true,
+	}
+	if params.Period == "" && params.Start == nil && params.End == nil {
+		params.Period = "max"
+	}
+	if params.Interval == "" {
+		params.Interval = "1d"
+	}
+	return params
+}
+
 // ValidPeriods returns all valid period values.
 func ValidPeriods() []string {
 	return []string{"1d", "5d", "1mo", "3mo", "6mo", "1y", "2y", "5y", "10y", "ytd", "max"}
@@ -180,3 +539,194 @@ func IsValidInterval(interval string) bool {
 	}
 	return false
 }
+
+// intradayLimit describes how much intraday data Yahoo serves per interval.
+type intradayLimit struct {
+	span     time.Duration // longest range of a single request
+	lookback time.Duration // how far back data is kept
+	label    string
+	history  string
+}
+
+const day = 24 * time.Hour
+
+var intradayLimits = map[string]intradayLimit{
+	"1m":  {7 * day, 30 * day, "7d", "30d"},
+	"2m":  {60 * day, 60 * day, "60d", "60d"},
+	"5m":  {60 * day, 60 * day, "60d", "60d"},
+	"15m": {60 * day, 60 * day, "60d", "60d"},
+	"30m": {60 * day, 60 * day, "60d", "60d"},
+	"90m": {60 * day, 60 * day, "60d", "60d"},
+	"60m": {730 * day, 730 * day, "730d", "730d"},
+	"1h":  {730 * day, 730 * day, "730d", "730d"},
+}
+
+// IntradayLimits returns the longest range Yahoo serves in one request for
+// an intraday interval (window, e.g. 7 days for "1m") and how far back it
+// keeps data (lookback, e.g. 30 days for "1m"). ok is false for daily and
+// longer intervals.
+func IntradayLimits(interval string) (window, lookback time.Duration, ok bool) {
+	limit, ok := intradayLimits[interval]
+	return limit.span, limit.lookback, ok
+}
+
+// TimeRange returns the range the params request as of now: Start and End
+// when either is set (defaulting to one month before now and now), or else
+// Period counted back from now. Period "max" has no start and returns an
+// error.
+//
+// Example:
+//
+//	start, end, err := models.HistoryParams{Period: "5d"}.TimeRange(time.Now())
+func (p HistoryParams) TimeRange(now time.Time) (start, end time.Time, err error) {
+	if p.Start != nil || p.End != nil {
+		start, end = now.AddDate(0, -1, 0), now
+		if p.Start != nil {
+			start = *p.Start
+		}
+		if p.End != nil {
+			end = *p.End
+		}
+		return start, end, nil
+	}
+
+	period := p.Period
+	if period == "" {
+		period = "1mo"
+	}
+	if period == "max" {
+		return time.Time{}, time.Time{}, fmt.Errorf("period max has no fixed start")
+	}
+	d, err := periodDuration(period, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return now.Add(-d), now, nil
+}
+
+// IsIntradayInterval reports whether interval is shorter than one day.
+func IsIntradayInterval(interval string) bool {
+	_, ok := intradayLimits[interval]
+	return ok
+}
+
+// Validate checks the Period or Start/End range against the Interval
+// before a request is made, so callers get a descriptive error instead of
+// Yahoo's generic 422 response. Empty Period and Interval are accepted, as
+// the ticker package fills in defaults. PrePost is ignored for daily and
+// longer intervals.
+//
+// An explicit range must have a non-zero Start before End and before now,
+// must fit in one request for intraday intervals unless Chunked is set,
+// and must not start before Yahoo's intraday lookback (e.g. 30 days for
+// 1m).
+//
+// Example:
+//
+//	params := models.HistoryParams{Period: "1mo", Interval: "1m"}
+//	err := params.Validate() // interval 1m supports at most 7d period
+//
+//	start := time.Now().AddDate(0, 0, -45)
+//	params = models.HistoryParams{Start: &start, Interval: "5m"}
+//	err = params.Validate() // nil
+func (p HistoryParams) Validate() error {
+	return p.validateAt(time.Now())
+}
+
+// rangeLayout formats Start and End in validation errors.
+const rangeLayout = "2006-01-02 15:04 MST"
+
+func (p HistoryParams) validateAt(now time.Time) error {
+	if p.Interval != "" && !IsValidInterval(p.Interval) {
+		return fmt.Errorf("invalid interval %q, valid intervals: %s", p.Interval, strings.Join(ValidIntervals(), ", "))
+	}
+	switch p.Adjust {
+	case "", AdjustNone, AdjustAuto, AdjustBack, AdjustColumns:
+	default:
+		return fmt.Errorf("invalid adjust mode %q, valid modes: none, auto, back, columns", p.Adjust)
+	}
+	if p.Currency != "" && !isCurrencyCode(p.Currency) {
+		return fmt.Errorf("invalid currency %q, expected a 3-letter code such as USD", p.Currency)
+	}
+
+	var span time.Duration
+	if p.Start == nil && p.End == nil {
+		period := p.Period
+		if period == "" {
+			period = "1mo" // ticker default
+		}
+		d, err := periodDuration(period, now)
+		if err != nil {
+			return err
+		}
+		span = d
+	} else {
+		if (p.Start != nil && p.Start.IsZero()) || (p.End != nil && p.End.IsZero()) {
+			return fmt.Errorf("start and end must not be the zero time")
+		}
+		start := now.AddDate(0, -1, 0) // ticker default when only End is set
+		if p.Start != nil {
+			start = *p.Start
+		}
+		end := now
+		if p.End != nil {
+			end = *p.End
+		}
+		if !start.Before(end) {
+			return fmt.Errorf("start %s must be before end %s", start.Format(rangeLayout), end.Format(rangeLayout))
+		}
+		if !start.Before(now) {
+			return fmt.Errorf("start %s is in the future", start.Format(rangeLayout))
+		}
+		span = end.Sub(start)
+
+		if limit, ok := intradayLimits[p.Interval]; ok && now.Sub(start) > limit.lookback {
+			return fmt.Errorf("interval %s data is only available for the last %s", p.Interval, limit.history)
+		}
+	}
+
+	if limit, ok := intradayLimits[p.Interval]; ok && span > limit.span && !p.Chunked {
+		return fmt.Errorf("interval %s supports at most %s period", p.Interval, limit.label)
+	}
+
+	return nil
+}
+
+// periodDuration returns the length of a period such as "5d", "3mo" or
+// "ytd". "max" has no limit. A month counts as 30 days, so "2mo" fits the
+// 60 day window of 5m and 15m bars as it does at Yahoo.
+func periodDuration(period string, now time.Time) (time.Duration, error) {
+	switch period {
+	case "max":
+		return time.Duration(math.MaxInt64), nil
+	case "ytd":
+		return now.Sub(time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())), nil
+	}
+
+	for _, unit := range []struct {
+		suffix string
+		days   int
+	}{{"wk", 7}, {"mo", 30}, {"d", 1}, {"y", 365}} {
+		if num, ok := strings.CutSuffix(period, unit.suffix); ok {
+			n, err := strconv.Atoi(num)
+			if err != nil || n <= 0 {
+				break
+			}
+			return time.Duration(n*unit.days) * day, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid period %q, valid periods: %s", period, strings.Join(ValidPeriods(), ", "))
+}
+
+// isCurrencyCode reports whether s looks like a 3-letter currency code.
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return false
+		}
+	}
+	return true
+}
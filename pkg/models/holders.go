@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
 
 // MajorHolders represents the breakdown of major shareholders.
 //
@@ -29,6 +33,53 @@ type MajorHolders struct {
 	InstitutionsCount int `json:"institutionsCount"`
 }
 
+// MajorHoldersRow is one row of the major holders table.
+type MajorHoldersRow struct {
+	// Value is the formatted value, e.g. "0.07%" or "6,379".
+	Value string `json:"value"`
+
+	// Label describes the value, e.g. "% of Shares Held by All Insider".
+	Label string `json:"label"`
+}
+
+// Rows returns the breakdown in the layout of Python yfinance's
+// major_holders table: percentages with two decimals, the institution count
+// with thousands separators, and Yahoo's original labels.
+//
+// Example:
+//
+//	for _, row := range holders.Rows() {
+//	    fmt.Printf("%-8s %s\n", row.Value, row.Label)
+//	}
+//	// 0.07%    % of Shares Held by All Insider
+//	// 61.77%   % of Shares Held by Institutions
+//	// 62.04%   % of Float Held by Institutions
+//	// 6,379    Number of Institutions Holding Shares
+func (m *MajorHolders) Rows() []MajorHoldersRow {
+	return []MajorHoldersRow{
+		{Value: formatHolderPercent(m.InsidersPercentHeld), Label: "% of Shares Held by All Insider"},
+		{Value: formatHolderPercent(m.InstitutionsPercentHeld), Label: "% of Shares Held by Institutions"},
+		{Value: formatHolderPercent(m.InstitutionsFloatPercentHeld), Label: "% of Float Held by Institutions"},
+		{Value: formatHolderCount(m.InstitutionsCount), Label: "Number of Institutions Holding Shares"},
+	}
+}
+
+func formatHolderPercent(v float64) string {
+	return fmt.Sprintf("%.2f%%", v*100)
+}
+
+func formatHolderCount(n int) string {
+	s := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
+
 // Holder represents an institutional or mutual fund holder.
 //
 // This structure is used for both institutional holders and mutual fund holders.
@@ -52,6 +103,82 @@ type Holder struct {
 	PctChange float64 `json:"pctChange"`
 }
 
+// OwnershipPeriod aggregates the institutional holders whose latest filing
+// has the same report date.
+//
+// Changes are measured against each holder's previous filing, so they compare
+// consecutive report dates even though Yahoo lists only the latest holding.
+type OwnershipPeriod struct {
+	// ReportDate is the filing date (UTC midnight).
+	ReportDate time.Time `json:"reportDate"`
+
+	// Holders is the number of holders that reported on the date.
+	Holders int `json:"holders"`
+
+	// Shares is the total number of shares held.
+	Shares int64 `json:"shares"`
+
+	// Value is the total value of the holdings.
+	Value float64 `json:"value"`
+
+	// PctHeld is the total percentage of outstanding shares held (0.0-1.0).
+	PctHeld float64 `json:"pctHeld"`
+
+	// Increased, Decreased and Unchanged count the holders by the direction
+	// of their position change.
+	Increased int `json:"increased"`
+	Decreased int `json:"decreased"`
+	Unchanged int `json:"unchanged"`
+
+	// SharesChange is the net number of shares bought (positive) or sold
+	// since the holders' previous filings.
+	SharesChange int64 `json:"sharesChange"`
+
+	// Accumulation is SharesChange relative to the previously held shares,
+	// e.g. 0.05 when the holders grew their positions by 5% in total.
+	Accumulation float64 `json:"accumulation"`
+
+	// Breadth is (Increased - Decreased) / Holders, from -1 when every
+	// holder sold to 1 when every holder bought.
+	Breadth float64 `json:"breadth"`
+}
+
+// OwnershipTrend is the institutional ownership of a ticker by report date,
+// with an accumulation indicator over all holders.
+//
+// Example:
+//
+//	trend, err := ticker.InstitutionalOwnershipTrend()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, p := range trend.Periods {
+//	    fmt.Printf("%s: %d holders, net %+d shares (%.1f%%)\n",
+//	        p.ReportDate.Format("2006-01-02"), p.Holders, p.SharesChange, p.Accumulation*100)
+//	}
+type OwnershipTrend struct {
+	// Symbol is the ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// Periods contains one entry per report date, oldest first.
+	Periods []OwnershipPeriod `json:"periods"`
+
+	// Total aggregates all periods. Its ReportDate is the latest one.
+	Total OwnershipPeriod `json:"total"`
+}
+
+// Accumulating reports whether institutions added to their positions in
+// total, i.e. both the net share change and the breadth are positive.
+func (t *OwnershipTrend) Accumulating() bool {
+	return t.Total.SharesChange > 0 && t.Total.Breadth > 0
+}
+
+// Distributing reports whether institutions reduced their positions in
+// total, i.e. both the net share change and the breadth are negative.
+func (t *OwnershipTrend) Distributing() bool {
+	return t.Total.SharesChange < 0 && t.Total.Breadth < 0
+}
+
 // InsiderTransaction represents a single insider transaction.
 //
 // This includes purchases, sales, and other transactions by company insiders.
@@ -84,6 +211,44 @@ type InsiderTransaction struct {
 	Ownership string `json:"ownership"`
 }
 
+// InsiderActivity is the insider buying and selling of one calendar quarter.
+//
+// Example:
+//
+//	quarters, err := ticker.InsiderActivityByQuarter()
+//	for _, q := range quarters {
+//	    fmt.Printf("%s: %d buys, %d sells, score %+.2f\n",
+//	        q.Quarter, q.Purchases.Transactions, q.Sales.Transactions, q.ValueScore)
+//	}
+type InsiderActivity struct {
+	// Quarter labels the quarter, e.g. "2024Q3".
+	Quarter string `json:"quarter"`
+
+	// Start is the first day of the quarter (UTC).
+	Start time.Time `json:"start"`
+
+	Purchases InsiderTradeTotals `json:"purchases"`
+	Sales     InsiderTradeTotals `json:"sales"`
+
+	// Other is the number of transactions that are neither purchases nor
+	// sales, such as awards, gifts and option exercises.
+	Other int `json:"other,omitempty"`
+
+	// CountScore is (purchases - sales) / (purchases + sales) by number of
+	// transactions, in [-1, 1]; positive means net buying.
+	CountScore float64 `json:"countScore"`
+
+	// ValueScore is the same ratio by transaction value.
+	ValueScore float64 `json:"valueScore"`
+}
+
+// InsiderTradeTotals sums insider transactions of one direction.
+type InsiderTradeTotals struct {
+	Transactions int     `json:"transactions"`
+	Shares       int64   `json:"shares"`
+	Value        float64 `json:"value"`
+}
+
 // InsiderHolder represents an insider on the company's roster.
 //
 // This provides information about company insiders and their holdings.
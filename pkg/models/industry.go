@@ -117,15 +117,15 @@ type IndustryData struct {
 // IndustryResponse represents the raw API response for industry data.
 type IndustryResponse struct {
 	Data struct {
-		Name                   string                   `json:"name"`
-		Symbol                 string                   `json:"symbol"`
-		SectorKey              string                   `json:"sectorKey"`
-		SectorName             string                   `json:"sectorName"`
-		Overview               map[string]interface{}   `json:"overview"`
-		TopCompanies           []map[string]interface{} `json:"topCompanies"`
-		TopPerformingCompanies []map[string]interface{} `json:"topPerformingCompanies"`
-		TopGrowthCompanies     []map[string]interface{} `json:"topGrowthCompanies"`
-		ResearchReports        []map[string]interface{} `json:"researchReports"`
+		Name                   string              `json:"name"`
+		Symbol                 string              `json:"symbol"`
+		SectorKey              string              `json:"sectorKey"`
+		SectorName             string              `json:"sectorName"`
+		Overview               *RawDomainOverview  `json:"overview"`
+		TopCompanies           []RawDomainEntry    `json:"topCompanies"`
+		TopPerformingCompanies []RawDomainEntry    `json:"topPerformingCompanies"`
+		TopGrowthCompanies     []RawDomainEntry    `json:"topGrowthCompanies"`
+		ResearchReports        []RawResearchReport `json:"researchReports"`
 	} `json:"data"`
 	Error *struct {
 		Code        string `json:"code"`
@@ -10,6 +10,8 @@ type Info struct {
 	// Company profile (assetProfile module)
 	Sector                    string    `json:"sector,omitempty"`
 	Industry                  string    `json:"industry,omitempty"`
+	SectorKey                 string    `json:"sectorKey,omitempty"`
+	IndustryKey               string    `json:"industryKey,omitempty"`
 	FullTimeEmployees         int64     `json:"fullTimeEmployees,omitempty"`
 	City                      string    `json:"city,omitempty"`
 	State                     string    `json:"state,omitempty"`
@@ -159,3 +161,41 @@ type Officer struct {
 	ExercisedValue   int64  `json:"exercisedValue,omitempty"`
 	UnexercisedValue int64  `json:"unexercisedValue,omitempty"`
 }
+
+// ESGScores holds a company's Sustainalytics ESG risk scores (esgScores
+// module). Lower scores mean lower risk.
+type ESGScores struct {
+	TotalEsg           float64 `json:"totalEsg,omitempty"`
+	EnvironmentScore   float64 `json:"environmentScore,omitempty"`
+	SocialScore        float64 `json:"socialScore,omitempty"`
+	GovernanceScore    float64 `json:"governanceScore,omitempty"`
+	HighestControversy float64 `json:"highestControversy,omitempty"`
+	Percentile         float64 `json:"percentile,omitempty"`
+	PeerGroup          string  `json:"peerGroup,omitempty"`
+	RatingYear         int     `json:"ratingYear,omitempty"`
+	RatingMonth        int     `json:"ratingMonth,omitempty"`
+
+	// Performance compares TotalEsg with the peer group, e.g. "OUT_PERF",
+	// "AVG_PERF" or "UNDER_PERF".
+	Performance string `json:"performance,omitempty"`
+
+	// PeerCount is the number of companies in PeerGroup.
+	PeerCount int `json:"peerCount,omitempty"`
+
+	// Peer score ranges, nil when Yahoo does not report them.
+	PeerEsg                *ESGPeerRange `json:"peerEsg,omitempty"`
+	PeerEnvironment        *ESGPeerRange `json:"peerEnvironment,omitempty"`
+	PeerSocial             *ESGPeerRange `json:"peerSocial,omitempty"`
+	PeerGovernance         *ESGPeerRange `json:"peerGovernance,omitempty"`
+	PeerHighestControversy *ESGPeerRange `json:"peerHighestControversy,omitempty"`
+
+	// RelatedControversy lists the areas of the company's controversies.
+	RelatedControversy []string `json:"relatedControversy,omitempty"`
+}
+
+// ESGPeerRange is the spread of an ESG score across a peer group.
+type ESGPeerRange struct {
+	Min float64 `json:"min"`
+	Avg float64 `json:"avg"`
+	Max float64 `json:"max"`
+}
@@ -105,13 +105,43 @@ type PricingData struct {
 
 	// MarketCap is the market capitalization.
 	MarketCap float64 `json:"market_cap,omitempty"`
+
+	// BackfillBar is set on messages synthesized from chart bars to fill a gap
+	// after a reconnect; it is nil for live messages.
+	BackfillBar *Bar `json:"backfill_bar,omitempty"`
+
+	// Anomaly is set by the live price check on ticks that look wrong
+	// relative to the symbol's last accepted price; it is empty otherwise.
+	Anomaly PriceAnomaly `json:"anomaly,omitempty"`
 }
 
+// PriceAnomaly classifies a streamed price that failed a sanity check.
+type PriceAnomaly string
+
+const (
+	// PriceAnomalyZero is a zero, negative or non-finite price.
+	PriceAnomalyZero PriceAnomaly = "zero"
+
+	// PriceAnomalyUnitMixup is a price about 100x off, e.g. pence quoted
+	// as pounds.
+	PriceAnomalyUnitMixup PriceAnomaly = "unit_mixup"
+
+	// PriceAnomalyDeviation is a price further from the last accepted price
+	// than the configured limit.
+	PriceAnomalyDeviation PriceAnomaly = "deviation"
+)
+
 // Timestamp returns the quote time as time.Time.
 func (p *PricingData) Timestamp() time.Time {
 	return time.Unix(p.Time, 0)
 }
 
+// IsBackfill reports whether the message was synthesized from chart data
+// after a reconnect rather than received from the stream.
+func (p *PricingData) IsBackfill() bool {
+	return p.BackfillBar != nil
+}
+
 // ExpireTime returns the option expiration date as time.Time.
 func (p *PricingData) ExpireTime() time.Time {
 	if p.ExpireDate == 0 {
@@ -163,10 +163,10 @@ func DefaultLookupParams() LookupParams {
 type LookupResponse struct {
 	Finance struct {
 		Result []struct {
-			Documents []map[string]interface{} `json:"documents"`
-			Count     int                      `json:"count,omitempty"`
-			Start     int                      `json:"start,omitempty"`
-			Total     int                      `json:"total,omitempty"`
+			Documents []RawLookupDocument `json:"documents"`
+			Count     int                 `json:"count,omitempty"`
+			Start     int                 `json:"start,omitempty"`
+			Total     int                 `json:"total,omitempty"`
 		} `json:"result"`
 		Error *struct {
 			Code        string `json:"code"`
@@ -174,3 +174,30 @@ type LookupResponse struct {
 		} `json:"error,omitempty"`
 	} `json:"finance"`
 }
+
+// RawLookupDocument is a document in a raw lookup response.
+type RawLookupDocument struct {
+	Symbol                     string `json:"symbol"`
+	Name                       string `json:"name"`
+	ShortName                  string `json:"shortName"`
+	Exchange                   string `json:"exchange"`
+	ExchangeDisplay            string `json:"exchDisp"`
+	QuoteType                  string `json:"quoteType"`
+	TypeDisplay                string `json:"typeDisp"`
+	Industry                   string `json:"industry"`
+	Sector                     string `json:"sector"`
+	Score                      Number `json:"score"`
+	RegularMarketPrice         Number `json:"regularMarketPrice"`
+	RegularMarketChange        Number `json:"regularMarketChange"`
+	RegularMarketChangePercent Number `json:"regularMarketChangePercent"`
+	RegularMarketPreviousClose Number `json:"regularMarketPreviousClose"`
+	RegularMarketOpen          Number `json:"regularMarketOpen"`
+	RegularMarketDayHigh       Number `json:"regularMarketDayHigh"`
+	RegularMarketDayLow        Number `json:"regularMarketDayLow"`
+	RegularMarketVolume        Number `json:"regularMarketVolume"`
+	MarketCap                  Number `json:"marketCap"`
+	FiftyTwoWeekHigh           Number `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow            Number `json:"fiftyTwoWeekLow"`
+	Currency                   string `json:"currency"`
+	MarketState                string `json:"marketState"`
+}
@@ -94,6 +94,15 @@ type MarketSummaryItem struct {
 	ExchangeDataDelayedBy int `json:"exchangeDataDelayedBy,omitempty"`
 }
 
+// RegularMarketDatetime returns RegularMarketTime as a time.Time.
+// It returns the zero time when the timestamp is missing.
+func (m *MarketSummaryItem) RegularMarketDatetime() time.Time {
+	if m.RegularMarketTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(m.RegularMarketTime, 0)
+}
+
 // MarketSummary represents the summary of all market indices.
 //
 // This provides an overview of major market indices like S&P 500,
@@ -112,10 +121,52 @@ type MarketSummaryItem struct {
 //	}
 type MarketSummary map[string]MarketSummaryItem
 
+// MarketSummaryChange is emitted by market.Watch when an index's price or
+// percent change moves between two polls.
+type MarketSummaryChange struct {
+	// Exchange is the summary key of the index (e.g., "SNP").
+	Exchange string `json:"exchange,omitempty"`
+
+	// Previous is the item from the last poll, nil on first observation.
+	Previous *MarketSummaryItem `json:"previous,omitempty"`
+
+	// Current is the item from this poll.
+	Current MarketSummaryItem `json:"current"`
+
+	// PriceDelta is Current minus Previous RegularMarketPrice.
+	PriceDelta float64 `json:"priceDelta"`
+
+	// PercentDelta is Current minus Previous RegularMarketChangePercent,
+	// in percentage points.
+	PercentDelta float64 `json:"percentDelta"`
+
+	// Time is when the poll completed.
+	Time time.Time `json:"time"`
+
+	// Err is set, with all other fields empty, when a poll failed.
+	Err error `json:"-"`
+}
+
+// MarketMovers lists the day's top gainers, losers and most active stocks
+// of one region, as returned by market.Movers.
+type MarketMovers struct {
+	// Region is the lowercase region code, e.g. "de".
+	Region string `json:"region"`
+
+	// Gainers are sorted by percent change, largest first.
+	Gainers []ScreenerQuote `json:"gainers"`
+
+	// Losers are sorted by percent change, largest loss first.
+	Losers []ScreenerQuote `json:"losers"`
+
+	// Actives are sorted by day volume, largest first.
+	Actives []ScreenerQuote `json:"actives"`
+}
+
 // MarketSummaryResponse represents the raw API response for market summary.
 type MarketSummaryResponse struct {
 	MarketSummaryResponse struct {
-		Result []map[string]interface{} `json:"result"`
+		Result []RawMarketSummaryItem `json:"result"`
 		Error  *struct {
 			Code        string `json:"code"`
 			Description string `json:"description"`
@@ -123,17 +174,34 @@ type MarketSummaryResponse struct {
 	} `json:"marketSummaryResponse"`
 }
 
+// RawMarketSummaryItem is an index in a raw market summary response.
+type RawMarketSummaryItem struct {
+	Exchange                   string `json:"exchange"`
+	Symbol                     string `json:"symbol"`
+	ShortName                  string `json:"shortName"`
+	FullExchangeName           string `json:"fullExchangeName"`
+	MarketState                string `json:"marketState"`
+	RegularMarketPrice         Number `json:"regularMarketPrice"`
+	RegularMarketChange        Number `json:"regularMarketChange"`
+	RegularMarketChangePercent Number `json:"regularMarketChangePercent"`
+	RegularMarketPreviousClose Number `json:"regularMarketPreviousClose"`
+	RegularMarketTime          Number `json:"regularMarketTime"`
+	QuoteType                  string `json:"quoteType"`
+	SourceInterval             Number `json:"sourceInterval"`
+	ExchangeDataDelayedBy      Number `json:"exchangeDataDelayedBy"`
+}
+
 // MarketTimeResponse represents the raw API response for market time.
 type MarketTimeResponse struct {
 	Finance struct {
 		MarketTimes []struct {
 			ID         string `json:"id"`
 			MarketTime []struct {
-				ID       string                   `json:"id"`
-				Open     string                   `json:"open"`
-				Close    string                   `json:"close"`
-				Timezone []map[string]interface{} `json:"timezone"`
-				Time     string                   `json:"time,omitempty"`
+				ID       string              `json:"id"`
+				Open     string              `json:"open"`
+				Close    string              `json:"close"`
+				Timezone []RawMarketTimezone `json:"timezone"`
+				Time     string              `json:"time,omitempty"`
 			} `json:"marketTime"`
 		} `json:"marketTimes"`
 		Error *struct {
@@ -143,6 +211,14 @@ type MarketTimeResponse struct {
 	} `json:"finance"`
 }
 
+// RawMarketTimezone is the timezone of a market in a raw market time
+// response.
+type RawMarketTimezone struct {
+	GMTOffset Number `json:"gmtoffset"`
+	Short     string `json:"short"`
+	Long      string `json:"long"`
+}
+
 // MarketRegion represents Yahoo market regions accepted by the market summary endpoint.
 type MarketRegion string
 
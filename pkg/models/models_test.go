@@ -1,6 +1,12 @@
 package models
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -57,6 +63,183 @@ func TestIsValidInterval(t *testing.T) {
 	}
 }
 
+func TestHistoryParamsValidate(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	ago := func(days int) *time.Time {
+		d := now.AddDate(0, 0, -days)
+		return &d
+	}
+
+	tests := []struct {
+		name    string
+		params  HistoryParams
+		wantErr string
+	}{
+		{"defaults", HistoryParams{}, ""},
+		{"daily max", HistoryParams{Period: "max", Interval: "1d"}, ""},
+		{"1m 5d", HistoryParams{Period: "5d", Interval: "1m", PrePost: true}, ""},
+		{"1m 1mo", HistoryParams{Period: "1mo", Interval: "1m"}, "interval 1m supports at most 7d period"},
+		{"5m 1y", HistoryParams{Period: "1y", Interval: "5m"}, "interval 5m supports at most 60d period"},
+		{"1h 2y", HistoryParams{Period: "2y", Interval: "1h"}, ""},
+		{"1h max", HistoryParams{Period: "max", Interval: "1h"}, "interval 1h supports at most 730d period"},
+		{"1m default period", HistoryParams{Interval: "1m"}, "interval 1m supports at most 7d period"},
+		{"1m recent range", HistoryParams{Interval: "1m", Start: ago(10), End: ago(5)}, ""},
+		{"1m 1mo chunked", HistoryParams{Period: "1mo", Interval: "1m", Chunked: true}, ""},
+		{"1m 20d chunked", HistoryParams{Interval: "1m", Start: ago(20), Chunked: true}, ""},
+		{"1m old range chunked", HistoryParams{Interval: "1m", Start: ago(40), Chunked: true}, "interval 1m data is only available for the last 30d"},
+		{"1m old range", HistoryParams{Interval: "1m", Start: ago(40), End: ago(35)}, "interval 1m data is only available for the last 30d"},
+		{"start after end", HistoryParams{Interval: "1d", Start: ago(1), End: ago(2)}, "must be before end"},
+		{"start in future", HistoryParams{Interval: "1d", Start: ago(-2), End: ago(-5)}, "is in the future"},
+		{"end in future", HistoryParams{Interval: "1d", Start: ago(2), End: ago(-5)}, ""},
+		{"zero start", HistoryParams{Interval: "1d", Start: &time.Time{}}, "must not be the zero time"},
+		{"range ignores period", HistoryParams{Period: "forever", Interval: "1d", Start: ago(30)}, ""},
+		{"1m range too long", HistoryParams{Interval: "1m", Start: ago(20), End: ago(2)}, "interval 1m supports at most 7d period"},
+		{"prepost daily", HistoryParams{Period: "1mo", Interval: "1d", PrePost: true}, ""},
+		{"5m 2mo", HistoryParams{Period: "2mo", Interval: "5m"}, ""},
+		{"15m 3mo", HistoryParams{Period: "3mo", Interval: "15m"}, "interval 15m supports at most 60d period"},
+		{"bad interval", HistoryParams{Interval: "4h"}, "invalid interval"},
+		{"bad period", HistoryParams{Period: "forever"}, "invalid period"},
+		{"currency", HistoryParams{Currency: "usd"}, ""},
+		{"bad currency", HistoryParams{Currency: "US$"}, "invalid currency"},
+		{"adjust back", HistoryParams{Adjust: AdjustBack}, ""},
+		{"bad adjust", HistoryParams{Adjust: "full"}, "invalid adjust mode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.validateAt(now)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHistoryParamsAdjustment(t *testing.T) {
+	tests := []struct {
+		params HistoryParams
+		want   AdjustMode
+	}{
+		{HistoryParams{}, AdjustNone},
+		{HistoryParams{AutoAdjust: true}, AdjustAuto},
+		{HistoryParams{BackAdjust: true}, AdjustBack},
+		{HistoryParams{AutoAdjust: true, BackAdjust: true}, AdjustAuto},
+		{HistoryParams{AutoAdjust: true, AdjustedColumns: true}, AdjustColumns},
+		{HistoryParams{AutoAdjust: true, Adjust: AdjustBack}, AdjustBack},
+	}
+	for _, tt := range tests {
+		if got := tt.params.Adjustment(); got != tt.want {
+			t.Errorf("%+v.Adjustment() = %s, want %s", tt.params, got, tt.want)
+		}
+	}
+}
+
+func TestHistoryParamsTimeRange(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	start := now.AddDate(0, 0, -20)
+
+	got, end, err := HistoryParams{Period: "5d"}.TimeRange(now)
+	if err != nil || !got.Equal(now.AddDate(0, 0, -5)) || !end.Equal(now) {
+		t.Errorf("5d range = %s..%s, %v", got, end, err)
+	}
+	got, end, err = HistoryParams{Start: &start}.TimeRange(now)
+	if err != nil || !got.Equal(start) || !end.Equal(now) {
+		t.Errorf("start range = %s..%s, %v", got, end, err)
+	}
+	if _, _, err := (HistoryParams{Period: "max"}).TimeRange(now); err == nil {
+		t.Error("Expected error for max period")
+	}
+
+	if window, lookback, ok := IntradayLimits("1m"); !ok || window != 7*24*time.Hour || lookback != 30*24*time.Hour {
+		t.Errorf("IntradayLimits(1m) = %s, %s, %v", window, lookback, ok)
+	}
+	if _, _, ok := IntradayLimits("1d"); ok {
+		t.Error("1d should have no intraday limits")
+	}
+}
+
+func TestMergeBars(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	a := []Bar{
+		{Date: day(3), Close: 3},
+		{Date: day(1), Close: 1},
+		{Date: day(2), Open: 2, High: 2, Low: 2, Close: 2},
+	}
+	b := []Bar{
+		{Date: day(2), Close: 20},
+		{Date: day(4), Close: 4},
+	}
+
+	merged := MergeBars(a, b, nil)
+	want := []float64{1, 20, 3, 4}
+	if len(merged) != len(want) {
+		t.Fatalf("Expected %d bars, got %d", len(want), len(merged))
+	}
+	for i, w := range want {
+		if merged[i].Close != w {
+			t.Errorf("bar %d: expected close %v, got %v", i, w, merged[i].Close)
+		}
+	}
+	if a[0].Close != 3 {
+		t.Error("MergeBars should not modify its inputs")
+	}
+
+	if got := MergeBars(a, b, PreferOld)[1].Close; got != 2 {
+		t.Errorf("PreferOld: expected close 2, got %v", got)
+	}
+	if got := MergeBars(a, b, PreferComplete)[1].Close; got != 2 {
+		t.Errorf("PreferComplete: expected the complete bar, got close %v", got)
+	}
+	repaired := []Bar{{Date: day(2), Close: 21, Repaired: true}}
+	if got := MergeBars(repaired, b, PreferRepaired)[0].Close; got != 21 {
+		t.Errorf("PreferRepaired: expected the repaired bar, got close %v", got)
+	}
+
+	deduped := DedupeBars([]Bar{{Date: day(1), Close: 1}, {Date: day(1), Close: 2}}, nil)
+	if len(deduped) != 1 || deduped[0].Close != 2 {
+		t.Errorf("Expected one deduplicated bar with close 2, got %+v", deduped)
+	}
+}
+
+func TestInsertBar(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	var bars []Bar
+	for _, d := range []int{3, 1, 2, 5} {
+		bars = InsertBar(bars, Bar{Date: day(d), Close: float64(d)}, nil)
+	}
+	bars = InsertBar(bars, Bar{Date: day(2), Close: 22}, nil)
+	bars = InsertBar(bars, Bar{Date: day(5), Close: 55}, PreferOld)
+
+	want := []float64{1, 22, 3, 5}
+	if len(bars) != len(want) {
+		t.Fatalf("Expected %d bars, got %d", len(want), len(bars))
+	}
+	for i, w := range want {
+		if bars[i].Close != w {
+			t.Errorf("bar %d: expected close %v, got %v", i, w, bars[i].Close)
+		}
+	}
+}
+
+func TestBarScale(t *testing.T) {
+	bar := Bar{Open: 100, High: 120, Low: 90, Close: 110, AdjClose: 105, Volume: 7, Dividends: 2}
+	scaled := bar.Scale(0.01)
+	if scaled.Open != 1 || scaled.High != 1.2 || scaled.Close != 1.1 || scaled.Dividends != 0.02 {
+		t.Errorf("Unexpected scaled bar: %+v", scaled)
+	}
+	if scaled.Volume != 7 || bar.Open != 100 {
+		t.Error("Scale should keep volume and not modify the receiver")
+	}
+}
+
 func TestBarStruct(t *testing.T) {
 	now := time.Now()
 	bar := Bar{
@@ -150,3 +333,632 @@ func TestValidIntervals(t *testing.T) {
 		t.Errorf("Expected 13 valid intervals, got %d", len(intervals))
 	}
 }
+
+func TestBarMissing(t *testing.T) {
+	nan := math.NaN()
+
+	if !(Bar{Open: nan, High: 0, Low: nan, Close: nan}).Missing() {
+		t.Error("expected all-NaN/zero bar to be missing")
+	}
+	if (Bar{Open: nan, High: 1, Low: 1, Close: 1}).Complete() {
+		t.Error("expected bar with NaN open to be incomplete")
+	}
+	if !(Bar{Open: 1, High: 1, Low: 1, Close: 1}).Complete() {
+		t.Error("expected full bar to be complete")
+	}
+}
+
+func TestBarJSONNaN(t *testing.T) {
+	bar := Bar{
+		Date:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Open:     math.NaN(),
+		High:     101,
+		Low:      99,
+		Close:    100,
+		AdjClose: 100,
+		Volume:   10,
+	}
+
+	data, err := json.Marshal(bar)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal(raw) error: %v", err)
+	}
+	if v, ok := raw["open"]; !ok || v != nil {
+		t.Errorf("expected NaN open to encode as null, got %v", v)
+	}
+
+	var decoded Bar
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !math.IsNaN(decoded.Open) {
+		t.Errorf("expected null open to decode as NaN, got %v", decoded.Open)
+	}
+	if decoded.Close != 100 || decoded.Volume != 10 || !decoded.Date.Equal(bar.Date) {
+		t.Errorf("unexpected round-trip result: %+v", decoded)
+	}
+}
+
+func TestQueryForRegions(t *testing.T) {
+	q, err := QueryForRegions("DE", "fr")
+	if err != nil {
+		t.Fatalf("QueryForRegions() error: %v", err)
+	}
+
+	dict := q.ToDict()
+	if dict["operator"] != "OR" {
+		t.Errorf("Expected IS-IN to expand to OR, got %v", dict["operator"])
+	}
+	want := len(EquityScreenerExchangeMap["de"]) + len(EquityScreenerExchangeMap["fr"])
+	if ops := dict["operands"].([]any); len(ops) != want {
+		t.Errorf("Expected %d exchange conditions, got %d", want, len(ops))
+	}
+
+	single, err := QueryForRegions("at")
+	if err != nil {
+		t.Fatalf("QueryForRegions() error: %v", err)
+	}
+	if single.ToDict()["operator"] != "EQ" {
+		t.Errorf("Expected single exchange to use EQ, got %v", single.ToDict()["operator"])
+	}
+
+	if _, err := QueryForRegions("xx"); err == nil {
+		t.Error("Expected error for unknown region")
+	}
+	if _, err := QueryForRegions(); err == nil {
+		t.Error("Expected error for no regions")
+	}
+	if _, err := QueryForRegions("de", "pe"); err == nil {
+		t.Error("Expected error for region without exchanges")
+	}
+}
+
+func TestRegionExchanges(t *testing.T) {
+	codes, err := RegionExchanges("KR")
+	if err != nil {
+		t.Fatalf("RegionExchanges() error: %v", err)
+	}
+	if len(codes) != 2 || codes[0] != "KOE" || codes[1] != "KSC" {
+		t.Errorf("Expected [KOE KSC], got %v", codes)
+	}
+
+	for _, region := range []string{"pe", "sr"} {
+		_, err := RegionExchanges(region)
+		if err == nil || !strings.Contains(err.Error(), "no screener exchanges") {
+			t.Errorf("RegionExchanges(%q): expected no-exchanges error, got %v", region, err)
+		}
+	}
+	if _, err := RegionExchanges("xx"); err == nil {
+		t.Error("Expected error for unknown region")
+	}
+}
+
+func TestQuoteStaleness(t *testing.T) {
+	now := time.Date(2024, 6, 3, 15, 0, 0, 0, time.UTC)
+	q := Quote{
+		MarketState:       "REGULAR",
+		RegularMarketTime: now.Add(-20 * time.Minute),
+	}
+
+	if got := q.ageAt(now); got != 20*time.Minute {
+		t.Errorf("Expected age 20m, got %s", got)
+	}
+	if !q.isStaleAt(now, 15*time.Minute) {
+		t.Error("Expected 20m old quote to be stale with 15m threshold")
+	}
+	if q.isStaleAt(now, 30*time.Minute) {
+		t.Error("Expected 20m old quote not to be stale with 30m threshold")
+	}
+
+	q.MarketState = "CLOSED"
+	if q.isStaleAt(now, time.Minute) {
+		t.Error("Expected closed market quote never to be stale")
+	}
+
+	q.MarketState = "POST"
+	q.PostMarketTime = now.Add(-time.Minute)
+	if !q.LastTradeTime().Equal(q.PostMarketTime) {
+		t.Error("Expected post-market time to be the last trade time")
+	}
+	if q.isStaleAt(now, 15*time.Minute) {
+		t.Error("Expected recent post-market quote not to be stale")
+	}
+
+	if !(&Quote{MarketState: "REGULAR"}).isStaleAt(now, time.Hour) {
+		t.Error("Expected quote without trade time to be stale")
+	}
+
+	item := MarketSummaryItem{}
+	if !item.RegularMarketDatetime().IsZero() {
+		t.Error("Expected zero time for missing RegularMarketTime")
+	}
+	item.RegularMarketTime = 1717426800
+	if item.RegularMarketDatetime().Unix() != 1717426800 {
+		t.Error("Expected RegularMarketDatetime to match epoch")
+	}
+}
+
+func TestOptionChainExportAndDiff(t *testing.T) {
+	prev := &OptionChain{
+		Calls: []Option{
+			{ContractSymbol: "AAPL240621C00190000", Strike: 190, OpenInterest: 100, Volume: 10, ImpliedVolatility: 0.25, LastPrice: 5},
+			{ContractSymbol: "AAPL240621C00200000", Strike: 200, OpenInterest: 50, Volume: 5, ImpliedVolatility: 0.30, LastPrice: 2},
+		},
+		Puts: []Option{
+			{ContractSymbol: "AAPL240621P00180000", Strike: 180, OpenInterest: 70, Volume: 7, ImpliedVolatility: 0.28, LastPrice: 1},
+		},
+	}
+	cur := &OptionChain{
+		Calls: []Option{
+			{ContractSymbol: "AAPL240621C00190000", Strike: 190, OpenInterest: 150, Volume: 40, ImpliedVolatility: 0.27, LastPrice: 6, Expiration: 1718928000},
+			{ContractSymbol: "AAPL240621C00200000", Strike: 200, OpenInterest: 50, Volume: 5, ImpliedVolatility: 0.30, LastPrice: 2},
+			{ContractSymbol: "AAPL240621C00210000", Strike: 210, OpenInterest: 5, Volume: 5},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := cur.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV() error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected header + 3 rows, got %d lines", len(lines))
+	}
+	if !strings.HasPrefix(lines[1], "call,AAPL240621C00190000,190,,6,") || !strings.Contains(lines[1], "2024-06-21T00:00:00Z") {
+		t.Errorf("unexpected CSV row: %s", lines[1])
+	}
+
+	buf.Reset()
+	if err := prev.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON() error: %v", err)
+	}
+	loaded, err := ReadOptionChainJSON(&buf)
+	if err != nil {
+		t.Fatalf("ReadOptionChainJSON() error: %v", err)
+	}
+
+	diff := cur.Diff(loaded)
+	if len(diff.Changes) != 3 {
+		t.Fatalf("Expected 3 changes, got %d: %+v", len(diff.Changes), diff.Changes)
+	}
+
+	changed := diff.Changes[0]
+	if changed.Status != OptionChanged || changed.OpenInterestChange != 50 || changed.VolumeChange != 30 {
+		t.Errorf("unexpected changed contract: %+v", changed)
+	}
+	if math.Abs(changed.IVChange-0.02) > 1e-9 {
+		t.Errorf("Expected IV change 0.02, got %f", changed.IVChange)
+	}
+	if diff.Changes[1].Status != OptionAdded || diff.Changes[1].Strike != 210 {
+		t.Errorf("Expected added 210 call, got %+v", diff.Changes[1])
+	}
+	removed := diff.Changes[2]
+	if removed.Status != OptionRemoved || removed.Type != OptionTypePut || removed.OpenInterestChange != -70 {
+		t.Errorf("Expected removed put, got %+v", removed)
+	}
+}
+
+func TestSectorIndustryLookups(t *testing.T) {
+	keys := map[string]string{
+		"Software—Infrastructure":            "software-infrastructure",
+		"Oil & Gas E&P":                      "oil-gas-e-p",
+		"Furnishings, Fixtures & Appliances": "furnishings-fixtures-appliances",
+		"Basic Materials":                    "basic-materials",
+		"software-infrastructure":            "software-infrastructure",
+	}
+	for name, want := range keys {
+		if got := KeyFromName(name); got != want {
+			t.Errorf("KeyFromName(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	if name, ok := NameFromKey("software-infrastructure"); !ok || name != "Software—Infrastructure" {
+		t.Errorf("NameFromKey() = %q, %v", name, ok)
+	}
+	if name, ok := NameFromKey("real-estate"); !ok || name != "Real Estate" {
+		t.Errorf("NameFromKey(sector) = %q, %v", name, ok)
+	}
+	if _, ok := NameFromKey("no-such-industry"); ok {
+		t.Error("Expected unknown key to fail")
+	}
+
+	industries := IndustriesForSector("technology")
+	if len(industries) != len(SectorIndustryMapping["Technology"]) {
+		t.Fatalf("Expected %d technology industries, got %d", len(SectorIndustryMapping["Technology"]), len(industries))
+	}
+	if industries[0] != "Communication Equipment" {
+		t.Errorf("Expected sorted industries, got %v", industries)
+	}
+	if IndustriesForSector("Unknown") != nil {
+		t.Error("Expected nil for unknown sector")
+	}
+
+	for _, in := range []string{"Semiconductors", "semiconductors"} {
+		if sector, ok := SectorForIndustry(in); !ok || sector != "Technology" {
+			t.Errorf("SectorForIndustry(%q) = %q, %v", in, sector, ok)
+		}
+	}
+	if sector, ok := SectorForIndustry("banks-regional"); !ok || sector != "Financial Services" {
+		t.Errorf("SectorForIndustry(banks-regional) = %q, %v", sector, ok)
+	}
+
+	for _, s := range AllSectors() {
+		if _, ok := NameFromKey(string(s)); !ok {
+			t.Errorf("Predefined sector %q has no display name", s)
+		}
+	}
+}
+
+func TestScreenerQueryCloneAndAnd(t *testing.T) {
+	gt, _ := NewEquityQuery("gt", []any{"percentchange", 3})
+	region, _ := NewEquityQuery("eq", []any{"region", "us"})
+
+	combined, err := gt.And(region)
+	if err != nil {
+		t.Fatalf("And() error: %v", err)
+	}
+	if combined.Operator() != "AND" || len(combined.Operands()) != 2 {
+		t.Errorf("Expected AND of 2 operands, got %s", combined)
+	}
+
+	clone := combined.Clone()
+	if clone.String() != combined.String() {
+		t.Errorf("Expected equal clone, got %s", clone)
+	}
+	if clone.Operands()[0] == combined.Operands()[0] {
+		t.Error("Expected nested queries to be copied")
+	}
+
+	price, _ := NewEquityQuery("gte", []any{"intradayprice", 5})
+	flat, err := combined.And(price)
+	if err != nil {
+		t.Fatalf("And() error: %v", err)
+	}
+	if len(flat.Operands()) != 3 || len(combined.Operands()) != 2 {
+		t.Errorf("Expected flattened copy with 3 operands, got %d (original %d)", len(flat.Operands()), len(combined.Operands()))
+	}
+
+	if CloneQuery(nil) != nil {
+		t.Error("Expected nil to clone to nil")
+	}
+}
+
+func TestOptionChainParams(t *testing.T) {
+	if err := (OptionChainParams{CallsOnly: true, PutsOnly: true}).Validate(); err == nil {
+		t.Error("expected error for calls only and puts only")
+	}
+	if err := (OptionChainParams{MinStrike: 200, MaxStrike: 100}).Validate(); err == nil {
+		t.Error("expected error for inverted strike range")
+	}
+	if err := (OptionChainParams{MinStrike: 100}).Validate(); err != nil {
+		t.Errorf("unexpected error for open-ended range: %v", err)
+	}
+
+	chain := &OptionChain{
+		Calls: []Option{{Strike: 90}, {Strike: 100}, {Strike: 110}},
+		Puts:  []Option{{Strike: 95}, {Strike: 105}},
+	}
+
+	got := OptionChainParams{MinStrike: 100, MaxStrike: 105}.Filter(chain)
+	if len(got.Calls) != 1 || got.Calls[0].Strike != 100 {
+		t.Errorf("unexpected calls: %+v", got.Calls)
+	}
+	if len(got.Puts) != 1 || got.Puts[0].Strike != 105 {
+		t.Errorf("unexpected puts: %+v", got.Puts)
+	}
+
+	got = OptionChainParams{CallsOnly: true}.Filter(chain)
+	if len(got.Calls) != 3 || len(got.Puts) != 0 {
+		t.Errorf("calls only: got %d calls, %d puts", len(got.Calls), len(got.Puts))
+	}
+	if len(chain.Calls) != 3 || len(chain.Puts) != 2 {
+		t.Error("Filter should not modify the original chain")
+	}
+
+	if err := (OptionChainParams{InTheMoneyOnly: true, OutOfTheMoneyOnly: true}).Validate(); err == nil {
+		t.Error("expected error for in and out of the money only")
+	}
+	if err := (OptionChainParams{MinMoneyness: 1.2, MaxMoneyness: 0.8}).Validate(); err == nil {
+		t.Error("expected error for inverted moneyness range")
+	}
+
+	liquid := &OptionChain{
+		Underlying: &OptionQuote{RegularMarketPrice: 100},
+		Calls: []Option{
+			{Strike: 80, InTheMoney: true, OpenInterest: 500, Volume: 10},
+			{Strike: 95, InTheMoney: true, OpenInterest: 50, Volume: 10},
+			{Strike: 105, OpenInterest: 800, Volume: 0},
+			{Strike: 108, OpenInterest: 900, Volume: 30},
+		},
+	}
+	got = OptionChainParams{MinMoneyness: 0.9, MaxMoneyness: 1.1, MinOpenInterest: 100}.Filter(liquid)
+	if len(got.Calls) != 2 || got.Calls[0].Strike != 105 || got.Calls[1].Strike != 108 {
+		t.Errorf("moneyness and open interest: got %+v", got.Calls)
+	}
+	got = OptionChainParams{MinVolume: 1, OutOfTheMoneyOnly: true}.Filter(liquid)
+	if len(got.Calls) != 1 || got.Calls[0].Strike != 108 {
+		t.Errorf("volume and out of the money: got %+v", got.Calls)
+	}
+	got = OptionChainParams{InTheMoneyOnly: true, MaxMoneyness: 0.9}.Filter(liquid)
+	if len(got.Calls) != 1 || got.Calls[0].Strike != 80 {
+		t.Errorf("in the money: got %+v", got.Calls)
+	}
+	if got = (OptionChainParams{MaxMoneyness: 0.5}).Filter(chain); len(got.Calls) != 3 {
+		t.Errorf("moneyness without underlying should keep all, got %+v", got.Calls)
+	}
+}
+
+func TestRepairSummaryAdd(t *testing.T) {
+	var s RepairSummary
+	s.Add(RepairSummary{Repaired: 2, ByCategory: map[RepairCategory]int{RepairZeroes: 2}})
+	s.Add(RepairSummary{Repaired: 1, ByCategory: map[RepairCategory]int{RepairZeroes: 1, RepairSplits: 1}})
+	if s.Repaired != 3 || s.ByCategory[RepairZeroes] != 3 || s.ByCategory[RepairSplits] != 1 {
+		t.Errorf("summary = %+v", s)
+	}
+}
+
+func TestFinancialStatementConvertTo(t *testing.T) {
+	d1 := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	fs := &FinancialStatement{
+		Currency: "TWD",
+		Dates:    []time.Time{d1, d2},
+		Data: map[string][]FinancialItem{
+			"TotalRevenue": {
+				{AsOfDate: d1, CurrencyCode: "TWD", Value: 3000, Formatted: "3k"},
+				{AsOfDate: d2, Value: 4000},
+			},
+			"BasicAverageShares": {{AsOfDate: d2, CurrencyCode: "TWD", Value: 25}},
+			"NetIncome":          {{AsOfDate: d2, CurrencyCode: "USD", Value: 7}},
+		},
+	}
+
+	calls := 0
+	rate := func(from, to string, date time.Time) (float64, error) {
+		calls++
+		if from != "TWD" || to != "USD" {
+			t.Errorf("unexpected pair %s/%s", from, to)
+		}
+		if date.Equal(d1) {
+			return 0.03, nil
+		}
+		return 0.031, nil
+	}
+
+	usd, err := fs.ConvertTo("USD", rate)
+	if err != nil {
+		t.Fatalf("ConvertTo() error: %v", err)
+	}
+	if usd.Currency != "USD" || len(usd.Dates) != 2 {
+		t.Errorf("Currency = %q, Dates = %v", usd.Currency, usd.Dates)
+	}
+	if v, _ := usd.Get("TotalRevenue", d1); math.Abs(v-90) > 1e-9 {
+		t.Errorf("TotalRevenue %v = %v, want 90", d1, v)
+	}
+	if v, _ := usd.Get("TotalRevenue", d2); math.Abs(v-124) > 1e-9 {
+		t.Errorf("TotalRevenue %v = %v, want 124", d2, v)
+	}
+	if item := usd.Data["TotalRevenue"][0]; item.CurrencyCode != "USD" || item.Formatted != "" {
+		t.Errorf("converted item = %+v", item)
+	}
+	if v, _ := usd.Get("BasicAverageShares", d2); v != 25 {
+		t.Errorf("share count should not be converted, got %v", v)
+	}
+	if v, _ := usd.Get("NetIncome", d2); v != 7 {
+		t.Errorf("USD item should be kept, got %v", v)
+	}
+	if calls != 2 {
+		t.Errorf("expected one rate request per date, got %d", calls)
+	}
+	if v, _ := fs.Get("TotalRevenue", d1); v != 3000 {
+		t.Errorf("original statement modified: %v", v)
+	}
+
+	errRate := errors.New("no quote")
+	if _, err := fs.ConvertTo("USD", func(string, string, time.Time) (float64, error) { return 0, errRate }); !errors.Is(err, errRate) {
+		t.Errorf("expected rate error, got %v", err)
+	}
+}
+
+func TestFXRoute(t *testing.T) {
+	direct := FXRoute{From: "GBP", To: "USD", Pairs: []string{"GBPUSD=X"}}
+	if !direct.Direct() || direct.String() != "GBP->USD" {
+		t.Errorf("direct route = %v (direct %v)", direct, direct.Direct())
+	}
+	cross := FXRoute{From: "NOK", To: "KRW", Pairs: []string{"NOKUSD=X", "USDKRW=X"}}
+	if cross.Direct() || cross.String() != "NOK->USD->KRW" {
+		t.Errorf("cross route = %v (direct %v)", cross, cross.Direct())
+	}
+}
+
+func TestEPSTrendSeries(t *testing.T) {
+	tr := EPSTrend{
+		Period:     "0q",
+		Currency:   "USD",
+		Current:    1.60,
+		SevenDays:  1.57,
+		ThirtyDays: 1.50,
+		NinetyDays: 1.30,
+	}
+	asOf := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	s := tr.Series(asOf)
+	if s.Period != "0q" || s.Currency != "USD" {
+		t.Errorf("unexpected series header: %+v", s)
+	}
+	if len(s.Points) != 4 {
+		t.Fatalf("expected missing 60-day snapshot to be skipped, got %d points", len(s.Points))
+	}
+	first, last := s.Points[0], s.Points[3]
+	if first.DaysAgo != 90 || !first.Date.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)) || first.EPS != 1.30 {
+		t.Errorf("unexpected oldest point: %+v", first)
+	}
+	if last.DaysAgo != 0 || !last.Date.Equal(asOf) || last.EPS != 1.60 {
+		t.Errorf("unexpected current point: %+v", last)
+	}
+	if s.Slope <= 0 || s.Slope != tr.Slope() {
+		t.Errorf("expected positive slope matching Slope(), got %v", s.Slope)
+	}
+	if math.Abs(s.Change-0.3/1.3) > 1e-9 {
+		t.Errorf("expected change %.4f, got %.4f", 0.3/1.3, s.Change)
+	}
+
+	empty := EPSTrend{Period: "+1y", Current: 2}.Series(asOf)
+	if len(empty.Points) != 1 || empty.Slope != 0 || empty.Change != 0 {
+		t.Errorf("single snapshot should have no slope or change: %+v", empty)
+	}
+}
+
+func TestBarColumnsRoundTrip(t *testing.T) {
+	loc := time.FixedZone("EST", -5*3600)
+	bars := []Bar{
+		{Date: time.Date(2024, 1, 2, 9, 30, 0, 0, loc), Open: 1, High: 2, Low: 0.5, Close: 1.5, AdjClose: 1.4, Volume: 10, Dividends: 0.1},
+		{Date: time.Date(2024, 1, 3, 9, 30, 0, 0, loc), Open: 1.5, High: 3, Low: 1, Close: 2, AdjClose: 1.9, Volume: 20},
+	}
+
+	cols := ColumnsFromBars(bars)
+	if cols.Len() != 2 || cols.Close[1] != 2 || cols.Volume[0] != 10 {
+		t.Fatalf("unexpected columns: %+v", cols)
+	}
+	if cols.Location != loc {
+		t.Errorf("expected location %v, got %v", loc, cols.Location)
+	}
+
+	back := cols.Bars()
+	for i, b := range back {
+		if !b.Date.Equal(bars[i].Date) || b.Date.Location() != loc {
+			t.Errorf("bar %d: date %v, want %v", i, b.Date, bars[i].Date)
+		}
+		if b.Open != bars[i].Open || b.AdjClose != bars[i].AdjClose || b.Volume != bars[i].Volume {
+			t.Errorf("bar %d: got %+v", i, b)
+		}
+	}
+	if back[0].Dividends != 0 {
+		t.Errorf("expected dividends to be dropped, got %v", back[0].Dividends)
+	}
+
+	var appended BarColumns
+	for _, b := range bars {
+		appended.Append(b)
+	}
+	if appended.Len() != 2 || appended.Location != loc || appended.High[1] != 3 {
+		t.Errorf("unexpected appended columns: %+v", appended)
+	}
+	if got := (BarColumns{Time: []int64{0}, Open: []float64{0}, High: []float64{0}, Low: []float64{0}, Close: []float64{0}, AdjClose: []float64{0}, Volume: []int64{0}}).Date(0); got.Location() != time.UTC {
+		t.Errorf("expected nil location to mean UTC, got %v", got.Location())
+	}
+}
+
+func TestActionsParamsHistoryParams(t *testing.T) {
+	p := ActionsParams{}.HistoryParams()
+	if p.Period != "max" || p.Interval != "1d" || !p.Actions {
+		t.Errorf("unexpected defaults %+v", p)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	p = ActionsParams{Start: &start, Interval: "3mo"}.HistoryParams()
+	if p.Period != "" || p.Start != &start || p.Interval != "3mo" {
+		t.Errorf("unexpected range params %+v", p)
+	}
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+}
+
+func TestTradingPeriods(t *testing.T) {
+	period := func(start, end int64) string {
+		return fmt.Sprintf(`{"timezone":"EST","start":%d,"end":%d,"gmtoffset":-18000}`, start, end)
+	}
+	prePost := `{"pre":[[` + period(100, 200) + `],[` + period(1100, 1200) + `]],` +
+		`"regular":[[` + period(200, 300) + `],[` + period(1200, 1300) + `]],` +
+		`"post":[[` + period(300, 400) + `],[` + period(1300, 1400) + `]]}`
+
+	var meta ChartMeta
+	if err := json.Unmarshal([]byte(`{"tradingPeriods":`+prePost+`}`), &meta); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	p := meta.TradingPeriods
+	tests := []struct {
+		ts   int64
+		want Session
+	}{
+		{150, SessionPre}, {200, SessionRegular}, {299, SessionRegular},
+		{300, SessionPost}, {500, ""}, {1250, SessionRegular}, {1399, SessionPost}, {1400, ""},
+	}
+	for _, tt := range tests {
+		if got := p.SessionAt(tt.ts); got != tt.want {
+			t.Errorf("SessionAt(%d) = %q, want %q", tt.ts, got, tt.want)
+		}
+	}
+
+	var regular TradingPeriods
+	if err := json.Unmarshal([]byte(`[[`+period(200, 300)+`]]`), &regular); err != nil {
+		t.Fatalf("Unmarshal(regular) error: %v", err)
+	}
+	if regular.SessionAt(250) != SessionRegular || len(regular.Pre) != 0 {
+		t.Errorf("unexpected regular-only periods %+v", regular)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	var decoded TradingPeriods
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal(round trip) error: %v", err)
+	}
+	if len(decoded.Post) != 2 || decoded.SessionAt(150) != SessionPre {
+		t.Errorf("unexpected round trip %+v", decoded)
+	}
+
+	if (*TradingPeriods)(nil).SessionAt(250) != "" {
+		t.Error("expected nil periods to have no session")
+	}
+}
+
+func TestMergeAnalystTimeline(t *testing.T) {
+	asOf := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	trend := &RecommendationTrend{Trend: []Recommendation{
+		{Period: "0m", Buy: 10},
+		{Period: "-1m", Buy: 9},
+		{Period: "bad"},
+	}}
+	changes := []UpgradeDowngrade{
+		{Date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Firm: "A", Action: "up"},
+		{Date: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), Firm: "B", Action: "down"},
+	}
+
+	events := MergeAnalystTimeline(trend, changes, asOf)
+	if len(events) != 4 {
+		t.Fatalf("expected 4 events, got %d", len(events))
+	}
+	want := []struct {
+		date string
+		kind AnalystEventKind
+	}{
+		{"2024-01-20", AnalystEventRatingChange},
+		{"2024-02-01", AnalystEventTrend},
+		{"2024-03-01", AnalystEventTrend},
+		{"2024-03-01", AnalystEventRatingChange},
+	}
+	for i, w := range want {
+		if got := events[i].Date.Format("2006-01-02"); got != w.date || events[i].Kind != w.kind {
+			t.Errorf("event %d = %s %s, want %s %s", i, got, events[i].Kind, w.date, w.kind)
+		}
+	}
+	if events[1].Recommendation.Buy != 9 || events[3].Change.Firm != "A" {
+		t.Errorf("unexpected event payloads %+v", events)
+	}
+
+	if got := MergeAnalystTimeline(nil, nil, asOf); len(got) != 0 {
+		t.Errorf("expected no events, got %v", got)
+	}
+	if start, ok := (Recommendation{Period: "-3m"}).PeriodStart(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)); !ok || !start.Equal(time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("PeriodStart(-3m) = %v, %v", start, ok)
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // DownloadParams represents parameters for downloading multiple tickers.
 //
@@ -37,6 +40,14 @@ type DownloadParams struct {
 	// AutoAdjust adjusts OHLC for splits and dividends.
 	AutoAdjust bool
 
+	// BackAdjust adjusts Open/High/Low only when AutoAdjust is not set
+	// (see [AdjustBack]).
+	BackAdjust bool
+
+	// Currency converts prices into this currency using daily FX rates,
+	// so symbols listed in different currencies can be compared.
+	Currency string
+
 	// Threads is the number of concurrent downloads.
 	// 0 or 1 means sequential, >1 means parallel.
 	Threads int
@@ -92,3 +103,45 @@ func (r *MultiTickerResult) SuccessCount() int {
 func (r *MultiTickerResult) ErrorCount() int {
 	return len(r.Errors)
 }
+
+// CorrelationMatrix holds the pairwise correlations of the returns of a set
+// of symbols.
+//
+// Example:
+//
+//	m, _ := download.CorrelationMatrix([]string{"AAPL", "MSFT", "SPY"}, nil)
+//	rho, ok := m.Get("AAPL", "MSFT")
+type CorrelationMatrix struct {
+	// Symbols labels the rows and columns of Values, in request order.
+	Symbols []string `json:"symbols"`
+
+	// Values[i][j] is the correlation of Symbols[i] and Symbols[j], NaN
+	// when they share fewer than two returns.
+	Values [][]float64 `json:"values"`
+
+	// Observations[i][j] is the number of paired returns behind Values[i][j].
+	Observations [][]int `json:"observations"`
+
+	// Errors contains the symbols whose history could not be downloaded;
+	// they are left out of the matrix.
+	Errors map[string]error `json:"-"`
+}
+
+// Get returns the correlation of symbols a and b, reporting false if
+// either is not in the matrix.
+func (m *CorrelationMatrix) Get(a, b string) (float64, bool) {
+	i, j := m.index(a), m.index(b)
+	if i < 0 || j < 0 {
+		return 0, false
+	}
+	return m.Values[i][j], true
+}
+
+func (m *CorrelationMatrix) index(symbol string) int {
+	for i, s := range m.Symbols {
+		if strings.EqualFold(s, symbol) {
+			return i
+		}
+	}
+	return -1
+}
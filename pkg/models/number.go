@@ -0,0 +1,95 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/wnjoon/go-yfinance/internal/numeric"
+)
+
+// Number is a numeric field of a raw Yahoo response.
+//
+// Yahoo reports the same field as a JSON number, a numeric string such as
+// "1,234.5" or "2.5B", or a {"raw": ..., "fmt": ...} object depending on the
+// endpoint; Number decodes all of them. null and unparseable values decode
+// as missing, which reads as zero.
+//
+// Example:
+//
+//	var v struct {
+//	    MarketCap models.Number `json:"marketCap"`
+//	}
+//	json.Unmarshal([]byte(`{"marketCap": {"raw": 3.0e12, "fmt": "3T"}}`), &v)
+//	fmt.Println(v.MarketCap.Float64()) // 3e+12
+type Number struct {
+	value float64
+	valid bool
+}
+
+// NewNumber returns a present Number holding v.
+func NewNumber(v float64) Number {
+	return Number{value: v, valid: true}
+}
+
+// Float64 returns the value, or 0 if it is missing.
+func (n Number) Float64() float64 {
+	return n.value
+}
+
+// Int64 returns the value truncated to an integer, or 0 if it is missing.
+func (n Number) Int64() int64 {
+	return int64(n.value)
+}
+
+// Int returns the value truncated to an int, or 0 if it is missing.
+func (n Number) Int() int {
+	return int(n.value)
+}
+
+// Valid reports whether the response held a number.
+func (n Number) Valid() bool {
+	return n.valid
+}
+
+// Ptr returns a pointer to the value, or nil if it is missing.
+func (n Number) Ptr() *float64 {
+	if !n.valid {
+		return nil
+	}
+	v := n.value
+	return &v
+}
+
+// MarshalJSON encodes the value as a JSON number, or null if it is missing.
+func (n Number) MarshalJSON() ([]byte, error) {
+	if !n.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON decodes a number, numeric string or {"raw", "fmt"} object.
+// It never fails on a well-formed value; anything else decodes as missing.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	// Fast paths for plain numbers and null, which most fields hold
+	if len(data) > 0 && (data[0] == '-' || (data[0] >= '0' && data[0] <= '9')) {
+		if f, err := strconv.ParseFloat(string(data), 64); err == nil {
+			n.value, n.valid = numeric.Float(f)
+			return nil
+		}
+	}
+	if string(data) == "null" {
+		n.value, n.valid = 0, false
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	n.value, n.valid = numeric.Float(v)
+	return nil
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNumberUnmarshal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+		valid bool
+	}{
+		{`189.5`, 189.5, true},
+		{`"1,234.5"`, 1234.5, true},
+		{`{"raw": 0.0123, "fmt": "1.23%"}`, 0.0123, true},
+		{`{"fmt": "2.5B"}`, 2.5e9, true},
+		{`12345678901234`, 12345678901234, true},
+		{`null`, 0, false},
+		{`{}`, 0, false},
+		{`"N/A"`, 0, false},
+		{`true`, 0, false},
+	}
+
+	for _, tt := range tests {
+		var v struct {
+			N Number `json:"n"`
+		}
+		if err := json.Unmarshal([]byte(`{"n": `+tt.input+`}`), &v); err != nil {
+			t.Errorf("Unmarshal(%s) error: %v", tt.input, err)
+			continue
+		}
+		if v.N.Float64() != tt.want || v.N.Valid() != tt.valid {
+			t.Errorf("Unmarshal(%s) = %v (valid %v), want %v (valid %v)", tt.input, v.N.Float64(), v.N.Valid(), tt.want, tt.valid)
+		}
+	}
+
+	var missing struct {
+		N Number `json:"n"`
+	}
+	if err := json.Unmarshal([]byte(`{}`), &missing); err != nil || missing.N.Valid() || missing.N.Ptr() != nil {
+		t.Errorf("expected missing field to be invalid, got %+v (%v)", missing.N, err)
+	}
+}
+
+func TestNumberAccessors(t *testing.T) {
+	n := NewNumber(42.9)
+	if n.Int() != 42 || n.Int64() != 42 || *n.Ptr() != 42.9 {
+		t.Errorf("unexpected accessors for %v", n.Float64())
+	}
+
+	b, err := json.Marshal(struct {
+		A Number `json:"a"`
+		B Number `json:"b"`
+	}{A: n})
+	if err != nil || string(b) != `{"a":42.9,"b":null}` {
+		t.Errorf("Marshal() = %s, %v", b, err)
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // Option represents a single option contract (call or put).
 type Option struct {
@@ -58,6 +61,15 @@ type OptionQuote struct {
 	FiftyTwoWeekLow            float64 `json:"fiftyTwoWeekLow"`
 }
 
+// RegularMarketDatetime returns RegularMarketTime as a time.Time.
+// It returns the zero time when the timestamp is missing.
+func (q *OptionQuote) RegularMarketDatetime() time.Time {
+	if q.RegularMarketTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(q.RegularMarketTime, 0)
+}
+
 // OptionChain represents the complete option chain for a symbol.
 type OptionChain struct {
 	Calls      []Option     `json:"calls"`
@@ -66,12 +78,145 @@ type OptionChain struct {
 	Expiration time.Time    `json:"expiration"`
 }
 
+// OptionChainParams selects an option chain by expiry and filters its
+// contracts. The zero value selects the nearest expiration with all
+// contracts.
+//
+// Example:
+//
+//	params := models.OptionChainParams{
+//	    Expiry:          expirations[0],
+//	    MinMoneyness:    0.9,
+//	    MaxMoneyness:    1.1,
+//	    MinOpenInterest: 100,
+//	    CallsOnly:       true,
+//	}
+type OptionChainParams struct {
+	// Expiry is the expiration date, matched by calendar day against
+	// Ticker.Options. Zero selects the nearest expiration.
+	Expiry time.Time
+
+	// MinStrike and MaxStrike bound the strike range, inclusive. Zero
+	// leaves that side unbounded.
+	MinStrike float64
+	MaxStrike float64
+
+	// MinMoneyness and MaxMoneyness bound strike / underlying price,
+	// inclusive, so 0.9 and 1.1 keep strikes within 10% of the spot price.
+	// Zero leaves that side unbounded. They are ignored when the chain has
+	// no underlying price.
+	MinMoneyness float64
+	MaxMoneyness float64
+
+	// InTheMoneyOnly keeps contracts Yahoo flags as in the money;
+	// OutOfTheMoneyOnly keeps the others. They are exclusive.
+	InTheMoneyOnly    bool
+	OutOfTheMoneyOnly bool
+
+	// MinOpenInterest and MinVolume drop illiquid contracts. Zero keeps
+	// all.
+	MinOpenInterest int64
+	MinVolume       int64
+
+	// CallsOnly drops puts; PutsOnly drops calls. They are exclusive.
+	CallsOnly bool
+	PutsOnly  bool
+}
+
+// Validate checks the strike and moneyness ranges and the exclusive
+// selections.
+func (p OptionChainParams) Validate() error {
+	if p.CallsOnly && p.PutsOnly {
+		return fmt.Errorf("calls only and puts only are mutually exclusive")
+	}
+	if p.InTheMoneyOnly && p.OutOfTheMoneyOnly {
+		return fmt.Errorf("in the money only and out of the money only are mutually exclusive")
+	}
+	if p.MinStrike < 0 || p.MaxStrike < 0 {
+		return fmt.Errorf("strike bounds must not be negative")
+	}
+	if p.MaxStrike > 0 && p.MinStrike > p.MaxStrike {
+		return fmt.Errorf("min strike %g is above max strike %g", p.MinStrike, p.MaxStrike)
+	}
+	if p.MinMoneyness < 0 || p.MaxMoneyness < 0 {
+		return fmt.Errorf("moneyness bounds must not be negative")
+	}
+	if p.MaxMoneyness > 0 && p.MinMoneyness > p.MaxMoneyness {
+		return fmt.Errorf("min moneyness %g is above max moneyness %g", p.MinMoneyness, p.MaxMoneyness)
+	}
+	if p.MinOpenInterest < 0 || p.MinVolume < 0 {
+		return fmt.Errorf("minimum open interest and volume must not be negative")
+	}
+	return nil
+}
+
+// Filter returns a copy of c keeping only the contracts selected by p. The
+// underlying quote is shared with c.
+func (p OptionChainParams) Filter(c *OptionChain) *OptionChain {
+	spot := 0.0
+	if c.Underlying != nil {
+		spot = c.Underlying.RegularMarketPrice
+	}
+
+	keep := func(options []Option) []Option {
+		out := make([]Option, 0, len(options))
+		for _, o := range options {
+			if p.keep(o, spot) {
+				out = append(out, o)
+			}
+		}
+		return out
+	}
+
+	filtered := &OptionChain{
+		Calls:      []Option{},
+		Puts:       []Option{},
+		Underlying: c.Underlying,
+		Expiration: c.Expiration,
+	}
+	if !p.PutsOnly {
+		filtered.Calls = keep(c.Calls)
+	}
+	if !p.CallsOnly {
+		filtered.Puts = keep(c.Puts)
+	}
+	return filtered
+}
+
+// keep reports whether o passes the contract filters, given the underlying
+// price spot (0 if unknown).
+func (p OptionChainParams) keep(o Option, spot float64) bool {
+	if p.MinStrike > 0 && o.Strike < p.MinStrike {
+		return false
+	}
+	if p.MaxStrike > 0 && o.Strike > p.MaxStrike {
+		return false
+	}
+	if spot > 0 {
+		m := o.Strike / spot
+		if p.MinMoneyness > 0 && m < p.MinMoneyness {
+			return false
+		}
+		if p.MaxMoneyness > 0 && m > p.MaxMoneyness {
+			return false
+		}
+	}
+	if p.InTheMoneyOnly && !o.InTheMoney || p.OutOfTheMoneyOnly && o.InTheMoney {
+		return false
+	}
+	return o.OpenInterest >= p.MinOpenInterest && o.Volume >= p.MinVolume
+}
+
 // OptionsData holds all expiration dates and the current option chain.
 type OptionsData struct {
 	ExpirationDates []time.Time  `json:"expirationDates"`
 	Strikes         []float64    `json:"strikes"`
 	HasMiniOptions  bool         `json:"hasMiniOptions"`
 	OptionChain     *OptionChain `json:"optionChain,omitempty"`
+
+	// Chains holds the chain of every expiration, in the order of
+	// ExpirationDates, when filled by Ticker.AllOptionChains.
+	Chains []*OptionChain `json:"chains,omitempty"`
 }
 
 // OptionChainResponse represents the API response for options data.
@@ -0,0 +1,239 @@
+package models
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Option contract types used in exports and diffs.
+const (
+	OptionTypeCall = "call"
+	OptionTypePut  = "put"
+)
+
+// OptionChainCSVHeader is the column header written by [OptionChain.WriteCSV].
+var OptionChainCSVHeader = []string{
+	"Type", "Contract Symbol", "Strike", "Currency", "Last Price", "Change",
+	"Percent Change", "Volume", "Open Interest", "Bid", "Ask", "Contract Size",
+	"Expiration", "Last Trade Date", "Implied Volatility", "In The Money",
+}
+
+// WriteCSV writes calls followed by puts as CSV, including the header row.
+// Dates are written in RFC 3339.
+//
+// Example:
+//
+//	chain, _ := t.OptionChain("")
+//	f, _ := os.Create("aapl-options.csv")
+//	defer f.Close()
+//	err := chain.WriteCSV(f)
+func (c *OptionChain) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(OptionChainCSVHeader); err != nil {
+		return err
+	}
+
+	write := func(kind string, options []Option) error {
+		for _, o := range options {
+			row := []string{
+				kind,
+				o.ContractSymbol,
+				formatOptionFloat(o.Strike),
+				o.Currency,
+				formatOptionFloat(o.LastPrice),
+				formatOptionFloat(o.Change),
+				formatOptionFloat(o.PercentChange),
+				strconv.FormatInt(o.Volume, 10),
+				strconv.FormatInt(o.OpenInterest, 10),
+				formatOptionFloat(o.Bid),
+				formatOptionFloat(o.Ask),
+				o.ContractSize,
+				formatOptionTime(o.Expiration),
+				formatOptionTime(o.LastTradeDate),
+				formatOptionFloat(o.ImpliedVolatility),
+				strconv.FormatBool(o.InTheMoney),
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := write(OptionTypeCall, c.Calls); err != nil {
+		return err
+	}
+	if err := write(OptionTypePut, c.Puts); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the chain as indented JSON. The output can be read back
+// with [ReadOptionChainJSON], e.g. to diff against a later snapshot.
+func (c *OptionChain) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
+
+// ReadOptionChainJSON reads a chain written by [OptionChain.WriteJSON].
+func ReadOptionChainJSON(r io.Reader) (*OptionChain, error) {
+	var chain OptionChain
+	if err := json.NewDecoder(r).Decode(&chain); err != nil {
+		return nil, fmt.Errorf("failed to decode option chain: %w", err)
+	}
+	return &chain, nil
+}
+
+func formatOptionFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatOptionTime(sec int64) string {
+	if sec == 0 {
+		return ""
+	}
+	return time.Unix(sec, 0).UTC().Format(time.RFC3339)
+}
+
+// OptionChangeStatus describes how a contract differs between two snapshots.
+type OptionChangeStatus string
+
+const (
+	// OptionAdded means the contract is only in the newer snapshot.
+	OptionAdded OptionChangeStatus = "added"
+	// OptionRemoved means the contract is only in the older snapshot.
+	OptionRemoved OptionChangeStatus = "removed"
+	// OptionChanged means open interest, volume, IV or last price changed.
+	OptionChanged OptionChangeStatus = "changed"
+)
+
+// OptionContractChange is the change of a single contract between two
+// option chain snapshots. Prev values are zero for added contracts and
+// current values are zero for removed ones.
+type OptionContractChange struct {
+	ContractSymbol string             `json:"contractSymbol"`
+	Type           string             `json:"type"` // "call" or "put"
+	Strike         float64            `json:"strike"`
+	Status         OptionChangeStatus `json:"status"`
+
+	OpenInterest       int64 `json:"openInterest"`
+	PrevOpenInterest   int64 `json:"prevOpenInterest"`
+	OpenInterestChange int64 `json:"openInterestChange"`
+
+	Volume       int64 `json:"volume"`
+	PrevVolume   int64 `json:"prevVolume"`
+	VolumeChange int64 `json:"volumeChange"`
+
+	ImpliedVolatility     float64 `json:"impliedVolatility"`
+	PrevImpliedVolatility float64 `json:"prevImpliedVolatility"`
+	IVChange              float64 `json:"ivChange"`
+
+	LastPrice     float64 `json:"lastPrice"`
+	PrevLastPrice float64 `json:"prevLastPrice"`
+	PriceChange   float64 `json:"priceChange"`
+}
+
+// OptionChainDiff lists the contracts that changed between two snapshots of
+// the same option chain, calls first, then by strike.
+type OptionChainDiff struct {
+	Expiration time.Time              `json:"expiration"`
+	Changes    []OptionContractChange `json:"changes"`
+}
+
+// Diff compares the chain with an older snapshot prev and returns the
+// contracts whose open interest, volume, implied volatility or last price
+// changed, plus added and removed contracts. A nil prev reports every
+// contract as added.
+//
+// Example:
+//
+//	prev, _ := models.ReadOptionChainJSON(yesterdayFile)
+//	diff := chain.Diff(prev)
+//	for _, ch := range diff.Changes {
+//	    fmt.Printf("%s OI %+d IV %+.4f\n", ch.ContractSymbol, ch.OpenInterestChange, ch.IVChange)
+//	}
+func (c *OptionChain) Diff(prev *OptionChain) *OptionChainDiff {
+	diff := &OptionChainDiff{Expiration: c.Expiration}
+
+	var prevCalls, prevPuts []Option
+	if prev != nil {
+		prevCalls, prevPuts = prev.Calls, prev.Puts
+	}
+	diff.Changes = append(diff.Changes, diffOptions(OptionTypeCall, c.Calls, prevCalls)...)
+	diff.Changes = append(diff.Changes, diffOptions(OptionTypePut, c.Puts, prevPuts)...)
+
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		a, b := diff.Changes[i], diff.Changes[j]
+		if a.Type != b.Type {
+			return a.Type == OptionTypeCall
+		}
+		if a.Strike != b.Strike {
+			return a.Strike < b.Strike
+		}
+		return a.ContractSymbol < b.ContractSymbol
+	})
+
+	return diff
+}
+
+func diffOptions(kind string, current, prev []Option) []OptionContractChange {
+	prevBySymbol := make(map[string]Option, len(prev))
+	for _, o := range prev {
+		prevBySymbol[o.ContractSymbol] = o
+	}
+
+	var changes []OptionContractChange
+	seen := make(map[string]bool, len(current))
+	for _, o := range current {
+		seen[o.ContractSymbol] = true
+		p, ok := prevBySymbol[o.ContractSymbol]
+		if !ok {
+			changes = append(changes, newOptionChange(kind, OptionAdded, o, Option{}))
+			continue
+		}
+		if o.OpenInterest != p.OpenInterest || o.Volume != p.Volume ||
+			o.ImpliedVolatility != p.ImpliedVolatility || o.LastPrice != p.LastPrice {
+			changes = append(changes, newOptionChange(kind, OptionChanged, o, p))
+		}
+	}
+	for _, p := range prev {
+		if !seen[p.ContractSymbol] {
+			changes = append(changes, newOptionChange(kind, OptionRemoved, Option{}, p))
+		}
+	}
+	return changes
+}
+
+func newOptionChange(kind string, status OptionChangeStatus, cur, prev Option) OptionContractChange {
+	ref := cur
+	if status == OptionRemoved {
+		ref = prev
+	}
+	return OptionContractChange{
+		ContractSymbol:        ref.ContractSymbol,
+		Type:                  kind,
+		Strike:                ref.Strike,
+		Status:                status,
+		OpenInterest:          cur.OpenInterest,
+		PrevOpenInterest:      prev.OpenInterest,
+		OpenInterestChange:    cur.OpenInterest - prev.OpenInterest,
+		Volume:                cur.Volume,
+		PrevVolume:            prev.Volume,
+		VolumeChange:          cur.Volume - prev.Volume,
+		ImpliedVolatility:     cur.ImpliedVolatility,
+		PrevImpliedVolatility: prev.ImpliedVolatility,
+		IVChange:              cur.ImpliedVolatility - prev.ImpliedVolatility,
+		LastPrice:             cur.LastPrice,
+		PrevLastPrice:         prev.LastPrice,
+		PriceChange:           cur.LastPrice - prev.LastPrice,
+	}
+}
@@ -15,6 +15,7 @@ type Quote struct {
 	ExchangeName         string `json:"exchangeName"`
 	ExchangeTimezoneName string `json:"exchangeTimezoneName"`
 	Currency             string `json:"currency"`
+	FinancialCurrency    string `json:"financialCurrency,omitempty"`
 
 	// Regular market data
 	RegularMarketPrice         float64   `json:"regularMarketPrice"`
@@ -86,7 +87,65 @@ type Quote struct {
 	MarketState string `json:"marketState"` // PRE, REGULAR, POST, CLOSED
 }
 
+// LastTradeTime returns the time of the most recent trade for the current
+// market state: the pre- or post-market time during extended hours, and
+// RegularMarketTime otherwise.
+func (q *Quote) LastTradeTime() time.Time {
+	switch q.MarketState {
+	case "PRE", "PREPRE":
+		if !q.PreMarketTime.IsZero() && q.PreMarketTime.After(q.RegularMarketTime) {
+			return q.PreMarketTime
+		}
+	case "POST", "POSTPOST":
+		if !q.PostMarketTime.IsZero() && q.PostMarketTime.After(q.RegularMarketTime) {
+			return q.PostMarketTime
+		}
+	}
+	return q.RegularMarketTime
+}
+
+// Age returns how long ago the last trade happened. It returns 0 when the
+// quote has no trade time.
+func (q *Quote) Age() time.Duration {
+	return q.ageAt(time.Now())
+}
+
+// IsStale reports whether the quote is older than threshold while the market
+// is open. Quotes of a closed market are never stale, since no newer prices
+// exist; a quote without a trade time is always stale.
+//
+// Example:
+//
+//	if quote.IsStale(15 * time.Minute) {
+//	    log.Printf("%s quote is delayed by %s", quote.Symbol, quote.Age())
+//	}
+func (q *Quote) IsStale(threshold time.Duration) bool {
+	return q.isStaleAt(time.Now(), threshold)
+}
+
+func (q *Quote) ageAt(now time.Time) time.Duration {
+	last := q.LastTradeTime()
+	if last.IsZero() {
+		return 0
+	}
+	return now.Sub(last)
+}
+
+func (q *Quote) isStaleAt(now time.Time, threshold time.Duration) bool {
+	if q.LastTradeTime().IsZero() {
+		return true
+	}
+	switch q.MarketState {
+	case "CLOSED", "PREPRE", "POSTPOST":
+		return false
+	}
+	return q.ageAt(now) > threshold
+}
+
 // FastInfo represents a subset of quote data that can be fetched quickly.
+//
+// Price and volume statistics are derived from one year of daily bars;
+// MarketCap is Shares × LastPrice. YearChange is a fraction (0.1 = 10%).
 type FastInfo struct {
 	Currency                   string  `json:"currency"`
 	QuoteType                  string  `json:"quoteType"`
@@ -1,5 +1,7 @@
 package models
 
+import "encoding/json"
+
 // ChartResponse represents the response from Yahoo Finance chart API.
 type ChartResponse struct {
 	Chart struct {
@@ -70,6 +72,29 @@ type ChartAdjClose struct {
 	AdjClose []*float64 `json:"adjclose"`
 }
 
+// ChartRawResponse mirrors ChartResponse but keeps the exact text of each
+// price, for decimal parsing.
+type ChartRawResponse struct {
+	Chart struct {
+		Result []struct {
+			Indicators struct {
+				Quote    []ChartRawQuote `json:"quote"`
+				AdjClose []struct {
+					AdjClose []*json.Number `json:"adjclose"`
+				} `json:"adjclose,omitempty"`
+			} `json:"indicators"`
+		} `json:"result"`
+	} `json:"chart"`
+}
+
+// ChartRawQuote contains OHLC arrays as raw JSON numbers.
+type ChartRawQuote struct {
+	Open  []*json.Number `json:"open"`
+	High  []*json.Number `json:"high"`
+	Low   []*json.Number `json:"low"`
+	Close []*json.Number `json:"close"`
+}
+
 // QuoteSummaryResponse represents the response from quoteSummary API.
 type QuoteSummaryResponse struct {
 	QuoteSummary struct {
@@ -87,6 +112,7 @@ type QuoteSummaryResult struct {
 	QuoteType            map[string]interface{} `json:"quoteType,omitempty"`
 	Price                map[string]interface{} `json:"price,omitempty"`
 	CalendarEvents       map[string]interface{} `json:"calendarEvents,omitempty"`
+	EsgScores            map[string]interface{} `json:"esgScores,omitempty"`
 }
 
 // QuoteSummaryError represents an error from quoteSummary API.
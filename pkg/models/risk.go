@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// Beta is the historical beta of a symbol against a benchmark, computed from
+// aligned daily returns.
+type Beta struct {
+	// Symbol is the measured symbol.
+	Symbol string `json:"symbol"`
+
+	// Benchmark is the benchmark symbol (e.g. "^GSPC").
+	Benchmark string `json:"benchmark"`
+
+	// Period is the history period the returns cover (e.g. "1y").
+	Period string `json:"period"`
+
+	// Start and End are the first and last dates of the aligned series.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Observations is the number of paired daily returns used.
+	Observations int `json:"observations"`
+
+	// Beta is Cov(symbol, benchmark) / Var(benchmark).
+	Beta float64 `json:"beta"`
+
+	// Correlation is the Pearson correlation of the returns.
+	Correlation float64 `json:"correlation"`
+}
+
+// VolatilityPoint is the realized volatility of the window ending at Date.
+type VolatilityPoint struct {
+	Date  time.Time `json:"date"`
+	Value float64   `json:"value"`
+}
+
+// Volatility is the realized volatility of a symbol: the annualized
+// standard deviation of its daily log returns.
+type Volatility struct {
+	// Symbol is the measured symbol.
+	Symbol string `json:"symbol"`
+
+	// Period is the history period the returns cover (e.g. "1y").
+	Period string `json:"period"`
+
+	// Window is the rolling window in trading days, 0 when no series was
+	// requested.
+	Window int `json:"window,omitempty"`
+
+	// Observations is the number of daily returns used.
+	Observations int `json:"observations"`
+
+	// Annualized is the volatility over the whole period.
+	Annualized float64 `json:"annualized"`
+
+	// Series is the rolling annualized volatility, oldest first, one point
+	// per day once a full window is available.
+	Series []VolatilityPoint `json:"series,omitempty"`
+}
@@ -1,5 +1,7 @@
 package models
 
+import "encoding/json"
+
 // ScreenerResult represents the result from a stock screener query.
 //
 // Example:
@@ -26,6 +28,29 @@ type ScreenerResult struct {
 	Quotes []ScreenerQuote `json:"quotes"`
 }
 
+// ScreenerExplanation describes a custom screener query without fetching
+// its results. See screener.Screener.Explain.
+type ScreenerExplanation struct {
+	// QuoteType is the quote type sent with the query.
+	QuoteType string `json:"quoteType"`
+
+	// Body is the JSON request body that ScreenWithQuery would send.
+	Body json.RawMessage `json:"body"`
+
+	// Conditions is the number of leaf comparisons after IS-IN expansion.
+	Conditions int `json:"conditions"`
+
+	// Depth is the nesting depth of the query; a single comparison is 1.
+	Depth int `json:"depth"`
+
+	// Total is the number of matches reported by a count-only request.
+	Total int `json:"total"`
+
+	// Pages is the number of requests of the params' Count results
+	// ([MaxScreenerCount] if unset) needed to fetch every match.
+	Pages int `json:"pages"`
+}
+
 // ScreenerQuote represents a single stock from screener results.
 type ScreenerQuote struct {
 	// Symbol is the ticker symbol.
@@ -124,6 +149,26 @@ type ScreenerQuote struct {
 	// BookValue is the book value per share.
 	BookValue float64 `json:"bookValue,omitempty"`
 
+	// Enriched fields, filled by screener.Enrich
+
+	// PegRatio is the trailing PEG ratio.
+	PegRatio float64 `json:"pegRatio,omitempty"`
+
+	// ProfitMargins is the net profit margin as a fraction.
+	ProfitMargins float64 `json:"profitMargins,omitempty"`
+
+	// GrossMargins is the gross margin as a fraction.
+	GrossMargins float64 `json:"grossMargins,omitempty"`
+
+	// OperatingMargins is the operating margin as a fraction.
+	OperatingMargins float64 `json:"operatingMargins,omitempty"`
+
+	// EbitdaMargins is the EBITDA margin as a fraction.
+	EbitdaMargins float64 `json:"ebitdaMargins,omitempty"`
+
+	// ESG holds the ESG risk scores, nil when not fetched or not covered.
+	ESG *ESGScores `json:"esg,omitempty"`
+
 	// Fund-specific fields
 
 	// FundNetAssets is the fund's total net assets.
@@ -145,6 +190,27 @@ type ScreenerQuote struct {
 	AnnualReturnNavY1CategoryRank float64 `json:"annualReturnNavY1CategoryRank,omitempty"`
 }
 
+// EnrichField names a group of fundamental fields that screener.Enrich can
+// add to screener quotes.
+type EnrichField string
+
+const (
+	// EnrichPEG fills PegRatio.
+	EnrichPEG EnrichField = "peg"
+
+	// EnrichMargins fills ProfitMargins, GrossMargins, OperatingMargins and
+	// EbitdaMargins.
+	EnrichMargins EnrichField = "margins"
+
+	// EnrichESG fills ESG.
+	EnrichESG EnrichField = "esg"
+)
+
+// AllEnrichFields returns every field group supported by screener.Enrich.
+func AllEnrichFields() []EnrichField {
+	return []EnrichField{EnrichPEG, EnrichMargins, EnrichESG}
+}
+
 // PredefinedScreener represents a predefined screener query name.
 type PredefinedScreener string
 
@@ -200,12 +266,17 @@ func AllPredefinedScreeners() []PredefinedScreener {
 	}
 }
 
+// MaxScreenerCount is the largest number of results Yahoo returns for a
+// single screener request.
+const MaxScreenerCount = 250
+
 // ScreenerParams represents parameters for the Screen function.
 type ScreenerParams struct {
 	// Offset is the result offset for pagination (default 0).
 	Offset int
 
-	// Count is the number of results to return (default 25, max 250).
+	// Count is the number of results to return (default 25, max
+	// [MaxScreenerCount]).
 	Count int
 
 	// SortField is the field to sort by (default "ticker").
@@ -254,9 +325,9 @@ const (
 type ScreenerResponse struct {
 	Finance struct {
 		Result []struct {
-			Total  int              `json:"total"`
-			Count  int              `json:"count"`
-			Quotes []map[string]any `json:"quotes"`
+			Total  int                `json:"total"`
+			Count  int                `json:"count"`
+			Quotes []RawScreenerQuote `json:"quotes"`
 		} `json:"result"`
 		Error *struct {
 			Code        string `json:"code"`
@@ -264,3 +335,45 @@ type ScreenerResponse struct {
 		} `json:"error"`
 	} `json:"finance"`
 }
+
+// RawScreenerQuote is a quote in a raw screener response.
+type RawScreenerQuote struct {
+	Symbol                        string `json:"symbol"`
+	ShortName                     string `json:"shortName"`
+	LongName                      string `json:"longName"`
+	Exchange                      string `json:"exchange"`
+	FullExchangeName              string `json:"fullExchangeName"`
+	QuoteType                     string `json:"quoteType"`
+	Region                        string `json:"region"`
+	Sector                        string `json:"sector"`
+	Industry                      string `json:"industry"`
+	Currency                      string `json:"currency"`
+	MarketState                   string `json:"marketState"`
+	RegularMarketPrice            Number `json:"regularMarketPrice"`
+	RegularMarketChange           Number `json:"regularMarketChange"`
+	RegularMarketChangePercent    Number `json:"regularMarketChangePercent"`
+	RegularMarketVolume           Number `json:"regularMarketVolume"`
+	RegularMarketDayHigh          Number `json:"regularMarketDayHigh"`
+	RegularMarketDayLow           Number `json:"regularMarketDayLow"`
+	RegularMarketOpen             Number `json:"regularMarketOpen"`
+	RegularMarketPreviousClose    Number `json:"regularMarketPreviousClose"`
+	MarketCap                     Number `json:"marketCap"`
+	FiftyTwoWeekHigh              Number `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow               Number `json:"fiftyTwoWeekLow"`
+	FiftyTwoWeekChangePercent     Number `json:"fiftyTwoWeekChangePercent"`
+	FiftyDayAverage               Number `json:"fiftyDayAverage"`
+	TwoHundredDayAverage          Number `json:"twoHundredDayAverage"`
+	AverageDailyVolume3Month      Number `json:"averageDailyVolume3Month"`
+	TrailingPE                    Number `json:"trailingPE"`
+	ForwardPE                     Number `json:"forwardPE"`
+	PriceToBook                   Number `json:"priceToBook"`
+	DividendYield                 Number `json:"dividendYield"`
+	EpsTrailingTwelveMonths       Number `json:"epsTrailingTwelveMonths"`
+	BookValue                     Number `json:"bookValue"`
+	FundNetAssets                 Number `json:"fundNetAssets"`
+	CategoryName                  string `json:"categoryName"`
+	PerformanceRatingOverall      Number `json:"performanceRatingOverall"`
+	RiskRatingOverall             Number `json:"riskRatingOverall"`
+	InitialInvestment             Number `json:"initialInvestment"`
+	AnnualReturnNavY1CategoryRank Number `json:"annualReturnNavY1CategoryRank"`
+}
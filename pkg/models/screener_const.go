@@ -1,5 +1,10 @@
 package models
 
+import (
+	"sort"
+	"strings"
+)
+
 // SectorIndustryMapping maps sector names to their industries.
 // Matches Python's SECTOR_INDUSTY_MAPPING from yfinance/const.py.
 var SectorIndustryMapping = map[string][]string{
@@ -587,3 +592,63 @@ func regionCodes(exchangeMap map[string][]string) map[string]bool {
 	}
 	return result
 }
+
+// screenerSortOnlyFields lists fields Yahoo accepts as sortField that are not
+// valid query fields for the quote type.
+var screenerSortOnlyFields = map[string][]string{
+	"EQUITY":     {"ticker"},
+	"MUTUALFUND": {"ticker", "fundnetassets", "percentchange"},
+	"ETF":        {"ticker"},
+}
+
+// ValidSortFields returns the sorted list of sortField values accepted by the
+// screener for the given quote type ("EQUITY", "MUTUALFUND" or "ETF").
+// An empty quote type returns the fields valid for any quote type.
+//
+// Example:
+//
+//	for _, f := range models.ValidSortFields("EQUITY") {
+//	    fmt.Println(f)
+//	}
+func ValidSortFields(quoteType string) []string {
+	set := sortFieldSet(quoteType)
+	fields := make([]string, 0, len(set))
+	for f := range set {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// IsValidSortField reports whether field is a valid sortField for the quote type.
+// An empty quote type accepts fields valid for any quote type.
+func IsValidSortField(quoteType, field string) bool {
+	return sortFieldSet(quoteType)[field]
+}
+
+// sortFieldSet returns the set of valid sort fields for a quote type.
+func sortFieldSet(quoteType string) map[string]bool {
+	qt := strings.ToUpper(quoteType)
+	set := make(map[string]bool)
+	add := func(fields map[string]bool) {
+		for f := range fields {
+			set[f] = true
+		}
+	}
+
+	if qt == "EQUITY" || qt == "" {
+		add(allEquityValidFields())
+	}
+	if qt == "MUTUALFUND" || qt == "" {
+		add(allFundValidFields())
+	}
+	if qt == "ETF" || qt == "" {
+		add(allETFValidFields())
+	}
+	for t, fields := range screenerSortOnlyFields {
+		if qt == t || qt == "" {
+			add(sliceToSet(fields))
+		}
+	}
+	return set
+}
@@ -78,6 +78,69 @@ func NewETFQuery(operator string, operands []any) (*ETFQuery, error) {
 	return q, nil
 }
 
+// RegionExchanges returns the equity screener exchange codes of a region,
+// as listed in [EquityScreenerExchangeMap]. Region codes are
+// case-insensitive. Regions Yahoo lists without exchanges, such as "pe"
+// and "sr", return an error since they cannot be screened by exchange.
+//
+// Example:
+//
+//	codes, err := models.RegionExchanges("kr") // [KOE KSC]
+func RegionExchanges(region string) ([]string, error) {
+	codes, ok := EquityScreenerExchangeMap[strings.ToLower(region)]
+	if !ok {
+		return nil, fmt.Errorf("unknown region %q", region)
+	}
+	var out []string
+	for _, code := range codes {
+		if code != "" {
+			out = append(out, code)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("region %q has no screener exchanges", region)
+	}
+	return out, nil
+}
+
+// QueryForRegions returns an equity query matching every exchange of the given
+// regions, as listed in [EquityScreenerExchangeMap]. Region codes are
+// case-insensitive; an unknown region, or one without exchanges, returns an
+// error.
+//
+// Combine the result with other conditions using OpAND.
+//
+// Example:
+//
+//	q, err := models.QueryForRegions("de", "fr")
+//	// IS-IN exchange BER, DUS, EUX, FRA, ..., PAR
+func QueryForRegions(regions ...string) (*EquityQuery, error) {
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("at least one region is required")
+	}
+
+	seen := make(map[string]bool)
+	operands := []any{"exchange"}
+	for _, region := range regions {
+		codes, err := RegionExchanges(region)
+		if err != nil {
+			return nil, err
+		}
+		for _, code := range codes {
+			if seen[code] {
+				continue
+			}
+			seen[code] = true
+			operands = append(operands, code)
+		}
+	}
+
+	if len(operands) == 2 {
+		return NewEquityQuery(OpEQ, operands)
+	}
+	return NewEquityQuery(OpISIN, operands)
+}
+
 // QuoteType returns "EQUITY".
 func (q *EquityQuery) QuoteType() string { return "EQUITY" }
 
@@ -87,6 +150,150 @@ func (q *FundQuery) QuoteType() string { return "MUTUALFUND" }
 // QuoteType returns "ETF".
 func (q *ETFQuery) QuoteType() string { return "ETF" }
 
+// Operator returns the query operator, e.g. "AND" or "GT".
+func (q queryBase) Operator() string { return q.operator }
+
+// Operands returns a copy of the query operands. Nested queries are shared;
+// use [CloneQuery] for an independent copy.
+func (q queryBase) Operands() []any { return append([]any(nil), q.operands...) }
+
+// Clone returns a deep copy of the query.
+func (q *EquityQuery) Clone() *EquityQuery {
+	return &EquityQuery{q.queryBase.clone()}
+}
+
+// Clone returns a deep copy of the query.
+func (q *FundQuery) Clone() *FundQuery {
+	return &FundQuery{q.queryBase.clone()}
+}
+
+// Clone returns a deep copy of the query.
+func (q *ETFQuery) Clone() *ETFQuery {
+	return &ETFQuery{q.queryBase.clone()}
+}
+
+// And returns a new query requiring q and all conditions. When q is itself
+// an AND query, the conditions are appended to a copy of its operands. q is
+// not modified.
+//
+// Example:
+//
+//	pq, _ := screener.PredefinedQueryFor(models.ScreenerDayGainers)
+//	base := pq.Query.(*models.EquityQuery)
+//	tech, _ := models.NewEquityQuery("eq", []any{"sector", "Technology"})
+//	q, err := base.And(tech)
+func (q *EquityQuery) And(conditions ...*EquityQuery) (*EquityQuery, error) {
+	extra := make([]any, len(conditions))
+	for i, c := range conditions {
+		extra[i] = c
+	}
+	clone := q.Clone()
+	return NewEquityQuery(OpAND, andOperands(clone, clone.queryBase, extra))
+}
+
+// And returns a new query requiring q and all conditions. See
+// [EquityQuery.And].
+func (q *FundQuery) And(conditions ...*FundQuery) (*FundQuery, error) {
+	extra := make([]any, len(conditions))
+	for i, c := range conditions {
+		extra[i] = c
+	}
+	clone := q.Clone()
+	return NewFundQuery(OpAND, andOperands(clone, clone.queryBase, extra))
+}
+
+// And returns a new query requiring q and all conditions. See
+// [EquityQuery.And].
+func (q *ETFQuery) And(conditions ...*ETFQuery) (*ETFQuery, error) {
+	extra := make([]any, len(conditions))
+	for i, c := range conditions {
+		extra[i] = c
+	}
+	clone := q.Clone()
+	return NewETFQuery(OpAND, andOperands(clone, clone.queryBase, extra))
+}
+
+// CloneQuery returns a deep copy of an EquityQuery, FundQuery or ETFQuery.
+// Other implementations are returned as is.
+func CloneQuery(q ScreenerQueryBuilder) ScreenerQueryBuilder {
+	switch v := q.(type) {
+	case *EquityQuery:
+		return v.Clone()
+	case *FundQuery:
+		return v.Clone()
+	case *ETFQuery:
+		return v.Clone()
+	default:
+		return q
+	}
+}
+
+// ValidateQuery re-validates q and every nested query, and checks that
+// nested queries have the same quote type as q.
+//
+// Queries built with NewEquityQuery, NewFundQuery and NewETFQuery are
+// validated on construction; ValidateQuery additionally catches zero-value
+// queries and AND/OR queries that mix query types. Other implementations of
+// ScreenerQueryBuilder are accepted as is.
+func ValidateQuery(q ScreenerQueryBuilder) error {
+	if q == nil {
+		return fmt.Errorf("query is required")
+	}
+
+	var (
+		base queryBase
+		err  error
+	)
+	switch v := q.(type) {
+	case *EquityQuery:
+		base, err = v.queryBase, v.validate()
+	case *FundQuery:
+		base, err = v.queryBase, v.validate()
+	case *ETFQuery:
+		base, err = v.queryBase, v.validate()
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, o := range base.operands {
+		child, ok := o.(ScreenerQueryBuilder)
+		if !ok {
+			continue
+		}
+		if child.QuoteType() != q.QuoteType() {
+			return fmt.Errorf("operand %d: %s query nested in %s query", i, child.QuoteType(), q.QuoteType())
+		}
+		if err := ValidateQuery(child); err != nil {
+			return fmt.Errorf("operand %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (q queryBase) clone() queryBase {
+	operands := make([]any, len(q.operands))
+	for i, o := range q.operands {
+		if child, ok := o.(ScreenerQueryBuilder); ok {
+			operands[i] = CloneQuery(child)
+		} else {
+			operands[i] = o
+		}
+	}
+	return queryBase{operator: q.operator, operands: operands}
+}
+
+// andOperands returns the operands of q AND extra, flattening into q's
+// operands when q is already an AND query.
+func andOperands(q ScreenerQueryBuilder, base queryBase, extra []any) []any {
+	if base.operator == "AND" {
+		return append(base.Operands(), extra...)
+	}
+	return append([]any{q}, extra...)
+}
+
 // validFields returns the flattened set of valid field names for equity screener.
 func (q *EquityQuery) validFields() map[string]bool {
 	return allEquityValidFields()
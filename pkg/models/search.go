@@ -145,6 +145,17 @@ type SearchResearch struct {
 	PublishDate string `json:"publishDate,omitempty"`
 }
 
+// Report converts the search result to a [ResearchReport], the model shared
+// with sector and industry research reports.
+func (r SearchResearch) Report() ResearchReport {
+	return ResearchReport{
+		ID:          r.ReportID,
+		Title:       r.Title,
+		Provider:    r.Provider,
+		PublishDate: r.PublishDate,
+	}
+}
+
 // SearchNav represents a navigation link from search results.
 type SearchNav struct {
 	// Name is the navigation item name.
@@ -187,12 +198,133 @@ func DefaultSearchParams() SearchParams {
 	}
 }
 
+// MatchOptions tunes how search results are scored when resolving a company
+// name to a symbol.
+type MatchOptions struct {
+	// PreferredExchanges lists exchange codes (e.g. "NMS", "NYQ"), most
+	// preferred first. Listings on other exchanges are still considered.
+	PreferredExchanges []string
+
+	// QuoteTypes lists accepted quote types, most preferred first. Results of
+	// other types are discarded.
+	QuoteTypes []string
+
+	// MinNameScore is the lowest name similarity (0-1) accepted as a match.
+	MinNameScore float64
+}
+
+// DefaultMatchOptions returns options preferring US equity listings.
+func DefaultMatchOptions() MatchOptions {
+	return MatchOptions{
+		PreferredExchanges: []string{"NMS", "NYQ", "NGM", "NCM", "ASE"},
+		QuoteTypes:         []string{"EQUITY"},
+		MinNameScore:       0.6,
+	}
+}
+
+// SymbolMatch is a search quote chosen for a company name.
+type SymbolMatch struct {
+	// Quote is the matching search result.
+	Quote SearchQuote `json:"quote"`
+
+	// Score is the total match score (0-1).
+	Score float64 `json:"score"`
+
+	// NameScore is the similarity between the query and the quote's name (0-1).
+	NameScore float64 `json:"nameScore"`
+}
+
+// Listing is one venue on which a security, or a depositary receipt of
+// it, trades.
+type Listing struct {
+	// Symbol is the venue's ticker symbol (e.g. "7203.T", "TM").
+	Symbol string `json:"symbol"`
+
+	// Name is the listing's company name.
+	Name string `json:"name"`
+
+	// Exchange is the exchange code (e.g. "JPX", "NYQ").
+	Exchange string `json:"exchange"`
+
+	// ExchangeDisp is the display name of the exchange.
+	ExchangeDisp string `json:"exchDisp,omitempty"`
+
+	// QuoteType is the type of asset (EQUITY, ETF, ...).
+	QuoteType string `json:"quoteType"`
+
+	// Currency is the trading currency of the listing.
+	Currency string `json:"currency,omitempty"`
+
+	// FinancialCurrency is the currency the company reports in.
+	FinancialCurrency string `json:"financialCurrency,omitempty"`
+
+	// Price is the last regular market price.
+	Price float64 `json:"price,omitempty"`
+
+	// AverageVolume is the 3-month average daily volume, in shares.
+	AverageVolume int64 `json:"averageVolume,omitempty"`
+
+	// ISINMatch is true if the listing was returned for the queried ISIN.
+	ISINMatch bool `json:"isinMatch,omitempty"`
+
+	// IsADR is true for a US listing in USD of a company reporting in
+	// another currency, such as an American depositary receipt.
+	IsADR bool `json:"isADR,omitempty"`
+
+	// NameScore is the similarity between the listing's name and the
+	// security's name (0-1).
+	NameScore float64 `json:"nameScore"`
+}
+
 // SearchResponse represents the raw API response from Yahoo Finance search.
 type SearchResponse struct {
-	Quotes   []map[string]interface{} `json:"quotes"`
-	News     []map[string]interface{} `json:"news"`
-	Lists    []map[string]interface{} `json:"lists,omitempty"`
-	Research []map[string]interface{} `json:"researchReports,omitempty"`
-	Nav      []map[string]interface{} `json:"nav,omitempty"`
-	Count    int                      `json:"count,omitempty"`
+	Quotes   []RawSearchQuote    `json:"quotes"`
+	News     []RawSearchNews     `json:"news"`
+	Lists    []RawSearchList     `json:"lists,omitempty"`
+	Research []RawResearchReport `json:"researchReports,omitempty"`
+	Nav      []SearchNav         `json:"nav,omitempty"`
+	Count    int                 `json:"count,omitempty"`
+}
+
+// RawSearchQuote is a quote in a raw search response.
+type RawSearchQuote struct {
+	Symbol         string `json:"symbol"`
+	ShortName      string `json:"shortname"`
+	LongName       string `json:"longname"`
+	Exchange       string `json:"exchange"`
+	ExchangeDisp   string `json:"exchDisp"`
+	QuoteType      string `json:"quoteType"`
+	TypeDisp       string `json:"typeDisp"`
+	Score          Number `json:"score"`
+	IsYahooFinance bool   `json:"isYahooFinance"`
+	Industry       string `json:"industry"`
+	Sector         string `json:"sector"`
+}
+
+// RawSearchNews is a news article in a raw search response.
+type RawSearchNews struct {
+	UUID                string   `json:"uuid"`
+	Title               string   `json:"title"`
+	Publisher           string   `json:"publisher"`
+	Link                string   `json:"link"`
+	ProviderPublishTime Number   `json:"providerPublishTime"`
+	Type                string   `json:"type"`
+	RelatedTickers      []string `json:"relatedTickers"`
+	Thumbnail           *struct {
+		Resolutions []struct {
+			URL    string `json:"url"`
+			Width  Number `json:"width"`
+			Height Number `json:"height"`
+			Tag    string `json:"tag"`
+		} `json:"resolutions"`
+	} `json:"thumbnail"`
+}
+
+// RawSearchList is a curated list in a raw search response.
+type RawSearchList struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SymbolCount Number `json:"symbolCount"`
+	URL         string `json:"url"`
 }
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // SectorOverview contains overview information for a sector.
 type SectorOverview struct {
 	// CompaniesCount is the number of companies in the sector.
@@ -114,17 +119,110 @@ type SectorData struct {
 	ResearchReports []ResearchReport `json:"research_reports,omitempty"`
 }
 
+// CorporateActionKind identifies the type of a corporate action.
+type CorporateActionKind string
+
+const (
+	// CorporateActionDividend is a cash dividend.
+	CorporateActionDividend CorporateActionKind = "dividend"
+	// CorporateActionSplit is a stock split.
+	CorporateActionSplit CorporateActionKind = "split"
+)
+
+// CorporateAction is a dividend or split of a single company.
+type CorporateAction struct {
+	// Symbol is the company ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the company name.
+	Name string `json:"name,omitempty"`
+
+	// Kind is the type of action.
+	Kind CorporateActionKind `json:"kind"`
+
+	// Date is the ex-date of the action.
+	Date time.Time `json:"date"`
+
+	// Amount is the dividend per share (dividends only).
+	Amount float64 `json:"amount,omitempty"`
+
+	// Currency is the dividend currency, if reported.
+	Currency string `json:"currency,omitempty"`
+
+	// Ratio is the split ratio such as "4:1" (splits only).
+	Ratio string `json:"ratio,omitempty"`
+}
+
+// SectorActions is a sector-wide calendar of corporate actions of the
+// sector's top companies, sorted by date.
+type SectorActions struct {
+	// Sector is the sector key.
+	Sector string `json:"sector"`
+
+	// Since is the start of the covered period.
+	Since time.Time `json:"since"`
+
+	// Actions contains the dividends and splits, oldest first.
+	Actions []CorporateAction `json:"actions"`
+
+	// Errors maps symbols whose actions could not be fetched to the error.
+	Errors map[string]error `json:"-"`
+}
+
+// DomainShare is a company's weight within one sector or industry.
+type DomainShare struct {
+	// Key is the sector or industry key, e.g. "technology".
+	Key string `json:"key"`
+
+	// Name is the display name of the sector or industry.
+	Name string `json:"name,omitempty"`
+
+	// MarketCap is the total market capitalization of the sector or industry.
+	MarketCap float64 `json:"market_cap,omitempty"`
+
+	// Weight is the company's share of the total market capitalization (0.0-1.0).
+	Weight float64 `json:"weight"`
+
+	// TopCompany is true when Weight was taken from Yahoo's top companies
+	// list rather than computed from market caps.
+	TopCompany bool `json:"top_company"`
+}
+
+// DomainWeight is a company's market weight within its industry and sector.
+//
+// Example:
+//
+//	w, err := domain.WeightOf("NVDA")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%.1f%% of %s, %.1f%% of %s\n",
+//	    w.Industry.Weight*100, w.Industry.Name, w.Sector.Weight*100, w.Sector.Name)
+type DomainWeight struct {
+	// Symbol is the company's ticker symbol.
+	Symbol string `json:"symbol"`
+
+	// MarketCap is the company's market capitalization.
+	MarketCap int64 `json:"market_cap,omitempty"`
+
+	// Industry is the company's weight within its industry.
+	Industry DomainShare `json:"industry"`
+
+	// Sector is the company's weight within its sector.
+	Sector DomainShare `json:"sector"`
+}
+
 // SectorResponse represents the raw API response for sector data.
 type SectorResponse struct {
 	Data struct {
-		Name            string                   `json:"name"`
-		Symbol          string                   `json:"symbol"`
-		Overview        map[string]interface{}   `json:"overview"`
-		TopCompanies    []map[string]interface{} `json:"topCompanies"`
-		Industries      []map[string]interface{} `json:"industries"`
-		TopETFs         []map[string]interface{} `json:"topETFs"`
-		TopMutualFunds  []map[string]interface{} `json:"topMutualFunds"`
-		ResearchReports []map[string]interface{} `json:"researchReports"`
+		Name            string              `json:"name"`
+		Symbol          string              `json:"symbol"`
+		Overview        *RawDomainOverview  `json:"overview"`
+		TopCompanies    []RawDomainEntry    `json:"topCompanies"`
+		Industries      []RawDomainEntry    `json:"industries"`
+		TopETFs         []RawDomainEntry    `json:"topETFs"`
+		TopMutualFunds  []RawDomainEntry    `json:"topMutualFunds"`
+		ResearchReports []RawResearchReport `json:"researchReports"`
 	} `json:"data"`
 	Error *struct {
 		Code        string `json:"code"`
@@ -132,6 +230,48 @@ type SectorResponse struct {
 	} `json:"error,omitempty"`
 }
 
+// RawDomainOverview is the overview block of a raw sector or industry
+// response.
+type RawDomainOverview struct {
+	CompaniesCount  Number `json:"companiesCount"`
+	MarketCap       Number `json:"marketCap"`
+	MessageBoardID  string `json:"messageBoardId"`
+	Description     string `json:"description"`
+	IndustriesCount Number `json:"industriesCount"`
+	MarketWeight    Number `json:"marketWeight"`
+	EmployeeCount   Number `json:"employeeCount"`
+}
+
+// RawDomainEntry is a company, industry or fund listed in a raw sector or
+// industry response. Fields not reported for the kind of entry are empty.
+type RawDomainEntry struct {
+	Key            string `json:"key"`
+	Symbol         string `json:"symbol"`
+	Name           string `json:"name"`
+	Rating         string `json:"rating"`
+	MarketWeight   Number `json:"marketWeight"`
+	YTDReturn      Number `json:"ytdReturn"`
+	LastPrice      Number `json:"lastPrice"`
+	TargetPrice    Number `json:"targetPrice"`
+	GrowthEstimate Number `json:"growthEstimate"`
+}
+
+// RawResearchReport is a research report in a raw sector, industry or search
+// response. Search results use reportId, reportHeadline or reportTitle, and
+// a reportDate in Unix milliseconds or as a date string.
+type RawResearchReport struct {
+	ID             string          `json:"id"`
+	ReportID       string          `json:"reportId"`
+	Title          string          `json:"title"`
+	ReportHeadline string          `json:"reportHeadline"`
+	ReportTitle    string          `json:"reportTitle"`
+	Provider       string          `json:"provider"`
+	Ticker         string          `json:"ticker"`
+	PublishDate    string          `json:"publishDate"`
+	ReportDate     json.RawMessage `json:"reportDate"`
+	Summary        string          `json:"summary"`
+}
+
 // PredefinedSector represents commonly used sector identifiers.
 type PredefinedSector string
 
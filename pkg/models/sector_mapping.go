@@ -0,0 +1,86 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// KeyFromName converts a sector or industry display name to the key used by
+// Yahoo's sector and industry endpoints, e.g. "Software—Infrastructure" to
+// "software-infrastructure" and "Oil & Gas E&P" to "oil-gas-e-p". Keys are
+// returned unchanged.
+func KeyFromName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
+
+// NameFromKey returns the display name of a sector or industry key listed in
+// SectorIndustryMapping, e.g. "software-infrastructure" to
+// "Software—Infrastructure". Display names are accepted as well.
+//
+// Example:
+//
+//	name, ok := models.NameFromKey("banks-regional") // "Banks—Regional", true
+func NameFromKey(key string) (string, bool) {
+	key = KeyFromName(key)
+	if key == "" {
+		return "", false
+	}
+	for sector, industries := range SectorIndustryMapping {
+		if KeyFromName(sector) == key {
+			return sector, true
+		}
+		for _, industry := range industries {
+			if KeyFromName(industry) == key {
+				return industry, true
+			}
+		}
+	}
+	return "", false
+}
+
+// IndustriesForSector returns the industry display names of a sector, sorted
+// alphabetically. The sector may be given as a display name or key
+// ("Technology", "technology"). Returns nil for unknown sectors.
+//
+// Example:
+//
+//	for _, ind := range models.IndustriesForSector("technology") {
+//	    fmt.Println(ind, models.KeyFromName(ind))
+//	}
+func IndustriesForSector(name string) []string {
+	key := KeyFromName(name)
+	for sector, industries := range SectorIndustryMapping {
+		if KeyFromName(sector) == key {
+			out := append([]string(nil), industries...)
+			sort.Strings(out)
+			return out
+		}
+	}
+	return nil
+}
+
+// SectorForIndustry returns the display name of the sector containing an
+// industry, given as a display name or key.
+//
+// Example:
+//
+//	name, ok := models.SectorForIndustry("semiconductors") // "Technology", true
+//	s, _ := sector.New(models.KeyFromName(name))
+func SectorForIndustry(name string) (string, bool) {
+	key := KeyFromName(name)
+	if key == "" {
+		return "", false
+	}
+	for sector, industries := range SectorIndustryMapping {
+		for _, industry := range industries {
+			if KeyFromName(industry) == key {
+				return sector, true
+			}
+		}
+	}
+	return "", false
+}
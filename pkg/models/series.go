@@ -0,0 +1,178 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// UnmarshalJSON decodes the OHLCV arrays without an allocation per value,
+// which matters for long histories.
+func (q *ChartQuote) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Open   json.RawMessage `json:"open"`
+		High   json.RawMessage `json:"high"`
+		Low    json.RawMessage `json:"low"`
+		Close  json.RawMessage `json:"close"`
+		Volume json.RawMessage `json:"volume"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var out ChartQuote
+	var err error
+	if out.Open, err = decodeFloats(raw.Open); err != nil {
+		return err
+	}
+	if out.High, err = decodeFloats(raw.High); err != nil {
+		return err
+	}
+	if out.Low, err = decodeFloats(raw.Low); err != nil {
+		return err
+	}
+	if out.Close, err = decodeFloats(raw.Close); err != nil {
+		return err
+	}
+	if out.Volume, err = decodeInts(raw.Volume); err != nil {
+		return err
+	}
+	*q = out
+	return nil
+}
+
+// UnmarshalJSON decodes the adjusted closes like [ChartQuote.UnmarshalJSON].
+func (a *ChartAdjClose) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		AdjClose json.RawMessage `json:"adjclose"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	values, err := decodeFloats(raw.AdjClose)
+	if err != nil {
+		return err
+	}
+	a.AdjClose = values
+	return nil
+}
+
+// decodeFloats decodes a chart price array, with nil for null entries.
+//
+// The values share one backing array, so a series costs two allocations
+// however long it is, instead of one per value as with encoding/json.
+func decodeFloats(data []byte) ([]*float64, error) {
+	elems, ok := seriesElements(data)
+	if !ok {
+		if len(data) == 0 || isJSONNull(data) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid float series: expected array")
+	}
+
+	n := countSeriesElements(elems)
+	values := make([]float64, n)
+	out := make([]*float64, n)
+	i := 0
+	err := eachSeriesElement(elems, func(tok []byte) error {
+		defer func() { i++ }()
+		if isJSONNull(tok) {
+			return nil
+		}
+		// string(tok) of a short token does not escape and is not allocated
+		v, err := strconv.ParseFloat(string(tok), 64)
+		if err != nil {
+			return fmt.Errorf("invalid float series value %q", tok)
+		}
+		values[i] = v
+		out[i] = &values[i]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// decodeInts decodes a chart integer array such as volumes, with nil for
+// null entries. Fractional values are truncated. Like decodeFloats, the
+// values share one backing array.
+func decodeInts(data []byte) ([]*int64, error) {
+	elems, ok := seriesElements(data)
+	if !ok {
+		if len(data) == 0 || isJSONNull(data) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid int series: expected array")
+	}
+
+	n := countSeriesElements(elems)
+	values := make([]int64, n)
+	out := make([]*int64, n)
+	i := 0
+	err := eachSeriesElement(elems, func(tok []byte) error {
+		defer func() { i++ }()
+		if isJSONNull(tok) {
+			return nil
+		}
+		v, err := strconv.ParseInt(string(tok), 10, 64)
+		if err != nil {
+			f, ferr := strconv.ParseFloat(string(tok), 64)
+			if ferr != nil || f > math.MaxInt64 || f < math.MinInt64 {
+				return fmt.Errorf("invalid int series value %q", tok)
+			}
+			v = int64(f)
+		}
+		values[i] = v
+		out[i] = &values[i]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// seriesElements returns the bytes between the brackets of a JSON array.
+func seriesElements(data []byte) ([]byte, bool) {
+	data = bytes.TrimSpace(data)
+	if len(data) < 2 || data[0] != '[' || data[len(data)-1] != ']' {
+		return nil, false
+	}
+	return data[1 : len(data)-1], true
+}
+
+// countSeriesElements counts the elements of a flat array body.
+func countSeriesElements(elems []byte) int {
+	if len(bytes.TrimSpace(elems)) == 0 {
+		return 0
+	}
+	return bytes.Count(elems, []byte{','}) + 1
+}
+
+// eachSeriesElement calls fn with each trimmed element of a flat array body.
+func eachSeriesElement(elems []byte, fn func(tok []byte) error) error {
+	if len(bytes.TrimSpace(elems)) == 0 {
+		return nil
+	}
+	for {
+		i := bytes.IndexByte(elems, ',')
+		tok := elems
+		if i >= 0 {
+			tok = elems[:i]
+		}
+		if err := fn(bytes.TrimSpace(tok)); err != nil {
+			return err
+		}
+		if i < 0 {
+			return nil
+		}
+		elems = elems[i+1:]
+	}
+}
+
+func isJSONNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
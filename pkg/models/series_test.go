@@ -0,0 +1,75 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestChartQuoteUnmarshal(t *testing.T) {
+	var q ChartQuote
+	body := `{"open": [1.5, null ,2e2,-0.25], "volume": [1704067200, null, 12.9, 9007199254740993]}`
+	if err := json.Unmarshal([]byte(body), &q); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if len(q.Open) != 4 || *q.Open[0] != 1.5 || q.Open[1] != nil || *q.Open[2] != 200 || *q.Open[3] != -0.25 {
+		t.Fatalf("unexpected open %v", q.Open)
+	}
+	if len(q.Volume) != 4 || *q.Volume[0] != 1704067200 || q.Volume[1] != nil || *q.Volume[2] != 12 || *q.Volume[3] != 9007199254740993 {
+		t.Fatalf("unexpected volume %v", q.Volume)
+	}
+	if q.High != nil || q.Close != nil {
+		t.Errorf("expected absent arrays to stay nil")
+	}
+
+	for _, input := range []string{`{"close": []}`, `{"close": null}`} {
+		var empty ChartQuote
+		if err := json.Unmarshal([]byte(input), &empty); err != nil || len(empty.Close) != 0 {
+			t.Errorf("Unmarshal(%s) = %v, %v", input, empty.Close, err)
+		}
+	}
+	for _, input := range []string{`{"close": ["1"]}`, `{"close": {"a": 1}}`, `{"close": [true]}`} {
+		if err := json.Unmarshal([]byte(input), &q); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+	}
+}
+
+func TestChartResponseSeries(t *testing.T) {
+	body := `{"chart": {"result": [{"timestamp": [1, 2],
+		"indicators": {"quote": [{"open": [1.0, null], "close": [1.5, 2.5], "volume": [100, null]}],
+			"adjclose": [{"adjclose": [1.4, 2.4]}]}}]}}`
+	var resp ChartResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	r := resp.Chart.Result[0]
+	q := r.Indicators.Quote[0]
+	if len(r.Timestamp) != 2 || q.Open[1] != nil || *q.Close[1] != 2.5 || q.Volume[1] != nil || q.High != nil {
+		t.Errorf("unexpected chart %+v", r)
+	}
+	if *r.Indicators.AdjClose[0].AdjClose[0] != 1.4 {
+		t.Errorf("unexpected adjclose %v", r.Indicators.AdjClose)
+	}
+}
+
+func BenchmarkDecodeFloats(b *testing.B) {
+	values := make([]string, 10000)
+	for i := range values {
+		values[i] = strconv.FormatFloat(100+float64(i)*0.0123456789, 'f', -1, 64)
+		if i%500 == 0 {
+			values[i] = "null"
+		}
+	}
+	data := []byte("[" + strings.Join(values, ",") + "]")
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := decodeFloats(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Session is the trading session a bar falls in.
+type Session string
+
+const (
+	// SessionPre is pre-market trading.
+	SessionPre Session = "pre"
+
+	// SessionRegular is the regular trading session.
+	SessionRegular Session = "regular"
+
+	// SessionPost is post-market (after-hours) trading.
+	SessionPost Session = "post"
+)
+
+// TradingPeriod is one session of one trading day, as reported in the chart
+// meta. Start and End are Unix seconds; End is exclusive.
+type TradingPeriod struct {
+	Timezone  string `json:"timezone"`
+	Start     int64  `json:"start"`
+	End       int64  `json:"end"`
+	GMTOffset int    `json:"gmtoffset"`
+}
+
+// Contains reports whether the Unix timestamp ts falls within the period.
+func (p TradingPeriod) Contains(ts int64) bool {
+	return ts >= p.Start && ts < p.End
+}
+
+// TradingPeriods holds the daily trading periods of an intraday chart,
+// oldest day first.
+//
+// Yahoo sends an object of pre, regular and post periods when pre/post-market
+// data is requested and a bare list of regular periods otherwise; both
+// decode into TradingPeriods.
+type TradingPeriods struct {
+	Pre     []TradingPeriod `json:"pre,omitempty"`
+	Regular []TradingPeriod `json:"regular,omitempty"`
+	Post    []TradingPeriod `json:"post,omitempty"`
+}
+
+// SessionAt returns the session containing the Unix timestamp ts, or ""
+// if it falls outside every period.
+func (p *TradingPeriods) SessionAt(ts int64) Session {
+	if p == nil {
+		return ""
+	}
+	switch {
+	case periodsContain(p.Regular, ts):
+		return SessionRegular
+	case periodsContain(p.Pre, ts):
+		return SessionPre
+	case periodsContain(p.Post, ts):
+		return SessionPost
+	}
+	return ""
+}
+
+// UnmarshalJSON decodes either form of Yahoo's tradingPeriods, flattening
+// the per-day nesting. Flat lists, as encoded by encoding/json, decode too.
+func (p *TradingPeriods) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		*p = TradingPeriods{}
+		return nil
+	}
+
+	if regular, err := decodePeriods(data); err == nil {
+		*p = TradingPeriods{Regular: regular}
+		return nil
+	}
+
+	var aux struct {
+		Pre     json.RawMessage `json:"pre"`
+		Regular json.RawMessage `json:"regular"`
+		Post    json.RawMessage `json:"post"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return fmt.Errorf("invalid trading periods: %w", err)
+	}
+	var out TradingPeriods
+	for _, f := range []struct {
+		raw json.RawMessage
+		dst *[]TradingPeriod
+	}{{aux.Pre, &out.Pre}, {aux.Regular, &out.Regular}, {aux.Post, &out.Post}} {
+		if len(f.raw) == 0 {
+			continue
+		}
+		periods, err := decodePeriods(f.raw)
+		if err != nil {
+			return fmt.Errorf("invalid trading periods: %w", err)
+		}
+		*f.dst = periods
+	}
+	*p = out
+	return nil
+}
+
+// decodePeriods decodes a list of periods, either per day ([][]) or flat.
+func decodePeriods(data []byte) ([]TradingPeriod, error) {
+	var days [][]TradingPeriod
+	if err := json.Unmarshal(data, &days); err == nil {
+		var out []TradingPeriod
+		for _, day := range days {
+			out = append(out, day...)
+		}
+		return out, nil
+	}
+	var flat []TradingPeriod
+	if err := json.Unmarshal(data, &flat); err != nil {
+		return nil, err
+	}
+	return flat, nil
+}
+
+// periodsContain reports whether any of periods, which are sorted by
+// Start, contains ts.
+func periodsContain(periods []TradingPeriod, ts int64) bool {
+	i := sort.Search(len(periods), func(i int) bool {
+		return periods[i].End > ts
+	})
+	return i < len(periods) && periods[i].Contains(ts)
+}
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxRatioDecimals is the number of decimals kept when converting share
+// worths such as 1.5 into an exact ratio.
+const maxRatioDecimals = 6
+
+// SplitRatio is an exact stock split ratio of new shares to old shares,
+// reduced to lowest terms: a 3-for-2 split is 3:2 and a 1-for-10 reverse
+// split is 1:10. The zero value is an unknown ratio.
+//
+// It encodes to JSON as a "new:old" string.
+//
+// Example:
+//
+//	r, _ := models.NewSplitRatio(1.5, 1) // 3:2
+//	fmt.Println(r, r.Factor())        // 3:2 1.5
+//	r, _ = models.NewSplitRatio(1, 10)  // 1:10
+//	fmt.Println(r.IsReverse())         // true
+type SplitRatio struct {
+	// Numerator is the number of shares after the split.
+	Numerator int64 `json:"numerator"`
+
+	// Denominator is the number of shares before the split.
+	Denominator int64 `json:"denominator"`
+}
+
+// NewSplitRatio returns the ratio of newShares to oldShares in lowest terms.
+// Fractional values are kept to six decimals. ok is false if either value is
+// not positive.
+func NewSplitRatio(newShares, oldShares float64) (SplitRatio, bool) {
+	if !(newShares > 0) || !(oldShares > 0) || math.IsInf(newShares, 0) || math.IsInf(oldShares, 0) {
+		return SplitRatio{}, false
+	}
+
+	scale := 1.0
+	for i := 0; i < maxRatioDecimals; i++ {
+		if isWhole(newShares*scale) && isWhole(oldShares*scale) {
+			break
+		}
+		scale *= 10
+	}
+
+	num, den := math.Round(newShares*scale), math.Round(oldShares*scale)
+	if num < 1 || den < 1 || num > math.MaxInt64/2 || den > math.MaxInt64/2 {
+		return SplitRatio{}, false
+	}
+	return reducedRatio(int64(num), int64(den)), true
+}
+
+// ParseSplitRatio parses a ratio written as "new:old" or "new/old", e.g.
+// "3:2" or "1/10".
+func ParseSplitRatio(s string) (SplitRatio, error) {
+	sep := strings.IndexAny(s, ":/")
+	if sep < 0 {
+		return SplitRatio{}, fmt.Errorf("invalid split ratio %q", s)
+	}
+	num, err1 := strconv.ParseFloat(strings.TrimSpace(s[:sep]), 64)
+	den, err2 := strconv.ParseFloat(strings.TrimSpace(s[sep+1:]), 64)
+	if err1 != nil || err2 != nil {
+		return SplitRatio{}, fmt.Errorf("invalid split ratio %q", s)
+	}
+	r, ok := NewSplitRatio(num, den)
+	if !ok {
+		return SplitRatio{}, fmt.Errorf("invalid split ratio %q", s)
+	}
+	return r, nil
+}
+
+// Valid reports whether the ratio is known.
+func (r SplitRatio) Valid() bool {
+	return r.Numerator > 0 && r.Denominator > 0
+}
+
+// IsReverse reports whether the split reduces the share count.
+func (r SplitRatio) IsReverse() bool {
+	return r.Valid() && r.Numerator < r.Denominator
+}
+
+// Factor returns the multiplier applied to a share count, e.g. 1.5 for 3:2
+// and 0.1 for 1:10, or 0 if the ratio is unknown.
+func (r SplitRatio) Factor() float64 {
+	if !r.Valid() {
+		return 0
+	}
+	return float64(r.Numerator) / float64(r.Denominator)
+}
+
+// PriceFactor returns the multiplier applied to a pre-split price, the
+// inverse of [SplitRatio.Factor], or 0 if the ratio is unknown.
+func (r SplitRatio) PriceFactor() float64 {
+	if !r.Valid() {
+		return 0
+	}
+	return float64(r.Denominator) / float64(r.Numerator)
+}
+
+// String returns the ratio as "new:old", or "" if it is unknown.
+func (r SplitRatio) String() string {
+	if !r.Valid() {
+		return ""
+	}
+	return strconv.FormatInt(r.Numerator, 10) + ":" + strconv.FormatInt(r.Denominator, 10)
+}
+
+// MarshalJSON encodes the ratio as a "new:old" string, or null if unknown.
+func (r SplitRatio) MarshalJSON() ([]byte, error) {
+	if !r.Valid() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON decodes a "new:old" string or null.
+func (r *SplitRatio) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid split ratio: %w", err)
+	}
+	if s == nil || *s == "" {
+		*r = SplitRatio{}
+		return nil
+	}
+	parsed, err := ParseSplitRatio(*s)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+func reducedRatio(num, den int64) SplitRatio {
+	a, b := num, den
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return SplitRatio{Numerator: num / a, Denominator: den / a}
+}
+
+func isWhole(v float64) bool {
+	return math.Abs(v-math.Round(v)) < 1e-9*math.Max(1, math.Abs(v))
+}
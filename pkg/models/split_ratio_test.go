@@ -0,0 +1,72 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewSplitRatio(t *testing.T) {
+	tests := []struct {
+		newShares, oldShares float64
+		want                 string
+		reverse              bool
+		factor               float64
+	}{
+		{4, 1, "4:1", false, 4},
+		{3, 2, "3:2", false, 1.5},
+		{1.5, 1, "3:2", false, 1.5},
+		{1, 10, "1:10", true, 0.1},
+		{20, 5, "4:1", false, 4},
+		{1.25, 1, "5:4", false, 1.25},
+		{1, 1, "1:1", false, 1},
+	}
+	for _, tt := range tests {
+		r, ok := NewSplitRatio(tt.newShares, tt.oldShares)
+		if !ok || r.String() != tt.want || r.IsReverse() != tt.reverse || r.Factor() != tt.factor {
+			t.Errorf("NewSplitRatio(%v, %v) = %v (reverse %v, factor %v), want %s",
+				tt.newShares, tt.oldShares, r, r.IsReverse(), r.Factor(), tt.want)
+		}
+		if r.PriceFactor()*r.Factor() < 0.999999 || r.PriceFactor()*r.Factor() > 1.000001 {
+			t.Errorf("PriceFactor of %v is not the inverse of Factor", r)
+		}
+	}
+
+	for _, v := range [][2]float64{{0, 1}, {1, 0}, {-2, 1}} {
+		if r, ok := NewSplitRatio(v[0], v[1]); ok || r.Valid() || r.String() != "" || r.Factor() != 0 {
+			t.Errorf("NewSplitRatio(%v, %v) should be invalid, got %v", v[0], v[1], r)
+		}
+	}
+}
+
+func TestParseSplitRatio(t *testing.T) {
+	for in, want := range map[string]string{"3:2": "3:2", "1/10": "1:10", " 6 : 4 ": "3:2"} {
+		r, err := ParseSplitRatio(in)
+		if err != nil || r.String() != want {
+			t.Errorf("ParseSplitRatio(%q) = %v, %v; want %s", in, r, err, want)
+		}
+	}
+	for _, in := range []string{"", "4", "a:b", "0:1"} {
+		if _, err := ParseSplitRatio(in); err == nil {
+			t.Errorf("ParseSplitRatio(%q) should fail", in)
+		}
+	}
+}
+
+func TestSplitRatioJSON(t *testing.T) {
+	data, err := json.Marshal(CalendarSplitEvent{Symbol: "XYZ", Ratio: SplitRatio{Numerator: 1, Denominator: 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got CalendarSplitEvent
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Ratio.String() != "1:10" {
+		t.Errorf("round trip of %s gave %v", data, got.Ratio)
+	}
+
+	data, _ = json.Marshal(SplitRatio{})
+	if string(data) != "null" {
+		t.Errorf("expected null for unknown ratio, got %s", data)
+	}
+}
@@ -39,17 +39,39 @@
 //	// Download history for all
 //	result, _ := tickers.History(nil)
 //
+//	// Current quotes for all, batched into few requests
+//	quotes, _ := tickers.Quotes()
+//
+// # Retries
+//
+// Transient failures (network errors, timeouts, rate limits) are retried
+// per symbol using config MaxRetries and RetryDelay. A symbol that still
+// fails has a *client.RetryError in result.Errors describing every attempt:
+//
+//	var rerr *client.RetryError
+//	if errors.As(result.Errors["AAPL"], &rerr) {
+//	    fmt.Println(len(rerr.Attempts), rerr.TotalDelay())
+//	}
+//
+// # Cancellation
+//
+// [DownloadContext] and [Tickers.HistoryContext] stop dispatching symbols
+// once the context is done; the rest are reported with the context error.
+// Downloads run on [download.History], which validates params up front.
+//
 // # Thread Safety
 //
 // All multi package functions are safe for concurrent use.
 package multi
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
 
 	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/download"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 	"github.com/wnjoon/go-yfinance/pkg/ticker"
 )
@@ -70,7 +92,6 @@ type Option func(*Tickers)
 func WithClient(c *client.Client) Option {
 	return func(t *Tickers) {
 		t.client = c
-		t.ownsClient = false
 	}
 }
 
@@ -91,22 +112,22 @@ func NewTickers(symbols []string, opts ...Option) (*Tickers, error) {
 	}
 
 	t := &Tickers{
-		symbols:    make([]string, 0, len(symbols)),
-		tickers:    make(map[string]*ticker.Ticker),
-		ownsClient: true,
+		symbols: make([]string, 0, len(symbols)),
+		tickers: make(map[string]*ticker.Ticker),
 	}
 
 	for _, opt := range opts {
 		opt(t)
 	}
 
-	// Create shared client if not provided
+	// Use the shared default client if not provided
 	if t.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		t.client = c
+		t.ownsClient = true
 	}
 
 	// Normalize and store symbols
@@ -120,7 +141,6 @@ func NewTickers(symbols []string, opts ...Option) (*Tickers, error) {
 		// Create ticker with shared client
 		tkr, err := ticker.New(sym, ticker.WithClient(t.client))
 		if err != nil {
-			// Clean up already created tickers
 			t.Close()
 			return nil, fmt.Errorf("failed to create ticker for %s: %w", sym, err)
 		}
@@ -148,19 +168,15 @@ func NewTickersFromString(tickerStr string, opts ...Option) (*Tickers, error) {
 	return NewTickers(symbols, opts...)
 }
 
-// Close releases all resources used by Tickers.
+// Close releases the shared [client.Default] client if the Tickers
+// acquired it. A client passed with WithClient is left open.
 func (t *Tickers) Close() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	// Close all individual tickers (they don't own the client)
-	for _, tkr := range t.tickers {
-		tkr.Close()
-	}
-
-	// Close the shared client if we own it
-	if t.ownsClient && t.client != nil {
-		t.client.Close()
+	if t.ownsClient {
+		t.ownsClient = false
+		t.client.Release()
 	}
 }
 
@@ -187,6 +203,19 @@ func (t *Tickers) Count() int {
 	return len(t.symbols)
 }
 
+// Quotes fetches the current quotes of all tickers in batched requests.
+// Symbols Yahoo does not know are missing from the returned map.
+//
+// Example:
+//
+//	quotes, err := tickers.Quotes()
+//	for sym, q := range quotes {
+//	    fmt.Printf("%s %.2f %s\n", sym, q.RegularMarketPrice, q.MarketState)
+//	}
+func (t *Tickers) Quotes() (map[string]*models.Quote, error) {
+	return ticker.Quotes(t.Symbols(), ticker.WithClient(t.client))
+}
+
 // History downloads historical data for all tickers.
 //
 // Example:
@@ -196,12 +225,14 @@ func (t *Tickers) Count() int {
 //	    Interval: "1d",
 //	})
 func (t *Tickers) History(params *models.DownloadParams) (*models.MultiTickerResult, error) {
-	if params == nil {
-		defaultParams := models.DefaultDownloadParams()
-		params = &defaultParams
-	}
-	params.Symbols = t.Symbols()
-	return download(t.tickers, params)
+	return t.HistoryContext(context.Background(), params)
+}
+
+// HistoryContext is like History but stops dispatching symbols once ctx is
+// done; symbols not started are reported with the context error. It runs
+// [download.History] on the client of t.
+func (t *Tickers) HistoryContext(ctx context.Context, params *models.DownloadParams) (*models.MultiTickerResult, error) {
+	return download.History(ctx, t.Symbols(), params, download.WithClient(t.client))
 }
 
 // Download downloads historical data for all tickers with default parameters.
@@ -209,120 +240,6 @@ func (t *Tickers) Download() (*models.MultiTickerResult, error) {
 	return t.History(nil)
 }
 
-// download performs the actual download for multiple tickers.
-func download(tickers map[string]*ticker.Ticker, params *models.DownloadParams) (*models.MultiTickerResult, error) {
-	result := &models.MultiTickerResult{
-		Data:    make(map[string][]models.Bar),
-		Errors:  make(map[string]error),
-		Symbols: make([]string, 0),
-	}
-
-	if len(params.Symbols) == 0 {
-		return result, nil
-	}
-
-	// Build history params
-	histParams := models.HistoryParams{
-		Period:     params.Period,
-		Interval:   params.Interval,
-		PrePost:    params.PrePost,
-		AutoAdjust: params.AutoAdjust,
-	}
-
-	histParams.Start = params.Start
-	histParams.End = params.End
-
-	// Determine concurrency
-	threads := params.Threads
-	if threads <= 0 {
-		threads = 1 // Sequential
-	}
-
-	if threads == 1 {
-		// Sequential download
-		for _, symbol := range params.Symbols {
-			tkr := tickers[symbol]
-			if tkr == nil {
-				result.Errors[symbol] = fmt.Errorf("ticker not found: %s", symbol)
-				continue
-			}
-
-			bars, err := tkr.History(histParams)
-			if err != nil {
-				result.Errors[symbol] = err
-			} else {
-				result.Data[symbol] = bars
-				result.Symbols = append(result.Symbols, symbol)
-			}
-		}
-	} else {
-		// Parallel download with worker pool
-		type downloadResult struct {
-			symbol string
-			bars   []models.Bar
-			err    error
-		}
-
-		symbolChan := make(chan string, len(params.Symbols))
-		resultChan := make(chan downloadResult, len(params.Symbols))
-
-		// Start workers
-		var wg sync.WaitGroup
-		workerCount := threads
-		if workerCount > len(params.Symbols) {
-			workerCount = len(params.Symbols)
-		}
-
-		for i := 0; i < workerCount; i++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				for symbol := range symbolChan {
-					tkr := tickers[symbol]
-					if tkr == nil {
-						resultChan <- downloadResult{
-							symbol: symbol,
-							err:    fmt.Errorf("ticker not found: %s", symbol),
-						}
-						continue
-					}
-
-					bars, err := tkr.History(histParams)
-					resultChan <- downloadResult{
-						symbol: symbol,
-						bars:   bars,
-						err:    err,
-					}
-				}
-			}()
-		}
-
-		// Send symbols to workers
-		for _, symbol := range params.Symbols {
-			symbolChan <- symbol
-		}
-		close(symbolChan)
-
-		// Wait for workers to finish
-		go func() {
-			wg.Wait()
-			close(resultChan)
-		}()
-
-		// Collect results
-		for res := range resultChan {
-			if res.err != nil {
-				result.Errors[res.symbol] = res.err
-			} else {
-				result.Data[res.symbol] = res.bars
-				result.Symbols = append(result.Symbols, res.symbol)
-			}
-		}
-	}
-
-	return result, nil
-}
-
 // Download is a convenience function to download data for multiple tickers.
 //
 // Example:
@@ -335,13 +252,24 @@ func download(tickers map[string]*ticker.Ticker, params *models.DownloadParams)
 //	    fmt.Printf("%s: %d bars\n", symbol, len(bars))
 //	}
 func Download(symbols []string, params *models.DownloadParams) (*models.MultiTickerResult, error) {
+	return DownloadContext(context.Background(), symbols, params)
+}
+
+// DownloadContext is like Download but can be cancelled through ctx.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//	result, err := multi.DownloadContext(ctx, []string{"AAPL", "MSFT"}, nil)
+func DownloadContext(ctx context.Context, symbols []string, params *models.DownloadParams) (*models.MultiTickerResult, error) {
 	tickers, err := NewTickers(symbols)
 	if err != nil {
 		return nil, err
 	}
 	defer tickers.Close()
 
-	return tickers.History(params)
+	return tickers.HistoryContext(ctx, params)
 }
 
 // DownloadString is like Download but accepts a space/comma separated string.
@@ -1,6 +1,8 @@
 package multi
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/wnjoon/go-yfinance/pkg/models"
@@ -131,6 +133,33 @@ func TestDefaultDownloadParams(t *testing.T) {
 	}
 }
 
+func TestTickersHistoryContext(t *testing.T) {
+	tickers, err := NewTickers([]string{"AAPL", "MSFT"})
+	if err != nil {
+		t.Fatalf("Failed to create tickers: %v", err)
+	}
+	defer tickers.Close()
+
+	if _, err := tickers.History(&models.DownloadParams{Period: "1y", Interval: "bogus"}); err == nil {
+		t.Error("Expected invalid params to be rejected up front")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := tickers.HistoryContext(ctx, &models.DownloadParams{Period: "1mo", Interval: "1d", Threads: 2})
+	if err != nil {
+		t.Fatalf("HistoryContext() error: %v", err)
+	}
+	if len(result.Data) != 0 || len(result.Errors) != 2 {
+		t.Fatalf("Expected both symbols to fail, got %d bars sets and %v", len(result.Data), result.Errors)
+	}
+	for symbol, err := range result.Errors {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("%s: expected context.Canceled, got %v", symbol, err)
+		}
+	}
+}
+
 func TestMultiTickerResult(t *testing.T) {
 	result := &models.MultiTickerResult{
 		Data: map[string][]models.Bar{
@@ -124,7 +124,7 @@ func detectDoubleCount(bars []models.Bar, idx int, normalVolatility float64) boo
 	bar := bars[idx]
 	prevBar := bars[idx-1]
 
-	if prevBar.Close == 0 || bar.Close == 0 {
+	if invalidPrice(prevBar.Close) || invalidPrice(bar.Close) {
 		return false
 	}
 
@@ -170,7 +170,7 @@ func applyCapitalGainsRepair(bars []models.Bar, cgIndices []int) []models.Bar {
 		bar := &result[idx]
 		prevBar := result[idx-1]
 
-		if bar.Close == 0 || prevBar.Close == 0 || bar.AdjClose == 0 || prevBar.AdjClose == 0 {
+		if invalidPrice(bar.Close) || invalidPrice(prevBar.Close) || invalidPrice(bar.AdjClose) || invalidPrice(prevBar.AdjClose) {
 			continue
 		}
 
@@ -268,7 +268,7 @@ func fixMissingDivAdj(bars []models.Bar, divIdx int) []models.Bar {
 
 	div := result[divIdx].Dividends
 	prevClose := result[divIdx-1].Close
-	if prevClose == 0 {
+	if invalidPrice(prevClose) {
 		return result
 	}
 
@@ -24,6 +24,14 @@
 //	repairer := repair.New(opts)
 //	repairedBars, err := repairer.Repair(bars)
 //
+// # Repair Summary
+//
+// [Repairer.RepairWithSummary] also returns how many bars each repair
+// changed, for logging data quality:
+//
+//	bars, summary, err := repairer.RepairWithSummary(bars)
+//	fmt.Println(summary.Repaired, summary.ByCategory[models.RepairZeroes])
+//
 // # Repair Options
 //
 // Individual repair functions can be enabled/disabled:
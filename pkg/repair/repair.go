@@ -1,6 +1,8 @@
 package repair
 
 import (
+	"math"
+
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -68,41 +70,96 @@ func New(opts Options) *Repairer {
 //
 // Returns the repaired bars and any error encountered.
 func (r *Repairer) Repair(bars []models.Bar) ([]models.Bar, error) {
+	result, _, err := r.RepairWithSummary(bars)
+	return result, err
+}
+
+// RepairWithSummary is like [Repairer.Repair] and also reports how many
+// bars each repair changed.
+//
+// Example:
+//
+//	bars, summary, err := repairer.RepairWithSummary(bars)
+//	log.Printf("%d bars repaired: %v", summary.Repaired, summary.ByCategory)
+func (r *Repairer) RepairWithSummary(bars []models.Bar) ([]models.Bar, models.RepairSummary, error) {
+	var summary models.RepairSummary
 	if len(bars) == 0 {
-		return bars, nil
+		return bars, summary, nil
 	}
 
 	// Make a copy to avoid modifying the original
 	result := make([]models.Bar, len(bars))
 	copy(result, bars)
 
+	step := func(cat models.RepairCategory, fix func([]models.Bar) []models.Bar) {
+		before := make([]models.Bar, len(result))
+		copy(before, result)
+		result = fix(result)
+		if n := countChanged(before, result); n > 0 {
+			if summary.ByCategory == nil {
+				summary.ByCategory = make(map[models.RepairCategory]int)
+			}
+			summary.ByCategory[cat] += n
+		}
+	}
+
 	// Apply repairs in order (order matters!)
 	// 1. Dividend adjustments first
 	if r.opts.FixDividends {
-		result = r.repairDividends(result)
+		step(models.RepairDividends, r.repairDividends)
 	}
 
 	// 2. 100x unit errors
 	if r.opts.FixUnitMixups {
-		result = r.repairUnitMixups(result)
+		step(models.RepairUnitMixups, r.repairUnitMixups)
 	}
 
 	// 3. Stock split errors
 	if r.opts.FixSplits {
-		result = r.repairStockSplits(result)
+		step(models.RepairSplits, r.repairStockSplits)
 	}
 
 	// 4. Zero/missing values
 	if r.opts.FixZeroes {
-		result = r.repairZeroes(result)
+		step(models.RepairZeroes, r.repairZeroes)
 	}
 
 	// 5. Capital gains double-counting (only for ETF/MutualFund)
 	if r.opts.FixCapitalGains && r.isCapitalGainsApplicable() {
-		result = r.repairCapitalGains(result)
+		step(models.RepairCapitalGains, r.repairCapitalGains)
 	}
 
-	return result, nil
+	summary.Repaired = countChanged(bars, result)
+	return result, summary, nil
+}
+
+// countChanged counts the bars of after that differ from before. Bars are
+// matched by date, so repairs that drop or insert bars count only changes.
+func countChanged(before, after []models.Bar) int {
+	prev := make(map[int64]models.Bar, len(before))
+	for _, b := range before {
+		prev[b.Date.UnixNano()] = b
+	}
+	n := 0
+	for _, b := range after {
+		if old, ok := prev[b.Date.UnixNano()]; !ok || barChanged(old, b) {
+			n++
+		}
+	}
+	return n
+}
+
+// barChanged reports whether a repair changed the values of a bar.
+func barChanged(a, b models.Bar) bool {
+	return !sameValue(a.Open, b.Open) || !sameValue(a.High, b.High) ||
+		!sameValue(a.Low, b.Low) || !sameValue(a.Close, b.Close) ||
+		!sameValue(a.AdjClose, b.AdjClose) || a.Volume != b.Volume ||
+		!sameValue(a.Dividends, b.Dividends) || !sameValue(a.CapitalGains, b.CapitalGains)
+}
+
+// sameValue compares floats, treating NaN as equal to NaN.
+func sameValue(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
 }
 
 // isCapitalGainsApplicable returns true if capital gains repair should be applied.
@@ -0,0 +1,42 @@
+package repair
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestRepairWithSummary(t *testing.T) {
+	var bars []models.Bar
+	for i := 0; i < 10; i++ {
+		p := 100 + float64(i)
+		bars = append(bars, models.Bar{
+			Date: time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC),
+			Open: p, High: p + 2, Low: p - 2, Close: p + 1, AdjClose: p + 1, Volume: 1000,
+		})
+	}
+	bars[4].High = 0
+
+	opts := DefaultOptions()
+	opts.FixDividends, opts.FixUnitMixups, opts.FixSplits, opts.FixCapitalGains = false, false, false, false
+	repaired, summary, err := New(opts).RepairWithSummary(bars)
+	if err != nil {
+		t.Fatalf("RepairWithSummary() error: %v", err)
+	}
+
+	if repaired[4].High == 0 {
+		t.Fatal("zero High should be repaired")
+	}
+	if summary.Repaired != 1 || summary.ByCategory[models.RepairZeroes] != 1 || len(summary.ByCategory) != 1 {
+		t.Errorf("summary = %+v, want 1 zero repair", summary)
+	}
+	if bars[4].High != 0 {
+		t.Error("input bars should not be modified")
+	}
+
+	_, summary, _ = New(opts).RepairWithSummary(repaired)
+	if summary.Repaired != 0 || summary.ByCategory != nil {
+		t.Errorf("clean data summary = %+v, want empty", summary)
+	}
+}
@@ -167,7 +167,7 @@ func splitDateChange(bars []models.Bar, splitIdx int) float64 {
 	}
 	prevPrice := ohlcMedian(bars[splitIdx-1])
 	currPrice := ohlcMedian(bars[splitIdx])
-	if prevPrice == 0 {
+	if invalidPrice(prevPrice) {
 		return 0
 	}
 	return (currPrice - prevPrice) / prevPrice
@@ -272,7 +272,7 @@ func DetectBadSplits(bars []models.Bar) []int {
 		// Check if price change matches unadjusted split
 		prevPrice := ohlcMedian(bars[idx-1])
 		currPrice := ohlcMedian(bars[idx])
-		if prevPrice == 0 {
+		if invalidPrice(prevPrice) {
 			continue
 		}
 
@@ -93,6 +93,33 @@ func (r *Repairer) repairRandomUnitMixups(bars []models.Bar) []models.Bar {
 	return result
 }
 
+// UnitMixupCorrection returns the factor that fixes a 100x currency unit
+// error in price relative to a trusted reference price: 0.01 when price is
+// about 100x too high, 100 when it is about 100x too low, and 1 otherwise.
+//
+// The ratio is rounded to the nearest 20 before comparing with 100, so
+// genuine moves of a few percent never trigger it.
+//
+// Example:
+//
+//	repair.UnitMixupCorrection(15250, 152.3) // 0.01
+func UnitMixupCorrection(price, reference float64) float64 {
+	if price <= 0 || reference <= 0 {
+		return 1
+	}
+
+	ratio := price / reference
+	switch {
+	case math.Round(ratio/20)*20 == 100:
+		// Price is 100x too high, need to divide by 100
+		return 0.01
+	case math.Round((1/ratio)/20)*20 == 100:
+		// Price is 100x too low, need to multiply by 100
+		return 100
+	}
+	return 1
+}
+
 func ohlcMatrix(bars []models.Bar) [][]float64 {
 	data := make([][]float64, len(bars))
 	for i, bar := range bars {
@@ -122,7 +149,7 @@ func nonZeroMatrixRows(data [][]float64) []int {
 
 func rowHasZero(row []float64) bool {
 	for _, value := range row {
-		if value == 0 {
+		if value == 0 || math.IsNaN(value) {
 			return true
 		}
 	}
@@ -172,21 +199,8 @@ func detectAndCorrectMixups(data [][]float64) []float64 {
 				continue
 			}
 
-			ratio := data[i][j] / median[i][j]
-			ratioRcp := 1.0 / ratio
-
-			// Round ratio to nearest 20
-			ratioRounded := math.Round(ratio/20) * 20
-			ratioRcpRounded := math.Round(ratioRcp/20) * 20
-
-			// Check if ratio is ~100 (either direction)
-			if ratioRounded == 100 {
-				// Price is 100x too high, need to divide by 100
-				corrections[i] = 0.01
-				break
-			} else if ratioRcpRounded == 100 {
-				// Price is 100x too low, need to multiply by 100
-				corrections[i] = 100.0
+			if c := UnitMixupCorrection(data[i][j], median[i][j]); c != 1 {
+				corrections[i] = c
 				break
 			}
 		}
@@ -256,7 +270,7 @@ func (r *Repairer) fixPricesSuddenChange(bars []models.Bar, change float64) []mo
 	for i := 1; i < len(bars); i++ {
 		prev := ohlcMedian(result[i-1])
 		curr := ohlcMedian(result[i])
-		if prev == 0 {
+		if invalidPrice(prev) {
 			continue
 		}
 
@@ -274,3 +274,20 @@ func TestRepairRandomUnitMixupsWithZeroes(t *testing.T) {
 		t.Error("100x error should be repaired")
 	}
 }
+
+func TestUnitMixupCorrection(t *testing.T) {
+	tests := []struct {
+		price, reference, want float64
+	}{
+		{15230, 152.3, 0.01},
+		{1.523, 152.3, 100},
+		{160, 152.3, 1},
+		{0, 152.3, 1},
+		{152.3, 0, 1},
+	}
+	for _, tc := range tests {
+		if got := UnitMixupCorrection(tc.price, tc.reference); got != tc.want {
+			t.Errorf("UnitMixupCorrection(%v, %v) = %v, want %v", tc.price, tc.reference, got, tc.want)
+		}
+	}
+}
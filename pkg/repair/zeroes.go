@@ -254,7 +254,7 @@ func repairVolumeZeroes(bars []models.Bar) []models.Bar {
 		}
 
 		// Check if price changed significantly
-		if prevBar.Close == 0 {
+		if invalidPrice(prevBar.Close) {
 			continue
 		}
 
@@ -0,0 +1,316 @@
+package screener
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
+)
+
+// BreadthSource is a named screener whose total match count is recorded by
+// a [BreadthSnapshotter]. Exactly one of Predefined or Query should be set.
+type BreadthSource struct {
+	// Name is the key under which the count is stored (e.g. "advancers").
+	Name string
+
+	// Predefined is a predefined screener to run.
+	Predefined models.PredefinedScreener
+
+	// Query is a custom screener query to run.
+	Query models.ScreenerQueryBuilder
+}
+
+// BreadthSnapshot holds the match counts of all sources at a point in time.
+type BreadthSnapshot struct {
+	// Time is when the snapshot was taken.
+	Time time.Time `json:"time"`
+
+	// Counts maps source name to the total number of matching symbols.
+	Counts map[string]int `json:"counts"`
+
+	// Errors maps source name to the error encountered, if any.
+	Errors map[string]error `json:"-"`
+}
+
+// Ratio returns Counts[a] / Counts[b], or 0 if either count is missing or b is zero.
+//
+// Example:
+//
+//	adRatio := snap.Ratio("advancers", "decliners")
+func (s *BreadthSnapshot) Ratio(a, b string) float64 {
+	num, ok1 := s.Counts[a]
+	den, ok2 := s.Counts[b]
+	if !ok1 || !ok2 || den == 0 {
+		return 0
+	}
+	return float64(num) / float64(den)
+}
+
+// Net returns Counts[a] - Counts[b] (e.g. advancers minus decliners).
+func (s *BreadthSnapshot) Net(a, b string) int {
+	return s.Counts[a] - s.Counts[b]
+}
+
+// AdvancersQuery returns an equity query matching stocks in the region that
+// are up on the day.
+func AdvancersQuery(region string) (*models.EquityQuery, error) {
+	return breadthQuery(region, models.OpGT)
+}
+
+// DeclinersQuery returns an equity query matching stocks in the region that are
+// down on the day.
+func DeclinersQuery(region string) (*models.EquityQuery, error) {
+	return breadthQuery(region, models.OpLT)
+}
+
+func breadthQuery(region, op string) (*models.EquityQuery, error) {
+	regionQ, err := models.NewEquityQuery(models.OpEQ, []any{"region", region})
+	if err != nil {
+		return nil, err
+	}
+	changeQ, err := models.NewEquityQuery(op, []any{"percentchange", 0})
+	if err != nil {
+		return nil, err
+	}
+	return models.NewEquityQuery(models.OpAND, []any{regionQ, changeQ})
+}
+
+// DefaultBreadthSources returns advancers, decliners and day gainers sources
+// for the given region (e.g. "us").
+func DefaultBreadthSources(region string) ([]BreadthSource, error) {
+	adv, err := AdvancersQuery(region)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := DeclinersQuery(region)
+	if err != nil {
+		return nil, err
+	}
+	return []BreadthSource{
+		{Name: "advancers", Query: adv},
+		{Name: "decliners", Query: dec},
+		{Name: "day_gainers", Predefined: models.ScreenerDayGainers},
+	}, nil
+}
+
+// BreadthOption is a function that configures a BreadthSnapshotter.
+type BreadthOption func(*BreadthSnapshotter)
+
+// WithMaxHistory limits the number of snapshots kept in memory (default 1000).
+// Zero or a negative value keeps all snapshots.
+func WithMaxHistory(n int) BreadthOption {
+	return func(b *BreadthSnapshotter) {
+		b.maxHistory = n
+	}
+}
+
+// WithSnapshotHandler sets a callback invoked after every snapshot.
+func WithSnapshotHandler(handler func(BreadthSnapshot)) BreadthOption {
+	return func(b *BreadthSnapshotter) {
+		b.onSnapshot = handler
+	}
+}
+
+// WithBreadthStore saves every snapshot to st under [BreadthStoreKey], so
+// the history outlives the process and [WithMaxHistory]. Load it with
+// [LoadBreadthHistory].
+func WithBreadthStore(st store.Store) BreadthOption {
+	return func(b *BreadthSnapshotter) {
+		b.store = st
+	}
+}
+
+// BreadthSnapshotter periodically runs a set of screeners and stores their
+// match counts over time, providing simple market-breadth analytics.
+//
+// Example:
+//
+//	s, _ := screener.New()
+//	defer s.Close()
+//
+//	sources, _ := screener.DefaultBreadthSources("us")
+//	b := screener.NewBreadthSnapshotter(s, sources)
+//	if err := b.Start(5 * time.Minute); err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer b.Stop()
+//
+//	for _, snap := range b.History() {
+//	    fmt.Printf("%s A/D: %.2f\n", snap.Time.Format(time.Kitchen), snap.Ratio("advancers", "decliners"))
+//	}
+type BreadthSnapshotter struct {
+	sources    []BreadthSource
+	maxHistory int
+	onSnapshot func(BreadthSnapshot)
+	store      store.Store
+
+	// count returns the total matches for a source; overridable in tests.
+	count func(BreadthSource) (int, error)
+
+	mu      sync.RWMutex
+	history []BreadthSnapshot
+	done    chan struct{}
+	running bool
+}
+
+// NewBreadthSnapshotter creates a snapshotter that runs sources with s.
+func NewBreadthSnapshotter(s *Screener, sources []BreadthSource, opts ...BreadthOption) *BreadthSnapshotter {
+	b := &BreadthSnapshotter{
+		sources:    sources,
+		maxHistory: 1000,
+	}
+	b.count = func(src BreadthSource) (int, error) {
+		return s.countMatches(src)
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// countMatches runs the source with a minimal page size and returns the total.
+func (s *Screener) countMatches(src BreadthSource) (int, error) {
+	params := models.DefaultScreenerParams()
+	params.Count = 1
+
+	var (
+		result *models.ScreenerResult
+		err    error
+	)
+	switch {
+	case src.Query != nil:
+		result, err = s.ScreenWithQuery(src.Query, &params)
+	case src.Predefined != "":
+		params.SortField = ""
+		result, err = s.Screen(src.Predefined, &params)
+	default:
+		return 0, fmt.Errorf("breadth source %q has no screener", src.Name)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
+
+// Snapshot runs every source once, records the counts and returns the snapshot.
+// Sources that fail are reported in Errors and omitted from Counts; an error
+// is returned only when every source failed, or together with the snapshot
+// when saving it to the store failed.
+func (b *BreadthSnapshotter) Snapshot() (*BreadthSnapshot, error) {
+	snap := BreadthSnapshot{
+		Time:   time.Now(),
+		Counts: make(map[string]int, len(b.sources)),
+		Errors: make(map[string]error),
+	}
+
+	var lastErr error
+	for _, src := range b.sources {
+		n, err := b.count(src)
+		if err != nil {
+			snap.Errors[src.Name] = err
+			lastErr = err
+			continue
+		}
+		snap.Counts[src.Name] = n
+	}
+
+	if len(b.sources) > 0 && len(snap.Counts) == 0 {
+		return nil, fmt.Errorf("all breadth sources failed: %w", lastErr)
+	}
+
+	b.mu.Lock()
+	b.history = append(b.history, snap)
+	if b.maxHistory > 0 && len(b.history) > b.maxHistory {
+		b.history = b.history[len(b.history)-b.maxHistory:]
+	}
+	handler := b.onSnapshot
+	b.mu.Unlock()
+
+	if handler != nil {
+		handler(snap)
+	}
+
+	if b.store != nil {
+		if err := store.PutJSON(b.store, BreadthStoreKey, snap.Time, snap); err != nil {
+			return &snap, fmt.Errorf("failed to save breadth snapshot: %w", err)
+		}
+	}
+
+	return &snap, nil
+}
+
+// Start takes a snapshot immediately and then every interval until Stop is called.
+// Calling Start on a running snapshotter is a no-op. The interval must be
+// positive.
+func (b *BreadthSnapshotter) Start(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("snapshot interval must be positive, got %s", interval)
+	}
+
+	b.mu.Lock()
+	if b.running {
+		b.mu.Unlock()
+		return nil
+	}
+	b.running = true
+	b.done = make(chan struct{})
+	done := b.done
+	b.mu.Unlock()
+
+	go func() {
+		_, _ = b.Snapshot()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_, _ = b.Snapshot()
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops periodic snapshots started with Start.
+func (b *BreadthSnapshotter) Stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.running {
+		return
+	}
+	close(b.done)
+	b.running = false
+}
+
+// History returns a copy of all recorded snapshots, oldest first.
+func (b *BreadthSnapshotter) History() []BreadthSnapshot {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	out := make([]BreadthSnapshot, len(b.history))
+	copy(out, b.history)
+	return out
+}
+
+// Series returns the recorded counts of one source over time, oldest first.
+// Snapshots in which the source failed are skipped.
+func (b *BreadthSnapshotter) Series(name string) ([]time.Time, []int) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	times := make([]time.Time, 0, len(b.history))
+	counts := make([]int, 0, len(b.history))
+	for _, snap := range b.history {
+		if n, ok := snap.Counts[name]; ok {
+			times = append(times, snap.Time)
+			counts = append(counts, n)
+		}
+	}
+	return times, counts
+}
@@ -0,0 +1,98 @@
+package screener
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestDefaultBreadthSources(t *testing.T) {
+	sources, err := DefaultBreadthSources("us")
+	if err != nil {
+		t.Fatalf("DefaultBreadthSources() error: %v", err)
+	}
+	if len(sources) != 3 {
+		t.Fatalf("expected 3 sources, got %d", len(sources))
+	}
+	if sources[0].Query == nil || sources[0].Query.QuoteType() != "EQUITY" {
+		t.Error("expected advancers to be an equity query")
+	}
+	if sources[2].Predefined != models.ScreenerDayGainers {
+		t.Errorf("expected day_gainers source, got %q", sources[2].Predefined)
+	}
+}
+
+func TestBreadthSnapshotter(t *testing.T) {
+	counts := map[string]int{"advancers": 300, "decliners": 200}
+	sources := []BreadthSource{{Name: "advancers"}, {Name: "decliners"}, {Name: "broken"}}
+
+	var seen int
+	b := NewBreadthSnapshotter(nil, sources, WithMaxHistory(2), WithSnapshotHandler(func(BreadthSnapshot) {
+		seen++
+	}))
+	b.count = func(src BreadthSource) (int, error) {
+		n, ok := counts[src.Name]
+		if !ok {
+			return 0, fmt.Errorf("boom")
+		}
+		return n, nil
+	}
+
+	snap, err := b.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error: %v", err)
+	}
+	if got := snap.Ratio("advancers", "decliners"); got != 1.5 {
+		t.Errorf("Ratio() = %v, want 1.5", got)
+	}
+	if got := snap.Net("advancers", "decliners"); got != 100 {
+		t.Errorf("Net() = %v, want 100", got)
+	}
+	if _, ok := snap.Errors["broken"]; !ok {
+		t.Error("expected error for broken source")
+	}
+
+	counts["advancers"] = 100
+	_, _ = b.Snapshot()
+	_, _ = b.Snapshot()
+
+	if h := b.History(); len(h) != 2 {
+		t.Errorf("expected history capped at 2, got %d", len(h))
+	}
+	if _, series := b.Series("advancers"); len(series) != 2 || series[1] != 100 {
+		t.Errorf("unexpected series: %v", series)
+	}
+	if seen != 3 {
+		t.Errorf("expected handler called 3 times, got %d", seen)
+	}
+}
+
+func TestBreadthSnapshotterAllFail(t *testing.T) {
+	b := NewBreadthSnapshotter(nil, []BreadthSource{{Name: "x"}})
+	b.count = func(BreadthSource) (int, error) { return 0, fmt.Errorf("boom") }
+
+	if _, err := b.Snapshot(); err == nil {
+		t.Error("expected error when all sources fail")
+	}
+	if len(b.History()) != 0 {
+		t.Error("failed snapshot should not be recorded")
+	}
+}
+
+func TestBreadthSnapshotterStartInterval(t *testing.T) {
+	b := NewBreadthSnapshotter(nil, []BreadthSource{{Name: "x"}})
+	b.count = func(BreadthSource) (int, error) { return 1, nil }
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := b.Start(interval); err == nil {
+			b.Stop()
+			t.Errorf("Start(%s): expected error", interval)
+		}
+	}
+	if err := b.Start(time.Hour); err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+	b.Stop()
+}
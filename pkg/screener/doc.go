@@ -47,19 +47,65 @@
 //
 // Custom Queries:
 //   - [Screener.ScreenWithQuery]: Use custom query criteria
+//   - [Query], [FundQuery]: Fluent query builders
 //
 // # Custom Queries
 //
-// Build custom queries using [models.ScreenerQuery]:
-//
-//	// Find US tech stocks with price > $50
-//	query := models.NewEquityQuery(models.OpAND, []interface{}{
-//	    models.NewEquityQuery(models.OpEQ, []interface{}{"region", "us"}),
-//	    models.NewEquityQuery(models.OpEQ, []interface{}{"sector", "Technology"}),
-//	    models.NewEquityQuery(models.OpGT, []interface{}{"intradayprice", 50}),
-//	})
+// Build custom queries with [Query] (equities) or [FundQuery] (mutual
+// funds). Field names and values such as sectors are checked against
+// [models.EquityScreenerFields] and [models.FundScreenerFields] as
+// conditions are added, and Build reports every invalid one:
+//
+//	// Find US tech stocks with price > $50 and a $1-10B market cap
+//	query, err := screener.Query().
+//	    Region("us").
+//	    Sector("Technology").
+//	    PriceGT(50).
+//	    MarketCapBetween(1e9, 1e10).
+//	    Build()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
 //	result, err := s.ScreenWithQuery(query, nil)
 //
+// Fields without a typed method use GT, LT, Between, In and the other
+// generic conditions, and alternatives combine with Or:
+//
+//	query, err := screener.Query().
+//	    GT("returnonequity.lasttwelvemonths", 20).
+//	    Or(screener.Query().Sector("Technology"), screener.Query().Sector("Healthcare")).
+//	    Build()
+//
+// Queries can also be assembled from [models.NewEquityQuery] conditions:
+//
+//	regionQ, _ := models.NewEquityQuery(models.OpEQ, []any{"region", "us"})
+//	priceQ, _ := models.NewEquityQuery(models.OpGT, []any{"intradayprice", 50})
+//	query, err := models.NewEquityQuery(models.OpAND, []any{regionQ, priceQ})
+//
+// To screen by country without knowing Yahoo's exchange codes, use
+// [models.QueryForRegions]:
+//
+//	regionQ, _ := models.QueryForRegions("de", "fr")
+//	capQ, _ := models.NewEquityQuery(models.OpGT, []any{"intradaymarketcap", 1e9})
+//	query, _ := models.NewEquityQuery(models.OpAND, []any{regionQ, capQ})
+//
+// [RegionPreset] builds the same filter for a single region, adding the
+// region condition itself and any extra conditions. Regions Yahoo lists
+// without exchanges, such as "pe" and "sr", return an error:
+//
+//	query, err := screener.RegionPreset("kr", capQ)
+//
+// Requests are limited to [models.MaxScreenerCount] results; page through
+// larger result sets with ScreenerParams.Offset.
+//
+// The queries behind predefined screeners are exported through
+// [PredefinedQueryFor] and [PredefinedQueryNames]. The returned copy can be
+// extended with And to build a custom screen:
+//
+//	pq, _ := screener.PredefinedQueryFor(models.ScreenerMostActives)
+//	query, _ := pq.Query.(*models.EquityQuery).And(capQ)
+//	result, err := s.ScreenWithQuery(query, &models.ScreenerParams{SortField: pq.SortField})
+//
 // # Query Operators
 //
 // Available operators for custom queries:
@@ -73,6 +119,76 @@
 //   - OpAND: All conditions must match
 //   - OpOR: Any condition can match
 //
+// # Sort Fields
+//
+// params.SortField is validated before the request is sent. An unknown field
+// returns an [InvalidSortFieldError]; list accepted fields with
+// [models.ValidSortFields] or [ValidSortFields].
+//
+// # Explaining Queries
+//
+// [Screener.Explain] validates a custom query, returns the JSON body that
+// would be sent, and estimates the result size with a count-only request:
+//
+//	ex, err := s.Explain(query, nil)
+//	fmt.Println(ex.Total, ex.Pages, ex.Conditions, ex.Depth)
+//	fmt.Println(string(ex.Body))
+//
+// # Market Breadth
+//
+// [BreadthSnapshotter] periodically records the total match counts of a set
+// of screeners, e.g. advancers vs decliners:
+//
+//	sources, _ := screener.DefaultBreadthSources("us")
+//	b := screener.NewBreadthSnapshotter(s, sources)
+//	if err := b.Start(5 * time.Minute); err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer b.Stop()
+//	snap, _ := b.Snapshot()
+//	fmt.Printf("A/D ratio: %.2f\n", snap.Ratio("advancers", "decliners"))
+//
+// # Result History
+//
+// [NewResultSnapshot] records a screener result with a timestamp, and
+// [WriteResultSnapshot] appends it to a JSON Lines file holding the history
+// of a screen. [DiffResults] compares two runs, reporting new entrants,
+// dropped symbols and rank changes:
+//
+//	result, _ := s.DayGainers(100)
+//	screener.WriteResultSnapshot(f, screener.NewResultSnapshot("day_gainers", result))
+//
+//	snaps, _ := screener.ReadResultSnapshots(f)
+//	diff := screener.DiffResults(snaps[len(snaps)-2], snaps[len(snaps)-1])
+//	fmt.Println(len(diff.Entered), "new,", len(diff.Dropped), "dropped")
+//
+// To keep the history in a [store.Store] instead (files or SQLite), use
+// [SaveResultSnapshot] and [LoadResultSnapshots]; [WithBreadthStore] does
+// the same for breadth snapshots:
+//
+//	st, _ := store.NewFileStore("./snapshots")
+//	screener.SaveResultSnapshot(st, screener.NewResultSnapshot("day_gainers", result))
+//	snaps, _ := screener.LoadResultSnapshots(st, "day_gainers", time.Time{}, time.Time{})
+//
+// # Enrichment
+//
+// Screener quotes lack some fundamentals. [Screener.Enrich] (or [Enrich],
+// which uses the shared default client) fetches the PEG ratio, margins and
+// ESG scores of the result's equities and merges them into the quotes:
+//
+//	result, _ := s.Screen(models.ScreenerUndervaluedGrowth, nil)
+//	errs, _ := s.Enrich(result, []models.EnrichField{models.EnrichPEG, models.EnrichMargins})
+//	for sym, err := range errs {
+//	    log.Printf("%s: %v", sym, err)
+//	}
+//
+// # Rate Limits
+//
+// Screener queries only read data, so they are retried on network errors
+// and HTTP 429 and 5xx responses with the policy set by
+// config.SetMaxRetries and config.SetRetryDelay (see
+// client.Client.PostJSONIdempotent). Raise the delay for bulk screening.
+//
 // # Thread Safety
 //
 // All Screener methods are safe for concurrent use from multiple goroutines.
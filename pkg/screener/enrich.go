@@ -0,0 +1,214 @@
+package screener
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+// maxEnrichWorkers bounds the number of symbols fetched concurrently.
+const maxEnrichWorkers = 8
+
+// fundamentals holds the data fetched for one symbol. Either field may be
+// nil when it was not requested or could not be fetched.
+type fundamentals struct {
+	info *models.Info
+	esg  *models.ESGScores
+}
+
+// fundamentalsFetcher fetches info and/or ESG scores for a single symbol.
+type fundamentalsFetcher func(symbol string, needInfo, needESG bool) (fundamentals, error)
+
+// Enrich fills fundamental fields that screener responses lack, such as
+// the PEG ratio, margins and ESG scores, into the quotes of result. It uses
+// the shared default client; see [Screener.Enrich] to use another one.
+//
+// See [Screener.Enrich] for details.
+//
+// Example:
+//
+//	result, _ := s.Screen(models.ScreenerUndervaluedGrowth, nil)
+//	errs, err := screener.Enrich(result, []models.EnrichField{models.EnrichPEG, models.EnrichESG})
+func Enrich(result *models.ScreenerResult, fields []models.EnrichField) (map[string]error, error) {
+	c, err := client.AcquireDefault()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	defer c.Release()
+	return enrich(result, fields, tickerFundamentals(c))
+}
+
+// Enrich fills fundamental fields that screener responses lack into the
+// quotes of result, in place. A nil or empty fields means all of
+// [models.AllEnrichFields].
+//
+// Only quotes still missing a requested field are fetched, once per symbol
+// and concurrently. Quotes of funds and ETFs are skipped, as Yahoo has no
+// such fundamentals for them. Per-symbol failures are returned keyed by
+// symbol and leave the quote's fields unchanged; the error is non-nil only
+// for invalid arguments.
+//
+// Example:
+//
+//	result, err := s.Screen(models.ScreenerUndervaluedGrowth, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if _, err := s.Enrich(result, nil); err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, q := range result.Quotes {
+//	    fmt.Printf("%s PEG %.2f margin %.1f%%\n", q.Symbol, q.PegRatio, q.ProfitMargins*100)
+//	}
+func (s *Screener) Enrich(result *models.ScreenerResult, fields []models.EnrichField) (map[string]error, error) {
+	return enrich(result, fields, tickerFundamentals(s.client))
+}
+
+// tickerFundamentals returns a fetcher that uses ticker lookups over c.
+func tickerFundamentals(c *client.Client) fundamentalsFetcher {
+	return func(symbol string, needInfo, needESG bool) (fundamentals, error) {
+		var f fundamentals
+		tkr, err := ticker.New(symbol, ticker.WithClient(c))
+		if err != nil {
+			return f, err
+		}
+		defer tkr.Close()
+
+		var errs []error
+		if needInfo {
+			if f.info, err = tkr.Info(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if needESG {
+			if f.esg, err = tkr.Sustainability(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return f, errors.Join(errs...)
+	}
+}
+
+// enrich fetches missing fields with fetch and merges them into result.
+func enrich(result *models.ScreenerResult, fields []models.EnrichField, fetch fundamentalsFetcher) (map[string]error, error) {
+	if result == nil {
+		return nil, fmt.Errorf("result is required")
+	}
+	if len(fields) == 0 {
+		fields = models.AllEnrichFields()
+	}
+
+	want := make(map[models.EnrichField]bool, len(fields))
+	for _, f := range fields {
+		switch f {
+		case models.EnrichPEG, models.EnrichMargins, models.EnrichESG:
+			want[f] = true
+		default:
+			return nil, fmt.Errorf("unknown enrich field %q", f)
+		}
+	}
+
+	type need struct{ info, esg bool }
+	needs := make(map[string]need)
+	var symbols []string
+	for i := range result.Quotes {
+		q := &result.Quotes[i]
+		if q.Symbol == "" || !enrichable(q) {
+			continue
+		}
+		n := needs[q.Symbol]
+		n.info = n.info ||
+			(want[models.EnrichPEG] && q.PegRatio == 0) ||
+			(want[models.EnrichMargins] && !hasMargins(q))
+		n.esg = n.esg || (want[models.EnrichESG] && q.ESG == nil)
+		if !n.info && !n.esg {
+			continue
+		}
+		if _, ok := needs[q.Symbol]; !ok {
+			symbols = append(symbols, q.Symbol)
+		}
+		needs[q.Symbol] = n
+	}
+
+	errs := make(map[string]error)
+	fetched := make(map[string]fundamentals, len(symbols))
+	jobs := make(chan string)
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	workers := maxEnrichWorkers
+	if len(symbols) < workers {
+		workers = len(symbols)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for sym := range jobs {
+				n := needs[sym]
+				f, err := fetch(sym, n.info, n.esg)
+
+				mu.Lock()
+				if err != nil {
+					errs[sym] = err
+				}
+				fetched[sym] = f
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, sym := range symbols {
+		jobs <- sym
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i := range result.Quotes {
+		q := &result.Quotes[i]
+		if f, ok := fetched[q.Symbol]; ok {
+			applyFundamentals(q, f, want)
+		}
+	}
+	return errs, nil
+}
+
+// applyFundamentals copies the wanted fields of f into q, filling only
+// fields that are still missing.
+func applyFundamentals(q *models.ScreenerQuote, f fundamentals, want map[models.EnrichField]bool) {
+	if info := f.info; info != nil {
+		if want[models.EnrichPEG] && q.PegRatio == 0 {
+			q.PegRatio = info.TrailingPegRatio
+			if q.PegRatio == 0 {
+				q.PegRatio = info.PegRatio
+			}
+		}
+		if want[models.EnrichMargins] && !hasMargins(q) {
+			q.ProfitMargins = info.ProfitMargins
+			q.GrossMargins = info.GrossMargins
+			q.OperatingMargins = info.OperatingMargins
+			q.EbitdaMargins = info.EbitdaMargins
+		}
+	}
+	if want[models.EnrichESG] && q.ESG == nil && f.esg != nil {
+		esg := *f.esg
+		q.ESG = &esg
+	}
+}
+
+// hasMargins reports whether any margin field of q is set.
+func hasMargins(q *models.ScreenerQuote) bool {
+	return q.ProfitMargins != 0 || q.GrossMargins != 0 ||
+		q.OperatingMargins != 0 || q.EbitdaMargins != 0
+}
+
+// enrichable reports whether q is an equity, or of unknown type.
+func enrichable(q *models.ScreenerQuote) bool {
+	return q.QuoteType == "" || strings.EqualFold(q.QuoteType, "EQUITY")
+}
@@ -0,0 +1,77 @@
+package screener
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestEnrich(t *testing.T) {
+	result := &models.ScreenerResult{Quotes: []models.ScreenerQuote{
+		{Symbol: "AAPL", QuoteType: "EQUITY"},
+		{Symbol: "MSFT", QuoteType: "EQUITY", PegRatio: 2.5},
+		{Symbol: "SPY", QuoteType: "ETF"},
+		{Symbol: "FAIL", QuoteType: "EQUITY"},
+	}}
+
+	var (
+		mu    sync.Mutex
+		calls = make(map[string][2]bool)
+	)
+	fetch := func(symbol string, needInfo, needESG bool) (fundamentals, error) {
+		mu.Lock()
+		calls[symbol] = [2]bool{needInfo, needESG}
+		mu.Unlock()
+		if symbol == "FAIL" {
+			return fundamentals{}, fmt.Errorf("boom")
+		}
+		return fundamentals{
+			info: &models.Info{PegRatio: 1.1, TrailingPegRatio: 1.5, ProfitMargins: 0.25},
+			esg:  &models.ESGScores{TotalEsg: 17.2},
+		}, nil
+	}
+
+	errs, err := enrich(result, []models.EnrichField{models.EnrichPEG, models.EnrichESG}, fetch)
+	if err != nil {
+		t.Fatalf("enrich() error: %v", err)
+	}
+
+	if _, ok := calls["SPY"]; ok {
+		t.Error("ETF quotes should not be fetched")
+	}
+	if got := calls["MSFT"]; got != [2]bool{false, true} {
+		t.Errorf("MSFT needs = %v, want info=false esg=true", got)
+	}
+	if len(errs) != 1 || errs["FAIL"] == nil {
+		t.Errorf("expected only FAIL error, got %v", errs)
+	}
+
+	aapl := result.Quotes[0]
+	if aapl.PegRatio != 1.5 {
+		t.Errorf("AAPL PegRatio = %v, want trailing 1.5", aapl.PegRatio)
+	}
+	if aapl.ProfitMargins != 0 {
+		t.Error("margins were not requested and should stay unset")
+	}
+	if aapl.ESG == nil || aapl.ESG.TotalEsg != 17.2 {
+		t.Errorf("AAPL ESG = %+v", aapl.ESG)
+	}
+	if result.Quotes[1].PegRatio != 2.5 {
+		t.Error("existing PegRatio should not be overwritten")
+	}
+	if result.Quotes[3].ESG != nil {
+		t.Error("failed symbol should be left unchanged")
+	}
+}
+
+func TestEnrichInvalidField(t *testing.T) {
+	fetch := func(string, bool, bool) (fundamentals, error) { return fundamentals{}, nil }
+	if _, err := enrich(&models.ScreenerResult{}, []models.EnrichField{"dividends"}, fetch); err == nil {
+		t.Error("expected error for unknown field")
+	}
+	if _, err := enrich(nil, nil, fetch); err == nil {
+		t.Error("expected error for nil result")
+	}
+}
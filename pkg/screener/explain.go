@@ -0,0 +1,114 @@
+package screener
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// matchCounter posts a request body and returns the reported match total.
+type matchCounter func(ctx context.Context, body []byte) (int, error)
+
+// Explain validates query, resolves the request body ScreenWithQuery would
+// send with params, and estimates the result size with a count-only request.
+//
+// Use it to debug complex nested queries before paging through their
+// results. A nil params uses [models.DefaultScreenerParams].
+//
+// Example:
+//
+//	ex, err := s.Explain(query, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d matches in %d pages\n", ex.Total, ex.Pages)
+//	fmt.Println(string(ex.Body))
+func (s *Screener) Explain(query models.ScreenerQueryBuilder, params *models.ScreenerParams) (*models.ScreenerExplanation, error) {
+	return s.ExplainContext(context.Background(), query, params)
+}
+
+// ExplainContext is like [Screener.Explain] but returns ctx's error as soon
+// as ctx is done.
+func (s *Screener) ExplainContext(ctx context.Context, query models.ScreenerQueryBuilder, params *models.ScreenerParams) (*models.ScreenerExplanation, error) {
+	count := func(ctx context.Context, body []byte) (int, error) {
+		resp, err := s.client.PostJSONIdempotentContext(ctx, endpoints.ScreenerURL, nil, body)
+		if err != nil {
+			return 0, fmt.Errorf("screener request failed: %w", err)
+		}
+		result, err := s.parseResponse(resp.Body, 0)
+		if err != nil {
+			return 0, err
+		}
+		return result.Total, nil
+	}
+	return explain(ctx, query, params, count)
+}
+
+func explain(ctx context.Context, query models.ScreenerQueryBuilder, params *models.ScreenerParams, count matchCounter) (*models.ScreenerExplanation, error) {
+	if err := models.ValidateQuery(query); err != nil {
+		return nil, fmt.Errorf("invalid query: %w", err)
+	}
+
+	if params == nil {
+		defaultParams := models.DefaultScreenerParams()
+		params = &defaultParams
+	}
+
+	body, err := queryBody(query, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ask for a single quote; only the total is needed.
+	countParams := *params
+	countParams.Offset = 0
+	countParams.Count = 1
+	countBody, err := queryBody(query, &countParams)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := count(ctx, countBody)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pages are as large as the requests params makes
+	pageSize := params.Count
+	if pageSize <= 0 {
+		pageSize = models.MaxScreenerCount
+	}
+
+	conditions, depth := queryShape(query.ToDict())
+	return &models.ScreenerExplanation{
+		QuoteType:  query.QuoteType(),
+		Body:       body,
+		Conditions: conditions,
+		Depth:      depth,
+		Total:      total,
+		Pages:      (total + pageSize - 1) / pageSize,
+	}, nil
+}
+
+// queryShape returns the number of leaf comparisons and the nesting depth
+// of a serialized query.
+func queryShape(dict map[string]any) (conditions, depth int) {
+	operands, _ := dict["operands"].([]any)
+	for _, o := range operands {
+		child, ok := o.(map[string]any)
+		if !ok {
+			continue
+		}
+		c, d := queryShape(child)
+		conditions += c
+		if d > depth {
+			depth = d
+		}
+	}
+	if depth == 0 {
+		return 1, 1
+	}
+	return conditions, depth + 1
+}
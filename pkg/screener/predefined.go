@@ -1,6 +1,10 @@
 package screener
 
-import "github.com/wnjoon/go-yfinance/pkg/models"
+import (
+	"sort"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
 
 // PredefinedQuery holds a predefined screener query with its sort configuration.
 type PredefinedQuery struct {
@@ -9,10 +13,58 @@ type PredefinedQuery struct {
 	Query     models.ScreenerQueryBuilder
 }
 
+// Clone returns a deep copy of the predefined query, safe to modify.
+func (p PredefinedQuery) Clone() PredefinedQuery {
+	p.Query = models.CloneQuery(p.Query)
+	return p
+}
+
 // PredefinedScreenerQueries maps predefined screener names to their query definitions.
 // Matches Python's PREDEFINED_SCREENER_QUERIES from yfinance v1.3.0.
+//
+// The map is shared by [Screener.Screen]; prefer [PredefinedQueryFor], which
+// returns a copy, as the basis for custom screens.
 var PredefinedScreenerQueries map[string]PredefinedQuery
 
+// PredefinedQueryFor returns a copy of the query and sort settings behind a
+// predefined screener, to run with [Screener.ScreenWithQuery] or to extend
+// into a custom screen. It reports false for unknown screeners.
+//
+// Example:
+//
+//	pq, ok := screener.PredefinedQueryFor(models.ScreenerDayGainers)
+//	if !ok {
+//	    log.Fatal("unknown screener")
+//	}
+//	tech, _ := models.NewEquityQuery("eq", []any{"sector", "Technology"})
+//	q, err := pq.Query.(*models.EquityQuery).And(tech)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	result, err := s.ScreenWithQuery(q, &models.ScreenerParams{
+//	    SortField: pq.SortField,
+//	    SortAsc:   pq.SortAsc,
+//	    Count:     25,
+//	})
+func PredefinedQueryFor(name models.PredefinedScreener) (PredefinedQuery, bool) {
+	pq, ok := PredefinedScreenerQueries[string(name)]
+	if !ok {
+		return PredefinedQuery{}, false
+	}
+	return pq.Clone(), true
+}
+
+// PredefinedQueryNames returns the names of all screeners with a query
+// definition, sorted alphabetically.
+func PredefinedQueryNames() []models.PredefinedScreener {
+	names := make([]models.PredefinedScreener, 0, len(PredefinedScreenerQueries))
+	for name := range PredefinedScreenerQueries {
+		names = append(names, models.PredefinedScreener(name))
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
 func init() {
 	PredefinedScreenerQueries = map[string]PredefinedQuery{
 		// --- Equity Screeners ---
@@ -0,0 +1,381 @@
+package screener
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// Screener fields behind the typed builder methods.
+const (
+	fieldPrice         = "intradayprice"
+	fieldMarketCap     = "intradaymarketcap"
+	fieldVolume        = "dayvolume"
+	fieldPercentChange = "percentchange"
+	fieldPERatio       = "peratio.lasttwelvemonths"
+	fieldDividendYield = "forward_dividend_yield"
+)
+
+// builder holds the conditions and errors shared by the query builders.
+type builder struct {
+	newQuery func(op string, operands []any) (models.ScreenerQueryBuilder, error)
+	conds    []any
+	errs     []error
+}
+
+// add validates and appends one condition, recording its error instead.
+func (b *builder) add(op, field string, values ...any) {
+	q, err := b.newQuery(op, append([]any{field}, values...))
+	if err != nil {
+		b.errs = append(b.errs, fmt.Errorf("%s %s: %w", strings.ToUpper(op), field, err))
+		return
+	}
+	b.conds = append(b.conds, q)
+}
+
+// in adds an EQ condition for one value and an IS-IN condition for more.
+func (b *builder) in(field string, values []string) {
+	switch len(values) {
+	case 0:
+		b.errs = append(b.errs, fmt.Errorf("%s: at least one value is required", field))
+	case 1:
+		b.add(models.OpEQ, field, values[0])
+	default:
+		operands := make([]any, len(values))
+		for i, v := range values {
+			operands[i] = v
+		}
+		b.add(models.OpISIN, field, operands...)
+	}
+}
+
+// or adds the OR of alternatives, each the AND of its own conditions.
+func (b *builder) or(alternatives []*builder) {
+	if len(alternatives) == 0 {
+		b.errs = append(b.errs, fmt.Errorf("OR: at least one alternative is required"))
+		return
+	}
+	operands := make([]any, 0, len(alternatives))
+	for _, alt := range alternatives {
+		q, err := alt.build()
+		if err != nil {
+			b.errs = append(b.errs, fmt.Errorf("OR: %w", err))
+			return
+		}
+		operands = append(operands, q)
+	}
+	if len(operands) == 1 {
+		b.conds = append(b.conds, operands[0])
+		return
+	}
+	q, err := b.newQuery(models.OpOR, operands)
+	if err != nil {
+		b.errs = append(b.errs, fmt.Errorf("OR: %w", err))
+		return
+	}
+	b.conds = append(b.conds, q)
+}
+
+// build returns the single condition, or the AND of all conditions.
+func (b *builder) build() (models.ScreenerQueryBuilder, error) {
+	if len(b.errs) > 0 {
+		return nil, errors.Join(b.errs...)
+	}
+	switch len(b.conds) {
+	case 0:
+		return nil, fmt.Errorf("query has no conditions")
+	case 1:
+		return b.conds[0].(models.ScreenerQueryBuilder), nil
+	}
+	return b.newQuery(models.OpAND, b.conds)
+}
+
+// EquityBuilder builds a [models.EquityQuery] fluently. Every condition is
+// validated against [models.EquityScreenerFields] and the known regions,
+// sectors, industries and exchanges as it is added; Build reports all
+// invalid conditions at once. Conditions are ANDed.
+//
+// A builder is not safe for concurrent use.
+type EquityBuilder struct {
+	b builder
+}
+
+// Query returns an empty equity query builder.
+//
+// Example:
+//
+//	q, err := screener.Query().
+//	    Region("us").
+//	    Sector("Technology").
+//	    PriceGT(50).
+//	    MarketCapBetween(1e9, 1e10).
+//	    Build()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	result, err := s.ScreenWithQuery(q, nil)
+func Query() *EquityBuilder {
+	return &EquityBuilder{builder{newQuery: func(op string, operands []any) (models.ScreenerQueryBuilder, error) {
+		return models.NewEquityQuery(op, operands)
+	}}}
+}
+
+// Eq requires field to equal value.
+func (q *EquityBuilder) Eq(field string, value any) *EquityBuilder {
+	q.b.add(models.OpEQ, field, value)
+	return q
+}
+
+// In requires field to equal one of values.
+func (q *EquityBuilder) In(field string, values ...string) *EquityBuilder {
+	q.b.in(field, values)
+	return q
+}
+
+// GT requires field to be greater than v.
+func (q *EquityBuilder) GT(field string, v float64) *EquityBuilder {
+	q.b.add(models.OpGT, field, v)
+	return q
+}
+
+// GTE requires field to be at least v.
+func (q *EquityBuilder) GTE(field string, v float64) *EquityBuilder {
+	q.b.add(models.OpGTE, field, v)
+	return q
+}
+
+// LT requires field to be less than v.
+func (q *EquityBuilder) LT(field string, v float64) *EquityBuilder {
+	q.b.add(models.OpLT, field, v)
+	return q
+}
+
+// LTE requires field to be at most v.
+func (q *EquityBuilder) LTE(field string, v float64) *EquityBuilder {
+	q.b.add(models.OpLTE, field, v)
+	return q
+}
+
+// Between requires field to lie between lo and hi.
+func (q *EquityBuilder) Between(field string, lo, hi float64) *EquityBuilder {
+	q.b.add(models.OpBTWN, field, lo, hi)
+	return q
+}
+
+// Region requires one of the region codes, e.g. "us". Codes are
+// case-insensitive.
+func (q *EquityBuilder) Region(codes ...string) *EquityBuilder {
+	lower := make([]string, len(codes))
+	for i, c := range codes {
+		lower[i] = strings.ToLower(strings.TrimSpace(c))
+	}
+	return q.In("region", lower...)
+}
+
+// Sector requires one of the sectors in [models.EquityScreenerSectors].
+func (q *EquityBuilder) Sector(names ...string) *EquityBuilder {
+	return q.In("sector", names...)
+}
+
+// Industry requires one of the industries in
+// [models.SectorIndustryMapping].
+func (q *EquityBuilder) Industry(names ...string) *EquityBuilder {
+	return q.In("industry", names...)
+}
+
+// Exchange requires one of the exchange codes in
+// [models.EquityScreenerExchangeMap], e.g. "NMS".
+func (q *EquityBuilder) Exchange(codes ...string) *EquityBuilder {
+	return q.In("exchange", codes...)
+}
+
+// PriceGT requires an intraday price above v.
+func (q *EquityBuilder) PriceGT(v float64) *EquityBuilder { return q.GT(fieldPrice, v) }
+
+// PriceLT requires an intraday price below v.
+func (q *EquityBuilder) PriceLT(v float64) *EquityBuilder { return q.LT(fieldPrice, v) }
+
+// PriceBetween requires an intraday price between lo and hi.
+func (q *EquityBuilder) PriceBetween(lo, hi float64) *EquityBuilder {
+	return q.Between(fieldPrice, lo, hi)
+}
+
+// MarketCapGT requires an intraday market cap above v.
+func (q *EquityBuilder) MarketCapGT(v float64) *EquityBuilder { return q.GT(fieldMarketCap, v) }
+
+// MarketCapLT requires an intraday market cap below v.
+func (q *EquityBuilder) MarketCapLT(v float64) *EquityBuilder { return q.LT(fieldMarketCap, v) }
+
+// MarketCapBetween requires an intraday market cap between lo and hi.
+func (q *EquityBuilder) MarketCapBetween(lo, hi float64) *EquityBuilder {
+	return q.Between(fieldMarketCap, lo, hi)
+}
+
+// VolumeGT requires a day volume above v.
+func (q *EquityBuilder) VolumeGT(v float64) *EquityBuilder { return q.GT(fieldVolume, v) }
+
+// PercentChangeGT requires a day change above v percent.
+func (q *EquityBuilder) PercentChangeGT(v float64) *EquityBuilder {
+	return q.GT(fieldPercentChange, v)
+}
+
+// PercentChangeLT requires a day change below v percent.
+func (q *EquityBuilder) PercentChangeLT(v float64) *EquityBuilder {
+	return q.LT(fieldPercentChange, v)
+}
+
+// PERatioBetween requires a trailing P/E ratio between lo and hi.
+func (q *EquityBuilder) PERatioBetween(lo, hi float64) *EquityBuilder {
+	return q.Between(fieldPERatio, lo, hi)
+}
+
+// DividendYieldGT requires a forward dividend yield above v percent.
+func (q *EquityBuilder) DividendYieldGT(v float64) *EquityBuilder {
+	return q.GT(fieldDividendYield, v)
+}
+
+// Or requires any of alternatives to match, each the AND of its own
+// conditions.
+//
+// Example:
+//
+//	q, err := screener.Query().
+//	    Region("us").
+//	    Or(screener.Query().Sector("Technology"), screener.Query().DividendYieldGT(4)).
+//	    Build()
+func (q *EquityBuilder) Or(alternatives ...*EquityBuilder) *EquityBuilder {
+	alts := make([]*builder, len(alternatives))
+	for i, a := range alternatives {
+		alts[i] = &a.b
+	}
+	q.b.or(alts)
+	return q
+}
+
+// Build returns the query, or every invalid condition joined into one
+// error.
+func (q *EquityBuilder) Build() (*models.EquityQuery, error) {
+	built, err := q.b.build()
+	if err != nil {
+		return nil, err
+	}
+	return built.(*models.EquityQuery), nil
+}
+
+// FundBuilder builds a [models.FundQuery] fluently, validating conditions
+// against [models.FundScreenerFields]. See [EquityBuilder].
+type FundBuilder struct {
+	b builder
+}
+
+// FundQuery returns an empty mutual fund query builder.
+//
+// Example:
+//
+//	q, err := screener.FundQuery().
+//	    Exchange("NAS").
+//	    PerformanceRatingGTE(4).
+//	    PriceLT(100).
+//	    Build()
+func FundQuery() *FundBuilder {
+	return &FundBuilder{builder{newQuery: func(op string, operands []any) (models.ScreenerQueryBuilder, error) {
+		return models.NewFundQuery(op, operands)
+	}}}
+}
+
+// Eq requires field to equal value.
+func (q *FundBuilder) Eq(field string, value any) *FundBuilder {
+	q.b.add(models.OpEQ, field, value)
+	return q
+}
+
+// In requires field to equal one of values.
+func (q *FundBuilder) In(field string, values ...string) *FundBuilder {
+	q.b.in(field, values)
+	return q
+}
+
+// GT requires field to be greater than v.
+func (q *FundBuilder) GT(field string, v float64) *FundBuilder {
+	q.b.add(models.OpGT, field, v)
+	return q
+}
+
+// GTE requires field to be at least v.
+func (q *FundBuilder) GTE(field string, v float64) *FundBuilder {
+	q.b.add(models.OpGTE, field, v)
+	return q
+}
+
+// LT requires field to be less than v.
+func (q *FundBuilder) LT(field string, v float64) *FundBuilder {
+	q.b.add(models.OpLT, field, v)
+	return q
+}
+
+// LTE requires field to be at most v.
+func (q *FundBuilder) LTE(field string, v float64) *FundBuilder {
+	q.b.add(models.OpLTE, field, v)
+	return q
+}
+
+// Between requires field to lie between lo and hi.
+func (q *FundBuilder) Between(field string, lo, hi float64) *FundBuilder {
+	q.b.add(models.OpBTWN, field, lo, hi)
+	return q
+}
+
+// Exchange requires one of the exchange codes in
+// [models.FundScreenerExchangeMap].
+func (q *FundBuilder) Exchange(codes ...string) *FundBuilder {
+	return q.In("exchange", codes...)
+}
+
+// Category requires one of the Morningstar category names.
+func (q *FundBuilder) Category(names ...string) *FundBuilder {
+	return q.In("categoryname", names...)
+}
+
+// PerformanceRatingGTE requires an overall performance rating of at least
+// stars (1-5).
+func (q *FundBuilder) PerformanceRatingGTE(stars int) *FundBuilder {
+	return q.GTE("performanceratingoverall", float64(stars))
+}
+
+// RiskRatingLTE requires an overall risk rating of at most rating (1-5).
+func (q *FundBuilder) RiskRatingLTE(rating int) *FundBuilder {
+	return q.LTE("riskratingoverall", float64(rating))
+}
+
+// PriceGT requires an intraday price above v.
+func (q *FundBuilder) PriceGT(v float64) *FundBuilder { return q.GT(fieldPrice, v) }
+
+// PriceLT requires an intraday price below v.
+func (q *FundBuilder) PriceLT(v float64) *FundBuilder { return q.LT(fieldPrice, v) }
+
+// PriceBetween requires an intraday price between lo and hi.
+func (q *FundBuilder) PriceBetween(lo, hi float64) *FundBuilder {
+	return q.Between(fieldPrice, lo, hi)
+}
+
+// Or requires any of alternatives to match. See [EquityBuilder.Or].
+func (q *FundBuilder) Or(alternatives ...*FundBuilder) *FundBuilder {
+	alts := make([]*builder, len(alternatives))
+	for i, a := range alternatives {
+		alts[i] = &a.b
+	}
+	q.b.or(alts)
+	return q
+}
+
+// Build returns the query, or every invalid condition joined into one
+// error.
+func (q *FundBuilder) Build() (*models.FundQuery, error) {
+	built, err := q.b.build()
+	if err != nil {
+		return nil, err
+	}
+	return built.(*models.FundQuery), nil
+}
@@ -0,0 +1,48 @@
+package screener
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// RegionPreset returns an equity query limited to one region: the region
+// itself and every exchange Yahoo lists for it in
+// [models.EquityScreenerExchangeMap]. Extra conditions are ANDed in.
+//
+// Region codes are case-insensitive. Unknown regions, and regions Yahoo
+// lists without exchanges (such as "pe" and "sr"), return an error rather
+// than a query Yahoo would reject or silently leave unfiltered.
+//
+// Example:
+//
+//	capQ, _ := models.NewEquityQuery(models.OpGT, []any{"intradaymarketcap", 1e12})
+//	query, err := screener.RegionPreset("kr", capQ)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	result, err := s.ScreenWithQuery(query, nil)
+func RegionPreset(region string, conditions ...*models.EquityQuery) (*models.EquityQuery, error) {
+	code := strings.ToLower(strings.TrimSpace(region))
+	if code == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	exchanges, err := models.QueryForRegions(code)
+	if err != nil {
+		return nil, fmt.Errorf("region preset: %w", err)
+	}
+	regionQ, err := models.NewEquityQuery(models.OpEQ, []any{"region", code})
+	if err != nil {
+		return nil, fmt.Errorf("region preset: %w", err)
+	}
+
+	operands := []any{regionQ, exchanges}
+	for _, c := range conditions {
+		if c != nil {
+			operands = append(operands, c)
+		}
+	}
+	return models.NewEquityQuery(models.OpAND, operands)
+}
@@ -1,6 +1,7 @@
 package screener
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -9,6 +10,7 @@ import (
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Screener provides Yahoo Finance stock screener functionality.
@@ -24,7 +26,6 @@ type Option func(*Screener)
 func WithClient(c *client.Client) Option {
 	return func(s *Screener) {
 		s.client = c
-		s.ownsClient = false
 	}
 }
 
@@ -38,29 +39,30 @@ func WithClient(c *client.Client) Option {
 //	}
 //	defer s.Close()
 func New(opts ...Option) (*Screener, error) {
-	s := &Screener{
-		ownsClient: true,
-	}
+	s := &Screener{}
 
 	for _, opt := range opts {
 		opt(s)
 	}
 
 	if s.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		s.client = c
+		s.ownsClient = true
 	}
 
 	return s, nil
 }
 
-// Close releases resources used by the Screener instance.
+// Close releases the shared [client.Default] client if the Screener acquired
+// it. A client passed with WithClient is left open.
 func (s *Screener) Close() {
-	if s.ownsClient && s.client != nil {
-		s.client.Close()
+	if s.ownsClient {
+		s.ownsClient = false
+		s.client.Release()
 	}
 }
 
@@ -76,13 +78,25 @@ func (s *Screener) Close() {
 //	    fmt.Printf("%s: %.2f%%\n", quote.Symbol, quote.RegularMarketChangePercent)
 //	}
 func (s *Screener) Screen(screener models.PredefinedScreener, params *models.ScreenerParams) (*models.ScreenerResult, error) {
+	return s.ScreenContext(context.Background(), screener, params)
+}
+
+// ScreenContext is like [Screener.Screen] but returns ctx's error as soon
+// as ctx is done.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	result, err := s.ScreenContext(ctx, models.ScreenerMostActives, nil)
+func (s *Screener) ScreenContext(ctx context.Context, screener models.PredefinedScreener, params *models.ScreenerParams) (*models.ScreenerResult, error) {
 	if params == nil {
 		defaultParams := models.DefaultScreenerParams()
 		params = &defaultParams
 	}
 
-	if params.Count > 250 {
-		return nil, fmt.Errorf("yahoo limits query count to 250, reduce count")
+	if params.Count > models.MaxScreenerCount {
+		return nil, fmt.Errorf("yahoo limits query count to %d, reduce count", models.MaxScreenerCount)
 	}
 
 	// If offset is specified, switch to POST endpoint with predefined query body
@@ -97,10 +111,14 @@ func (s *Screener) Screen(screener models.PredefinedScreener, params *models.Scr
 			if !params.SortAsc {
 				params.SortAsc = predefined.SortAsc
 			}
-			return s.ScreenWithQuery(predefined.Query, params)
+			return s.ScreenWithQueryContext(ctx, predefined.Query, params)
 		}
 	}
 
+	if err := validateSortField(predefinedQuoteType(screener), params.SortField); err != nil {
+		return nil, err
+	}
+
 	// Build URL for predefined screener
 	screenerURL := fmt.Sprintf("%s/predefined/%s", endpoints.ScreenerURL, string(screener))
 
@@ -115,7 +133,7 @@ func (s *Screener) Screen(screener models.PredefinedScreener, params *models.Scr
 		urlParams.Set("sortAsc", "true")
 	}
 
-	resp, err := s.client.Get(screenerURL, urlParams)
+	resp, err := s.client.GetContext(ctx, screenerURL, urlParams)
 	if err != nil {
 		return nil, fmt.Errorf("screener request failed: %w", err)
 	}
@@ -136,17 +154,42 @@ func (s *Screener) Screen(screener models.PredefinedScreener, params *models.Scr
 //	query, _ := models.NewEquityQuery("and", []interface{}{q1, q2})
 //	result, err := s.ScreenWithQuery(query, nil)
 func (s *Screener) ScreenWithQuery(query models.ScreenerQueryBuilder, params *models.ScreenerParams) (*models.ScreenerResult, error) {
-	if query == nil {
-		return nil, fmt.Errorf("query is required")
-	}
+	return s.ScreenWithQueryContext(context.Background(), query, params)
+}
 
+// ScreenWithQueryContext is like [Screener.ScreenWithQuery] but returns
+// ctx's error as soon as ctx is done.
+func (s *Screener) ScreenWithQueryContext(ctx context.Context, query models.ScreenerQueryBuilder, params *models.ScreenerParams) (*models.ScreenerResult, error) {
 	if params == nil {
 		defaultParams := models.DefaultScreenerParams()
 		params = &defaultParams
 	}
 
-	if params.Count > 250 {
-		return nil, fmt.Errorf("yahoo limits query count to 250, reduce count")
+	bodyBytes, err := queryBody(query, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.PostJSONIdempotentContext(ctx, endpoints.ScreenerURL, nil, bodyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("screener request failed: %w", err)
+	}
+
+	return s.parseResponse(resp.Body, params.Offset)
+}
+
+// queryBody checks params against query and builds the JSON request body.
+func queryBody(query models.ScreenerQueryBuilder, params *models.ScreenerParams) ([]byte, error) {
+	if query == nil {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	if params.Count > models.MaxScreenerCount {
+		return nil, fmt.Errorf("yahoo limits query count to %d, reduce count", models.MaxScreenerCount)
+	}
+
+	if err := validateSortField(query.QuoteType(), params.SortField); err != nil {
+		return nil, err
 	}
 
 	// Determine sort type
@@ -171,13 +214,7 @@ func (s *Screener) ScreenWithQuery(query models.ScreenerQueryBuilder, params *mo
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal query: %w", err)
 	}
-
-	resp, err := s.client.PostJSON(endpoints.ScreenerURL, nil, bodyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("screener request failed: %w", err)
-	}
-
-	return s.parseResponse(resp.Body, params.Offset)
+	return bodyBytes, nil
 }
 
 // DayGainers returns stocks with the highest percentage gain today.
@@ -223,13 +260,12 @@ func (s *Screener) MostActives(count int) (*models.ScreenerResult, error) {
 func (s *Screener) parseResponse(body string, offset int) (*models.ScreenerResult, error) {
 	var rawResp models.ScreenerResponse
 	if err := json.Unmarshal([]byte(body), &rawResp); err != nil {
-		return nil, fmt.Errorf("failed to parse screener response: %w", err)
+		return nil, yferr.Parse("screener response", err)
 	}
 
 	// Check for API error
 	if rawResp.Finance.Error != nil {
-		return nil, fmt.Errorf("screener API error: %s - %s",
-			rawResp.Finance.Error.Code, rawResp.Finance.Error.Description)
+		return nil, yferr.API("screener", rawResp.Finance.Error.Code, rawResp.Finance.Error.Description)
 	}
 
 	// Check if we have results
@@ -247,94 +283,53 @@ func (s *Screener) parseResponse(body string, offset int) (*models.ScreenerResul
 	}
 
 	// Parse quotes
+	if len(result.Quotes) > 0 {
+		screenerResult.Quotes = make([]models.ScreenerQuote, 0, len(result.Quotes))
+	}
 	for _, q := range result.Quotes {
 		quote := models.ScreenerQuote{
-			Symbol:                     getString(q, "symbol"),
-			ShortName:                  getString(q, "shortName"),
-			LongName:                   getString(q, "longName"),
-			Exchange:                   getString(q, "exchange"),
-			ExchangeDisp:               getString(q, "fullExchangeName"),
-			QuoteType:                  getString(q, "quoteType"),
-			Region:                     getString(q, "region"),
-			Sector:                     getString(q, "sector"),
-			Industry:                   getString(q, "industry"),
-			Currency:                   getString(q, "currency"),
-			MarketState:                getString(q, "marketState"),
-			RegularMarketPrice:         getFloat(q, "regularMarketPrice"),
-			RegularMarketChange:        getFloat(q, "regularMarketChange"),
-			RegularMarketChangePercent: getFloat(q, "regularMarketChangePercent"),
-			RegularMarketVolume:        getInt64(q, "regularMarketVolume"),
-			RegularMarketDayHigh:       getFloat(q, "regularMarketDayHigh"),
-			RegularMarketDayLow:        getFloat(q, "regularMarketDayLow"),
-			RegularMarketOpen:          getFloat(q, "regularMarketOpen"),
-			RegularMarketPreviousClose: getFloat(q, "regularMarketPreviousClose"),
-			MarketCap:                  getInt64(q, "marketCap"),
-			FiftyTwoWeekHigh:           getFloat(q, "fiftyTwoWeekHigh"),
-			FiftyTwoWeekLow:            getFloat(q, "fiftyTwoWeekLow"),
-			FiftyTwoWeekChange:         getFloat(q, "fiftyTwoWeekChangePercent"),
-			FiftyDayAverage:            getFloat(q, "fiftyDayAverage"),
-			TwoHundredDayAverage:       getFloat(q, "twoHundredDayAverage"),
-			AverageVolume:              getInt64(q, "averageDailyVolume3Month"),
-			TrailingPE:                 getFloat(q, "trailingPE"),
-			ForwardPE:                  getFloat(q, "forwardPE"),
-			PriceToBook:                getFloat(q, "priceToBook"),
-			DividendYield:              getFloat(q, "dividendYield"),
-			TrailingEPS:                getFloat(q, "epsTrailingTwelveMonths"),
-			BookValue:                  getFloat(q, "bookValue"),
+			Symbol:                     q.Symbol,
+			ShortName:                  q.ShortName,
+			LongName:                   q.LongName,
+			Exchange:                   q.Exchange,
+			ExchangeDisp:               q.FullExchangeName,
+			QuoteType:                  q.QuoteType,
+			Region:                     q.Region,
+			Sector:                     q.Sector,
+			Industry:                   q.Industry,
+			Currency:                   q.Currency,
+			MarketState:                q.MarketState,
+			RegularMarketPrice:         q.RegularMarketPrice.Float64(),
+			RegularMarketChange:        q.RegularMarketChange.Float64(),
+			RegularMarketChangePercent: q.RegularMarketChangePercent.Float64(),
+			RegularMarketVolume:        q.RegularMarketVolume.Int64(),
+			RegularMarketDayHigh:       q.RegularMarketDayHigh.Float64(),
+			RegularMarketDayLow:        q.RegularMarketDayLow.Float64(),
+			RegularMarketOpen:          q.RegularMarketOpen.Float64(),
+			RegularMarketPreviousClose: q.RegularMarketPreviousClose.Float64(),
+			MarketCap:                  q.MarketCap.Int64(),
+			FiftyTwoWeekHigh:           q.FiftyTwoWeekHigh.Float64(),
+			FiftyTwoWeekLow:            q.FiftyTwoWeekLow.Float64(),
+			FiftyTwoWeekChange:         q.FiftyTwoWeekChangePercent.Float64(),
+			FiftyDayAverage:            q.FiftyDayAverage.Float64(),
+			TwoHundredDayAverage:       q.TwoHundredDayAverage.Float64(),
+			AverageVolume:              q.AverageDailyVolume3Month.Int64(),
+			TrailingPE:                 q.TrailingPE.Float64(),
+			ForwardPE:                  q.ForwardPE.Float64(),
+			PriceToBook:                q.PriceToBook.Float64(),
+			DividendYield:              q.DividendYield.Float64(),
+			TrailingEPS:                q.EpsTrailingTwelveMonths.Float64(),
+			BookValue:                  q.BookValue.Float64(),
 			// Fund-specific fields
-			FundNetAssets:                 getFloat(q, "fundNetAssets"),
-			CategoryName:                  getString(q, "categoryName"),
-			PerformanceRatingOverall:      getInt(q, "performanceRatingOverall"),
-			RiskRatingOverall:             getInt(q, "riskRatingOverall"),
-			InitialInvestment:             getFloat(q, "initialInvestment"),
-			AnnualReturnNavY1CategoryRank: getFloat(q, "annualReturnNavY1CategoryRank"),
+			FundNetAssets:                 q.FundNetAssets.Float64(),
+			CategoryName:                  q.CategoryName,
+			PerformanceRatingOverall:      q.PerformanceRatingOverall.Int(),
+			RiskRatingOverall:             q.RiskRatingOverall.Int(),
+			InitialInvestment:             q.InitialInvestment.Float64(),
+			AnnualReturnNavY1CategoryRank: q.AnnualReturnNavY1CategoryRank.Float64(),
 		}
 		screenerResult.Quotes = append(screenerResult.Quotes, quote)
 	}
 
 	return screenerResult, nil
 }
-
-// Helper functions
-func getString(m map[string]any, key string) string {
-	if v, ok := m[key].(string); ok {
-		return v
-	}
-	return ""
-}
-
-func getFloat(m map[string]any, key string) float64 {
-	switch v := m[key].(type) {
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	}
-	return 0
-}
-
-func getInt(m map[string]any, key string) int {
-	switch v := m[key].(type) {
-	case float64:
-		return int(v)
-	case int:
-		return v
-	case int64:
-		return int(v)
-	}
-	return 0
-}
-
-func getInt64(m map[string]any, key string) int64 {
-	switch v := m[key].(type) {
-	case float64:
-		return int64(v)
-	case int:
-		return int64(v)
-	case int64:
-		return v
-	}
-	return 0
-}
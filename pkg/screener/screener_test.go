@@ -1,9 +1,17 @@
 package screener
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 func TestNew(t *testing.T) {
@@ -17,8 +25,16 @@ func TestNew(t *testing.T) {
 		return
 	}
 
-	if s.ownsClient != true {
-		t.Error("ownsClient should be true when no custom client is provided")
+	if !s.ownsClient {
+		t.Error("ownsClient should be true when the shared default client is acquired")
+	}
+
+	def, err := client.Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	if s.client != def {
+		t.Error("Client should be the shared default client")
 	}
 }
 
@@ -35,7 +51,7 @@ func TestNewWithClient(t *testing.T) {
 		t.Fatalf("Failed to create second Screener: %v", err)
 	}
 
-	if s2.ownsClient != false {
+	if s2.ownsClient {
 		t.Error("ownsClient should be false when custom client is provided")
 	}
 
@@ -364,6 +380,94 @@ func TestScreenWithQueryCountLimit(t *testing.T) {
 	}
 }
 
+func TestExplain(t *testing.T) {
+	us, _ := models.NewEquityQuery("eq", []any{"region", "us"})
+	sectors, _ := models.NewEquityQuery("is-in", []any{"sector", "Technology", "Healthcare", "Energy"})
+	price, _ := models.NewEquityQuery("gt", []any{"intradayprice", 10})
+	query, _ := models.NewEquityQuery("and", []any{us, sectors, price})
+
+	var sent map[string]any
+	count := func(_ context.Context, body []byte) (int, error) {
+		if err := json.Unmarshal(body, &sent); err != nil {
+			t.Fatalf("count body is not JSON: %v", err)
+		}
+		return 600, nil
+	}
+
+	params := &models.ScreenerParams{Offset: 50, Count: 100, SortField: "intradaymarketcap", UserIDType: "guid"}
+	ex, err := explain(context.Background(), query, params, count)
+	if err != nil {
+		t.Fatalf("explain() error: %v", err)
+	}
+
+	if sent["count"] != float64(1) || sent["offset"] != float64(0) {
+		t.Errorf("count request should ask for one quote at offset 0, got count=%v offset=%v", sent["count"], sent["offset"])
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(ex.Body, &body); err != nil {
+		t.Fatalf("Body is not JSON: %v", err)
+	}
+	if body["count"] != float64(100) || body["offset"] != float64(50) || body["sortField"] != "intradaymarketcap" {
+		t.Errorf("Body should use the given params, got %v", body)
+	}
+
+	if ex.QuoteType != "EQUITY" {
+		t.Errorf("QuoteType = %q", ex.QuoteType)
+	}
+	if ex.Conditions != 5 || ex.Depth != 3 {
+		t.Errorf("Conditions, Depth = %d, %d; want 5, 3", ex.Conditions, ex.Depth)
+	}
+	if ex.Total != 600 || ex.Pages != 6 {
+		t.Errorf("Total, Pages = %d, %d; want 600, 6", ex.Total, ex.Pages)
+	}
+
+	// Without a Count, pages are as large as Yahoo allows
+	params.Count = 0
+	if ex, err = explain(context.Background(), query, params, count); err != nil {
+		t.Fatalf("explain() error: %v", err)
+	}
+	if want := (600 + models.MaxScreenerCount - 1) / models.MaxScreenerCount; ex.Pages != want {
+		t.Errorf("Pages = %d; want %d", ex.Pages, want)
+	}
+}
+
+func TestExplainInvalidQuery(t *testing.T) {
+	count := func(context.Context, []byte) (int, error) {
+		t.Fatal("count should not be called")
+		return 0, nil
+	}
+
+	fund, _ := models.NewFundQuery("eq", []any{"exchange", "NAS"})
+	equity, _ := models.NewEquityQuery("eq", []any{"region", "us"})
+	mixed, _ := models.NewEquityQuery("and", []any{equity, fund})
+
+	tests := []struct {
+		name  string
+		query models.ScreenerQueryBuilder
+	}{
+		{"nil", nil},
+		{"zero value", &models.EquityQuery{}},
+		{"mixed types", mixed},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := explain(context.Background(), tc.query, nil, count); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestPredefinedQueriesValidate(t *testing.T) {
+	for _, name := range PredefinedQueryNames() {
+		pq, _ := PredefinedQueryFor(name)
+		if err := models.ValidateQuery(pq.Query); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
 func TestPredefinedScreenerQueries(t *testing.T) {
 	expectedEquity := []string{
 		"aggressive_small_caps", "day_gainers", "day_losers",
@@ -440,47 +544,295 @@ func TestPredefinedScreenerToDicts(t *testing.T) {
 	}
 }
 
-func TestHelperFunctions(t *testing.T) {
-	m := map[string]any{
-		"str":     "hello",
-		"int":     42,
-		"int64":   int64(100),
-		"float":   3.14,
-		"float64": float64(2.71),
+func TestParseResponse(t *testing.T) {
+	body := `{"finance": {"result": [{"total": 120, "count": 2, "quotes": [
+		{"symbol": "AAPL", "shortName": "Apple Inc.", "fullExchangeName": "NasdaqGS", "regularMarketPrice": 189.5,
+			"regularMarketVolume": {"raw": 52340100, "fmt": "52.34M"}, "marketCap": "3.02T", "trailingPE": 29.1,
+			"fiftyTwoWeekChangePercent": 12.5, "averageDailyVolume3Month": 58000000.7, "forwardPE": null},
+		{"symbol": "VFIAX", "quoteType": "MUTUALFUND", "categoryName": "Large Blend", "performanceRatingOverall": 4,
+			"riskRatingOverall": {"raw": 3, "fmt": "3"}, "fundNetAssets": 1.2e12, "annualReturnNavY1CategoryRank": "27"}
+	]}], "error": null}}`
+
+	result, err := (&Screener{}).parseResponse(body, 25)
+	if err != nil {
+		t.Fatalf("parseResponse() error: %v", err)
+	}
+	if result.Total != 120 || result.Count != 2 || result.Offset != 25 || len(result.Quotes) != 2 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	q := result.Quotes[0]
+	if q.Symbol != "AAPL" || q.ExchangeDisp != "NasdaqGS" || q.RegularMarketPrice != 189.5 {
+		t.Errorf("unexpected quote %+v", q)
+	}
+	if q.RegularMarketVolume != 52340100 || q.MarketCap != 3020000000000 {
+		t.Errorf("expected volume and market cap from raw and fmt values, got %d and %d", q.RegularMarketVolume, q.MarketCap)
+	}
+	if q.AverageVolume != 58000000 || q.FiftyTwoWeekChange != 12.5 || q.ForwardPE != 0 {
+		t.Errorf("unexpected derived fields %+v", q)
+	}
+
+	f := result.Quotes[1]
+	if f.CategoryName != "Large Blend" || f.PerformanceRatingOverall != 4 || f.RiskRatingOverall != 3 {
+		t.Errorf("unexpected fund ratings %+v", f)
+	}
+	if f.FundNetAssets != 1.2e12 || f.AnnualReturnNavY1CategoryRank != 27 {
+		t.Errorf("unexpected fund values %+v", f)
+	}
+
+	if _, err := (&Screener{}).parseResponse(`{"finance": {"error": {"code": "Bad Request", "description": "invalid"}}}`, 0); err == nil {
+		t.Error("expected API error")
+	}
+	if _, err := (&Screener{}).parseResponse(`{"finance": `, 0); !errors.Is(err, yferr.ErrParse) {
+		t.Errorf("expected yferr.ErrParse, got %v", err)
+	}
+}
+
+func TestSortFieldValidation(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatalf("Failed to create Screener: %v", err)
+	}
+
+	q, _ := models.NewEquityQuery("eq", []any{"region", "us"})
+	params := models.DefaultScreenerParams()
+	params.SortField = "notafield"
+
+	_, err = s.ScreenWithQuery(q, &params)
+	var sortErr *InvalidSortFieldError
+	if !errors.As(err, &sortErr) {
+		t.Fatalf("Expected InvalidSortFieldError, got %v", err)
+	}
+	if sortErr.QuoteType != "EQUITY" {
+		t.Errorf("Expected quote type EQUITY, got %q", sortErr.QuoteType)
+	}
+
+	_, err = s.Screen(models.ScreenerDayGainers, &params)
+	if !errors.As(err, &sortErr) {
+		t.Fatalf("Expected InvalidSortFieldError for predefined screener, got %v", err)
+	}
+
+	// Predefined sort fields must pass validation for their own quote type
+	for name, pq := range PredefinedScreenerQueries {
+		if err := validateSortField(pq.Query.QuoteType(), pq.SortField); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestValidSortFields(t *testing.T) {
+	fields := ValidSortFields(models.ScreenerTopMutualFunds)
+	if !sort.StringsAreSorted(fields) {
+		t.Error("Expected sorted sort fields")
+	}
+
+	has := func(fields []string, f string) bool {
+		for _, v := range fields {
+			if v == f {
+				return true
+			}
+		}
+		return false
+	}
+	if !has(fields, "fundnetassets") || !has(fields, "ticker") {
+		t.Errorf("Expected fund sort fields to include fundnetassets and ticker, got %v", fields)
 	}
+	if has(fields, "peratio.lasttwelvemonths") {
+		t.Error("Expected equity-only field to be excluded for fund screener")
+	}
+	if !has(models.ValidSortFields(""), "peratio.lasttwelvemonths") {
+		t.Error("Expected empty quote type to include equity fields")
+	}
+}
 
-	// Test getString
-	if got := getString(m, "str"); got != "hello" {
-		t.Errorf("getString expected 'hello', got '%s'", got)
+func TestPredefinedQueryFor(t *testing.T) {
+	names := PredefinedQueryNames()
+	if len(names) != len(PredefinedScreenerQueries) {
+		t.Fatalf("Expected %d names, got %d", len(PredefinedScreenerQueries), len(names))
+	}
+	if !sort.SliceIsSorted(names, func(i, j int) bool { return names[i] < names[j] }) {
+		t.Error("Expected names to be sorted")
 	}
 
-	if got := getString(m, "missing"); got != "" {
-		t.Errorf("getString for missing key expected '', got '%s'", got)
+	if _, ok := PredefinedQueryFor("no_such_screener"); ok {
+		t.Error("Expected unknown screener to fail")
 	}
 
-	// Test getFloat
-	if got := getFloat(m, "float"); got != 3.14 {
-		t.Errorf("getFloat expected 3.14, got %f", got)
+	pq, ok := PredefinedQueryFor(models.ScreenerDayGainers)
+	if !ok {
+		t.Fatal("Expected day_gainers query")
+	}
+	original := PredefinedScreenerQueries["day_gainers"]
+	if pq.SortField != original.SortField || pq.SortAsc != original.SortAsc {
+		t.Errorf("Expected sort settings to be copied, got %+v", pq)
+	}
+	if pq.Query == original.Query {
+		t.Error("Expected a copy of the query")
 	}
 
-	if got := getFloat(m, "int"); got != 42.0 {
-		t.Errorf("getFloat for int expected 42.0, got %f", got)
+	before := original.Query.(*models.EquityQuery).String()
+	tech, err := models.NewEquityQuery("eq", []any{"sector", "Technology"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom, err := pq.Query.(*models.EquityQuery).And(tech)
+	if err != nil {
+		t.Fatalf("And() error: %v", err)
+	}
+	if got := len(custom.Operands()); got != 6 {
+		t.Errorf("Expected condition appended to the AND (6 operands), got %d", got)
+	}
+	if original.Query.(*models.EquityQuery).String() != before {
+		t.Error("Extending a copy should not modify the predefined query")
 	}
+}
 
-	if got := getFloat(m, "int64"); got != 100.0 {
-		t.Errorf("getFloat for int64 expected 100.0, got %f", got)
+func TestRegionPreset(t *testing.T) {
+	capQ, err := models.NewEquityQuery(models.OpGT, []any{"intradaymarketcap", 1e12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := RegionPreset(" KR ", capQ)
+	if err != nil {
+		t.Fatalf("RegionPreset() error: %v", err)
+	}
+	if q.Operator() != "AND" {
+		t.Errorf("Expected AND query, got %s", q.Operator())
+	}
+	ops := q.Operands()
+	if len(ops) != 3 {
+		t.Fatalf("Expected region, exchanges and condition, got %d operands", len(ops))
+	}
+	if region := ops[0].(*models.EquityQuery).Operands(); region[0] != "region" || region[1] != "kr" {
+		t.Errorf("Expected region eq kr, got %v", region)
+	}
+	if got := len(ops[1].(*models.EquityQuery).Operands()); got != 3 {
+		t.Errorf("Expected exchange IS-IN with 2 codes, got %d operands", got)
 	}
 
-	// Test getInt64
-	if got := getInt64(m, "int64"); got != 100 {
-		t.Errorf("getInt64 expected 100, got %d", got)
+	for _, region := range []string{"pe", "sr", "xx", ""} {
+		if _, err := RegionPreset(region); err == nil {
+			t.Errorf("RegionPreset(%q): expected error", region)
+		}
 	}
+}
 
-	if got := getInt64(m, "int"); got != 42 {
-		t.Errorf("getInt64 for int expected 42, got %d", got)
+func TestScreenCountLimit(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
 	}
+	defer s.Close()
 
-	if got := getInt64(m, "float64"); got != 2 {
-		t.Errorf("getInt64 for float64 expected 2, got %d", got)
+	params := &models.ScreenerParams{Count: models.MaxScreenerCount + 1}
+	if _, err := s.Screen(models.ScreenerDayGainers, params); err == nil {
+		t.Error("Expected error above MaxScreenerCount")
+	}
+	q, _ := RegionPreset("us")
+	if _, err := s.ScreenWithQuery(q, params); err == nil {
+		t.Error("Expected error above MaxScreenerCount")
+	}
+}
+
+// screenerBody returns a screener response with n quotes.
+func screenerBody(n int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `{"finance":{"result":[{"total":%d,"count":%d,"quotes":[`, n*4, n)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		p := 10 + float64(i)*0.37
+		fmt.Fprintf(&b, `{"symbol":"SYM%d","shortName":"Company %d","longName":"Company %d Holdings Inc.",
+			"exchange":"NMS","fullExchangeName":"NasdaqGS","quoteType":"EQUITY","region":"US",
+			"sector":"Technology","industry":"Software","currency":"USD","marketState":"REGULAR",
+			"regularMarketPrice":%.2f,"regularMarketChange":1.25,"regularMarketChangePercent":3.5,
+			"regularMarketVolume":%d,"regularMarketDayHigh":%.2f,"regularMarketDayLow":%.2f,
+			"regularMarketOpen":%.2f,"regularMarketPreviousClose":%.2f,"marketCap":%d,
+			"fiftyTwoWeekHigh":%.2f,"fiftyTwoWeekLow":%.2f,"fiftyTwoWeekChangePercent":12.5,
+			"fiftyDayAverage":%.2f,"twoHundredDayAverage":%.2f,"averageDailyVolume3Month":%d,
+			"trailingPE":25.3,"forwardPE":21.7,"priceToBook":4.2,"dividendYield":0.8,
+			"epsTrailingTwelveMonths":3.12,"bookValue":18.4}`,
+			i, i, i, p, 1000000+i, p+1, p-1, p-0.5, p-1.25, 1000000000+i*1000, p*1.3, p*0.7, p*0.98, p*0.95, 900000+i)
+	}
+	b.WriteString(`]}],"error":null}}`)
+	return b.String()
+}
+
+func TestParseResponseQuotes(t *testing.T) {
+	result, err := (&Screener{}).parseResponse(screenerBody(3), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Quotes) != 3 || result.Total != 12 || result.Quotes[2].Symbol != "SYM2" || result.Quotes[0].RegularMarketPrice != 10 {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func BenchmarkParseResponse(b *testing.B) {
+	body := screenerBody(250)
+	s := &Screener{}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.parseResponse(body, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestQueryBuilder(t *testing.T) {
+	q, err := Query().Region("US").Sector("Technology").PriceGT(50).MarketCapBetween(1e9, 1e10).Build()
+	if err != nil {
+		t.Fatalf("Build() error: %v", err)
+	}
+	dict := q.ToDict()
+	if dict["operator"] != "AND" {
+		t.Errorf("Expected AND, got %v", dict["operator"])
+	}
+	ops := dict["operands"].([]any)
+	if len(ops) != 4 {
+		t.Fatalf("Expected 4 conditions, got %d", len(ops))
+	}
+	region := ops[0].(map[string]any)
+	if region["operator"] != "EQ" || region["operands"].([]any)[1] != "us" {
+		t.Errorf("Unexpected region condition: %v", region)
+	}
+	if btwn := ops[3].(map[string]any); btwn["operator"] != "BTWN" {
+		t.Errorf("Unexpected market cap condition: %v", btwn)
+	}
+
+	single, err := Query().Exchange("NMS", "NYQ").Build()
+	if err != nil || single.Operator() != "IS-IN" {
+		t.Errorf("Single condition should not be wrapped in AND: %v, %v", single, err)
+	}
+
+	or, err := Query().Region("us").Or(Query().Sector("Technology"), Query().DividendYieldGT(4)).Build()
+	if err != nil {
+		t.Fatalf("Or Build() error: %v", err)
+	}
+	if ops := or.ToDict()["operands"].([]any); ops[1].(map[string]any)["operator"] != "OR" {
+		t.Errorf("Expected nested OR, got %v", ops[1])
+	}
+
+	_, err = Query().GT("notafield", 1).Sector("Nope").PriceGT(10).Build()
+	if err == nil {
+		t.Fatal("Expected error for invalid field and sector")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "notafield") || !strings.Contains(msg, "Nope") {
+		t.Errorf("Error should report every invalid condition: %v", err)
+	}
+	if _, err := Query().Build(); err == nil {
+		t.Error("Expected error for empty query")
+	}
+
+	fq, err := FundQuery().Exchange("NAS").PerformanceRatingGTE(4).PriceLT(100).Build()
+	if err != nil || fq.QuoteType() != "MUTUALFUND" {
+		t.Errorf("Fund Build() = %v, %v", fq, err)
+	}
+	if _, err := FundQuery().GT("intradaymarketcap", 1).Build(); err == nil {
+		t.Error("Expected error for an equity-only field in a fund query")
 	}
 }
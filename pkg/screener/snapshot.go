@@ -0,0 +1,210 @@
+package screener
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// snapshotNow returns the snapshot time; overridable in tests.
+var snapshotNow = time.Now
+
+// ResultSnapshot is a screener result recorded at a point in time, so runs
+// of the same screen can be stored and compared with [DiffResults].
+type ResultSnapshot struct {
+	// Name identifies the screen (e.g. "day_gainers").
+	Name string `json:"name,omitempty"`
+
+	// Time is when the result was recorded.
+	Time time.Time `json:"time"`
+
+	// Total is the total number of matches reported by Yahoo.
+	Total int `json:"total"`
+
+	// Offset is the pagination offset of the first quote.
+	Offset int `json:"offset"`
+
+	// Quotes are the returned quotes, in screen order.
+	Quotes []models.ScreenerQuote `json:"quotes"`
+}
+
+// NewResultSnapshot records result under name at the current time.
+//
+// Example:
+//
+//	result, _ := s.DayGainers(100)
+//	snap := screener.NewResultSnapshot("day_gainers", result)
+func NewResultSnapshot(name string, result *models.ScreenerResult) ResultSnapshot {
+	snap := ResultSnapshot{Name: name, Time: snapshotNow()}
+	if result != nil {
+		snap.Total = result.Total
+		snap.Offset = result.Offset
+		snap.Quotes = append([]models.ScreenerQuote(nil), result.Quotes...)
+	}
+	return snap
+}
+
+// Ranks maps each symbol to its 1-based rank in the screen, counting from
+// the snapshot's offset. A symbol listed twice keeps its best rank.
+func (s ResultSnapshot) Ranks() map[string]int {
+	ranks := make(map[string]int, len(s.Quotes))
+	for i, q := range s.Quotes {
+		if _, ok := ranks[q.Symbol]; !ok && q.Symbol != "" {
+			ranks[q.Symbol] = s.Offset + i + 1
+		}
+	}
+	return ranks
+}
+
+// WriteResultSnapshot appends snap to w as a single line of JSON. A file
+// written this way holds the history of a screen, one run per line.
+//
+// Example:
+//
+//	f, _ := os.OpenFile("gainers.jsonl", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+//	defer f.Close()
+//	if err := screener.WriteResultSnapshot(f, snap); err != nil {
+//	    log.Fatal(err)
+//	}
+func WriteResultSnapshot(w io.Writer, snap ResultSnapshot) error {
+	if err := json.NewEncoder(w).Encode(snap); err != nil {
+		return fmt.Errorf("failed to write screener snapshot: %w", err)
+	}
+	return nil
+}
+
+// ReadResultSnapshots reads the snapshots written by [WriteResultSnapshot],
+// oldest first by time.
+//
+// Example:
+//
+//	f, _ := os.Open("gainers.jsonl")
+//	defer f.Close()
+//	snaps, err := screener.ReadResultSnapshots(f)
+func ReadResultSnapshots(r io.Reader) ([]ResultSnapshot, error) {
+	var snaps []ResultSnapshot
+	dec := json.NewDecoder(r)
+	for {
+		var snap ResultSnapshot
+		err := dec.Decode(&snap)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read screener snapshot %d: %w", len(snaps)+1, err)
+		}
+		snaps = append(snaps, snap)
+	}
+	sort.SliceStable(snaps, func(i, j int) bool { return snaps[i].Time.Before(snaps[j].Time) })
+	return snaps, nil
+}
+
+// RankChange describes how a symbol's position changed between two runs.
+type RankChange struct {
+	// Symbol is the ticker symbol.
+	Symbol string
+
+	// Name is the symbol's short name in the latest run it appeared in.
+	Name string
+
+	// PrevRank is the rank in the earlier run, 0 for new entrants.
+	PrevRank int
+
+	// Rank is the rank in the later run, 0 for dropped symbols.
+	Rank int
+
+	// Change is PrevRank - Rank: positive when the symbol moved up. It is 0
+	// for entrants and dropped symbols.
+	Change int
+}
+
+// ResultDiff compares two runs of a screen.
+type ResultDiff struct {
+	// From and To are the times of the compared snapshots.
+	From, To time.Time
+
+	// Entered are symbols only in the later run, ordered by rank.
+	Entered []RankChange
+
+	// Dropped are symbols only in the earlier run, ordered by previous rank.
+	Dropped []RankChange
+
+	// Moved are symbols in both runs whose rank changed, largest move first.
+	Moved []RankChange
+
+	// Unchanged is the number of symbols with the same rank in both runs.
+	Unchanged int
+}
+
+// DiffResults compares an earlier and a later snapshot of a screen, listing
+// new entrants, dropped symbols and rank changes.
+//
+// Example:
+//
+//	snaps, _ := screener.ReadResultSnapshots(f)
+//	diff := screener.DiffResults(snaps[len(snaps)-2], snaps[len(snaps)-1])
+//	for _, c := range diff.Entered {
+//	    fmt.Printf("new: %s at #%d\n", c.Symbol, c.Rank)
+//	}
+//	for _, c := range diff.Moved {
+//	    fmt.Printf("%s: #%d -> #%d\n", c.Symbol, c.PrevRank, c.Rank)
+//	}
+func DiffResults(prev, curr ResultSnapshot) ResultDiff {
+	diff := ResultDiff{From: prev.Time, To: curr.Time}
+	prevRanks, currRanks := prev.Ranks(), curr.Ranks()
+
+	names := make(map[string]string)
+	for _, snap := range []ResultSnapshot{prev, curr} {
+		for _, q := range snap.Quotes {
+			if q.ShortName != "" {
+				names[q.Symbol] = q.ShortName
+			}
+		}
+	}
+
+	for sym, rank := range currRanks {
+		prevRank, ok := prevRanks[sym]
+		switch {
+		case !ok:
+			diff.Entered = append(diff.Entered, RankChange{Symbol: sym, Name: names[sym], Rank: rank})
+		case prevRank != rank:
+			diff.Moved = append(diff.Moved, RankChange{
+				Symbol:   sym,
+				Name:     names[sym],
+				PrevRank: prevRank,
+				Rank:     rank,
+				Change:   prevRank - rank,
+			})
+		default:
+			diff.Unchanged++
+		}
+	}
+	for sym, prevRank := range prevRanks {
+		if _, ok := currRanks[sym]; !ok {
+			diff.Dropped = append(diff.Dropped, RankChange{Symbol: sym, Name: names[sym], PrevRank: prevRank})
+		}
+	}
+
+	sort.Slice(diff.Entered, func(i, j int) bool { return diff.Entered[i].Rank < diff.Entered[j].Rank })
+	sort.Slice(diff.Dropped, func(i, j int) bool { return diff.Dropped[i].PrevRank < diff.Dropped[j].PrevRank })
+	sort.Slice(diff.Moved, func(i, j int) bool {
+		a, b := abs(diff.Moved[i].Change), abs(diff.Moved[j].Change)
+		if a != b {
+			return a > b
+		}
+		return diff.Moved[i].Rank < diff.Moved[j].Rank
+	})
+	return diff
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,107 @@
+package screener
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func snapshotOf(t time.Time, offset int, symbols ...string) ResultSnapshot {
+	snap := ResultSnapshot{Name: "test", Time: t, Offset: offset, Total: len(symbols)}
+	for _, sym := range symbols {
+		snap.Quotes = append(snap.Quotes, models.ScreenerQuote{Symbol: sym, ShortName: sym + " Inc"})
+	}
+	return snap
+}
+
+func TestNewResultSnapshot(t *testing.T) {
+	now := time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC)
+	snapshotNow = func() time.Time { return now }
+	defer func() { snapshotNow = time.Now }()
+
+	result := &models.ScreenerResult{Total: 40, Offset: 10, Quotes: []models.ScreenerQuote{{Symbol: "A"}, {Symbol: "B"}, {Symbol: "A"}}}
+	snap := NewResultSnapshot("gainers", result)
+	if !snap.Time.Equal(now) || snap.Total != 40 || snap.Name != "gainers" {
+		t.Errorf("unexpected snapshot %+v", snap)
+	}
+
+	ranks := snap.Ranks()
+	if ranks["A"] != 11 || ranks["B"] != 12 || len(ranks) != 2 {
+		t.Errorf("expected ranks from offset keeping best rank, got %v", ranks)
+	}
+
+	result.Quotes[0].Symbol = "Z"
+	if snap.Quotes[0].Symbol != "A" {
+		t.Error("snapshot should not share quotes with the result")
+	}
+}
+
+func TestResultSnapshotRoundTrip(t *testing.T) {
+	t0 := time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	// Written out of order; read back oldest first
+	for _, snap := range []ResultSnapshot{snapshotOf(t0.Add(24*time.Hour), 0, "B"), snapshotOf(t0, 0, "A", "B")} {
+		if err := WriteResultSnapshot(&buf, snap); err != nil {
+			t.Fatalf("WriteResultSnapshot() error: %v", err)
+		}
+	}
+	if n := strings.Count(buf.String(), "\n"); n != 2 {
+		t.Errorf("expected one line per snapshot, got %d", n)
+	}
+
+	snaps, err := ReadResultSnapshots(&buf)
+	if err != nil {
+		t.Fatalf("ReadResultSnapshots() error: %v", err)
+	}
+	if len(snaps) != 2 || !snaps[0].Time.Equal(t0) || len(snaps[0].Quotes) != 2 {
+		t.Fatalf("unexpected snapshots %+v", snaps)
+	}
+	if snaps[0].Quotes[1].ShortName != "B Inc" {
+		t.Errorf("expected quote fields to round-trip, got %+v", snaps[0].Quotes[1])
+	}
+
+	if _, err := ReadResultSnapshots(strings.NewReader(`{"name":"x"}` + "\n{broken")); err == nil {
+		t.Error("expected error for malformed snapshot")
+	}
+}
+
+func TestDiffResults(t *testing.T) {
+	t0 := time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC)
+	prev := snapshotOf(t0, 0, "A", "B", "C", "D", "E")
+	curr := snapshotOf(t0.Add(24*time.Hour), 0, "C", "A", "F", "D", "G", "B")
+
+	diff := DiffResults(prev, curr)
+	if !diff.From.Equal(prev.Time) || !diff.To.Equal(curr.Time) {
+		t.Errorf("unexpected diff times %v - %v", diff.From, diff.To)
+	}
+
+	if len(diff.Entered) != 2 || diff.Entered[0].Symbol != "F" || diff.Entered[0].Rank != 3 || diff.Entered[1].Symbol != "G" {
+		t.Errorf("unexpected entrants %+v", diff.Entered)
+	}
+	if len(diff.Dropped) != 1 || diff.Dropped[0].Symbol != "E" || diff.Dropped[0].PrevRank != 5 {
+		t.Errorf("unexpected dropped %+v", diff.Dropped)
+	}
+	if diff.Dropped[0].Name != "E Inc" {
+		t.Errorf("expected dropped symbol to keep its name, got %q", diff.Dropped[0].Name)
+	}
+
+	// B 2->6 (-4), C 3->1 (+2), A 1->2 (-1); D unchanged
+	want := []struct {
+		sym    string
+		change int
+	}{{"B", -4}, {"C", 2}, {"A", -1}}
+	if len(diff.Moved) != len(want) {
+		t.Fatalf("expected %d moves, got %+v", len(want), diff.Moved)
+	}
+	for i, w := range want {
+		if diff.Moved[i].Symbol != w.sym || diff.Moved[i].Change != w.change {
+			t.Errorf("move %d: expected %s %+d, got %+v", i, w.sym, w.change, diff.Moved[i])
+		}
+	}
+	if diff.Unchanged != 1 {
+		t.Errorf("expected 1 unchanged, got %d", diff.Unchanged)
+	}
+}
@@ -0,0 +1,58 @@
+package screener
+
+import (
+	"fmt"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// InvalidSortFieldError is returned when params.SortField is not a field the
+// screener can sort by. It is reported before any request is made, instead of
+// Yahoo's opaque "Invalid sortField" response.
+//
+// Example:
+//
+//	var sortErr *screener.InvalidSortFieldError
+//	if errors.As(err, &sortErr) {
+//	    fmt.Println("valid fields:", models.ValidSortFields(sortErr.QuoteType))
+//	}
+type InvalidSortFieldError struct {
+	// Field is the rejected sort field.
+	Field string
+
+	// QuoteType is the quote type the field was checked against ("" for any).
+	QuoteType string
+}
+
+// Error implements the error interface.
+func (e *InvalidSortFieldError) Error() string {
+	if e.QuoteType == "" {
+		return fmt.Sprintf("invalid sort field %q", e.Field)
+	}
+	return fmt.Sprintf("invalid sort field %q for %s screener", e.Field, e.QuoteType)
+}
+
+// ValidSortFields returns the fields accepted as params.SortField for a
+// predefined screener. Unknown screeners return the fields valid for any
+// quote type.
+func ValidSortFields(screener models.PredefinedScreener) []string {
+	return models.ValidSortFields(predefinedQuoteType(screener))
+}
+
+// validateSortField checks a sort field against the quote type. An empty
+// field is accepted and lets Yahoo apply its default ordering.
+func validateSortField(quoteType, field string) error {
+	if field == "" || models.IsValidSortField(quoteType, field) {
+		return nil
+	}
+	return &InvalidSortFieldError{Field: field, QuoteType: quoteType}
+}
+
+// predefinedQuoteType returns the quote type of a predefined screener, or ""
+// when it is not in PredefinedScreenerQueries.
+func predefinedQuoteType(screener models.PredefinedScreener) string {
+	if predefined, ok := PredefinedScreenerQueries[string(screener)]; ok {
+		return predefined.Query.QuoteType()
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package screener
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/store"
+)
+
+// BreadthStoreKey is the store key breadth snapshots are kept under.
+const BreadthStoreKey = "screener/breadth"
+
+// resultStoreKey returns the store key of the result history of a screen.
+func resultStoreKey(name string) string {
+	return store.Key("screener", "results", name)
+}
+
+// SaveResultSnapshot stores snap in st under its screen name at its time,
+// as an alternative to a JSON Lines file.
+//
+// Example:
+//
+//	st, _ := store.NewFileStore("./snapshots")
+//	result, _ := s.DayGainers(100)
+//	err := screener.SaveResultSnapshot(st, screener.NewResultSnapshot("day_gainers", result))
+func SaveResultSnapshot(st store.Store, snap ResultSnapshot) error {
+	if snap.Name == "" {
+		return fmt.Errorf("screener snapshot name is required")
+	}
+	if err := store.PutJSON(st, resultStoreKey(snap.Name), snap.Time, snap); err != nil {
+		return fmt.Errorf("failed to save screener snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadResultSnapshots returns the snapshots of the named screen saved with
+// [SaveResultSnapshot] in [from, to), oldest first. Zero bounds are open.
+//
+// Example:
+//
+//	snaps, _ := screener.LoadResultSnapshots(st, "day_gainers", time.Time{}, time.Time{})
+//	diff := screener.DiffResults(snaps[len(snaps)-2], snaps[len(snaps)-1])
+func LoadResultSnapshots(st store.Store, name string, from, to time.Time) ([]ResultSnapshot, error) {
+	records, err := st.List(resultStoreKey(name), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load screener snapshots: %w", err)
+	}
+	snaps := make([]ResultSnapshot, 0, len(records))
+	for _, rec := range records {
+		var snap ResultSnapshot
+		if err := json.Unmarshal(rec.Data, &snap); err != nil {
+			return nil, fmt.Errorf("failed to decode screener snapshot at %s: %w", rec.Time, err)
+		}
+		snaps = append(snaps, snap)
+	}
+	return snaps, nil
+}
+
+// LoadBreadthHistory returns the breadth snapshots saved by a
+// [BreadthSnapshotter] configured with [WithBreadthStore] in [from, to),
+// oldest first. Zero bounds are open.
+//
+// Example:
+//
+//	history, _ := screener.LoadBreadthHistory(st, time.Now().AddDate(0, 0, -7), time.Time{})
+func LoadBreadthHistory(st store.Store, from, to time.Time) ([]BreadthSnapshot, error) {
+	records, err := st.List(BreadthStoreKey, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load breadth history: %w", err)
+	}
+	snaps := make([]BreadthSnapshot, 0, len(records))
+	for _, rec := range records {
+		var snap BreadthSnapshot
+		if err := json.Unmarshal(rec.Data, &snap); err != nil {
+			return nil, fmt.Errorf("failed to decode breadth snapshot at %s: %w", rec.Time, err)
+		}
+		snaps = append(snaps, snap)
+	}
+	return snaps, nil
+}
@@ -0,0 +1,57 @@
+package screener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
+)
+
+func TestResultSnapshotStore(t *testing.T) {
+	st, err := store.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := time.Date(2024, 6, 3, 14, 30, 0, 0, time.UTC)
+
+	for i, sym := range []string{"AAPL", "MSFT"} {
+		snap := ResultSnapshot{Name: "day_gainers", Time: at.Add(time.Duration(i) * time.Hour), Total: 1,
+			Quotes: []models.ScreenerQuote{{Symbol: sym}}}
+		if err := SaveResultSnapshot(st, snap); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := SaveResultSnapshot(st, ResultSnapshot{Time: at}); err == nil {
+		t.Error("expected error for unnamed snapshot")
+	}
+
+	snaps, err := LoadResultSnapshots(st, "day_gainers", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snaps) != 2 || snaps[0].Quotes[0].Symbol != "AAPL" || !snaps[1].Time.Equal(at.Add(time.Hour)) {
+		t.Errorf("LoadResultSnapshots() = %+v", snaps)
+	}
+}
+
+func TestBreadthStore(t *testing.T) {
+	st, err := store.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewBreadthSnapshotter(nil, []BreadthSource{{Name: "advancers"}}, WithBreadthStore(st))
+	b.count = func(BreadthSource) (int, error) { return 42, nil }
+	if _, err := b.Snapshot(); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := LoadBreadthHistory(st, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Counts["advancers"] != 42 {
+		t.Errorf("LoadBreadthHistory() = %+v", history)
+	}
+}
@@ -30,6 +30,9 @@
 //   - [Search.SearchWithParams]: Search with custom parameters
 //   - [Search.Quotes]: Get only quote results
 //   - [Search.News]: Get only news results
+//   - [Search.ResearchReports]: Get only research reports
+//   - [Search.BestMatch]: Resolve a company name to its most likely symbol
+//   - [Search.Listings]: Find a security's listings and ADRs across exchanges
 //
 // # Search Parameters
 //
@@ -43,6 +46,18 @@
 //	}
 //	result, err := s.SearchWithParams(params)
 //
+// # Dual Listings and ADRs
+//
+// [Search.Listings] takes a symbol or an ISIN and returns the security's
+// listings on other exchanges, with currencies and average volume, most
+// liquid first. US listings in USD of companies reporting in another
+// currency are flagged IsADR:
+//
+//	listings, err := s.Listings("7203.T")
+//	for _, l := range listings {
+//	    fmt.Println(l.Symbol, l.Exchange, l.Currency, l.IsADR)
+//	}
+//
 // # Thread Safety
 //
 // All Search methods are safe for concurrent use from multiple goroutines.
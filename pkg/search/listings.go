@@ -0,0 +1,228 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/internal/parallel"
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+const (
+	// listingCandidates is the number of search quotes considered per query.
+	listingCandidates = 25
+
+	// listingMinNameScore is the lowest name similarity for a search quote
+	// to count as another listing of the same company.
+	listingMinNameScore = 0.8
+
+	// maxListingWorkers bounds the number of quotes fetched concurrently.
+	maxListingWorkers = 8
+)
+
+// usExchanges are the exchange codes of US venues, used to flag ADRs.
+var usExchanges = map[string]bool{
+	"NYQ": true, "NMS": true, "NGM": true, "NCM": true, "NYS": true,
+	"ASE": true, "PCX": true, "BTS": true, "PNK": true, "OTC": true, "OTCM": true,
+}
+
+// quotesFunc searches for quotes matching a query.
+type quotesFunc func(query string, maxResults int) ([]models.SearchQuote, error)
+
+// listingQuoteFunc fetches the quote of a single listing.
+type listingQuoteFunc func(symbol string) (*models.Quote, error)
+
+// Listings finds the listings of a security across exchanges, including
+// ADRs and other depositary receipts, so the most liquid venue can be
+// chosen. symbolOrISIN is a Yahoo symbol (e.g. "7203.T") or an ISIN (e.g.
+// "JP3633400001").
+//
+// An ISIN is searched directly and its results are marked ISINMatch. Other
+// listings, and all listings when a symbol is given, are matched by company
+// name. Each listing is enriched with its quote's currency, price and
+// average volume; listings whose quote cannot be fetched are kept without
+// them. Results are ordered by average volume, most liquid first.
+//
+// Returns a not-found [client.YFError] if the symbol or ISIN is unknown.
+//
+// Example:
+//
+//	listings, err := s.Listings("7203.T")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, l := range listings {
+//	    fmt.Printf("%-8s %-4s %s adr=%v vol=%d\n", l.Symbol, l.Exchange, l.Currency, l.IsADR, l.AverageVolume)
+//	}
+func (s *Search) Listings(symbolOrISIN string) ([]models.Listing, error) {
+	quoteFn := func(symbol string) (*models.Quote, error) {
+		tkr, err := ticker.New(symbol, ticker.WithClient(s.client))
+		if err != nil {
+			return nil, err
+		}
+		defer tkr.Close()
+		return tkr.Quote()
+	}
+	return listings(symbolOrISIN, s.Quotes, quoteFn)
+}
+
+// listings resolves query to its listings using search and quote.
+func listings(query string, search quotesFunc, quote listingQuoteFunc) ([]models.Listing, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("symbol or ISIN is required")
+	}
+
+	seeds, err := listingSeeds(query, search)
+	if err != nil {
+		return nil, err
+	}
+	if len(seeds) == 0 {
+		return nil, client.WrapNotFoundError(query)
+	}
+
+	name := quoteName(seeds[0].Quote)
+	candidates, err := search(name, listingCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var result []models.Listing
+	add := func(l models.Listing) {
+		if l.Symbol == "" || seen[l.Symbol] {
+			return
+		}
+		seen[l.Symbol] = true
+		result = append(result, l)
+	}
+	for _, m := range seeds {
+		add(newListing(m, query))
+	}
+	for _, q := range candidates {
+		if !strings.EqualFold(q.QuoteType, seeds[0].Quote.QuoteType) {
+			continue
+		}
+		score := nameSimilarity(name, q.LongName)
+		if s := nameSimilarity(name, q.ShortName); s > score {
+			score = s
+		}
+		if score >= listingMinNameScore {
+			add(newListing(models.SymbolMatch{Quote: q, NameScore: score}, ""))
+		}
+	}
+
+	enrichListings(result, quote)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].AverageVolume > result[j].AverageVolume
+	})
+	return result, nil
+}
+
+// listingSeeds returns the search quotes the query identifies: every result
+// of an ISIN query, or the result whose symbol equals the query.
+func listingSeeds(query string, search quotesFunc) ([]models.SymbolMatch, error) {
+	quotes, err := search(query, listingCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	isin := isISIN(query)
+	var seeds []models.SymbolMatch
+	for _, q := range quotes {
+		if isin || strings.EqualFold(q.Symbol, query) {
+			seeds = append(seeds, models.SymbolMatch{Quote: q, NameScore: 1})
+		}
+	}
+	return seeds, nil
+}
+
+// newListing converts a matched search quote to a listing. isin is the
+// queried ISIN, or empty when the quote was matched by name.
+func newListing(m models.SymbolMatch, isin string) models.Listing {
+	return models.Listing{
+		Symbol:       m.Quote.Symbol,
+		Name:         quoteName(m.Quote),
+		Exchange:     m.Quote.Exchange,
+		ExchangeDisp: m.Quote.ExchangeDisp,
+		QuoteType:    m.Quote.QuoteType,
+		ISINMatch:    isISIN(isin),
+		NameScore:    m.NameScore,
+	}
+}
+
+// enrichListings fills quote data into listings concurrently.
+func enrichListings(listings []models.Listing, quote listingQuoteFunc) {
+	parallel.For(context.Background(), len(listings), maxListingWorkers, func(i int) {
+		q, err := quote(listings[i].Symbol)
+		if err != nil || q == nil {
+			return
+		}
+		l := &listings[i]
+		l.Currency = q.Currency
+		l.FinancialCurrency = q.FinancialCurrency
+		l.Price = q.RegularMarketPrice
+		l.AverageVolume = q.AverageDailyVolume3Month
+		l.IsADR = isADR(l)
+	}, nil)
+}
+
+// isADR reports whether l trades in USD on a US exchange for a company
+// reporting in another currency.
+func isADR(l *models.Listing) bool {
+	return usExchanges[strings.ToUpper(l.Exchange)] &&
+		strings.EqualFold(l.Currency, "USD") &&
+		l.FinancialCurrency != "" && !strings.EqualFold(l.FinancialCurrency, "USD")
+}
+
+// quoteName returns the long name of q, or its short name.
+func quoteName(q models.SearchQuote) string {
+	if q.LongName != "" {
+		return q.LongName
+	}
+	return q.ShortName
+}
+
+// isISIN reports whether s is a well-formed ISIN with a valid check digit.
+func isISIN(s string) bool {
+	if len(s) != 12 {
+		return false
+	}
+	s = strings.ToUpper(s)
+
+	var digits strings.Builder
+	for i, r := range s {
+		switch {
+		case i < 2 && r >= 'A' && r <= 'Z':
+		case i >= 2 && i < 11 && (r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'):
+		case i == 11 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+		if r >= 'A' && r <= 'Z' {
+			fmt.Fprintf(&digits, "%d", r-'A'+10)
+		} else {
+			digits.WriteRune(r)
+		}
+	}
+
+	// Luhn checksum over the expanded digits
+	sum := 0
+	d := digits.String()
+	for i := len(d) - 1; i >= 0; i-- {
+		n := int(d[i] - '0')
+		if (len(d)-1-i)%2 == 1 {
+			n *= 2
+			if n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+	}
+	return sum%10 == 0
+}
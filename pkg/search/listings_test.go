@@ -0,0 +1,92 @@
+package search
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestIsISIN(t *testing.T) {
+	tests := map[string]bool{
+		"US0378331005": true,  // Apple
+		"JP3633400001": true,  // Toyota
+		"GB0002634946": true,  // BAE Systems
+		"us0378331005": true,  // case-insensitive
+		"US0378331006": false, // bad check digit
+		"AAPL":         false,
+		"1S0378331005": false,
+	}
+	for in, want := range tests {
+		if got := isISIN(in); got != want {
+			t.Errorf("isISIN(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestListings(t *testing.T) {
+	search := func(query string, _ int) ([]models.SearchQuote, error) {
+		switch query {
+		case "7203.T", "JP3633400001":
+			return []models.SearchQuote{
+				{Symbol: "7203.T", LongName: "Toyota Motor Corporation", Exchange: "JPX", QuoteType: "EQUITY"},
+			}, nil
+		case "Toyota Motor Corporation":
+			return []models.SearchQuote{
+				{Symbol: "7203.T", LongName: "Toyota Motor Corporation", Exchange: "JPX", QuoteType: "EQUITY"},
+				{Symbol: "TM", LongName: "Toyota Motor Corporation", Exchange: "NYQ", QuoteType: "EQUITY"},
+				{Symbol: "TOM.F", ShortName: "TOYOTA MOTOR CORP", Exchange: "FRA", QuoteType: "EQUITY"},
+				{Symbol: "TM250117C00200000", ShortName: "TM Jan 2025 200 call", Exchange: "OPR", QuoteType: "OPTION"},
+				{Symbol: "TYIDY", LongName: "Toyota Industries Corporation", Exchange: "PNK", QuoteType: "EQUITY"},
+			}, nil
+		}
+		return nil, nil
+	}
+	quotes := map[string]*models.Quote{
+		"7203.T": {Currency: "JPY", FinancialCurrency: "JPY", AverageDailyVolume3Month: 30_000_000},
+		"TM":     {Currency: "USD", FinancialCurrency: "JPY", AverageDailyVolume3Month: 400_000},
+	}
+	quote := func(symbol string) (*models.Quote, error) {
+		if q, ok := quotes[symbol]; ok {
+			return q, nil
+		}
+		return nil, fmt.Errorf("no quote")
+	}
+
+	got, err := listings("7203.T", search, quote)
+	if err != nil {
+		t.Fatalf("listings() error: %v", err)
+	}
+
+	var symbols []string
+	for _, l := range got {
+		symbols = append(symbols, l.Symbol)
+	}
+	if fmt.Sprint(symbols) != "[7203.T TM TOM.F]" {
+		t.Fatalf("symbols = %v, want [7203.T TM TOM.F]", symbols)
+	}
+	if tm := got[1]; !tm.IsADR || tm.Currency != "USD" {
+		t.Errorf("TM should be a USD ADR, got %+v", tm)
+	}
+	if got[0].IsADR || got[0].ISINMatch {
+		t.Errorf("7203.T flags = %+v", got[0])
+	}
+	if got[2].Currency != "" {
+		t.Error("listing without quote should have no currency")
+	}
+
+	got, err = listings("JP3633400001", search, quote)
+	if err != nil {
+		t.Fatalf("listings(ISIN) error: %v", err)
+	}
+	if !got[0].ISINMatch || got[1].ISINMatch {
+		t.Errorf("only the ISIN result should be an ISIN match: %+v", got)
+	}
+
+	if _, err := listings("NOPE", search, quote); err == nil {
+		t.Error("expected error for unknown symbol")
+	}
+	if _, err := listings(" ", search, quote); err == nil {
+		t.Error("expected error for empty query")
+	}
+}
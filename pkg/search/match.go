@@ -0,0 +1,218 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// Score weights; they sum to 1.
+const (
+	nameWeight      = 0.6
+	quoteTypeWeight = 0.25
+	exchangeWeight  = 0.15
+)
+
+// matchCandidates is the number of search quotes scored by BestMatch.
+const matchCandidates = 10
+
+// corporateSuffixes are dropped before comparing company names.
+var corporateSuffixes = map[string]bool{
+	"inc": true, "incorporated": true, "corp": true, "corporation": true,
+	"co": true, "company": true, "ltd": true, "limited": true, "plc": true,
+	"llc": true, "lp": true, "sa": true, "ag": true, "nv": true, "se": true,
+	"the": true, "holdings": true, "holding": true, "group": true,
+}
+
+// BestMatch resolves a company name, such as an entry of a third-party list,
+// to the single most likely symbol using [models.DefaultMatchOptions].
+//
+// Returns a not-found [client.YFError] if no result's name is similar
+// enough (MinNameScore).
+//
+// Example:
+//
+//	m, err := s.BestMatch("Microsoft Corporation")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s (%.2f)\n", m.Quote.Symbol, m.Score) // MSFT (0.99)
+func (s *Search) BestMatch(companyName string) (*models.SymbolMatch, error) {
+	return s.BestMatchWithOptions(companyName, models.DefaultMatchOptions())
+}
+
+// BestMatchWithOptions is like [Search.BestMatch] with custom scoring options.
+//
+// Each search quote is scored by name similarity (60%), its quote type's
+// rank in opts.QuoteTypes (25%) and its exchange's rank in
+// opts.PreferredExchanges (15%). Ties keep Yahoo's relevance order.
+//
+// Example:
+//
+//	opts := models.DefaultMatchOptions()
+//	opts.PreferredExchanges = []string{"LSE"}
+//	m, err := s.BestMatchWithOptions("Unilever", opts)
+func (s *Search) BestMatchWithOptions(companyName string, opts models.MatchOptions) (*models.SymbolMatch, error) {
+	quotes, err := s.Quotes(companyName, matchCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	best := bestMatch(companyName, quotes, opts)
+	if best == nil {
+		return nil, client.NewError(client.ErrCodeNotFound, fmt.Sprintf("no symbol matches %q", companyName), nil)
+	}
+	return best, nil
+}
+
+// bestMatch returns the highest scoring quote, or nil if no name reaches
+// opts.MinNameScore.
+func bestMatch(name string, quotes []models.SearchQuote, opts models.MatchOptions) *models.SymbolMatch {
+	var matches []models.SymbolMatch
+	for _, q := range quotes {
+		if m, ok := scoreQuote(name, q, opts); ok && m.NameScore >= opts.MinNameScore {
+			matches = append(matches, m)
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return &matches[0]
+}
+
+// scoreQuote scores a quote against name. It reports false for quote types
+// not accepted by opts.
+func scoreQuote(name string, q models.SearchQuote, opts models.MatchOptions) (models.SymbolMatch, bool) {
+	typeScore := 1.0
+	if len(opts.QuoteTypes) > 0 {
+		i := indexFold(opts.QuoteTypes, q.QuoteType)
+		if i < 0 {
+			return models.SymbolMatch{}, false
+		}
+		typeScore = rankScore(i, len(opts.QuoteTypes))
+	}
+
+	exchangeScore := 0.0
+	if i := indexFold(opts.PreferredExchanges, q.Exchange); i >= 0 {
+		exchangeScore = rankScore(i, len(opts.PreferredExchanges))
+	}
+
+	nameScore := nameSimilarity(name, q.LongName)
+	if s := nameSimilarity(name, q.ShortName); s > nameScore {
+		nameScore = s
+	}
+	if strings.EqualFold(strings.TrimSpace(name), q.Symbol) {
+		nameScore = 1
+	}
+
+	return models.SymbolMatch{
+		Quote:     q,
+		NameScore: nameScore,
+		Score:     nameWeight*nameScore + quoteTypeWeight*typeScore + exchangeWeight*exchangeScore,
+	}, true
+}
+
+// rankScore maps position i of n to a score from 1 (first) down to 1/n.
+func rankScore(i, n int) float64 {
+	return 1 - float64(i)/float64(n)
+}
+
+func indexFold(list []string, v string) int {
+	for i, s := range list {
+		if strings.EqualFold(s, v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// nameSimilarity compares two company names (0-1), ignoring case,
+// punctuation and corporate suffixes. It is the better of token overlap
+// and edit-distance similarity.
+func nameSimilarity(a, b string) float64 {
+	ta, tb := nameTokens(a), nameTokens(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	set := make(map[string]bool, len(ta))
+	for _, t := range ta {
+		set[t] = true
+	}
+	common := 0
+	seen := make(map[string]bool, len(tb))
+	for _, t := range tb {
+		if set[t] && !seen[t] {
+			common++
+		}
+		seen[t] = true
+	}
+	dice := 2 * float64(common) / float64(len(set)+len(seen))
+
+	ja, jb := strings.Join(ta, " "), strings.Join(tb, " ")
+	longest := len([]rune(ja))
+	if n := len([]rune(jb)); n > longest {
+		longest = n
+	}
+	edit := 1 - float64(levenshtein(ja, jb))/float64(longest)
+
+	if dice > edit {
+		return dice
+	}
+	return edit
+}
+
+// nameTokens lower-cases a name and splits it into words, dropping
+// punctuation, corporate suffixes and share classes. "&" becomes "and".
+func nameTokens(name string) []string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		if w == "class" && i+1 < len(words) && len(words[i+1]) == 1 {
+			i++ // share class designator, e.g. "Class A"
+			continue
+		}
+		if !corporateSuffixes[w] {
+			tokens = append(tokens, w)
+		}
+	}
+	if len(tokens) == 0 {
+		return words // the name is only suffixes, e.g. "The Company"
+	}
+	return tokens
+}
+
+// levenshtein returns the edit distance between a and b in runes.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{"Microsoft Corporation", "Microsoft Corp.", 1, 1},
+		{"Johnson & Johnson", "Johnson and Johnson", 1, 1},
+		{"Alphabet Inc.", "Alphabet Inc. Class A", 1, 1},
+		{"Alphabet", "Alphabet Holdings Europe", 0.4, 0.9},
+		{"Apple", "Applied Materials, Inc.", 0, 0.5},
+		{"", "Apple Inc.", 0, 0},
+	}
+	for _, tt := range tests {
+		got := nameSimilarity(tt.a, tt.b)
+		if got < tt.min || got > tt.max {
+			t.Errorf("nameSimilarity(%q, %q) = %.2f, want [%.2f, %.2f]", tt.a, tt.b, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestBestMatch(t *testing.T) {
+	quotes := []models.SearchQuote{
+		{Symbol: "APC.F", ShortName: "APPLE INC", Exchange: "FRA", QuoteType: "EQUITY"},
+		{Symbol: "AAPL", ShortName: "Apple Inc.", LongName: "Apple Inc.", Exchange: "NMS", QuoteType: "EQUITY"},
+		{Symbol: "AAPL250117C00150000", ShortName: "AAPL Jan 2025 150 call", Exchange: "OPR", QuoteType: "OPTION"},
+		{Symbol: "AMAT", ShortName: "Applied Materials, Inc.", Exchange: "NMS", QuoteType: "EQUITY"},
+	}
+
+	m := bestMatch("Apple Inc", quotes, models.DefaultMatchOptions())
+	if m == nil || m.Quote.Symbol != "AAPL" {
+		t.Fatalf("Expected AAPL, got %+v", m)
+	}
+	if m.NameScore != 1 || m.Score != 1 {
+		t.Errorf("Expected perfect scores, got name %.2f total %.2f", m.NameScore, m.Score)
+	}
+
+	opts := models.DefaultMatchOptions()
+	opts.PreferredExchanges = []string{"FRA"}
+	if m := bestMatch("Apple Inc", quotes, opts); m == nil || m.Quote.Symbol != "APC.F" {
+		t.Errorf("Expected exchange preference to pick APC.F, got %+v", m)
+	}
+
+	if m := bestMatch("Totally Unrelated Name", quotes, models.DefaultMatchOptions()); m != nil {
+		t.Errorf("Expected no match, got %+v", m)
+	}
+
+	if _, ok := scoreQuote("AAPL", quotes[2], models.DefaultMatchOptions()); ok {
+		t.Error("Expected option quotes to be rejected for EQUITY matching")
+	}
+}
@@ -1,10 +1,12 @@
 package search
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
 	"github.com/wnjoon/go-yfinance/pkg/client"
@@ -24,7 +26,6 @@ type Option func(*Search)
 func WithClient(c *client.Client) Option {
 	return func(s *Search) {
 		s.client = c
-		s.ownsClient = false
 	}
 }
 
@@ -38,29 +39,30 @@ func WithClient(c *client.Client) Option {
 //	}
 //	defer s.Close()
 func New(opts ...Option) (*Search, error) {
-	s := &Search{
-		ownsClient: true,
-	}
+	s := &Search{}
 
 	for _, opt := range opts {
 		opt(s)
 	}
 
 	if s.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		s.client = c
+		s.ownsClient = true
 	}
 
 	return s, nil
 }
 
-// Close releases resources used by the Search instance.
+// Close releases the shared [client.Default] client if the Search acquired
+// it. A client passed with WithClient is left open.
 func (s *Search) Close() {
-	if s.ownsClient && s.client != nil {
-		s.client.Close()
+	if s.ownsClient {
+		s.ownsClient = false
+		s.client.Release()
 	}
 }
 
@@ -78,9 +80,21 @@ func (s *Search) Close() {
 //	    fmt.Printf("%s: %s\n", quote.Symbol, quote.ShortName)
 //	}
 func (s *Search) Search(query string) (*models.SearchResult, error) {
+	return s.SearchContext(context.Background(), query)
+}
+
+// SearchContext is like [Search.Search] but returns ctx's error as soon as
+// ctx is done.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+//	defer cancel()
+//	result, err := s.SearchContext(ctx, "Apple")
+func (s *Search) SearchContext(ctx context.Context, query string) (*models.SearchResult, error) {
 	params := models.DefaultSearchParams()
 	params.Query = query
-	return s.SearchWithParams(params)
+	return s.SearchWithParamsContext(ctx, params)
 }
 
 // SearchWithParams searches with custom parameters.
@@ -95,6 +109,12 @@ func (s *Search) Search(query string) (*models.SearchResult, error) {
 //	}
 //	result, err := s.SearchWithParams(params)
 func (s *Search) SearchWithParams(params models.SearchParams) (*models.SearchResult, error) {
+	return s.SearchWithParamsContext(context.Background(), params)
+}
+
+// SearchWithParamsContext is like [Search.SearchWithParams] but returns
+// ctx's error as soon as ctx is done.
+func (s *Search) SearchWithParamsContext(ctx context.Context, params models.SearchParams) (*models.SearchResult, error) {
 	if params.Query == "" {
 		return nil, fmt.Errorf("query is required")
 	}
@@ -118,7 +138,7 @@ func (s *Search) SearchWithParams(params models.SearchParams) (*models.SearchRes
 		urlParams.Set("enableNavLinks", "true")
 	}
 
-	resp, err := s.client.Get(endpoints.SearchURL, urlParams)
+	resp, err := s.client.GetContext(ctx, endpoints.SearchURL, urlParams)
 	if err != nil {
 		return nil, fmt.Errorf("search request failed: %w", err)
 	}
@@ -175,6 +195,73 @@ func (s *Search) News(query string, newsCount int) ([]models.SearchNews, error)
 	return result.News, nil
 }
 
+// ResearchReports returns the research reports matching a search query.
+//
+// Reports use the same [models.ResearchReport] model as sector and industry
+// reports.
+//
+// Example:
+//
+//	reports, err := s.ResearchReports("AAPL")
+//	for _, r := range reports {
+//	    fmt.Printf("%s [%s] %s\n", r.PublishDate, r.Provider, r.Title)
+//	}
+func (s *Search) ResearchReports(query string) ([]models.ResearchReport, error) {
+	params := models.SearchParams{
+		Query:           query,
+		MaxResults:      0,
+		NewsCount:       0,
+		ListsCount:      0,
+		IncludeResearch: true,
+	}
+	result, err := s.SearchWithParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]models.ResearchReport, 0, len(result.Research))
+	for _, r := range result.Research {
+		if r.ReportID == "" && r.Title == "" {
+			continue
+		}
+		reports = append(reports, r.Report())
+	}
+	return reports, nil
+}
+
+// parseResearch converts a raw research report, accepting both the documented
+// keys and the ones Yahoo currently returns (reportHeadline, reportDate in ms).
+func parseResearch(r models.RawResearchReport) models.SearchResearch {
+	research := models.SearchResearch{
+		ReportID:    r.ReportID,
+		Title:       r.Title,
+		Provider:    r.Provider,
+		Ticker:      r.Ticker,
+		PublishDate: r.PublishDate,
+	}
+	if research.ReportID == "" {
+		research.ReportID = r.ID
+	}
+	if research.Title == "" {
+		research.Title = r.ReportHeadline
+	}
+	if research.Title == "" {
+		research.Title = r.ReportTitle
+	}
+	if research.PublishDate == "" && len(r.ReportDate) > 0 {
+		var ms models.Number
+		if err := json.Unmarshal(r.ReportDate, &ms); err == nil && ms.Int64() > 0 {
+			research.PublishDate = time.UnixMilli(ms.Int64()).UTC().Format("2006-01-02")
+		} else {
+			var date string
+			if err := json.Unmarshal(r.ReportDate, &date); err == nil {
+				research.PublishDate = date
+			}
+		}
+	}
+	return research
+}
+
 // parseSearchResult converts raw API response to SearchResult.
 func (s *Search) parseSearchResult(raw *models.SearchResponse) *models.SearchResult {
 	result := &models.SearchResult{
@@ -184,17 +271,17 @@ func (s *Search) parseSearchResult(raw *models.SearchResponse) *models.SearchRes
 	// Parse quotes
 	for _, q := range raw.Quotes {
 		quote := models.SearchQuote{
-			Symbol:         getString(q, "symbol"),
-			ShortName:      getString(q, "shortname"),
-			LongName:       getString(q, "longname"),
-			Exchange:       getString(q, "exchange"),
-			ExchangeDisp:   getString(q, "exchDisp"),
-			QuoteType:      getString(q, "quoteType"),
-			TypeDisp:       getString(q, "typeDisp"),
-			Score:          getFloat(q, "score"),
-			IsYahooFinance: getBool(q, "isYahooFinance"),
-			Industry:       getString(q, "industry"),
-			Sector:         getString(q, "sector"),
+			Symbol:         q.Symbol,
+			ShortName:      q.ShortName,
+			LongName:       q.LongName,
+			Exchange:       q.Exchange,
+			ExchangeDisp:   q.ExchangeDisp,
+			QuoteType:      q.QuoteType,
+			TypeDisp:       q.TypeDisp,
+			Score:          q.Score.Float64(),
+			IsYahooFinance: q.IsYahooFinance,
+			Industry:       q.Industry,
+			Sector:         q.Sector,
 		}
 		result.Quotes = append(result.Quotes, quote)
 	}
@@ -202,31 +289,27 @@ func (s *Search) parseSearchResult(raw *models.SearchResponse) *models.SearchRes
 	// Parse news
 	for _, n := range raw.News {
 		news := models.SearchNews{
-			UUID:           getString(n, "uuid"),
-			Title:          getString(n, "title"),
-			Publisher:      getString(n, "publisher"),
-			Link:           getString(n, "link"),
-			PublishTime:    getInt64(n, "providerPublishTime"),
-			Type:           getString(n, "type"),
-			RelatedTickers: getStringSlice(n, "relatedTickers"),
+			UUID:           n.UUID,
+			Title:          n.Title,
+			Publisher:      n.Publisher,
+			Link:           n.Link,
+			PublishTime:    n.ProviderPublishTime.Int64(),
+			Type:           n.Type,
+			RelatedTickers: n.RelatedTickers,
 		}
 
 		// Parse thumbnail
-		if thumbRaw, ok := n["thumbnail"].(map[string]interface{}); ok {
-			if resolutions, ok := thumbRaw["resolutions"].([]interface{}); ok {
-				thumb := &models.SearchThumbnail{}
-				for _, r := range resolutions {
-					if res, ok := r.(map[string]interface{}); ok {
-						thumb.Resolutions = append(thumb.Resolutions, models.ThumbnailResolution{
-							URL:    getString(res, "url"),
-							Width:  int(getFloat(res, "width")),
-							Height: int(getFloat(res, "height")),
-							Tag:    getString(res, "tag"),
-						})
-					}
-				}
-				news.Thumbnail = thumb
+		if n.Thumbnail != nil && n.Thumbnail.Resolutions != nil {
+			thumb := &models.SearchThumbnail{}
+			for _, res := range n.Thumbnail.Resolutions {
+				thumb.Resolutions = append(thumb.Resolutions, models.ThumbnailResolution{
+					URL:    res.URL,
+					Width:  res.Width.Int(),
+					Height: res.Height.Int(),
+					Tag:    res.Tag,
+				})
 			}
+			news.Thumbnail = thumb
 		}
 
 		result.News = append(result.News, news)
@@ -235,87 +318,21 @@ func (s *Search) parseSearchResult(raw *models.SearchResponse) *models.SearchRes
 	// Parse lists
 	for _, l := range raw.Lists {
 		list := models.SearchList{
-			ID:          getString(l, "id"),
-			Name:        getString(l, "name"),
-			Description: getString(l, "description"),
-			SymbolCount: int(getFloat(l, "symbolCount")),
-			URL:         getString(l, "url"),
+			ID:          l.ID,
+			Name:        l.Name,
+			Description: l.Description,
+			SymbolCount: l.SymbolCount.Int(),
+			URL:         l.URL,
 		}
 		result.Lists = append(result.Lists, list)
 	}
 
 	// Parse research
 	for _, r := range raw.Research {
-		research := models.SearchResearch{
-			ReportID:    getString(r, "reportId"),
-			Title:       getString(r, "title"),
-			Provider:    getString(r, "provider"),
-			Ticker:      getString(r, "ticker"),
-			PublishDate: getString(r, "publishDate"),
-		}
-		result.Research = append(result.Research, research)
+		result.Research = append(result.Research, parseResearch(r))
 	}
 
-	// Parse nav
-	for _, n := range raw.Nav {
-		nav := models.SearchNav{
-			Name: getString(n, "name"),
-			URL:  getString(n, "url"),
-		}
-		result.Nav = append(result.Nav, nav)
-	}
+	result.Nav = raw.Nav
 
 	return result
 }
-
-// Helper functions
-func getString(m map[string]interface{}, key string) string {
-	if v, ok := m[key].(string); ok {
-		return v
-	}
-	return ""
-}
-
-func getFloat(m map[string]interface{}, key string) float64 {
-	switch v := m[key].(type) {
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	}
-	return 0
-}
-
-func getInt64(m map[string]interface{}, key string) int64 {
-	switch v := m[key].(type) {
-	case float64:
-		return int64(v)
-	case int:
-		return int64(v)
-	case int64:
-		return v
-	}
-	return 0
-}
-
-func getBool(m map[string]interface{}, key string) bool {
-	if v, ok := m[key].(bool); ok {
-		return v
-	}
-	return false
-}
-
-func getStringSlice(m map[string]interface{}, key string) []string {
-	if arr, ok := m[key].([]interface{}); ok {
-		result := make([]string, 0, len(arr))
-		for _, v := range arr {
-			if s, ok := v.(string); ok {
-				result = append(result, s)
-			}
-		}
-		return result
-	}
-	return nil
-}
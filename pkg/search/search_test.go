@@ -1,8 +1,10 @@
 package search
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -17,8 +19,16 @@ func TestNew(t *testing.T) {
 		return
 	}
 
-	if s.ownsClient != true {
-		t.Error("ownsClient should be true when no custom client is provided")
+	if !s.ownsClient {
+		t.Error("ownsClient should be true when the shared default client is acquired")
+	}
+
+	def, err := client.Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	if s.client != def {
+		t.Error("Client should be the shared default client")
 	}
 }
 
@@ -35,7 +45,7 @@ func TestNewWithClient(t *testing.T) {
 		t.Fatalf("Failed to create second Search: %v", err)
 	}
 
-	if s2.ownsClient != false {
+	if s2.ownsClient {
 		t.Error("ownsClient should be false when custom client is provided")
 	}
 
@@ -88,60 +98,62 @@ func TestDefaultSearchParams(t *testing.T) {
 	}
 }
 
-func TestHelperFunctions(t *testing.T) {
-	// Test getString
-	m := map[string]interface{}{
-		"str":   "hello",
-		"int":   42,
-		"float": 3.14,
-		"bool":  true,
-	}
+func TestParseSearchResult(t *testing.T) {
+	body := `{
+		"count": 3,
+		"quotes": [{"symbol": "AAPL", "shortname": "Apple Inc.", "longname": "Apple Inc.", "exchange": "NMS",
+			"exchDisp": "NASDAQ", "quoteType": "EQUITY", "typeDisp": "Equity", "score": 42,
+			"isYahooFinance": true, "industry": "Consumer Electronics", "sector": "Technology"}],
+		"news": [{"uuid": "n1", "title": "Apple news", "publisher": "Reuters", "link": "https://example.com",
+			"providerPublishTime": "1700000000", "type": "STORY", "relatedTickers": ["a", "b", "c"],
+			"thumbnail": {"resolutions": [{"url": "https://example.com/t.jpg", "width": 140, "height": {"raw": 3.14}, "tag": "140x140"}]}},
+			{"uuid": "n2"}],
+		"lists": [{"id": "l1", "name": "Tech", "description": "Tech stocks", "symbolCount": {"raw": 30, "fmt": "30"}, "url": "https://example.com/l"}],
+		"nav": [{"name": "Markets", "url": "https://example.com/m"}]
+	}`
 
-	if got := getString(m, "str"); got != "hello" {
-		t.Errorf("getString expected 'hello', got '%s'", got)
+	var raw models.SearchResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
+	result := (&Search{}).parseSearchResult(&raw)
 
-	if got := getString(m, "missing"); got != "" {
-		t.Errorf("getString for missing key expected '', got '%s'", got)
+	if result.TotalCount != 3 || len(result.Quotes) != 1 {
+		t.Fatalf("unexpected result %+v", result)
 	}
-
-	// Test getFloat
-	if got := getFloat(m, "float"); got != 3.14 {
-		t.Errorf("getFloat expected 3.14, got %f", got)
+	q := result.Quotes[0]
+	if q.Symbol != "AAPL" || q.ShortName != "Apple Inc." || q.ExchangeDisp != "NASDAQ" {
+		t.Errorf("unexpected quote strings %+v", q)
 	}
-
-	if got := getFloat(m, "int"); got != 42.0 {
-		t.Errorf("getFloat for int expected 42.0, got %f", got)
+	if q.Score != 42.0 || !q.IsYahooFinance {
+		t.Errorf("expected score 42 and isYahooFinance, got %+v", q)
 	}
 
-	// Test getBool
-	if got := getBool(m, "bool"); got != true {
-		t.Errorf("getBool expected true, got %v", got)
+	if len(result.News) != 2 {
+		t.Fatalf("expected 2 news items, got %d", len(result.News))
 	}
-
-	if got := getBool(m, "missing"); got != false {
-		t.Errorf("getBool for missing key expected false, got %v", got)
+	n := result.News[0]
+	if n.PublishTime != 1700000000 {
+		t.Errorf("expected publish time from string, got %d", n.PublishTime)
 	}
-}
-
-func TestGetStringSlice(t *testing.T) {
-	m := map[string]interface{}{
-		"tags": []interface{}{"a", "b", "c"},
+	if len(n.RelatedTickers) != 3 || n.RelatedTickers[0] != "a" || n.RelatedTickers[2] != "c" {
+		t.Errorf("unexpected related tickers %v", n.RelatedTickers)
 	}
-
-	result := getStringSlice(m, "tags")
-	if len(result) != 3 {
-		t.Errorf("Expected 3 elements, got %d", len(result))
+	if n.Thumbnail == nil || len(n.Thumbnail.Resolutions) != 1 {
+		t.Fatalf("expected one thumbnail resolution, got %+v", n.Thumbnail)
 	}
-
-	if result[0] != "a" || result[1] != "b" || result[2] != "c" {
-		t.Errorf("Unexpected values: %v", result)
+	if r := n.Thumbnail.Resolutions[0]; r.Width != 140 || r.Height != 3 || r.Tag != "140x140" {
+		t.Errorf("unexpected resolution %+v", r)
+	}
+	if m := result.News[1]; m.Thumbnail != nil || m.RelatedTickers != nil || m.Title != "" {
+		t.Errorf("expected missing fields left empty, got %+v", m)
 	}
 
-	// Test missing key
-	result = getStringSlice(m, "missing")
-	if result != nil {
-		t.Errorf("Expected nil for missing key, got %v", result)
+	if len(result.Lists) != 1 || result.Lists[0].SymbolCount != 30 {
+		t.Errorf("unexpected lists %+v", result.Lists)
+	}
+	if len(result.Nav) != 1 || result.Nav[0].Name != "Markets" {
+		t.Errorf("unexpected nav %+v", result.Nav)
 	}
 }
 
@@ -205,3 +217,35 @@ func TestGetStringSlice(t *testing.T) {
 // 		t.Errorf("Expected at most 5 quotes, got %d", len(quotes))
 // 	}
 // }
+
+func TestParseResearch(t *testing.T) {
+	s := &Search{}
+	body := `{"researchReports": [
+		{"id": "ARGUS_123", "reportHeadline": "Raising target", "provider": "Argus", "reportDate": 1717200000000},
+		{"reportId": "MS_456", "title": "Initiating coverage", "provider": "Morningstar", "ticker": "AAPL", "publishDate": "2024-05-01"},
+		{"reportId": "CFRA_789", "reportTitle": "Hold", "reportDate": "2024-04-30"}
+	]}`
+	raw := &models.SearchResponse{}
+	if err := json.Unmarshal([]byte(body), raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	result := s.parseSearchResult(raw)
+	if len(result.Research) != 3 {
+		t.Fatalf("Expected 3 research reports, got %d", len(result.Research))
+	}
+
+	got := result.Research[0].Report()
+	want := models.ResearchReport{ID: "ARGUS_123", Title: "Raising target", Provider: "Argus", PublishDate: "2024-06-01"}
+	if got != want {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+
+	if r := result.Research[1]; r.ReportID != "MS_456" || r.Title != "Initiating coverage" || r.PublishDate != "2024-05-01" {
+		t.Errorf("Unexpected research report: %+v", r)
+	}
+
+	if r := result.Research[2]; r.Title != "Hold" || r.PublishDate != "2024-04-30" {
+		t.Errorf("Expected date string report date, got %+v", r)
+	}
+}
@@ -0,0 +1,141 @@
+package sector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/internal/parallel"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+// maxActionWorkers bounds the number of concurrent per-company requests.
+const maxActionWorkers = 8
+
+// actionsFetcher returns the dividends and splits of a single symbol.
+type actionsFetcher func(symbol string) (*models.Actions, error)
+
+// CorporateActions returns the dividends and splits of the sector's top
+// companies over the past months, merged into a single calendar sorted by date.
+//
+// Companies are fetched concurrently. Failures for individual symbols are
+// reported in [models.SectorActions.Errors]; an error is returned only if the
+// top companies cannot be fetched.
+//
+// Example:
+//
+//	s, _ := sector.New("technology")
+//	defer s.Close()
+//
+//	cal, err := s.CorporateActions(6)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, a := range cal.Actions {
+//	    fmt.Printf("%s %s %s %.2f %s\n", a.Date.Format("2006-01-02"), a.Symbol, a.Kind, a.Amount, a.Ratio)
+//	}
+func (s *Sector) CorporateActions(months int) (*models.SectorActions, error) {
+	if months <= 0 {
+		return nil, fmt.Errorf("months must be positive")
+	}
+
+	companies, err := s.TopCompanies()
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().AddDate(0, -months, 0)
+	fetch := func(symbol string) (*models.Actions, error) {
+		tkr, err := ticker.New(symbol, ticker.WithClient(s.client))
+		if err != nil {
+			return nil, err
+		}
+		defer tkr.Close()
+		// Only the window is fetched, not each company's full history
+		return tkr.ActionsWithParams(models.ActionsParams{Start: &since})
+	}
+
+	result := corporateActions(companies, since, fetch)
+	result.Sector = s.key
+	return result, nil
+}
+
+// corporateActions fetches and merges the actions of companies since the given time.
+func corporateActions(companies []models.SectorTopCompany, since time.Time, fetch actionsFetcher) *models.SectorActions {
+	result := &models.SectorActions{
+		Since:  since,
+		Errors: make(map[string]error),
+	}
+
+	var listed []models.SectorTopCompany
+	for _, c := range companies {
+		if c.Symbol != "" {
+			listed = append(listed, c)
+		}
+	}
+
+	var mu sync.Mutex
+	parallel.For(context.Background(), len(listed), maxActionWorkers, func(i int) {
+		c := listed[i]
+		actions, err := fetch(c.Symbol)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Errors[c.Symbol] = err
+			return
+		}
+		result.Actions = append(result.Actions, filterActions(c, actions, since)...)
+	}, nil)
+
+	sort.SliceStable(result.Actions, func(i, j int) bool {
+		a, b := result.Actions[i], result.Actions[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if a.Symbol != b.Symbol {
+			return a.Symbol < b.Symbol
+		}
+		return a.Kind < b.Kind
+	})
+
+	return result
+}
+
+// filterActions converts a company's actions on or after since.
+func filterActions(c models.SectorTopCompany, actions *models.Actions, since time.Time) []models.CorporateAction {
+	if actions == nil {
+		return nil
+	}
+
+	var out []models.CorporateAction
+	for _, d := range actions.Dividends {
+		if d.Date.Before(since) {
+			continue
+		}
+		out = append(out, models.CorporateAction{
+			Symbol:   c.Symbol,
+			Name:     c.Name,
+			Kind:     models.CorporateActionDividend,
+			Date:     d.Date,
+			Amount:   d.Amount,
+			Currency: d.Currency,
+		})
+	}
+	for _, sp := range actions.Splits {
+		if sp.Date.Before(since) {
+			continue
+		}
+		out = append(out, models.CorporateAction{
+			Symbol: c.Symbol,
+			Name:   c.Name,
+			Kind:   models.CorporateActionSplit,
+			Date:   sp.Date,
+			Ratio:  sp.Ratio,
+		})
+	}
+	return out
+}
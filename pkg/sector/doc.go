@@ -54,6 +54,15 @@
 //	etfs, err := s.TopETFs()
 //	funds, err := s.TopMutualFunds()
 //
+// # Corporate Actions
+//
+// Build a sector-wide dividend and split calendar from the top companies:
+//
+//	cal, err := s.CorporateActions(6) // past 6 months
+//	for _, a := range cal.Actions {
+//	    fmt.Printf("%s %s %s\n", a.Date.Format("2006-01-02"), a.Symbol, a.Kind)
+//	}
+//
 // # Predefined Sectors
 //
 // Common sector identifiers are available as constants:
@@ -1,6 +1,7 @@
 package sector
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -11,6 +12,7 @@ import (
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	yfconfig "github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Sector provides access to financial sector data from Yahoo Finance.
@@ -37,7 +39,6 @@ type Option func(*Sector)
 func WithClient(c *client.Client) Option {
 	return func(s *Sector) {
 		s.client = c
-		s.ownsClient = false
 	}
 }
 
@@ -69,9 +70,8 @@ func New(key string, opts ...Option) (*Sector, error) {
 	}
 
 	s := &Sector{
-		key:        key,
-		region:     "US",
-		ownsClient: true,
+		key:    key,
+		region: "US",
 	}
 
 	for _, opt := range opts {
@@ -79,14 +79,15 @@ func New(key string, opts ...Option) (*Sector, error) {
 	}
 
 	if s.client == nil {
-		c, err := client.New()
+		c, err := client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
 		s.client = c
+		s.ownsClient = true
 	}
 
-	s.auth = client.NewAuthManager(s.client)
+	s.auth = s.client.Auth()
 
 	return s, nil
 }
@@ -103,10 +104,12 @@ func NewWithPredefined(sector models.PredefinedSector, opts ...Option) (*Sector,
 	return New(string(sector), opts...)
 }
 
-// Close releases resources used by the Sector instance.
+// Close releases the shared [client.Default] client if the Sector acquired
+// it. A client passed with WithClient is left open.
 func (s *Sector) Close() {
-	if s.ownsClient && s.client != nil {
-		s.client.Close()
+	if s.ownsClient {
+		s.ownsClient = false
+		s.client.Release()
 	}
 }
 
@@ -121,7 +124,7 @@ func (s *Sector) Region() string {
 }
 
 // fetchData fetches sector data from Yahoo Finance API.
-func (s *Sector) fetchData() error {
+func (s *Sector) fetchData(ctx context.Context) error {
 	s.mu.RLock()
 	if s.dataCache != nil {
 		s.mu.RUnlock()
@@ -141,10 +144,10 @@ func (s *Sector) fetchData() error {
 	// Add crumb authentication
 	params, err := s.auth.AddCrumbToParams(params)
 	if err != nil {
-		return fmt.Errorf("failed to add crumb: %w", err)
+		return yferr.Wrap(yferr.ErrAuth, "failed to add crumb", err)
 	}
 
-	resp, err := s.client.Get(queryURL, params)
+	resp, err := s.client.GetContext(ctx, queryURL, params)
 	if err != nil {
 		return fmt.Errorf("failed to fetch sector data: %w", err)
 	}
@@ -155,11 +158,11 @@ func (s *Sector) fetchData() error {
 
 	var raw models.SectorResponse
 	if err := json.Unmarshal([]byte(resp.Body), &raw); err != nil {
-		return fmt.Errorf("failed to parse sector data: %w", err)
+		return yferr.Parse("sector data", err)
 	}
 
 	if raw.Error != nil {
-		return fmt.Errorf("sector API error: %s - %s", raw.Error.Code, raw.Error.Description)
+		return yferr.API("sector", raw.Error.Code, raw.Error.Description)
 	}
 
 	data := s.parseData(&raw)
@@ -181,24 +184,25 @@ func (s *Sector) parseData(raw *models.SectorResponse) *models.SectorData {
 
 	// Parse overview
 	if raw.Data.Overview != nil {
+		o := raw.Data.Overview
 		data.Overview = models.SectorOverview{
-			CompaniesCount:  getInt(raw.Data.Overview, "companiesCount"),
-			MarketCap:       getRawFloat(raw.Data.Overview, "marketCap"),
-			MessageBoardID:  getString(raw.Data.Overview, "messageBoardId"),
-			Description:     getString(raw.Data.Overview, "description"),
-			IndustriesCount: getInt(raw.Data.Overview, "industriesCount"),
-			MarketWeight:    getRawFloat(raw.Data.Overview, "marketWeight"),
-			EmployeeCount:   getInt64(raw.Data.Overview, "employeeCount"),
+			CompaniesCount:  o.CompaniesCount.Int(),
+			MarketCap:       o.MarketCap.Float64(),
+			MessageBoardID:  o.MessageBoardID,
+			Description:     o.Description,
+			IndustriesCount: o.IndustriesCount.Int(),
+			MarketWeight:    o.MarketWeight.Float64(),
+			EmployeeCount:   o.EmployeeCount.Int64(),
 		}
 	}
 
 	// Parse top companies
 	for _, c := range raw.Data.TopCompanies {
 		company := models.SectorTopCompany{
-			Symbol:       getString(c, "symbol"),
-			Name:         getString(c, "name"),
-			Rating:       getString(c, "rating"),
-			MarketWeight: getRawFloat(c, "marketWeight"),
+			Symbol:       c.Symbol,
+			Name:         c.Name,
+			Rating:       c.Rating,
+			MarketWeight: c.MarketWeight.Float64(),
 		}
 		if company.Symbol != "" {
 			data.TopCompanies = append(data.TopCompanies, company)
@@ -207,15 +211,14 @@ func (s *Sector) parseData(raw *models.SectorResponse) *models.SectorData {
 
 	// Parse industries
 	for _, i := range raw.Data.Industries {
-		name := getString(i, "name")
-		if name == "All Industries" {
+		if i.Name == "All Industries" {
 			continue
 		}
 		industry := models.SectorIndustry{
-			Key:          getString(i, "key"),
-			Name:         name,
-			Symbol:       getString(i, "symbol"),
-			MarketWeight: getRawFloat(i, "marketWeight"),
+			Key:          i.Key,
+			Name:         i.Name,
+			Symbol:       i.Symbol,
+			MarketWeight: i.MarketWeight.Float64(),
 		}
 		if industry.Key != "" {
 			data.Industries = append(data.Industries, industry)
@@ -225,31 +228,27 @@ func (s *Sector) parseData(raw *models.SectorResponse) *models.SectorData {
 	// Parse top ETFs
 	data.TopETFs = make(map[string]string)
 	for _, e := range raw.Data.TopETFs {
-		symbol := getString(e, "symbol")
-		name := getString(e, "name")
-		if symbol != "" {
-			data.TopETFs[symbol] = name
+		if e.Symbol != "" {
+			data.TopETFs[e.Symbol] = e.Name
 		}
 	}
 
 	// Parse top mutual funds
 	data.TopMutualFunds = make(map[string]string)
 	for _, f := range raw.Data.TopMutualFunds {
-		symbol := getString(f, "symbol")
-		name := getString(f, "name")
-		if symbol != "" {
-			data.TopMutualFunds[symbol] = name
+		if f.Symbol != "" {
+			data.TopMutualFunds[f.Symbol] = f.Name
 		}
 	}
 
 	// Parse research reports
 	for _, r := range raw.Data.ResearchReports {
 		report := models.ResearchReport{
-			ID:          getString(r, "id"),
-			Title:       getString(r, "title"),
-			Provider:    getString(r, "provider"),
-			PublishDate: getString(r, "publishDate"),
-			Summary:     getString(r, "summary"),
+			ID:          r.ID,
+			Title:       r.Title,
+			Provider:    r.Provider,
+			PublishDate: r.PublishDate,
+			Summary:     r.Summary,
 		}
 		if report.ID != "" || report.Title != "" {
 			data.ResearchReports = append(data.ResearchReports, report)
@@ -273,7 +272,14 @@ func (s *Sector) parseData(raw *models.SectorResponse) *models.SectorData {
 //	fmt.Printf("Sector: %s\n", data.Name)
 //	fmt.Printf("Companies: %d\n", data.Overview.CompaniesCount)
 func (s *Sector) Data() (*models.SectorData, error) {
-	if err := s.fetchData(); err != nil {
+	return s.DataContext(context.Background())
+}
+
+// DataContext is like [Sector.Data] but returns ctx's error as soon as ctx is
+// done. Once it succeeds, the other accessors are served from the cache
+// without further requests.
+func (s *Sector) DataContext(ctx context.Context) (*models.SectorData, error) {
+	if err := s.fetchData(ctx); err != nil {
 		return nil, err
 	}
 
@@ -289,7 +295,7 @@ func (s *Sector) Data() (*models.SectorData, error) {
 //	name, err := s.Name()
 //	fmt.Println(name) // "Technology"
 func (s *Sector) Name() (string, error) {
-	if err := s.fetchData(); err != nil {
+	if err := s.fetchData(context.Background()); err != nil {
 		return "", err
 	}
 
@@ -300,7 +306,7 @@ func (s *Sector) Name() (string, error) {
 
 // Symbol returns the sector symbol.
 func (s *Sector) Symbol() (string, error) {
-	if err := s.fetchData(); err != nil {
+	if err := s.fetchData(context.Background()); err != nil {
 		return "", err
 	}
 
@@ -320,7 +326,13 @@ func (s *Sector) Symbol() (string, error) {
 //	fmt.Printf("Companies: %d\n", overview.CompaniesCount)
 //	fmt.Printf("Market Cap: $%.2f\n", overview.MarketCap)
 func (s *Sector) Overview() (models.SectorOverview, error) {
-	if err := s.fetchData(); err != nil {
+	return s.OverviewContext(context.Background())
+}
+
+// OverviewContext is like [Sector.Overview] but returns ctx's error as soon as
+// ctx is done.
+func (s *Sector) OverviewContext(ctx context.Context) (models.SectorOverview, error) {
+	if err := s.fetchData(ctx); err != nil {
 		return models.SectorOverview{}, err
 	}
 
@@ -342,7 +354,13 @@ func (s *Sector) Overview() (models.SectorOverview, error) {
 //	        c.Symbol, c.Name, c.MarketWeight*100)
 //	}
 func (s *Sector) TopCompanies() ([]models.SectorTopCompany, error) {
-	if err := s.fetchData(); err != nil {
+	return s.TopCompaniesContext(context.Background())
+}
+
+// TopCompaniesContext is like [Sector.TopCompanies] but returns ctx's error as
+// soon as ctx is done.
+func (s *Sector) TopCompaniesContext(ctx context.Context) ([]models.SectorTopCompany, error) {
+	if err := s.fetchData(ctx); err != nil {
 		return nil, err
 	}
 
@@ -363,7 +381,7 @@ func (s *Sector) TopCompanies() ([]models.SectorTopCompany, error) {
 //	    fmt.Printf("%s: %s\n", i.Key, i.Name)
 //	}
 func (s *Sector) Industries() ([]models.SectorIndustry, error) {
-	if err := s.fetchData(); err != nil {
+	if err := s.fetchData(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -386,7 +404,7 @@ func (s *Sector) Industries() ([]models.SectorIndustry, error) {
 //	    fmt.Printf("%s: %s\n", symbol, name)
 //	}
 func (s *Sector) TopETFs() (map[string]string, error) {
-	if err := s.fetchData(); err != nil {
+	if err := s.fetchData(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -409,7 +427,7 @@ func (s *Sector) TopETFs() (map[string]string, error) {
 //	    fmt.Printf("%s: %s\n", symbol, name)
 //	}
 func (s *Sector) TopMutualFunds() (map[string]string, error) {
-	if err := s.fetchData(); err != nil {
+	if err := s.fetchData(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -430,7 +448,7 @@ func (s *Sector) TopMutualFunds() (map[string]string, error) {
 //	    fmt.Printf("%s: %s\n", r.Provider, r.Title)
 //	}
 func (s *Sector) ResearchReports() ([]models.ResearchReport, error) {
-	if err := s.fetchData(); err != nil {
+	if err := s.fetchData(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -447,39 +465,6 @@ func (s *Sector) ClearCache() {
 	s.dataCache = nil
 }
 
-// Helper functions for parsing map values
-
-func getString(m map[string]interface{}, key string) string {
-	if v, ok := m[key].(string); ok {
-		return v
-	}
-	return ""
-}
-
-func getInt(m map[string]interface{}, key string) int {
-	switch v := m[key].(type) {
-	case float64:
-		return int(v)
-	case int:
-		return v
-	case int64:
-		return int(v)
-	}
-	return 0
-}
-
-func getInt64(m map[string]interface{}, key string) int64 {
-	switch v := m[key].(type) {
-	case float64:
-		return int64(v)
-	case int:
-		return int64(v)
-	case int64:
-		return v
-	}
-	return 0
-}
-
 func normalizeRegion(region string) string {
 	region = strings.TrimSpace(strings.ToUpper(region))
 	if region == "" {
@@ -487,23 +472,3 @@ func normalizeRegion(region string) string {
 	}
 	return region
 }
-
-func getFloat(m map[string]interface{}, key string) float64 {
-	switch v := m[key].(type) {
-	case float64:
-		return v
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	}
-	return 0
-}
-
-// getRawFloat extracts the "raw" value from a nested object like {"raw": 123.45, "fmt": "123.45"}
-func getRawFloat(m map[string]interface{}, key string) float64 {
-	if v, ok := m[key].(map[string]interface{}); ok {
-		return getFloat(v, "raw")
-	}
-	return getFloat(m, key)
-}
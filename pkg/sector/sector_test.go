@@ -1,8 +1,12 @@
 package sector
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -24,8 +28,16 @@ func TestNew(t *testing.T) {
 		t.Errorf("Expected default region 'US', got '%s'", s.Region())
 	}
 
-	if s.ownsClient != true {
-		t.Error("ownsClient should be true when no custom client is provided")
+	if !s.ownsClient {
+		t.Error("ownsClient should be true when the shared default client is acquired")
+	}
+
+	def, err := client.Default()
+	if err != nil {
+		t.Fatalf("Default() error: %v", err)
+	}
+	if s.client != def {
+		t.Error("Client should be the shared default client")
 	}
 }
 
@@ -83,7 +95,7 @@ func TestNewWithClient(t *testing.T) {
 		t.Fatalf("Failed to create second Sector: %v", err)
 	}
 
-	if s2.ownsClient != false {
+	if s2.ownsClient {
 		t.Error("ownsClient should be false when custom client is provided")
 	}
 
@@ -170,61 +182,71 @@ func TestClearCache(t *testing.T) {
 	}
 }
 
-func TestHelperFunctions(t *testing.T) {
-	m := map[string]interface{}{
-		"str":   "hello",
-		"int":   42,
-		"float": 3.14,
-		"int64": int64(1234567890),
-		"nested": map[string]interface{}{
-			"raw": 99.99,
-			"fmt": "99.99",
+func TestParseData(t *testing.T) {
+	body := `{"data": {
+		"name": "Technology",
+		"symbol": "^YH311",
+		"overview": {
+			"companiesCount": 815,
+			"marketCap": {"raw": 2.1e13, "fmt": "21T"},
+			"messageBoardId": "INDEXYH311",
+			"description": "Tech",
+			"industriesCount": {"raw": 12, "fmt": "12"},
+			"marketWeight": {"raw": 0.2969, "fmt": "29.69%"},
+			"employeeCount": "1,234,567,890"
 		},
-	}
+		"topCompanies": [
+			{"symbol": "AAPL", "name": "Apple Inc.", "rating": "Buy", "marketWeight": {"fmt": "3.14%"}},
+			{"name": "No symbol"}
+		],
+		"industries": [
+			{"name": "All Industries", "key": "all"},
+			{"key": "semiconductors", "name": "Semiconductors", "symbol": "^YH31130020", "marketWeight": 0.1}
+		],
+		"topETFs": [{"symbol": "XLK", "name": "Technology Select Sector SPDR"}],
+		"topMutualFunds": [{"symbol": "FSPTX", "name": "Fidelity Select Technology"}],
+		"researchReports": [{"id": "R1", "title": "Outlook", "provider": "Argus", "publishDate": "2024-05-01"}]
+	}}`
 
-	// Test getString
-	if got := getString(m, "str"); got != "hello" {
-		t.Errorf("getString expected 'hello', got '%s'", got)
+	var raw models.SectorResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
 	}
+	s := &Sector{key: "technology"}
+	data := s.parseData(&raw)
 
-	if got := getString(m, "missing"); got != "" {
-		t.Errorf("getString for missing key expected '', got '%s'", got)
+	if data.Key != "technology" || data.Name != "Technology" || data.Symbol != "^YH311" {
+		t.Errorf("unexpected identity %+v", data)
 	}
 
-	// Test getInt
-	if got := getInt(m, "int"); got != 42 {
-		t.Errorf("getInt expected 42, got %d", got)
+	o := data.Overview
+	if o.CompaniesCount != 815 || o.IndustriesCount != 12 {
+		t.Errorf("expected counts 815/12, got %d/%d", o.CompaniesCount, o.IndustriesCount)
 	}
-
-	if got := getInt(m, "missing"); got != 0 {
-		t.Errorf("getInt for missing key expected 0, got %d", got)
+	if o.MarketCap != 2.1e13 || o.MarketWeight != 0.2969 {
+		t.Errorf("expected raw values preferred, got %v/%v", o.MarketCap, o.MarketWeight)
 	}
-
-	// Test getFloat
-	if got := getFloat(m, "float"); got != 3.14 {
-		t.Errorf("getFloat expected 3.14, got %f", got)
+	if o.EmployeeCount != 1234567890 {
+		t.Errorf("expected employee count from formatted string, got %d", o.EmployeeCount)
 	}
-
-	if got := getFloat(m, "int"); got != 42.0 {
-		t.Errorf("getFloat for int expected 42.0, got %f", got)
+	if o.MessageBoardID != "INDEXYH311" || o.Description != "Tech" {
+		t.Errorf("unexpected overview strings %+v", o)
 	}
 
-	// Test getInt64
-	if got := getInt64(m, "int64"); got != 1234567890 {
-		t.Errorf("getInt64 expected 1234567890, got %d", got)
+	if len(data.TopCompanies) != 1 || data.TopCompanies[0].Rating != "Buy" {
+		t.Fatalf("expected one top company, got %+v", data.TopCompanies)
 	}
-
-	if got := getInt64(m, "float"); got != 3 {
-		t.Errorf("getInt64 for float expected 3, got %d", got)
+	if got := data.TopCompanies[0].MarketWeight; got < 0.03139 || got > 0.03141 {
+		t.Errorf("expected market weight from fmt 0.0314, got %v", got)
 	}
-
-	// Test getRawFloat
-	if got := getRawFloat(m, "nested"); got != 99.99 {
-		t.Errorf("getRawFloat expected 99.99, got %f", got)
+	if len(data.Industries) != 1 || data.Industries[0].Key != "semiconductors" || data.Industries[0].MarketWeight != 0.1 {
+		t.Errorf("expected one industry without the aggregate, got %+v", data.Industries)
 	}
-
-	if got := getRawFloat(m, "float"); got != 3.14 {
-		t.Errorf("getRawFloat for simple float expected 3.14, got %f", got)
+	if data.TopETFs["XLK"] == "" || data.TopMutualFunds["FSPTX"] == "" {
+		t.Errorf("expected fund maps, got %v %v", data.TopETFs, data.TopMutualFunds)
+	}
+	if len(data.ResearchReports) != 1 || data.ResearchReports[0].PublishDate != "2024-05-01" {
+		t.Errorf("unexpected research reports %+v", data.ResearchReports)
 	}
 }
 
@@ -464,3 +486,52 @@ func TestCacheIntegration(t *testing.T) {
 
 	t.Logf("Results: first=%s, cached=%s, after_clear=%s", data1.Name, data2.Name, data3.Name)
 }
+
+func TestCorporateActions(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	companies := []models.SectorTopCompany{
+		{Symbol: "AAPL", Name: "Apple Inc."},
+		{Symbol: "NVDA", Name: "NVIDIA Corporation"},
+		{Symbol: "FAIL", Name: "Failing Co."},
+	}
+
+	fetch := func(symbol string) (*models.Actions, error) {
+		switch symbol {
+		case "AAPL":
+			return &models.Actions{
+				Dividends: []models.Dividend{
+					{Date: time.Date(2023, 11, 10, 0, 0, 0, 0, time.UTC), Amount: 0.24},
+					{Date: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC), Amount: 0.25},
+				},
+			}, nil
+		case "NVDA":
+			return &models.Actions{
+				Splits: []models.Split{
+					{Date: time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), Numerator: 10, Denominator: 1, Ratio: "10:1"},
+				},
+				Dividends: []models.Dividend{
+					{Date: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), Amount: 0.04},
+				},
+			}, nil
+		}
+		return nil, errors.New("boom")
+	}
+
+	result := corporateActions(companies, since, fetch)
+
+	if len(result.Actions) != 3 {
+		t.Fatalf("Expected 3 actions, got %d: %+v", len(result.Actions), result.Actions)
+	}
+	if result.Actions[0].Symbol != "NVDA" || result.Actions[0].Kind != models.CorporateActionDividend {
+		t.Errorf("Expected NVDA dividend first, got %+v", result.Actions[0])
+	}
+	if result.Actions[1].Amount != 0.25 || result.Actions[1].Name != "Apple Inc." {
+		t.Errorf("Unexpected second action: %+v", result.Actions[1])
+	}
+	if result.Actions[2].Kind != models.CorporateActionSplit || result.Actions[2].Ratio != "10:1" {
+		t.Errorf("Expected NVDA split last, got %+v", result.Actions[2])
+	}
+	if _, ok := result.Errors["FAIL"]; !ok || len(result.Errors) != 1 {
+		t.Errorf("Expected error for FAIL only, got %v", result.Errors)
+	}
+}
@@ -25,6 +25,15 @@
 //	filtered := stats.MedianFilter(data, windowSize)
 //	mask := stats.OutlierMask(data, multiplier)
 //
+// # Return Functions
+//
+// Return series and co-movement measures used for risk metrics such as
+// beta and volatility:
+//
+//	returns := stats.LogReturns(closes)
+//	beta := stats.Covariance(stock, bench, 1) / math.Pow(stats.Std(bench, 1), 2)
+//	rho := stats.Correlation(stock, bench)
+//
 // These functions are designed to match the behavior of numpy and scipy
 // functions used in the Python yfinance implementation.
 package stats
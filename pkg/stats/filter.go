@@ -235,8 +235,9 @@ func MedianOfSlice(data []float64) float64 {
 
 // OHLC calculates the median of Open, High, Low, Close values.
 // This provides a robust estimate of the "typical" price.
+// NaN values are ignored; returns NaN if all values are NaN.
 func OHLCMedian(open, high, low, close float64) float64 {
-	values := []float64{open, high, low, close}
+	values := RemoveNaN([]float64{open, high, low, close})
 	return MedianOfSlice(values)
 }
 
@@ -114,6 +114,17 @@ func Median(data []float64) float64 {
 	return Percentile(data, 50.0)
 }
 
+// NanMean calculates the arithmetic mean ignoring NaN values.
+// Returns NaN if there are no non-NaN values.
+func NanMean(data []float64) float64 {
+	return Mean(RemoveNaN(data))
+}
+
+// NanStd calculates the standard deviation ignoring NaN values.
+func NanStd(data []float64, ddof int) float64 {
+	return Std(RemoveNaN(data), ddof)
+}
+
 // RemoveNaN returns a new slice with NaN values removed.
 func RemoveNaN(data []float64) []float64 {
 	result := make([]float64, 0, len(data))
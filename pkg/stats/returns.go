@@ -0,0 +1,50 @@
+package stats
+
+import "math"
+
+// LogReturns calculates the log return between consecutive elements.
+// Returns slice of length n-1; NaN where either price is not positive.
+func LogReturns(data []float64) []float64 {
+	if len(data) < 2 {
+		return nil
+	}
+
+	result := make([]float64, len(data)-1)
+	for i := 1; i < len(data); i++ {
+		if data[i-1] > 0 && data[i] > 0 {
+			result[i-1] = math.Log(data[i] / data[i-1])
+		} else {
+			result[i-1] = math.NaN()
+		}
+	}
+	return result
+}
+
+// Covariance calculates the covariance of two equally long series.
+// Returns NaN if the lengths differ or there are not more than ddof values.
+//
+// Parameters:
+//   - x, y: paired observations
+//   - ddof: delta degrees of freedom (0 for population, 1 for sample)
+func Covariance(x, y []float64, ddof int) float64 {
+	if len(x) != len(y) || len(x) <= ddof {
+		return math.NaN()
+	}
+
+	meanX, meanY := Mean(x), Mean(y)
+	sum := 0.0
+	for i := range x {
+		sum += (x[i] - meanX) * (y[i] - meanY)
+	}
+	return sum / float64(len(x)-ddof)
+}
+
+// Correlation calculates the Pearson correlation of two equally long series.
+// Returns NaN if either series is constant or the lengths differ.
+func Correlation(x, y []float64) float64 {
+	den := Std(x, 1) * Std(y, 1)
+	if den == 0 || math.IsNaN(den) {
+		return math.NaN()
+	}
+	return Covariance(x, y, 1) / den
+}
@@ -426,3 +426,60 @@ func BenchmarkMedianFilter(b *testing.B) {
 		MedianFilter(data, 5)
 	}
 }
+
+func TestNanMeanStd(t *testing.T) {
+	data := []float64{1, math.NaN(), 3}
+
+	if got := NanMean(data); !almostEqual(got, 2) {
+		t.Errorf("NanMean() = %v, want 2", got)
+	}
+	if got := NanStd(data, 0); !almostEqual(got, 1) {
+		t.Errorf("NanStd() = %v, want 1", got)
+	}
+	if got := NanMean([]float64{math.NaN()}); !math.IsNaN(got) {
+		t.Errorf("NanMean(all NaN) = %v, want NaN", got)
+	}
+	if got := OHLCMedian(10, math.NaN(), 8, 12); !almostEqual(got, 10) {
+		t.Errorf("OHLCMedian() with NaN = %v, want 10", got)
+	}
+}
+
+func TestLogReturns(t *testing.T) {
+	got := LogReturns([]float64{100, 110, 0, 121})
+	want := []float64{math.Log(1.1), math.NaN(), math.NaN()}
+	if len(got) != len(want) {
+		t.Fatalf("LogReturns() length = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !almostEqual(got[i], want[i]) {
+			t.Errorf("LogReturns()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if LogReturns([]float64{1}) != nil {
+		t.Error("LogReturns() of one price should be nil")
+	}
+}
+
+func TestCovarianceAndCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{2, 4, 6, 8, 10}
+
+	if got := Covariance(x, y, 1); !almostEqual(got, 5) {
+		t.Errorf("Covariance() = %v, want 5", got)
+	}
+	if got := Covariance(x, y, 0); !almostEqual(got, 4) {
+		t.Errorf("Covariance(ddof=0) = %v, want 4", got)
+	}
+	if got := Correlation(x, y); !almostEqual(got, 1) {
+		t.Errorf("Correlation() = %v, want 1", got)
+	}
+	if got := Correlation(x, []float64{5, 4, 3, 2, 1}); !almostEqual(got, -1) {
+		t.Errorf("Correlation() = %v, want -1", got)
+	}
+	if !math.IsNaN(Covariance(x, y[:3], 1)) {
+		t.Error("Covariance() of mismatched lengths should be NaN")
+	}
+	if !math.IsNaN(Correlation(x, []float64{3, 3, 3, 3, 3})) {
+		t.Error("Correlation() with a constant series should be NaN")
+	}
+}
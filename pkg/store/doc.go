@@ -0,0 +1,45 @@
+// Package store provides pluggable persistence for snapshot data.
+//
+// # Overview
+//
+// A [Store] keeps opaque payloads by key and time. Features that record
+// data over time (option chain snapshots, stream recordings, incremental
+// history downloads and screener history) all write through it, so the same
+// files or database hold everything and the backend can be swapped without
+// changing the code that records.
+//
+// # Implementations
+//
+//   - [FileStore]: One file per record under a directory
+//   - [SQLStore]: A SQLite table through database/sql, with a driver of your choice
+//
+// Implement [Store] to keep records elsewhere, e.g. in object storage.
+//
+// # Basic Usage
+//
+//	st, _ := store.NewFileStore("./snapshots")
+//	defer st.Close()
+//
+//	key := store.Key("quotes", "AAPL")
+//	store.PutJSON(st, key, time.Now(), quote)
+//
+//	var last models.Quote
+//	at, err := store.GetJSON(st, key, time.Now(), &last)
+//
+// [Store.Get] returns the latest record at or before a time, so a past
+// state can be looked up directly; [Store.List] returns a time range.
+//
+// # Keys
+//
+// Keys are slash-separated paths built with [Key]. The packages using a
+// store choose theirs:
+//
+//   - "options/<SYMBOL>/<YYYY-MM-DD>": ticker.SaveOptionChain
+//   - "ticks/<SYMBOL>", "bars/<SYMBOL>": live.StoreSink
+//   - "history/<SYMBOL>/<interval>": download.HistoryToStore
+//   - "screener/results/<name>", "screener/breadth": screener history
+//
+// # Thread Safety
+//
+// [FileStore] and [SQLStore] are safe for concurrent use.
+package store
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// fileTimeLayout names record files so that they sort by time.
+const fileTimeLayout = "20060102T150405.000000000Z"
+
+// FileStore keeps each record in its own file under a directory: one
+// subdirectory per key (escaped to a single path element), one file per
+// record named by its UTC time. Records are written to a temporary file
+// and renamed, so readers never see partial data.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a store rooted at dir, creating it if needed.
+//
+// Example:
+//
+//	st, err := store.NewFileStore("./snapshots")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer st.Close()
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("store directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Put writes data to the record file of key at t.
+func (s *FileStore) Put(key string, t time.Time, data []byte) error {
+	dir, err := s.keyDir(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	path := filepath.Join(dir, t.UTC().Format(fileTimeLayout))
+	tmp, err := os.CreateTemp(dir, ".put-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	tmpName := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get reads the latest record of key at or before t.
+func (s *FileStore) Get(key string, t time.Time) (Record, error) {
+	times, err := s.times(key)
+	if err != nil {
+		return Record{}, err
+	}
+	i := sort.Search(len(times), func(i int) bool { return times[i].After(t) })
+	if i == 0 {
+		return Record{}, ErrNotFound
+	}
+	return s.read(key, times[i-1])
+}
+
+// List reads the records of key in [from, to), oldest first.
+func (s *FileStore) List(key string, from, to time.Time) ([]Record, error) {
+	times, err := s.times(key)
+	if err != nil {
+		return nil, err
+	}
+	var records []Record
+	for _, t := range times {
+		if !inRange(t, from, to) {
+			continue
+		}
+		rec, err := s.read(key, t)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// Close does nothing; a FileStore holds no open files.
+func (s *FileStore) Close() error {
+	return nil
+}
+
+// keyDir returns the directory holding the records of key. Keys that
+// would resolve outside the store directory, such as "..", are rejected.
+func (s *FileStore) keyDir(key string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+	name := url.PathEscape(key)
+	if name == "." || name == ".." {
+		return "", fmt.Errorf("invalid store key %q", key)
+	}
+	dir := filepath.Join(s.dir, name)
+	if rel, err := filepath.Rel(s.dir, dir); err != nil || rel != name {
+		return "", fmt.Errorf("invalid store key %q", key)
+	}
+	return dir, nil
+}
+
+// times returns the record times of key, oldest first. Files not named by
+// a record time are ignored.
+func (s *FileStore) times(key string) ([]time.Time, error) {
+	dir, err := s.keyDir(key)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", key, err)
+	}
+
+	var times []time.Time
+	for _, e := range entries {
+		if t, err := time.Parse(fileTimeLayout, e.Name()); err == nil && !e.IsDir() {
+			times = append(times, t)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times, nil
+}
+
+// read loads the record of key at t.
+func (s *FileStore) read(key string, t time.Time) (Record, error) {
+	dir, err := s.keyDir(key)
+	if err != nil {
+		return Record{}, err
+	}
+	path := filepath.Join(dir, t.Format(fileTimeLayout))
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Record{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return Record{Key: key, Time: t, Data: data}, nil
+}
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+)
+
+// DefaultTable is the table SQLStore keeps records in.
+const DefaultTable = "snapshots"
+
+// tablePattern matches table names usable without quoting.
+var tablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLOption is a function that configures an SQLStore.
+type SQLOption func(*SQLStore)
+
+// WithTable sets the table records are kept in (default "snapshots").
+func WithTable(name string) SQLOption {
+	return func(s *SQLStore) {
+		s.table = name
+	}
+}
+
+// SQLStore keeps records in an SQL table, created if missing. The SQL
+// targets SQLite; bring any SQLite driver (e.g. modernc.org/sqlite or
+// github.com/mattn/go-sqlite3) and pass the opened database.
+//
+// Records are keyed by key and time, stored as Unix nanoseconds.
+type SQLStore struct {
+	db    *sql.DB
+	table string
+}
+
+// NewSQLStore creates the store's table in db if it does not exist. The
+// caller keeps ownership of db.
+//
+// Example:
+//
+//	import _ "modernc.org/sqlite"
+//
+//	db, _ := sql.Open("sqlite", "snapshots.db")
+//	defer db.Close()
+//	st, err := store.NewSQLStore(db)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewSQLStore(db *sql.DB, opts ...SQLOption) (*SQLStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database is required")
+	}
+	s := &SQLStore{db: db, table: DefaultTable}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if !tablePattern.MatchString(s.table) {
+		return nil, fmt.Errorf("invalid store table name %q", s.table)
+	}
+
+	schema := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	key TEXT NOT NULL,
+	time INTEGER NOT NULL,
+	data BLOB,
+	PRIMARY KEY (key, time)
+)`, s.table)
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create store table: %w", err)
+	}
+	return s, nil
+}
+
+// Put inserts or replaces the record of key at t.
+func (s *SQLStore) Put(key string, t time.Time, data []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	_, err := s.db.Exec(fmt.Sprintf(`INSERT OR REPLACE INTO %s (key, time, data) VALUES (?, ?, ?)`, s.table),
+		key, t.UnixNano(), data)
+	if err != nil {
+		return fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get selects the latest record of key at or before t.
+func (s *SQLStore) Get(key string, t time.Time) (Record, error) {
+	if err := checkKey(key); err != nil {
+		return Record{}, err
+	}
+	row := s.db.QueryRow(fmt.Sprintf(`SELECT time, data FROM %s WHERE key = ? AND time <= ? ORDER BY time DESC LIMIT 1`, s.table),
+		key, t.UnixNano())
+
+	var (
+		nanos int64
+		data  []byte
+	)
+	if err := row.Scan(&nanos, &data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Record{}, ErrNotFound
+		}
+		return Record{}, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return Record{Key: key, Time: time.Unix(0, nanos).UTC(), Data: data}, nil
+}
+
+// List selects the records of key in [from, to), oldest first.
+func (s *SQLStore) List(key string, from, to time.Time) ([]Record, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	lo, hi := int64(math.MinInt64), int64(math.MaxInt64)
+	if !from.IsZero() {
+		lo = from.UnixNano()
+	}
+	if !to.IsZero() {
+		hi = to.UnixNano()
+	}
+	rows, err := s.db.Query(fmt.Sprintf(`SELECT time, data FROM %s WHERE key = ? AND time >= ? AND time < ? ORDER BY time`, s.table),
+		key, lo, hi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", key, err)
+	}
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var (
+			nanos int64
+			data  []byte
+		)
+		if err := rows.Scan(&nanos, &data); err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", key, err)
+		}
+		records = append(records, Record{Key: key, Time: time.Unix(0, nanos).UTC(), Data: data})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", key, err)
+	}
+	return records, nil
+}
+
+// Close does nothing; the database stays open.
+func (s *SQLStore) Close() error {
+	return nil
+}
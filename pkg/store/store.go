@@ -0,0 +1,99 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned by [Store.Get] when a key has no record at or
+// before the requested time.
+var ErrNotFound = errors.New("record not found")
+
+// Record is one payload stored under a key at a point in time.
+type Record struct {
+	// Key identifies the series the record belongs to (e.g.
+	// "options/AAPL/2024-06-21").
+	Key string
+
+	// Time is when the payload was recorded.
+	Time time.Time
+
+	// Data is the payload, usually JSON.
+	Data []byte
+}
+
+// Store persists snapshot payloads by key and time, so recorded data can
+// be kept in files or a database without each feature choosing its own
+// format. Implementations must be safe for concurrent use.
+type Store interface {
+	// Put stores data under key at t, replacing a record with the same key
+	// and time.
+	Put(key string, t time.Time, data []byte) error
+
+	// Get returns the latest record of key at or before t, or ErrNotFound.
+	Get(key string, t time.Time) (Record, error)
+
+	// List returns the records of key in [from, to), oldest first. A zero
+	// from or to leaves that side unbounded.
+	List(key string, from, to time.Time) ([]Record, error)
+
+	// Close releases the store's resources.
+	Close() error
+}
+
+// Key joins parts into a store key, e.g. Key("options", "AAPL") is
+// "options/AAPL".
+func Key(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// PutJSON stores v encoded as JSON under key at t.
+//
+// Example:
+//
+//	err := store.PutJSON(st, store.Key("quotes", "AAPL"), time.Now(), quote)
+func PutJSON(s Store, key string, t time.Time, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	return s.Put(key, t, data)
+}
+
+// GetJSON decodes into v the latest record of key at or before t and
+// returns the record's time.
+//
+// Example:
+//
+//	var quote models.Quote
+//	at, err := store.GetJSON(st, store.Key("quotes", "AAPL"), time.Now(), &quote)
+//	if errors.Is(err, store.ErrNotFound) {
+//	    log.Println("nothing recorded yet")
+//	}
+func GetJSON(s Store, key string, t time.Time, v interface{}) (time.Time, error) {
+	rec, err := s.Get(key, t)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := json.Unmarshal(rec.Data, v); err != nil {
+		return time.Time{}, fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+	return rec.Time, nil
+}
+
+// checkKey rejects keys no store can hold.
+func checkKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("store key is required")
+	}
+	return nil
+}
+
+// inRange reports whether t lies in [from, to), treating zero bounds as
+// unbounded.
+func inRange(t, from, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+}
@@ -0,0 +1,178 @@
+package store
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	st, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 12, 0, 0, 0, time.UTC) }
+	key := Key("options", "AAPL", "2024-06-21")
+
+	if _, err := st.Get(key, day(1)); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get() on empty store error = %v, want ErrNotFound", err)
+	}
+	for d, data := range map[int]string{1: "one", 3: "three", 5: "five"} {
+		if err := st.Put(key, day(d), []byte(data)); err != nil {
+			t.Fatalf("Put() error: %v", err)
+		}
+	}
+	if err := st.Put(key, day(3), []byte("three again")); err != nil {
+		t.Fatalf("Put() error: %v", err)
+	}
+
+	rec, err := st.Get(key, day(4))
+	if err != nil || string(rec.Data) != "three again" || !rec.Time.Equal(day(3)) {
+		t.Errorf("Get(day 4) = %+v, %v; want the replaced day 3 record", rec, err)
+	}
+	if _, err := st.Get(key, day(1).Add(-time.Second)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() before first record error = %v, want ErrNotFound", err)
+	}
+
+	records, err := st.List(key, day(2), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || string(records[0].Data) != "three again" || string(records[1].Data) != "five" {
+		t.Errorf("List(from day 2) = %+v", records)
+	}
+	if records, _ := st.List(key, time.Time{}, day(5)); len(records) != 2 {
+		t.Errorf("List(to day 5) = %d records, want 2", len(records))
+	}
+
+	if err := st.Put("", day(1), nil); err == nil {
+		t.Error("expected error for empty key")
+	}
+}
+
+func TestFileStoreRejectsEscapingKeys(t *testing.T) {
+	root := t.TempDir()
+	st, err := NewFileStore(filepath.Join(root, "store"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+
+	at := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, key := range []string{".", ".."} {
+		if err := st.Put(key, at, []byte("x")); err == nil {
+			t.Errorf("Put(%q) should be rejected", key)
+		}
+		if _, err := st.Get(key, at); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("Get(%q) error = %v, want an invalid key error", key, err)
+		}
+	}
+
+	// Keys with separators are escaped into a single directory name
+	for _, key := range []string{"../outside", "a/../.."} {
+		if err := st.Put(key, at, []byte("x")); err != nil {
+			t.Errorf("Put(%q) error: %v", key, err)
+		}
+	}
+	if entries, _ := os.ReadDir(root); len(entries) != 1 {
+		t.Errorf("store wrote outside its directory: %d entries next to it", len(entries))
+	}
+}
+
+func TestJSON(t *testing.T) {
+	st, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2024, 6, 3, 14, 30, 0, 0, time.UTC)
+	if err := PutJSON(st, "quotes/AAPL", at, map[string]float64{"price": 190.5}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]float64
+	taken, err := GetJSON(st, "quotes/AAPL", at.Add(time.Hour), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !taken.Equal(at) || got["price"] != 190.5 {
+		t.Errorf("GetJSON() = %v at %s", got, taken)
+	}
+}
+
+// fakeDB is a database/sql driver recording executed statements.
+type fakeDB struct {
+	mu    sync.Mutex
+	execs []fakeExec
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+func (d *fakeDB) Open(string) (driver.Conn, error) { return &fakeConn{db: d}, nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	s.db.execs = append(s.db.execs, fakeExec{query: s.query, args: args})
+	return driver.RowsAffected(1), nil
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestSQLStore(t *testing.T) {
+	fake := &fakeDB{}
+	sql.Register("fake-store", fake)
+	db, err := sql.Open("fake-store", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := NewSQLStore(db, WithTable("bad name")); err == nil {
+		t.Error("expected error for invalid table name")
+	}
+
+	st, err := NewSQLStore(db, WithTable("records"))
+	if err != nil {
+		t.Fatalf("NewSQLStore() error: %v", err)
+	}
+	if len(fake.execs) != 1 || !strings.Contains(fake.execs[0].query, "CREATE TABLE IF NOT EXISTS records") {
+		t.Fatalf("expected schema creation, got %+v", fake.execs)
+	}
+
+	at := time.Unix(1700000000, 5)
+	if err := st.Put("ticks/AAPL", at, []byte(`{}`)); err != nil {
+		t.Fatalf("Put() error: %v", err)
+	}
+	put := fake.execs[1]
+	if !strings.HasPrefix(put.query, "INSERT OR REPLACE INTO records") || put.args[0] != "ticks/AAPL" || put.args[1] != at.UnixNano() {
+		t.Errorf("unexpected insert %+v", put)
+	}
+}
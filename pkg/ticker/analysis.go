@@ -1,15 +1,22 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // analysisCache stores cached analysis data.
@@ -22,17 +29,24 @@ type analysisCache struct {
 	epsRevisions      []models.EPSRevision
 	earningsHistory   *models.EarningsHistory
 	growthEstimates   []models.GrowthEstimate
+	upgrades          []models.UpgradeDowngrade
 	// Raw cached data from API
 	earningsTrendRaw map[string]interface{}
 }
 
 // Recommendations returns analyst recommendation trends.
 func (t *Ticker) Recommendations() (*models.RecommendationTrend, error) {
+	return t.RecommendationsContext(context.Background())
+}
+
+// RecommendationsContext is like [Ticker.Recommendations] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) RecommendationsContext(ctx context.Context) (*models.RecommendationTrend, error) {
 	if t.analysisCache != nil && t.analysisCache.recommendations != nil {
 		return t.analysisCache.recommendations, nil
 	}
 
-	data, err := t.fetchQuoteSummary([]string{"recommendationTrend"})
+	data, err := t.fetchQuoteSummary(ctx, []string{"recommendationTrend"})
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +68,7 @@ func (t *Ticker) AnalystPriceTargets() (*models.PriceTarget, error) {
 		return t.analysisCache.priceTarget, nil
 	}
 
-	data, err := t.fetchQuoteSummary([]string{"financialData"})
+	data, err := t.fetchQuoteSummary(context.Background(), []string{"financialData"})
 	if err != nil {
 		return nil, err
 	}
@@ -78,11 +92,17 @@ func (t *Ticker) PriceTarget() (*models.PriceTarget, error) {
 // EarningsEstimate returns earnings estimates for upcoming periods.
 // This method name matches Python yfinance's ticker.earnings_estimate property.
 func (t *Ticker) EarningsEstimate() ([]models.EarningsEstimate, error) {
+	return t.EarningsEstimateContext(context.Background())
+}
+
+// EarningsEstimateContext is like [Ticker.EarningsEstimate] but returns ctx's error as soon as ctx
+// is done.
+func (t *Ticker) EarningsEstimateContext(ctx context.Context) ([]models.EarningsEstimate, error) {
 	if t.analysisCache != nil && t.analysisCache.earningsEstimates != nil {
 		return t.analysisCache.earningsEstimates, nil
 	}
 
-	if err := t.ensureEarningsTrend(); err != nil {
+	if err := t.ensureEarningsTrend(ctx); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +126,7 @@ func (t *Ticker) RevenueEstimate() ([]models.RevenueEstimate, error) {
 		return t.analysisCache.revenueEstimates, nil
 	}
 
-	if err := t.ensureEarningsTrend(); err != nil {
+	if err := t.ensureEarningsTrend(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -125,11 +145,17 @@ func (t *Ticker) RevenueEstimates() ([]models.RevenueEstimate, error) {
 
 // EPSTrend returns EPS trend data.
 func (t *Ticker) EPSTrend() ([]models.EPSTrend, error) {
+	return t.EPSTrendContext(context.Background())
+}
+
+// EPSTrendContext is like [Ticker.EPSTrend] but returns ctx's error as soon as ctx
+// is done.
+func (t *Ticker) EPSTrendContext(ctx context.Context) ([]models.EPSTrend, error) {
 	if t.analysisCache != nil && t.analysisCache.epsTrends != nil {
 		return t.analysisCache.epsTrends, nil
 	}
 
-	if err := t.ensureEarningsTrend(); err != nil {
+	if err := t.ensureEarningsTrend(ctx); err != nil {
 		return nil, err
 	}
 
@@ -140,13 +166,43 @@ func (t *Ticker) EPSTrend() ([]models.EPSTrend, error) {
 	return result, nil
 }
 
+// EPSTrendSeries returns the EPS trend of each period as a dated series
+// with its slope, ready for charting estimate drift. The Current estimate
+// is dated today (UTC) and earlier snapshots are counted back from it.
+//
+// Example:
+//
+//	series, err := t.EPSTrendSeries()
+//	for _, s := range series {
+//	    fmt.Printf("%s: %+.4f EPS/day (%+.1f%%)\n", s.Period, s.Slope, s.Change*100)
+//	}
+func (t *Ticker) EPSTrendSeries() ([]models.EPSTrendSeries, error) {
+	trends, err := t.EPSTrend()
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := time.Now().UTC().Truncate(24 * time.Hour)
+	series := make([]models.EPSTrendSeries, 0, len(trends))
+	for _, tr := range trends {
+		series = append(series, tr.Series(asOf))
+	}
+	return series, nil
+}
+
 // EPSRevisions returns EPS revision data.
 func (t *Ticker) EPSRevisions() ([]models.EPSRevision, error) {
+	return t.EPSRevisionsContext(context.Background())
+}
+
+// EPSRevisionsContext is like [Ticker.EPSRevisions] but returns ctx's error as soon as ctx
+// is done.
+func (t *Ticker) EPSRevisionsContext(ctx context.Context) ([]models.EPSRevision, error) {
 	if t.analysisCache != nil && t.analysisCache.epsRevisions != nil {
 		return t.analysisCache.epsRevisions, nil
 	}
 
-	if err := t.ensureEarningsTrend(); err != nil {
+	if err := t.ensureEarningsTrend(ctx); err != nil {
 		return nil, err
 	}
 
@@ -157,13 +213,89 @@ func (t *Ticker) EPSRevisions() ([]models.EPSRevision, error) {
 	return result, nil
 }
 
+// RevisionMomentum returns an estimate-revision momentum score per period,
+// combining EPSRevisions, EPSTrend and the analyst count from EarningsEstimate.
+//
+// See [models.RevisionMomentum] for the definition of each component.
+//
+// Example:
+//
+//	momentum, err := t.RevisionMomentum()
+//	for _, m := range momentum {
+//	    fmt.Printf("%s: score %.2f (net %d of %d analysts)\n",
+//	        m.Period, m.Score, m.NetRevisions30d, m.Analysts)
+//	}
+func (t *Ticker) RevisionMomentum() ([]models.RevisionMomentum, error) {
+	trends, err := t.EPSTrend()
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := t.EPSRevisions()
+	if err != nil {
+		return nil, err
+	}
+	estimates, err := t.EarningsEstimate()
+	if err != nil {
+		return nil, err
+	}
+
+	return computeRevisionMomentum(trends, revisions, estimates), nil
+}
+
+// computeRevisionMomentum joins trends, revisions and estimates by period.
+// Periods are returned in the order they appear in revisions.
+func computeRevisionMomentum(trends []models.EPSTrend, revisions []models.EPSRevision, estimates []models.EarningsEstimate) []models.RevisionMomentum {
+	trendByPeriod := make(map[string]models.EPSTrend, len(trends))
+	for _, tr := range trends {
+		trendByPeriod[tr.Period] = tr
+	}
+	analystsByPeriod := make(map[string]int, len(estimates))
+	for _, e := range estimates {
+		analystsByPeriod[e.Period] = e.NumberOfAnalysts
+	}
+
+	result := make([]models.RevisionMomentum, 0, len(revisions))
+	for _, rev := range revisions {
+		m := models.RevisionMomentum{
+			Period:          rev.Period,
+			Analysts:        analystsByPeriod[rev.Period],
+			NetRevisions7d:  rev.UpLast7Days - rev.DownLast7Days,
+			NetRevisions30d: rev.UpLast30Days - rev.DownLast30Days,
+		}
+
+		analysts := m.Analysts
+		if analysts == 0 {
+			analysts = rev.UpLast30Days + rev.DownLast30Days
+		}
+		if analysts > 0 {
+			m.RevisionRatio = clampUnit(float64(m.NetRevisions30d) / float64(analysts))
+		}
+
+		if tr, ok := trendByPeriod[rev.Period]; ok {
+			if tr.ThirtyDays != 0 {
+				m.TrendChange30d = (tr.Current - tr.ThirtyDays) / math.Abs(tr.ThirtyDays)
+			}
+			m.TrendSlope = tr.Slope()
+		}
+
+		m.Score = (m.RevisionRatio + clampUnit(m.TrendChange30d)) / 2
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func clampUnit(v float64) float64 {
+	return math.Max(-1, math.Min(1, v))
+}
+
 // EarningsHistory returns historical earnings data.
 func (t *Ticker) EarningsHistory() (*models.EarningsHistory, error) {
 	if t.analysisCache != nil && t.analysisCache.earningsHistory != nil {
 		return t.analysisCache.earningsHistory, nil
 	}
 
-	data, err := t.fetchQuoteSummary([]string{"earningsHistory"})
+	data, err := t.fetchQuoteSummary(context.Background(), []string{"earningsHistory"})
 	if err != nil {
 		return nil, err
 	}
@@ -184,12 +316,12 @@ func (t *Ticker) GrowthEstimates() ([]models.GrowthEstimate, error) {
 		return t.analysisCache.growthEstimates, nil
 	}
 
-	if err := t.ensureEarningsTrend(); err != nil {
+	if err := t.ensureEarningsTrend(context.Background()); err != nil {
 		return nil, err
 	}
 
 	// Fetch additional trend data
-	trendData, err := t.fetchQuoteSummary([]string{"industryTrend", "sectorTrend", "indexTrend"})
+	trendData, err := t.fetchQuoteSummary(context.Background(), []string{"industryTrend", "sectorTrend", "indexTrend"})
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +333,60 @@ func (t *Ticker) GrowthEstimates() ([]models.GrowthEstimate, error) {
 	return result, nil
 }
 
+// UpgradesDowngrades returns the analyst rating changes for the ticker,
+// oldest first.
+// This method name matches Python yfinance's ticker.upgrades_downgrades property.
+func (t *Ticker) UpgradesDowngrades() ([]models.UpgradeDowngrade, error) {
+	if t.analysisCache != nil && t.analysisCache.upgrades != nil {
+		return t.analysisCache.upgrades, nil
+	}
+
+	data, err := t.fetchQuoteSummary(context.Background(), []string{"upgradeDowngradeHistory"})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := parseUpgradesDowngrades(data)
+	if err != nil {
+		return nil, err
+	}
+
+	t.initAnalysisCache()
+	t.analysisCache.upgrades = result
+	return result, nil
+}
+
+// AnalystTimeline merges the monthly recommendation trend with the
+// individual rating changes of [Ticker.UpgradesDowngrades] into one
+// chronological timeline. Trend periods are dated from the current month.
+// Either source may be missing; an error is returned only when both are.
+//
+// Example:
+//
+//	tl, err := ticker.AnalystTimeline()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: %d analyst events\n", tl.Symbol, len(tl.Events))
+func (t *Ticker) AnalystTimeline() (*models.AnalystTimeline, error) {
+	trend, trendErr := t.Recommendations()
+	if trendErr != nil && !errors.Is(trendErr, yferr.ErrNoData) {
+		return nil, trendErr
+	}
+	changes, changesErr := t.UpgradesDowngrades()
+	if changesErr != nil && !errors.Is(changesErr, yferr.ErrNoData) {
+		return nil, changesErr
+	}
+	if trendErr != nil && changesErr != nil {
+		return nil, yferr.NoData("no analyst recommendations for %s", t.symbol)
+	}
+
+	return &models.AnalystTimeline{
+		Symbol: t.symbol,
+		Events: models.MergeAnalystTimeline(trend, changes, time.Now()),
+	}, nil
+}
+
 // initAnalysisCache initializes the analysis cache if nil.
 func (t *Ticker) initAnalysisCache() {
 	if t.analysisCache == nil {
@@ -209,13 +395,13 @@ func (t *Ticker) initAnalysisCache() {
 }
 
 // ensureEarningsTrend fetches earningsTrend data if not cached.
-func (t *Ticker) ensureEarningsTrend() error {
+func (t *Ticker) ensureEarningsTrend(ctx context.Context) error {
 	t.initAnalysisCache()
 	if t.analysisCache.earningsTrendRaw != nil {
 		return nil
 	}
 
-	data, err := t.fetchQuoteSummary([]string{"earningsTrend"})
+	data, err := t.fetchQuoteSummary(ctx, []string{"earningsTrend"})
 	if err != nil {
 		return err
 	}
@@ -225,7 +411,10 @@ func (t *Ticker) ensureEarningsTrend() error {
 }
 
 // fetchQuoteSummary fetches data from quoteSummary API.
-func (t *Ticker) fetchQuoteSummary(modules []string) (map[string]interface{}, error) {
+func (t *Ticker) fetchQuoteSummary(ctx context.Context, modules []string) (map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	apiURL := fmt.Sprintf("%s/%s", endpoints.QuoteSummaryURL, t.symbol)
 
 	params := url.Values{}
@@ -238,36 +427,37 @@ func (t *Ticker) fetchQuoteSummary(modules []string) (map[string]interface{}, er
 
 	params, err := t.auth.AddCrumbToParams(params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to add crumb: %w", err)
+		return nil, yferr.Wrap(yferr.ErrAuth, "failed to add crumb", err)
 	}
 
-	resp, err := t.client.Get(apiURL, params)
+	resp, err := t.client.GetContext(t.withStats(ctx), apiURL, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch quoteSummary: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: status %d", resp.StatusCode)
+		return nil, client.HTTPStatusToError(resp.StatusCode, resp.Body)
 	}
+	t.keepRawSummary(resp.Body)
 
 	var rawResp map[string]interface{}
 	if err := json.Unmarshal([]byte(resp.Body), &rawResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, yferr.Parse("response", err)
 	}
 
 	quoteSummary, ok := rawResp["quoteSummary"].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("invalid response: missing quoteSummary")
+		return nil, yferr.Parse("response: missing quoteSummary", nil)
 	}
 
 	results, ok := quoteSummary["result"].([]interface{})
 	if !ok || len(results) == 0 {
-		return nil, fmt.Errorf("invalid response: missing result")
+		return nil, yferr.Parse("response: missing result", nil)
 	}
 
 	result, ok := results[0].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("invalid response: result is not an object")
+		return nil, yferr.Parse("response: result is not an object", nil)
 	}
 
 	return result, nil
@@ -277,12 +467,12 @@ func (t *Ticker) fetchQuoteSummary(modules []string) (map[string]interface{}, er
 func (t *Ticker) parseRecommendations(data map[string]interface{}) (*models.RecommendationTrend, error) {
 	recTrend, ok := data["recommendationTrend"].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("recommendationTrend not found")
+		return nil, yferr.NoData("recommendationTrend not found")
 	}
 
 	trend, ok := recTrend["trend"].([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("trend data not found")
+		return nil, yferr.NoData("trend data not found")
 	}
 
 	result := &models.RecommendationTrend{
@@ -313,7 +503,7 @@ func (t *Ticker) parseRecommendations(data map[string]interface{}) (*models.Reco
 func (t *Ticker) parsePriceTarget(data map[string]interface{}) (*models.PriceTarget, error) {
 	finData, ok := data["financialData"].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("financialData not found")
+		return nil, yferr.NoData("financialData not found")
 	}
 
 	return &models.PriceTarget{
@@ -507,16 +697,58 @@ func (t *Ticker) parseEPSRevisions() []models.EPSRevision {
 	return result
 }
 
+// parseUpgradesDowngrades parses upgradeDowngradeHistory data, oldest
+// first.
+func parseUpgradesDowngrades(data map[string]interface{}) ([]models.UpgradeDowngrade, error) {
+	udData, ok := data["upgradeDowngradeHistory"].(map[string]interface{})
+	if !ok {
+		return nil, yferr.NoData("upgradeDowngradeHistory not found")
+	}
+
+	history, ok := udData["history"].([]interface{})
+	if !ok {
+		return nil, yferr.NoData("history data not found")
+	}
+
+	result := make([]models.UpgradeDowngrade, 0, len(history))
+	for _, item := range history {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		epoch := getInt64(itemMap, "epochGradeDate")
+		if epoch == 0 {
+			continue
+		}
+
+		result = append(result, models.UpgradeDowngrade{
+			Date:               time.Unix(epoch, 0).UTC(),
+			Firm:               getString(itemMap, "firm"),
+			ToGrade:            getString(itemMap, "toGrade"),
+			FromGrade:          getString(itemMap, "fromGrade"),
+			Action:             getString(itemMap, "action"),
+			PriceTargetAction:  getString(itemMap, "priceTargetAction"),
+			CurrentPriceTarget: getNestedFloat(itemMap, "currentPriceTarget"),
+			PriorPriceTarget:   getNestedFloat(itemMap, "priorPriceTarget"),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Date.Before(result[j].Date)
+	})
+	return result, nil
+}
+
 // parseEarningsHistory parses earningsHistory data.
 func (t *Ticker) parseEarningsHistory(data map[string]interface{}) (*models.EarningsHistory, error) {
 	ehData, ok := data["earningsHistory"].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("earningsHistory not found")
+		return nil, yferr.NoData("earningsHistory not found")
 	}
 
 	history, ok := ehData["history"].([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("history data not found")
+		return nil, yferr.NoData("history data not found")
 	}
 
 	result := &models.EarningsHistory{
@@ -658,16 +890,7 @@ func applyPeerGrowthEstimate(est interface{}, field string, estimateMap map[stri
 // Helper functions for parsing (uses getString/getInt from info.go)
 
 func getNestedFloat(m map[string]interface{}, key string) float64 {
-	if nested, ok := m[key].(map[string]interface{}); ok {
-		if v, ok := nested["raw"].(float64); ok {
-			return v
-		}
-	}
-	// Also try direct value
-	if v, ok := m[key].(float64); ok {
-		return v
-	}
-	return 0
+	return utils.GetFloat(m, key)
 }
 
 func getNestedString(m map[string]interface{}, key string) string {
@@ -686,26 +909,9 @@ func getNestedString(m map[string]interface{}, key string) string {
 }
 
 func getNestedInt(m map[string]interface{}, key string) int {
-	if nested, ok := m[key].(map[string]interface{}); ok {
-		if v, ok := nested["raw"].(float64); ok {
-			return int(v)
-		}
-	}
-	// Also try direct value
-	if v, ok := m[key].(float64); ok {
-		return int(v)
-	}
-	return 0
+	return utils.GetInt(m, key)
 }
 
 func getNestedFloatPtr(m map[string]interface{}, key string) *float64 {
-	if nested, ok := m[key].(map[string]interface{}); ok {
-		if v, ok := nested["raw"].(float64); ok {
-			return &v
-		}
-	}
-	if v, ok := m[key].(float64); ok {
-		return &v
-	}
-	return nil
+	return utils.GetFloatPtr(m, key)
 }
@@ -1,9 +1,13 @@
 package ticker
 
 import (
+	"encoding/json"
+	"errors"
+	"math"
 	"testing"
 
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 func TestRecommendationTotal(t *testing.T) {
@@ -194,3 +198,73 @@ func TestEarningsHistoryItem(t *testing.T) {
 // 			h.Period, h.Quarter.Format("2006-01-02"), h.EPSActual, h.EPSEstimate, h.SurprisePercent*100)
 // 	}
 // }
+
+func TestComputeRevisionMomentum(t *testing.T) {
+	trends := []models.EPSTrend{
+		{Period: "0q", Current: 1.10, SevenDays: 1.08, ThirtyDays: 1.00, SixtyDays: 0.95, NinetyDays: 0.90},
+	}
+	revisions := []models.EPSRevision{
+		{Period: "0q", UpLast7Days: 2, DownLast7Days: 1, UpLast30Days: 8, DownLast30Days: 2},
+		{Period: "+1q", UpLast30Days: 1, DownLast30Days: 3},
+	}
+	estimates := []models.EarningsEstimate{
+		{Period: "0q", NumberOfAnalysts: 20},
+	}
+
+	result := computeRevisionMomentum(trends, revisions, estimates)
+	if len(result) != 2 {
+		t.Fatalf("expected 2 periods, got %d", len(result))
+	}
+
+	m := result[0]
+	if m.NetRevisions7d != 1 || m.NetRevisions30d != 6 || m.Analysts != 20 {
+		t.Errorf("unexpected counts: %+v", m)
+	}
+	if math.Abs(m.RevisionRatio-0.3) > 1e-9 {
+		t.Errorf("RevisionRatio = %v, want 0.3", m.RevisionRatio)
+	}
+	if math.Abs(m.TrendChange30d-0.1) > 1e-9 {
+		t.Errorf("TrendChange30d = %v, want 0.1", m.TrendChange30d)
+	}
+	if m.TrendSlope <= 0 {
+		t.Errorf("expected positive trend slope, got %v", m.TrendSlope)
+	}
+	if math.Abs(m.Score-0.2) > 1e-9 {
+		t.Errorf("Score = %v, want 0.2", m.Score)
+	}
+
+	// Without an estimate, the revision count is used as the analyst base.
+	if got := result[1].RevisionRatio; math.Abs(got-(-0.5)) > 1e-9 {
+		t.Errorf("RevisionRatio fallback = %v, want -0.5", got)
+	}
+}
+
+func TestParseUpgradesDowngrades(t *testing.T) {
+	var data map[string]interface{}
+	body := `{"upgradeDowngradeHistory": {"history": [
+		{"epochGradeDate": 1717200000, "firm": "Morgan Stanley", "toGrade": "Overweight", "fromGrade": "Overweight", "action": "main", "priceTargetAction": "Raises", "currentPriceTarget": 250, "priorPriceTarget": 220},
+		{"epochGradeDate": 1704067200, "firm": "Barclays", "toGrade": "Underweight", "fromGrade": "Equal-Weight", "action": "down"},
+		{"firm": "Undated", "toGrade": "Buy"}
+	]}}`
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := parseUpgradesDowngrades(data)
+	if err != nil {
+		t.Fatalf("parseUpgradesDowngrades() error: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 dated changes, got %d", len(changes))
+	}
+	if changes[0].Firm != "Barclays" || changes[0].Action != "down" {
+		t.Errorf("expected oldest change first, got %+v", changes[0])
+	}
+	if c := changes[1]; c.CurrentPriceTarget != 250 || c.PriorPriceTarget != 220 || c.PriceTargetAction != "Raises" {
+		t.Errorf("unexpected price targets %+v", c)
+	}
+
+	if _, err := parseUpgradesDowngrades(map[string]interface{}{}); !errors.Is(err, yferr.ErrNoData) {
+		t.Errorf("expected yferr.ErrNoData, got %v", err)
+	}
+}
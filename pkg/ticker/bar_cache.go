@@ -0,0 +1,168 @@
+package ticker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// barCache returns the client's bar cache and the series key of params,
+// or nil when the bars of params cannot be cached.
+//
+// Only explicit Start ranges are cached: Yahoo resolves a Period such as
+// "5d" in trading days, which a time range cannot reproduce. Exact decimal
+// prices need the raw response and are not cached either.
+func (t *Ticker) barCache(params models.HistoryParams) (*client.BarCache, string) {
+	if params.Start == nil || params.Decimal {
+		return nil, ""
+	}
+	bc := t.client.BarCache()
+	if bc == nil {
+		return nil, ""
+	}
+	key := fmt.Sprintf("%s interval=%s prepost=%t actions=%t nan=%t",
+		t.symbol, params.Interval, params.PrePost, params.Actions, params.MissingAsNaN)
+	return bc, key
+}
+
+// barDurations is the time each bar of an interval covers, used to tell
+// whether the last bar of a response is still forming.
+var barDurations = map[string]time.Duration{
+	"1m":  time.Minute,
+	"2m":  2 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"60m": time.Hour,
+	"90m": 90 * time.Minute,
+	"1h":  time.Hour,
+	"1d":  24 * time.Hour,
+	"5d":  5 * 24 * time.Hour,
+	"1wk": 7 * 24 * time.Hour,
+	"1mo": 31 * 24 * time.Hour,
+	"3mo": 92 * 24 * time.Hour,
+}
+
+// cachedChartBars returns the bars and events of the explicit range of
+// params like fetchChartBars, serving the parts already in bc and fetching
+// and caching only the missing ranges.
+//
+// A bar still forming at fetch time is returned but not cached. Cached bars
+// are unadjusted, but their AdjClose only accounts for the actions known
+// when they were fetched: when a newly fetched range has a later action,
+// the series is dropped and the whole range fetched again.
+func (t *Ticker) cachedChartBars(ctx context.Context, params models.HistoryParams, bc *client.BarCache, key string) (chartHistory, error) {
+	now := time.Now()
+	start, end, err := params.TimeRange(now)
+	if err != nil {
+		return chartHistory{}, fmt.Errorf("invalid history params: %w", err)
+	}
+
+	spans, missing := bc.Lookup(key, start, end)
+	cached := spans
+	fetched := false
+	var noData error
+	var latestAction time.Time
+	for _, r := range missing {
+		part, err := t.fetchChartBars(ctx, chunkParams(params, historyChunk{start: r.Start, end: r.End}))
+		if errors.Is(err, yferr.ErrNoData) {
+			// A range without trading, e.g. a weekend; not cached so a
+			// later request can still pick up late data
+			noData = err
+			continue
+		}
+		if err != nil {
+			return chartHistory{}, err
+		}
+		span := client.BarSpan{Start: r.Start, End: r.End, Bars: part.bars, Actions: part.actions, Meta: part.meta, Fetched: now}
+		bc.Store(key, span.Clip(r.Start, cacheableEnd(part.bars, r.End, params.Interval, now)))
+		spans = append(spans, span.Clip(r.Start, r.End))
+		fetched = true
+		if last := lastActionDate(part.actions); last.After(latestAction) {
+			latestAction = last
+		}
+	}
+	if len(spans) == 0 && noData != nil {
+		return chartHistory{}, noData
+	}
+
+	for _, s := range cached {
+		if s.Fetched.Before(latestAction) {
+			// Adjusted closes of s predate the action; refetch everything
+			bc.Drop(key)
+			ch, err := t.fetchChartBars(ctx, params)
+			if err != nil {
+				return chartHistory{}, err
+			}
+			bc.Store(key, client.BarSpan{
+				Start: start, End: cacheableEnd(ch.bars, end, params.Interval, now),
+				Bars: ch.bars, Actions: ch.actions, Meta: ch.meta, Fetched: now,
+			})
+			return ch, nil
+		}
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].Start.Before(spans[j].Start) })
+	var ch chartHistory
+	var metaEnd time.Time
+	for _, s := range spans {
+		ch.bars = models.MergeBars(ch.bars, s.Bars, models.PreferNew)
+		ch.actions = mergeActions(ch.actions, s.Actions)
+		// The meta of the most recent range describes the symbol best
+		if !s.End.Before(metaEnd) {
+			ch.meta, metaEnd = s.Meta, s.End
+		}
+	}
+	if !fetched {
+		meta := ch.meta
+		t.setHistoryMetadata(&meta)
+	}
+	return ch, nil
+}
+
+// cacheableEnd returns how much of a range ending at end, fetched at now,
+// may be cached: not past now, and not including a last bar that is still
+// forming.
+func cacheableEnd(bars []models.Bar, end time.Time, interval string, now time.Time) time.Time {
+	if end.After(now) {
+		end = now
+	}
+	if len(bars) == 0 {
+		return end
+	}
+	d, ok := barDurations[interval]
+	if !ok {
+		d = 24 * time.Hour
+	}
+	if last := bars[len(bars)-1].Date; last.Add(d).After(now) && last.Before(end) {
+		end = last
+	}
+	return end
+}
+
+// lastActionDate returns the date of the latest action, or the zero time.
+func lastActionDate(actions models.Actions) time.Time {
+	var last time.Time
+	for _, d := range actions.Dividends {
+		if d.Date.After(last) {
+			last = d.Date
+		}
+	}
+	for _, s := range actions.Splits {
+		if s.Date.After(last) {
+			last = s.Date
+		}
+	}
+	for _, g := range actions.CapitalGains {
+		if g.Date.After(last) {
+			last = g.Date
+		}
+	}
+	return last
+}
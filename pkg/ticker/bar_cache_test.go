@@ -0,0 +1,47 @@
+package ticker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestCacheableEnd(t *testing.T) {
+	now := time.Date(2024, 3, 4, 15, 2, 30, 0, time.UTC)
+	open := now.Truncate(time.Minute) // 15:02, still forming
+	bars := []models.Bar{{Date: open.Add(-time.Minute)}, {Date: open}}
+
+	if got := cacheableEnd(bars, now.Add(time.Hour), "1m", now); !got.Equal(open) {
+		t.Errorf("cacheableEnd() = %v, want the open bar's start %v", got, open)
+	}
+
+	closed := now.Add(-time.Hour)
+	if got := cacheableEnd(bars[:1], closed, "1m", now); !got.Equal(closed) {
+		t.Errorf("cacheableEnd() = %v, want the range end %v for a closed range", got, closed)
+	}
+
+	today := time.Date(2024, 3, 4, 14, 30, 0, 0, time.UTC)
+	daily := []models.Bar{{Date: today.AddDate(0, 0, -1)}, {Date: today}}
+	if got := cacheableEnd(daily, now, "1d", now); !got.Equal(today) {
+		t.Errorf("cacheableEnd() = %v, want today's bar %v left out", got, today)
+	}
+	if got := cacheableEnd(nil, now.Add(time.Hour), "1d", now); !got.Equal(now) {
+		t.Errorf("cacheableEnd() = %v, want now for a range ending in the future", got)
+	}
+}
+
+func TestLastActionDate(t *testing.T) {
+	d := func(day int) time.Time { return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC) }
+	actions := models.Actions{
+		Dividends:    []models.Dividend{{Date: d(3)}, {Date: d(10)}},
+		Splits:       []models.Split{{Date: d(12)}},
+		CapitalGains: []models.CapitalGain{{Date: d(5)}},
+	}
+	if got := lastActionDate(actions); !got.Equal(d(12)) {
+		t.Errorf("lastActionDate() = %v, want %v", got, d(12))
+	}
+	if got := lastActionDate(models.Actions{}); !got.IsZero() {
+		t.Errorf("lastActionDate() = %v, want zero", got)
+	}
+}
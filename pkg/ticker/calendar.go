@@ -1,10 +1,12 @@
 package ticker
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Calendar returns upcoming calendar events for the ticker.
@@ -24,6 +26,12 @@ import (
 //	    fmt.Printf("Earnings: %s\n", date.Format("2006-01-02"))
 //	}
 func (t *Ticker) Calendar() (*models.Calendar, error) {
+	return t.CalendarContext(context.Background())
+}
+
+// CalendarContext is like [Ticker.Calendar] but returns ctx's error as
+// soon as ctx is done.
+func (t *Ticker) CalendarContext(ctx context.Context) (*models.Calendar, error) {
 	t.mu.RLock()
 	if t.calendarCache != nil {
 		defer t.mu.RUnlock()
@@ -31,7 +39,7 @@ func (t *Ticker) Calendar() (*models.Calendar, error) {
 	}
 	t.mu.RUnlock()
 
-	data, err := t.fetchQuoteSummary([]string{"calendarEvents"})
+	data, err := t.fetchQuoteSummary(ctx, []string{"calendarEvents"})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch calendar data: %w", err)
 	}
@@ -48,11 +56,32 @@ func (t *Ticker) Calendar() (*models.Calendar, error) {
 	return calendar, nil
 }
 
+// NextEarnings returns the next confirmed or estimated earnings window.
+//
+// Returns nil (and no error) when Yahoo has no upcoming earnings date.
+//
+// Example:
+//
+//	w, err := ticker.NextEarnings()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if w != nil {
+//	    fmt.Printf("Earnings in %d days (estimated: %t)\n", w.DaysUntil(time.Now()), w.Estimated)
+//	}
+func (t *Ticker) NextEarnings() (*models.EarningsWindow, error) {
+	calendar, err := t.Calendar()
+	if err != nil {
+		return nil, err
+	}
+	return calendar.NextEarningsWindow(time.Now()), nil
+}
+
 // parseCalendar parses calendarEvents data.
 func (t *Ticker) parseCalendar(data map[string]interface{}) (*models.Calendar, error) {
 	events, ok := data["calendarEvents"].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("calendarEvents not found")
+		return nil, yferr.NoData("calendarEvents not found")
 	}
 
 	calendar := &models.Calendar{}
@@ -78,6 +107,7 @@ func (t *Ticker) parseCalendar(data map[string]interface{}) (*models.Calendar, e
 
 func parseEarningsCalendar(calendar *models.Calendar, earnings map[string]interface{}) {
 	calendar.EarningsDate = parseEarningsDates(earnings["earningsDate"])
+	calendar.IsEarningsDateEstimate, _ = earnings["isEarningsDateEstimate"].(bool)
 	assignCalendarEstimate(&calendar.EarningsHigh, earnings, "earningsHigh")
 	assignCalendarEstimate(&calendar.EarningsLow, earnings, "earningsLow")
 	assignCalendarEstimate(&calendar.EarningsAverage, earnings, "earningsAverage")
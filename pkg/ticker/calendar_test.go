@@ -279,3 +279,45 @@ func ptrTime(v time.Time) *time.Time {
 // 	}
 // 	return *p
 // }
+
+func TestNextEarningsWindow(t *testing.T) {
+	start := time.Date(2024, 4, 25, 20, 0, 0, 0, time.UTC)
+	calendar := models.Calendar{
+		EarningsDate:           []time.Time{start},
+		IsEarningsDateEstimate: false,
+	}
+
+	now := time.Date(2024, 4, 20, 9, 0, 0, 0, time.UTC)
+	w := calendar.NextEarningsWindow(now)
+	if w == nil {
+		t.Fatal("Expected an upcoming earnings window")
+	}
+	if w.Estimated {
+		t.Error("Expected single confirmed date not to be estimated")
+	}
+	if got := w.DaysUntil(now); got != 5 {
+		t.Errorf("Expected 5 days until earnings, got %d", got)
+	}
+
+	if calendar.NextEarningsWindow(start.AddDate(0, 0, 3)) != nil {
+		t.Error("Expected past earnings window to be nil")
+	}
+}
+
+func TestParseCalendarEarningsEstimateFlag(t *testing.T) {
+	tkr := &Ticker{}
+	calendar, err := tkr.parseCalendar(map[string]interface{}{
+		"calendarEvents": map[string]interface{}{
+			"earnings": map[string]interface{}{
+				"earningsDate":           []interface{}{float64(1714075200), float64(1714507200)},
+				"isEarningsDateEstimate": true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("parseCalendar() error: %v", err)
+	}
+	if !calendar.IsEarningsDateEstimate {
+		t.Error("Expected IsEarningsDateEstimate to be parsed")
+	}
+}
@@ -0,0 +1,160 @@
+package ticker
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// minUnadjustedSplitFactor is the smallest split that is checked for
+// unadjusted dividends; smaller splits are indistinguishable from ordinary
+// dividend changes.
+const minUnadjustedSplitFactor = 1.5
+
+// DividendStreak returns the number of consecutive calendar years in which
+// the ticker's dividend per share increased, with annual totals and growth
+// rates, from the full dividend history.
+//
+// Dividends are summed by calendar year and compared in split-adjusted
+// terms. Yahoo usually adjusts dividends for splits already; where the
+// dividends before a split are still on the old share basis, they are
+// adjusted here. Special dividends count towards their year's total.
+//
+// Example:
+//
+//	streak, err := ticker.DividendStreak()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: %d consecutive increases, 10y CAGR %.1f%%\n",
+//	    streak.Symbol, streak.Years, streak.CAGR10Y*100)
+func (t *Ticker) DividendStreak() (*models.DividendStreak, error) {
+	return t.DividendStreakContext(context.Background())
+}
+
+// DividendStreakContext is like [Ticker.DividendStreak] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) DividendStreakContext(ctx context.Context) (*models.DividendStreak, error) {
+	actions, err := t.ActionsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(actions.Dividends) == 0 {
+		return nil, yferr.NoData("no dividends found for %s", t.symbol)
+	}
+
+	streak := dividendStreak(actions.Dividends, actions.Splits, time.Now())
+	streak.Symbol = t.symbol
+	return streak, nil
+}
+
+// dividendStreak computes the streak of the complete years before now.
+func dividendStreak(dividends []models.Dividend, splits []models.Split, now time.Time) *models.DividendStreak {
+	adjusted := splitAdjustDividends(dividends, splits)
+	lastYear := now.Year() - 1
+	streak := &models.DividendStreak{LastYear: lastYear}
+
+	totals := make(map[int]*models.AnnualDividend)
+	firstYear := 0
+	for _, d := range adjusted {
+		y := d.Date.Year()
+		if y > lastYear {
+			continue
+		}
+		a, ok := totals[y]
+		if !ok {
+			a = &models.AnnualDividend{Year: y}
+			totals[y] = a
+		}
+		a.Amount += d.Amount
+		a.Payments++
+		if firstYear == 0 || y < firstYear {
+			firstYear = y
+		}
+	}
+	if firstYear == 0 {
+		return streak
+	}
+
+	for y := firstYear; y <= lastYear; y++ {
+		a := models.AnnualDividend{Year: y}
+		if total, ok := totals[y]; ok {
+			a = *total
+		}
+		if n := len(streak.Annual); n > 0 && streak.Annual[n-1].Amount > 0 {
+			a.Growth = a.Amount/streak.Annual[n-1].Amount - 1
+		}
+		streak.Annual = append(streak.Annual, a)
+	}
+
+	// Count increases backwards from the last year
+	for i := len(streak.Annual) - 1; i > 0; i-- {
+		if !dividendIncreased(streak.Annual[i-1].Amount, streak.Annual[i].Amount) {
+			break
+		}
+		streak.Years++
+		streak.StartYear = streak.Annual[i].Year
+	}
+
+	streak.Growth1Y = dividendCAGR(streak.Annual, 1)
+	streak.CAGR3Y = dividendCAGR(streak.Annual, 3)
+	streak.CAGR5Y = dividendCAGR(streak.Annual, 5)
+	streak.CAGR10Y = dividendCAGR(streak.Annual, 10)
+	return streak
+}
+
+// dividendIncreased compares two annual totals, ignoring float noise from
+// split adjustment.
+func dividendIncreased(prev, cur float64) bool {
+	return prev > 0 && cur > prev*(1+1e-9)
+}
+
+// dividendCAGR is the compound annual growth over the last years of annual.
+func dividendCAGR(annual []models.AnnualDividend, years int) float64 {
+	n := len(annual)
+	if n <= years {
+		return 0
+	}
+	base, last := annual[n-1-years].Amount, annual[n-1].Amount
+	if base <= 0 || last <= 0 {
+		return 0
+	}
+	return math.Pow(last/base, 1/float64(years)) - 1
+}
+
+// splitAdjustDividends returns the dividends sorted by date and expressed
+// in today's shares. A split is treated as unadjusted when the dividends on
+// either side of it differ by about the split factor.
+func splitAdjustDividends(dividends []models.Dividend, splits []models.Split) []models.Dividend {
+	out := append([]models.Dividend(nil), dividends...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+
+	for _, s := range splits {
+		ratio, ok := models.NewSplitRatio(s.Numerator, s.Denominator)
+		if !ok {
+			continue
+		}
+		factor := ratio.Factor()
+		if math.Max(factor, 1/factor) < minUnadjustedSplitFactor {
+			continue
+		}
+
+		// Dividends before the split end at idx
+		idx := sort.Search(len(out), func(i int) bool { return !out[i].Date.Before(s.Date) })
+		if idx == 0 || idx == len(out) {
+			continue
+		}
+		change := out[idx-1].Amount / out[idx].Amount
+		if change < factor*0.7 || change > factor*1.3 {
+			continue
+		}
+		for i := 0; i < idx; i++ {
+			out[i].Amount /= factor
+		}
+	}
+	return out
+}
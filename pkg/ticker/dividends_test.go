@@ -0,0 +1,99 @@
+package ticker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// quarterly returns four equal dividends paid in year.
+func quarterly(year int, amount float64) []models.Dividend {
+	var out []models.Dividend
+	for q := 0; q < 4; q++ {
+		out = append(out, models.Dividend{
+			Date:   time.Date(year, time.Month(2+3*q), 10, 0, 0, 0, 0, time.UTC),
+			Amount: amount,
+		})
+	}
+	return out
+}
+
+func TestDividendStreak(t *testing.T) {
+	var divs []models.Dividend
+	divs = append(divs, quarterly(2015, 0.50)...)
+	divs = append(divs, quarterly(2016, 0.45)...) // cut
+	for i, y := 0, 2017; y <= 2024; i, y = i+1, y+1 {
+		divs = append(divs, quarterly(y, 0.50+0.05*float64(i))...)
+	}
+	divs = append(divs, quarterly(2025, 0.10)[:1]...) // incomplete year
+
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := dividendStreak(divs, nil, now)
+
+	if s.LastYear != 2024 || len(s.Annual) != 10 {
+		t.Fatalf("unexpected years %d..%d (%d)", s.Annual[0].Year, s.LastYear, len(s.Annual))
+	}
+	// 2017 (up from the 2016 cut) through 2024
+	if s.Years != 8 || s.StartYear != 2017 {
+		t.Errorf("expected 8 years since 2017, got %d since %d", s.Years, s.StartYear)
+	}
+	last := s.Annual[len(s.Annual)-1]
+	if last.Payments != 4 || math.Abs(last.Amount-3.4) > 1e-9 {
+		t.Errorf("unexpected 2024 total %+v", last)
+	}
+	if math.Abs(s.Growth1Y-(3.4/3.2-1)) > 1e-9 {
+		t.Errorf("unexpected 1y growth %f", s.Growth1Y)
+	}
+	if math.Abs(s.CAGR5Y-(math.Pow(3.4/2.4, 0.2)-1)) > 1e-9 {
+		t.Errorf("unexpected 5y CAGR %f", s.CAGR5Y)
+	}
+	if s.CAGR10Y != 0 {
+		t.Errorf("expected no 10y CAGR with 10 years of data, got %f", s.CAGR10Y)
+	}
+	if s.IsAristocrat() {
+		t.Error("8 years is not an aristocrat")
+	}
+}
+
+func TestDividendStreakGapYear(t *testing.T) {
+	var divs []models.Dividend
+	divs = append(divs, quarterly(2020, 0.2)...)
+	divs = append(divs, quarterly(2022, 0.3)...)
+	divs = append(divs, quarterly(2023, 0.4)...)
+
+	s := dividendStreak(divs, nil, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	if len(s.Annual) != 4 || s.Annual[1].Amount != 0 || s.Annual[1].Payments != 0 {
+		t.Fatalf("expected an empty 2021, got %+v", s.Annual)
+	}
+	if s.Years != 1 || s.StartYear != 2023 {
+		t.Errorf("expected the streak to restart after the gap, got %d since %d", s.Years, s.StartYear)
+	}
+}
+
+func TestDividendStreakUnadjustedSplit(t *testing.T) {
+	split := time.Date(2020, 8, 31, 0, 0, 0, 0, time.UTC)
+	var divs []models.Dividend
+	// Before the 4:1 split the dividend is still on the old share basis
+	divs = append(divs, quarterly(2019, 0.80)...)
+	divs = append(divs, quarterly(2020, 0.84)[:3]...)
+	divs = append(divs, models.Dividend{Date: time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC), Amount: 0.22})
+	divs = append(divs, quarterly(2021, 0.23)...)
+
+	splits := []models.Split{{Date: split, Numerator: 4, Denominator: 1}}
+	s := dividendStreak(divs, splits, time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC))
+
+	if s.Years != 2 || s.StartYear != 2020 {
+		t.Errorf("expected 2 years of increases, got %d since %d (%+v)", s.Years, s.StartYear, s.Annual)
+	}
+	if math.Abs(s.Annual[0].Amount-0.8) > 1e-9 {
+		t.Errorf("expected 2019 adjusted to 0.80, got %f", s.Annual[0].Amount)
+	}
+
+	// Already adjusted dividends are left alone
+	adjusted := splitAdjustDividends(quarterly(2020, 0.2), splits)
+	if adjusted[0].Amount != 0.2 {
+		t.Errorf("expected adjusted dividends unchanged, got %f", adjusted[0].Amount)
+	}
+}
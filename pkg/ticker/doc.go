@@ -31,38 +31,155 @@
 // The Ticker type provides methods for:
 //
 //   - [Ticker.Quote]: Real-time quote data
+//   - [Quotes]: Quotes of many symbols in batched requests
 //   - [Ticker.History]: Historical OHLCV data
+//   - [Ticker.HistoryWithSummary]: History with currency, events and repair statistics
+//   - [Ticker.HistoryStream]: History delivered window by window on a channel
 //   - [Ticker.Info]: Company information and key statistics
+//   - [Ticker.InfoWithLocale]: Info with the business summary in another language
 //   - [Ticker.FastInfo]: Quick-access subset of info data
+//   - [Ticker.Timezone], [Ticker.QuoteType]: Exchange timezone and quote type
+//   - [Ticker.ISIN]: International Securities Identification Number
+//   - [Ticker.Sustainability]: ESG risk scores and peer comparisons
+//   - [Ticker.FundsData]: ETF and mutual fund holdings, allocation and fees
 //   - [Ticker.Dividends]: Dividend history
+//   - [Ticker.DividendStreak]: Consecutive years of dividend increases and growth rates
 //   - [Ticker.Splits]: Stock split history
-//   - [Ticker.Actions]: Combined dividends and splits
+//   - [Ticker.CapitalGains]: ETF and mutual fund capital gain distributions
+//   - [Ticker.Actions]: Combined dividends, splits and capital gains
+//   - [Ticker.DividendsWithParams], [Ticker.SplitsWithParams], [Ticker.ActionsWithParams]: Actions within a period or date range
 //   - [Ticker.Options]: Available option expiration dates
-//   - [Ticker.OptionChain]: Full option chain data
+//   - [Ticker.OptionChainWithParams]: Option chain by expiry, with strike, moneyness, type and liquidity filters
+//   - [Ticker.AllOptionChains]: Option chains of every expiration, fetched concurrently
+//   - [Ticker.SnapshotOptionChain]: Fetch an option chain and save it to a [store.Store] (see [LoadOptionChain])
 //   - [Ticker.IncomeStatement]: Income statement data
 //   - [Ticker.BalanceSheet]: Balance sheet data
 //   - [Ticker.CashFlow]: Cash flow statement data
+//   - [Ticker.IncomeStatement] and [Ticker.CashFlow] with "ttm": Trailing twelve months
+//     summed from the latest four quarters
+//   - [Ticker.IncomeStatementWithKeys], [Ticker.BalanceSheetWithKeys], [Ticker.CashFlowWithKeys]:
+//     Statements limited to a subset of [IncomeStatementKeys], [BalanceSheetKeys] or [CashFlowKeys]
+//   - [Ticker.SharesHistory]: Shares outstanding over time
+//   - [Ticker.Ratios]: Financial ratios computed from the statements
+//   - [Ticker.Beta]: Historical beta against a benchmark symbol
+//   - [Ticker.RealizedVolatility]: Annualized and rolling realized volatility
 //   - [Ticker.Recommendations]: Analyst recommendations
+//   - [Ticker.UpgradesDowngrades]: Analyst rating changes by firm
+//   - [Ticker.AnalystTimeline]: Recommendation trend and rating changes in one chronological timeline
 //   - [Ticker.AnalystPriceTargets]: Analyst price targets
 //   - [Ticker.EarningsEstimate]: Earnings estimates
 //   - [Ticker.RevenueEstimate]: Revenue estimates
 //   - [Ticker.EPSTrend]: EPS trend data
+//   - [Ticker.EPSTrendSeries]: EPS trend as dated series with slope, for charting
 //   - [Ticker.EPSRevisions]: EPS revision data
 //   - [Ticker.EarningsHistory]: Historical earnings data
+//   - [Ticker.EarningsDates]: Upcoming and past earnings dates with EPS surprise
 //   - [Ticker.GrowthEstimates]: Growth estimates
 //   - [Ticker.MajorHolders]: Major shareholders breakdown
 //   - [Ticker.InstitutionalHolders]: Institutional holder list
+//   - [Ticker.InstitutionalOwnershipTrend]: Institutional ownership by report date with accumulation indicator
 //   - [Ticker.MutualFundHolders]: Mutual fund holder list
 //   - [Ticker.InsiderTransactions]: Insider transaction history
 //   - [Ticker.InsiderRosterHolders]: Company insiders list
 //   - [Ticker.InsiderPurchases]: Insider purchase activity summary
+//   - [Ticker.InsiderActivityByQuarter]: Quarterly insider buy/sell totals and net score
 //   - [Ticker.Calendar]: Upcoming events (earnings, dividends)
 //
+// # Currency Conversion
+//
+// Set [models.HistoryParams].Currency to convert history into another
+// currency. Each bar is multiplied by the daily close of the FX pair (e.g.
+// "GBPUSD=X") on its date, carrying the last rate over FX holidays.
+// Currencies without a direct Yahoo pair, such as NOK and KRW, are
+// triangulated through USD, and [models.History].FXRoute records the pairs
+// used:
+//
+//	hist, err := t.History(models.HistoryParams{Period: "1y", Currency: "KRW"})
+//	fmt.Println(hist.FXRoute) // NOK->USD->KRW
+//
+// Financial statements convert with [models.FinancialStatement.ConvertTo]
+// using period-end rates from [HistoricalFXRate]:
+//
+//	income, _ := t.IncomeStatement("annual")
+//	usd, err := income.ConvertTo("USD", ticker.HistoricalFXRate(nil))
+//
+// # Long Intraday Ranges
+//
+// Yahoo serves at most 7 days of 1m bars (60 days of 5m bars) per request.
+// Set [models.HistoryParams].Chunked to fetch a longer intraday range in
+// windows and stitch them, or use [Ticker.HistoryStream] to process each
+// window as it arrives:
+//
+//	bars, err := t.History(models.HistoryParams{Period: "1mo", Interval: "1m", Chunked: true})
+//
+// # Risk Measures
+//
+// [Ticker.Beta] and [Ticker.RealizedVolatility] are computed from adjusted
+// daily history rather than read from Info, so any benchmark and period can
+// be used:
+//
+//	b, _ := t.Beta("^GSPC", "2y")
+//	vol, _ := t.RealizedVolatility("1y", 21) // with a 21-day rolling series
+//
 // # Caching
 //
 // The Ticker automatically caches API responses to minimize redundant requests.
 // Use [Ticker.ClearCache] to force a refresh of cached data.
 //
+// History is not cached by default, since bars change while the market is
+// open. [WithHistoryCache] keeps completed results for a TTL, keyed by the
+// full parameters, so pipelines that request the same bars repeatedly fetch
+// them once:
+//
+//	t, _ := ticker.New("AAPL", ticker.WithHistoryCache(5*time.Minute))
+//
+// With caching enabled in the config, history requests with a Start date
+// also go through the client's [client.BarCache]: Tickers sharing a client,
+// such as those of the download package, serve overlapping ranges from
+// bars already fetched and request only the missing ranges. A bar still
+// forming is never cached, and a range is fetched whole again when a newly
+// fetched part reveals a dividend or split the cached adjusted closes
+// predate.
+//
+//	config.Get().EnableCache(10 * time.Minute)
+//
+// Exchange timezones and quote types seen in chart or info responses are also
+// shared across Ticker instances through the global [cache], so
+// [Ticker.Timezone] rarely needs its own request. ClearCache does not clear
+// them; use [cache.ClearGlobal].
+//
+// # Cancellation
+//
+// The fetch methods have Context variants that return as soon as the
+// context is done instead of waiting for the client timeout:
+// [Ticker.QuoteContext], [Ticker.HistoryContext],
+// [Ticker.HistoryWithSummaryContext], [Ticker.InfoContext],
+// [Ticker.CalendarContext], [Ticker.OptionsContext],
+// [Ticker.OptionChainWithParamsContext], [Ticker.AllOptionChainsContext],
+// [Ticker.StrikesContext], [Ticker.NewsContext],
+// [Ticker.IncomeStatementContext], [Ticker.BalanceSheetContext],
+// [Ticker.CashFlowContext], [Ticker.RatiosContext],
+// [Ticker.RecommendationsContext], [Ticker.EarningsEstimateContext],
+// [Ticker.EPSTrendContext], [Ticker.EPSRevisionsContext],
+// [Ticker.MajorHoldersContext], [Ticker.InstitutionalHoldersContext],
+// [Ticker.InsiderTransactionsContext], [Ticker.DividendsContext],
+// [Ticker.SplitsContext], [Ticker.ActionsContext] and
+// [Ticker.DividendStreakContext]:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	bars, err := t.HistoryContext(ctx, models.HistoryParams{Period: "1y"})
+//
+// # Raw Responses
+//
+// Create the Ticker with [WithRawResponses] to keep the raw JSON of each
+// quoteSummary module it fetches. Attach the files written by
+// [Ticker.DumpRawResponses] to parsing bug reports:
+//
+//	t, _ := ticker.New("AAPL", ticker.WithRawResponses())
+//	t.Info()
+//	paths, err := t.DumpRawResponses("./yf-debug")
+//
 // # Thread Safety
 //
 // All Ticker methods are safe for concurrent use from multiple goroutines.
@@ -0,0 +1,190 @@
+package ticker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/config"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// maxEarningsDates is the largest page Yahoo returns for earnings dates.
+const maxEarningsDates = 100
+
+// earningsEventTypes maps Yahoo's numeric event types to labels.
+var earningsEventTypes = map[string]string{
+	"1":  "Call",
+	"2":  "Earnings",
+	"11": "Meeting",
+}
+
+// EarningsDates returns up to limit upcoming and past earnings dates,
+// newest first, with EPS estimate, reported EPS and surprise.
+//
+// This is the dated calendar view of Python yfinance's earnings_dates;
+// [Ticker.EarningsHistory] only covers the last four reported quarters.
+// limit defaults to 12 and is capped at 100. Dates are in the exchange
+// timezone (see [Ticker.Timezone]).
+//
+// Example:
+//
+//	dates, err := t.EarningsDates(8)
+//	for _, d := range dates {
+//	    if d.IsReported() {
+//	        fmt.Printf("%s: %.2f vs %.2f\n", d.Date.Format("2006-01-02"), *d.EPSActual, *d.EPSEstimate)
+//	    } else {
+//	        fmt.Printf("%s: upcoming\n", d.Date.Format("2006-01-02"))
+//	    }
+//	}
+func (t *Ticker) EarningsDates(limit int) ([]models.EarningsDate, error) {
+	return t.EarningsDatesContext(context.Background(), limit)
+}
+
+// EarningsDatesContext is like [Ticker.EarningsDates] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) EarningsDatesContext(ctx context.Context, limit int) ([]models.EarningsDate, error) {
+	if limit <= 0 {
+		limit = 12
+	}
+	if limit > maxEarningsDates {
+		limit = maxEarningsDates
+	}
+
+	t.mu.RLock()
+	if cached, ok := t.earningsCache[limit]; ok {
+		t.mu.RUnlock()
+		return cached, nil
+	}
+	t.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"size": limit,
+		"query": map[string]any{
+			"operator": "and",
+			"operands": []any{
+				map[string]any{"operator": "eq", "operands": []any{"ticker", t.symbol}},
+				map[string]any{"operator": "eq", "operands": []any{"eventtype", "2"}},
+			},
+		},
+		"sortField":    "startdatetime",
+		"sortType":     "DESC",
+		"entityIdType": "earnings",
+		"includeFields": []string{
+			"startdatetime", "timeZoneShortName", "epsestimate", "epsactual", "epssurprisepct", "eventtype",
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal earnings dates request: %w", err)
+	}
+
+	params := url.Values{}
+	lang, region := config.Get().GetLocale()
+	params.Set("lang", lang)
+	params.Set("region", region)
+	params, err = t.auth.AddCrumbToParams(params)
+	if err != nil {
+		return nil, yferr.Wrap(yferr.ErrAuth, "failed to get crumb", err)
+	}
+
+	resp, err := t.client.PostJSONIdempotentContext(t.withStats(ctx), endpoints.CalendarURL, params, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch earnings dates: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, client.HTTPStatusToError(resp.StatusCode, resp.Body)
+	}
+
+	// Without a timezone the dates stay in UTC.
+	tz, _ := t.TimezoneContext(ctx)
+	dates, err := parseEarningsDatesResponse(resp.Body, tz)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	if t.earningsCache == nil {
+		t.earningsCache = make(map[int][]models.EarningsDate)
+	}
+	t.earningsCache[limit] = dates
+	t.mu.Unlock()
+
+	return dates, nil
+}
+
+// parseEarningsDatesResponse converts a visualization response to earnings
+// dates in timezone.
+func parseEarningsDatesResponse(body, timezone string) ([]models.EarningsDate, error) {
+	var raw models.CalendarResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		return nil, client.WrapInvalidResponseError(err)
+	}
+	if raw.Finance.Error != nil {
+		return nil, yferr.API("earnings dates", raw.Finance.Error.Code, raw.Finance.Error.Description)
+	}
+	if len(raw.Finance.Result) == 0 || len(raw.Finance.Result[0].Documents) == 0 {
+		return nil, nil
+	}
+
+	doc := raw.Finance.Result[0].Documents[0]
+	col := make(map[string]int, len(doc.Columns))
+	for i, c := range doc.Columns {
+		col[c.Label] = i
+	}
+	cell := func(row []interface{}, label string) interface{} {
+		if i, ok := col[label]; ok && i < len(row) {
+			return row[i]
+		}
+		return nil
+	}
+	float := func(row []interface{}, label string) *float64 {
+		if f, ok := utils.Float(cell(row, label)); ok {
+			return &f
+		}
+		return nil
+	}
+
+	dates := make([]models.EarningsDate, 0, len(doc.Rows))
+	for _, row := range doc.Rows {
+		date, ok := utils.ParseTime(cell(row, "Event Start Date"), timezone)
+		if !ok {
+			continue
+		}
+		dates = append(dates, models.EarningsDate{
+			Date:            date,
+			EventType:       earningsEventType(cell(row, "Event Type")),
+			EPSEstimate:     float(row, "EPS Estimate"),
+			EPSActual:       float(row, "Reported EPS"),
+			SurprisePercent: float(row, "Surprise (%)"),
+		})
+	}
+	return dates, nil
+}
+
+// earningsEventType labels a numeric event type, returning unknown values
+// as text.
+func earningsEventType(v interface{}) string {
+	var s string
+	switch x := v.(type) {
+	case string:
+		s = x
+	case float64:
+		s = strconv.FormatFloat(x, 'f', -1, 64)
+	default:
+		return ""
+	}
+	if label, ok := earningsEventTypes[s]; ok {
+		return label
+	}
+	return s
+}
@@ -0,0 +1,81 @@
+package ticker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEarningsDatesResponse(t *testing.T) {
+	body := `{"finance":{"result":[{"documents":[{
+		"columns":[
+			{"label":"Event Start Date","type":"DATE"},
+			{"label":"Timezone short name","type":"STRING"},
+			{"label":"EPS Estimate","type":"NUMBER"},
+			{"label":"Reported EPS","type":"NUMBER"},
+			{"label":"Surprise (%)","type":"NUMBER"},
+			{"label":"Event Type","type":"STRING"}
+		],
+		"rows":[
+			["2025-01-30T21:30:00Z","EST",2.35,null,null,2],
+			["2024-10-31T20:30:00Z","EDT",1.6,1.64,2.5,"2"],
+			[null,"EDT",1.5,1.53,2,"2"]
+		]}]}],"error":null}}`
+
+	dates, err := parseEarningsDatesResponse(body, "America/New_York")
+	if err != nil {
+		t.Fatalf("parseEarningsDatesResponse() error: %v", err)
+	}
+	if len(dates) != 2 {
+		t.Fatalf("expected 2 dates (row without date skipped), got %d", len(dates))
+	}
+
+	upcoming := dates[0]
+	if upcoming.IsReported() || upcoming.SurprisePercent != nil {
+		t.Errorf("upcoming date should have no actual or surprise: %+v", upcoming)
+	}
+	if upcoming.EPSEstimate == nil || *upcoming.EPSEstimate != 2.35 {
+		t.Errorf("EPSEstimate = %v", upcoming.EPSEstimate)
+	}
+	if upcoming.EventType != "Earnings" {
+		t.Errorf("EventType = %q", upcoming.EventType)
+	}
+	if h, m, _ := upcoming.Date.Clock(); h != 16 || m != 30 || upcoming.Date.Location().String() != "America/New_York" {
+		t.Errorf("Date = %v, want 16:30 New York time", upcoming.Date)
+	}
+
+	past := dates[1]
+	if !past.IsReported() || *past.EPSActual != 1.64 || *past.SurprisePercent != 2.5 {
+		t.Errorf("past date = %+v", past)
+	}
+	if !past.Date.Equal(time.Date(2024, 10, 31, 20, 30, 0, 0, time.UTC)) {
+		t.Errorf("Date = %v", past.Date)
+	}
+}
+
+func TestParseEarningsDatesResponseError(t *testing.T) {
+	body := `{"finance":{"result":null,"error":{"code":"Bad Request","description":"invalid query"}}}`
+	if _, err := parseEarningsDatesResponse(body, ""); err == nil {
+		t.Error("expected API error")
+	}
+
+	dates, err := parseEarningsDatesResponse(`{"finance":{"result":[]}}`, "")
+	if err != nil || len(dates) != 0 {
+		t.Errorf("empty result = %v, %v", dates, err)
+	}
+}
+
+func TestEarningsDatesCancelled(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := tkr.EarningsDatesContext(ctx, 4); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
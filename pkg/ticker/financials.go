@@ -1,6 +1,7 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -9,7 +10,9 @@ import (
 	"time"
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // financialsCache stores cached financial statements.
@@ -29,10 +32,15 @@ type financialsPayloadGetter func(apiURL string, params url.Values) (string, err
 // IncomeStatement returns the income statement data.
 //
 // Parameters:
-//   - freq: "annual", "yearly", or "quarterly" (default: "annual")
+//   - freq: "annual", "yearly", "quarterly", "trailing", or "ttm" (default: "annual")
 //
 // Note: "yearly" is accepted as an alias for "annual" for Python yfinance compatibility.
 //
+// "trailing" returns the trailing twelve month values as Yahoo reports them,
+// which are missing for many fields and symbols. "ttm" instead computes them
+// client-side by summing the latest four quarterly periods, giving a TTM
+// value at every quarter that closes four consecutive reported quarters.
+//
 // The returned [models.FinancialStatement] contains fields like TotalRevenue, GrossProfit,
 // OperatingIncome, NetIncome, EBITDA, BasicEPS, and DilutedEPS.
 //
@@ -43,7 +51,20 @@ type financialsPayloadGetter func(apiURL string, params url.Values) (string, err
 //	    fmt.Printf("Revenue: %.2f\n", revenue)
 //	}
 func (t *Ticker) IncomeStatement(freq string) (*models.FinancialStatement, error) {
+	return t.IncomeStatementContext(context.Background(), freq)
+}
+
+// IncomeStatementContext is like [Ticker.IncomeStatement] but returns ctx's error as soon as
+// ctx is done.
+func (t *Ticker) IncomeStatementContext(ctx context.Context, freq string) (*models.FinancialStatement, error) {
 	freq = normalizeFrequency(freq)
+	if freq == "ttm" {
+		quarterly, err := t.IncomeStatementContext(ctx, "quarterly")
+		if err != nil {
+			return nil, err
+		}
+		return computeTTM(quarterly), nil
+	}
 
 	// Check cache
 	if t.financialsCache != nil {
@@ -55,7 +76,7 @@ func (t *Ticker) IncomeStatement(freq string) (*models.FinancialStatement, error
 		}
 	}
 
-	stmt, err := t.fetchFinancials("income", freq)
+	stmt, err := t.fetchFinancials(ctx, "income", freq)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +109,12 @@ func (t *Ticker) IncomeStatement(freq string) (*models.FinancialStatement, error
 //	    fmt.Printf("Total Assets: %.2f\n", assets)
 //	}
 func (t *Ticker) BalanceSheet(freq string) (*models.FinancialStatement, error) {
+	return t.BalanceSheetContext(context.Background(), freq)
+}
+
+// BalanceSheetContext is like [Ticker.BalanceSheet] but returns ctx's error as soon as
+// ctx is done.
+func (t *Ticker) BalanceSheetContext(ctx context.Context, freq string) (*models.FinancialStatement, error) {
 	freq = normalizeFrequency(freq)
 
 	// Check cache
@@ -100,7 +127,7 @@ func (t *Ticker) BalanceSheet(freq string) (*models.FinancialStatement, error) {
 		}
 	}
 
-	stmt, err := t.fetchFinancials("balance-sheet", freq)
+	stmt, err := t.fetchFinancials(ctx, "balance-sheet", freq)
 	if err != nil {
 		return nil, err
 	}
@@ -119,10 +146,15 @@ func (t *Ticker) BalanceSheet(freq string) (*models.FinancialStatement, error) {
 // CashFlow returns the cash flow statement data.
 //
 // Parameters:
-//   - freq: "annual", "yearly", or "quarterly" (default: "annual")
+//   - freq: "annual", "yearly", "quarterly", "trailing", or "ttm" (default: "annual")
 //
 // Note: "yearly" is accepted as an alias for "annual" for Python yfinance compatibility.
 //
+// "trailing" returns the trailing twelve month values as Yahoo reports them,
+// which are missing for many fields and symbols. "ttm" instead computes them
+// client-side by summing the latest four quarterly periods, giving a TTM
+// value at every quarter that closes four consecutive reported quarters.
+//
 // The returned [models.FinancialStatement] contains fields like OperatingCashFlow,
 // InvestingCashFlow, FinancingCashFlow, FreeCashFlow, and CapitalExpenditure.
 //
@@ -132,8 +164,26 @@ func (t *Ticker) BalanceSheet(freq string) (*models.FinancialStatement, error) {
 //	if fcf, ok := cashFlow.GetLatest("FreeCashFlow"); ok {
 //	    fmt.Printf("Free Cash Flow: %.2f\n", fcf)
 //	}
+//
+//	ttm, err := ticker.CashFlow("ttm")
+//	if fcf, ok := ttm.GetLatest("FreeCashFlow"); ok {
+//	    fmt.Printf("TTM Free Cash Flow: %.2f\n", fcf)
+//	}
 func (t *Ticker) CashFlow(freq string) (*models.FinancialStatement, error) {
+	return t.CashFlowContext(context.Background(), freq)
+}
+
+// CashFlowContext is like [Ticker.CashFlow] but returns ctx's error as soon as
+// ctx is done.
+func (t *Ticker) CashFlowContext(ctx context.Context, freq string) (*models.FinancialStatement, error) {
 	freq = normalizeFrequency(freq)
+	if freq == "ttm" {
+		quarterly, err := t.CashFlowContext(ctx, "quarterly")
+		if err != nil {
+			return nil, err
+		}
+		return computeTTM(quarterly), nil
+	}
 
 	// Check cache
 	if t.financialsCache != nil {
@@ -145,7 +195,7 @@ func (t *Ticker) CashFlow(freq string) (*models.FinancialStatement, error) {
 		}
 	}
 
-	stmt, err := t.fetchFinancials("cash-flow", freq)
+	stmt, err := t.fetchFinancials(ctx, "cash-flow", freq)
 	if err != nil {
 		return nil, err
 	}
@@ -181,9 +231,95 @@ func normalizeFrequency(freq string) string {
 	}
 }
 
+// IncomeStatementWithKeys returns the income statement limited to keys, a
+// subset of [IncomeStatementKeys]. Fewer keys make smaller, faster
+// requests. With no keys it is the same as [Ticker.IncomeStatement].
+// Subset results are not cached.
+//
+// Example:
+//
+//	income, err := t.IncomeStatementWithKeys("quarterly", "TotalRevenue", "NetIncome")
+func (t *Ticker) IncomeStatementWithKeys(freq string, keys ...string) (*models.FinancialStatement, error) {
+	if len(keys) == 0 {
+		return t.IncomeStatement(freq)
+	}
+	freq = normalizeFrequency(freq)
+	if freq == "ttm" {
+		quarterly, err := t.fetchFinancialsSubset("income", "quarterly", keys, t.financialsGetter(context.Background()))
+		if err != nil {
+			return nil, err
+		}
+		return computeTTM(quarterly), nil
+	}
+	return t.fetchFinancialsSubset("income", freq, keys, t.financialsGetter(context.Background()))
+}
+
+// BalanceSheetWithKeys returns the balance sheet limited to keys, a subset
+// of [BalanceSheetKeys]. With no keys it is the same as
+// [Ticker.BalanceSheet]. Subset results are not cached.
+//
+// Example:
+//
+//	balance, err := t.BalanceSheetWithKeys("annual", "TotalAssets", "TotalDebt")
+func (t *Ticker) BalanceSheetWithKeys(freq string, keys ...string) (*models.FinancialStatement, error) {
+	if len(keys) == 0 {
+		return t.BalanceSheet(freq)
+	}
+	return t.fetchFinancialsSubset("balance-sheet", normalizeFrequency(freq), keys, t.financialsGetter(context.Background()))
+}
+
+// CashFlowWithKeys returns the cash flow statement limited to keys, a
+// subset of [CashFlowKeys]. With no keys it is the same as
+// [Ticker.CashFlow]. Subset results are not cached.
+//
+// Example:
+//
+//	cashFlow, err := t.CashFlowWithKeys("annual", "FreeCashFlow")
+func (t *Ticker) CashFlowWithKeys(freq string, keys ...string) (*models.FinancialStatement, error) {
+	if len(keys) == 0 {
+		return t.CashFlow(freq)
+	}
+	freq = normalizeFrequency(freq)
+	if freq == "ttm" {
+		quarterly, err := t.fetchFinancialsSubset("cash-flow", "quarterly", keys, t.financialsGetter(context.Background()))
+		if err != nil {
+			return nil, err
+		}
+		return computeTTM(quarterly), nil
+	}
+	return t.fetchFinancialsSubset("cash-flow", freq, keys, t.financialsGetter(context.Background()))
+}
+
+// IncomeStatementKeys returns the income statement keys requested by
+// [Ticker.IncomeStatement].
+func IncomeStatementKeys() []string {
+	return append([]string(nil), endpoints.IncomeStatementKeys...)
+}
+
+// BalanceSheetKeys returns the balance sheet keys requested by
+// [Ticker.BalanceSheet].
+func BalanceSheetKeys() []string {
+	return append([]string(nil), endpoints.BalanceSheetKeys...)
+}
+
+// CashFlowKeys returns the cash flow keys requested by [Ticker.CashFlow].
+func CashFlowKeys() []string {
+	return append([]string(nil), endpoints.CashFlowKeys...)
+}
+
 // fetchFinancials fetches financial data from the timeseries API.
-func (t *Ticker) fetchFinancials(statementType, freq string) (*models.FinancialStatement, error) {
-	return t.fetchFinancialsWithGetter(statementType, freq, t.fetchFinancialsBody)
+func (t *Ticker) fetchFinancials(ctx context.Context, statementType, freq string) (*models.FinancialStatement, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return t.fetchFinancialsWithGetter(statementType, freq, t.financialsGetter(ctx))
+}
+
+// financialsGetter returns a payload getter whose requests honor ctx.
+func (t *Ticker) financialsGetter(ctx context.Context) financialsPayloadGetter {
+	return func(apiURL string, params url.Values) (string, error) {
+		return t.fetchFinancialsBody(ctx, apiURL, params)
+	}
 }
 
 func (t *Ticker) fetchFinancialsWithGetter(statementType, freq string, getter financialsPayloadGetter) (*models.FinancialStatement, error) {
@@ -191,7 +327,45 @@ func (t *Ticker) fetchFinancialsWithGetter(statementType, freq string, getter fi
 	if err != nil {
 		return nil, err
 	}
+	return t.fetchFinancialsKeys(prefix, keys, getter)
+}
+
+// fetchFinancialsSubset fetches a statement for keys, which must all belong
+// to the statement type.
+func (t *Ticker) fetchFinancialsSubset(statementType, freq string, keys []string, getter financialsPayloadGetter) (*models.FinancialStatement, error) {
+	all, prefix, err := financialKeysAndPrefix(statementType, freq)
+	if err != nil {
+		return nil, err
+	}
+	subset, err := selectFinancialKeys(statementType, all, keys)
+	if err != nil {
+		return nil, err
+	}
+	return t.fetchFinancialsKeys(prefix, subset, getter)
+}
+
+// selectFinancialKeys validates keys against all and drops duplicates.
+func selectFinancialKeys(statementType string, all, keys []string) ([]string, error) {
+	known := make(map[string]bool, len(all))
+	for _, k := range all {
+		known[k] = true
+	}
+
+	seen := make(map[string]bool, len(keys))
+	subset := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if !known[k] {
+			return nil, fmt.Errorf("unknown %s key %q", statementType, k)
+		}
+		if !seen[k] {
+			seen[k] = true
+			subset = append(subset, k)
+		}
+	}
+	return subset, nil
+}
 
+func (t *Ticker) fetchFinancialsKeys(prefix string, keys []string, getter financialsPayloadGetter) (*models.FinancialStatement, error) {
 	apiURL := fmt.Sprintf("%s/%s", endpoints.FundamentalsURL, t.symbol)
 	baseParams, err := t.financialsBaseParams()
 	if err != nil {
@@ -266,7 +440,7 @@ func (t *Ticker) financialsBaseParams() (url.Values, error) {
 	// Add crumb
 	params, err := t.auth.AddCrumbToParams(params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to add crumb: %w", err)
+		return nil, yferr.Wrap(yferr.ErrAuth, "failed to add crumb", err)
 	}
 	return params, nil
 }
@@ -305,14 +479,14 @@ func (t *Ticker) fetchFinancialsChunked(apiURL string, baseParams url.Values, pr
 	return result, nil
 }
 
-func (t *Ticker) fetchFinancialsBody(apiURL string, params url.Values) (string, error) {
-	resp, err := t.client.Get(apiURL, params)
+func (t *Ticker) fetchFinancialsBody(ctx context.Context, apiURL string, params url.Values) (string, error) {
+	resp, err := t.client.GetContext(t.withStats(ctx), apiURL, params)
 	if err != nil {
 		return "", fmt.Errorf("failed to fetch financials: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return "", fmt.Errorf("API error: status %d", resp.StatusCode)
+		return "", client.HTTPStatusToError(resp.StatusCode, resp.Body)
 	}
 
 	return resp.Body, nil
@@ -337,12 +511,12 @@ func cloneURLValues(values url.Values) url.Values {
 func financialsResultItems(body string) ([]interface{}, error) {
 	var rawResp map[string]interface{}
 	if err := json.Unmarshal([]byte(body), &rawResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, yferr.Parse("response", err)
 	}
 
 	timeseries, ok := rawResp["timeseries"].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("invalid response: missing timeseries")
+		return nil, yferr.Parse("response: missing timeseries", nil)
 	}
 
 	if errObj, ok := timeseries["error"]; ok && errObj != nil {
@@ -351,7 +525,7 @@ func financialsResultItems(body string) ([]interface{}, error) {
 
 	result, ok := timeseries["result"].([]interface{})
 	if !ok || len(result) == 0 {
-		return nil, fmt.Errorf("invalid response: missing result")
+		return nil, yferr.Parse("response: missing result", nil)
 	}
 
 	return result, nil
@@ -522,10 +696,10 @@ func (t *Ticker) fetchFinancialsRaw(statementType, freq string) (map[string]inte
 
 	params, err := t.auth.AddCrumbToParams(params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to add crumb: %w", err)
+		return nil, yferr.Wrap(yferr.ErrAuth, "failed to add crumb", err)
 	}
 
-	resp, err := t.client.Get(apiURL, params)
+	resp, err := t.client.GetContext(t.withStats(context.Background()), apiURL, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch financials: %w", err)
 	}
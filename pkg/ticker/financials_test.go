@@ -72,6 +72,100 @@ func TestFinancialStatementMethods(t *testing.T) {
 	}
 }
 
+func TestComputeRatios(t *testing.T) {
+	date1 := time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)
+	date2 := time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	set := func(fs *models.FinancialStatement, field string, v1, v2 float64) {
+		fs.Data[field] = []models.FinancialItem{
+			{AsOfDate: date1, Value: v1},
+			{AsOfDate: date2, Value: v2},
+		}
+	}
+
+	income := models.NewFinancialStatement()
+	income.Dates = []time.Time{date1, date2}
+	set(income, "TotalRevenue", 1000, 2000)
+	set(income, "GrossProfit", 400, 900)
+	set(income, "OperatingIncome", 200, 500)
+	set(income, "NetIncome", 100, 300)
+
+	// A newer income statement whose balance sheet is not out yet
+	date3 := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+	income.Dates = append(income.Dates, date3)
+	income.Data["NetIncome"] = append(income.Data["NetIncome"], models.FinancialItem{AsOfDate: date3, Value: 400})
+
+	balance := models.NewFinancialStatement()
+	balance.Dates = []time.Time{date1, date2}
+	set(balance, "CurrentAssets", 600, 800)
+	set(balance, "CurrentLiabilities", 300, 0) // zero denominator in 2024
+	set(balance, "Inventory", 150, 100)
+	set(balance, "TotalDebt", 500, 750)
+	set(balance, "StockholdersEquity", 1000, 1500)
+	set(balance, "TotalAssets", 2000, 3000)
+
+	ratios := computeRatios(income, balance)
+	if len(ratios.Periods) != 2 {
+		t.Fatalf("Expected 2 periods, got %d", len(ratios.Periods))
+	}
+
+	p := ratios.Periods[0]
+	checks := []struct {
+		name string
+		got  *float64
+		want float64
+	}{
+		{"CurrentRatio", p.CurrentRatio, 2},
+		{"QuickRatio", p.QuickRatio, 1.5},
+		{"DebtToEquity", p.DebtToEquity, 0.5},
+		{"ReturnOnEquity", p.ReturnOnEquity, 0.1},
+		{"ReturnOnAssets", p.ReturnOnAssets, 0.05},
+		{"GrossMargin", p.GrossMargin, 0.4},
+		{"OperatingMargin", p.OperatingMargin, 0.2},
+		{"NetMargin", p.NetMargin, 0.1},
+	}
+	for _, c := range checks {
+		if c.got == nil || *c.got != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, c.got)
+		}
+	}
+
+	latest := ratios.Latest()
+	if !latest.Date.Equal(date2) {
+		t.Errorf("Expected latest period %v, got %v", date2, latest.Date)
+	}
+	if latest.CurrentRatio != nil || latest.QuickRatio != nil {
+		t.Error("Expected nil liquidity ratios for zero current liabilities")
+	}
+
+	info := &models.Info{
+		DebtToEquity:   50, // percent
+		GrossMargins:   0.44,
+		ReturnOnEquity: 0.2,
+	}
+
+	annual := crossCheckRatios(latest, info, "annual")
+	if len(annual) != 3 {
+		t.Fatalf("Expected 3 annual checks, got %d: %+v", len(annual), annual)
+	}
+	for _, c := range annual {
+		switch c.Name {
+		case "debtToEquity":
+			if c.Reported != 0.5 || c.Difference != 0 {
+				t.Errorf("Unexpected debtToEquity check: %+v", c)
+			}
+		case "grossMargin":
+			if c.Computed != 0.45 || c.Reported != 0.44 {
+				t.Errorf("Unexpected grossMargin check: %+v", c)
+			}
+		}
+	}
+
+	if quarterly := crossCheckRatios(latest, info, "quarterly"); len(quarterly) != 1 || quarterly[0].Name != "debtToEquity" {
+		t.Errorf("Expected margins and returns to be skipped for quarterly, got %+v", quarterly)
+	}
+}
+
 func TestFinancialsCacheInitialization(t *testing.T) {
 	tkr, err := New("AAPL")
 	if err != nil {
@@ -130,6 +224,9 @@ func TestFrequencyValues(t *testing.T) {
 	if models.FrequencyTrailing != "trailing" {
 		t.Error("FrequencyTrailing should be 'trailing'")
 	}
+	if models.FrequencyTTM != "ttm" {
+		t.Error("FrequencyTTM should be 'ttm'")
+	}
 }
 
 func TestNormalizeFrequency(t *testing.T) {
@@ -381,3 +478,43 @@ func financialsPayloadForTypes(typeParam string) string {
 //
 // 	t.Logf("Quarterly Income - Dates: %d", len(incomeQ.Dates))
 // }
+
+func TestSelectFinancialKeys(t *testing.T) {
+	subset, err := selectFinancialKeys("income", IncomeStatementKeys(), []string{"NetIncome", "TotalRevenue", "NetIncome"})
+	if err != nil {
+		t.Fatalf("Expected valid subset, got %v", err)
+	}
+	if len(subset) != 2 || subset[0] != "NetIncome" || subset[1] != "TotalRevenue" {
+		t.Errorf("Expected deduplicated subset in order, got %v", subset)
+	}
+
+	if _, err := selectFinancialKeys("income", IncomeStatementKeys(), []string{"TotalAssets"}); err == nil {
+		t.Error("Expected error for balance sheet key in income statement")
+	}
+}
+
+func TestFinancialKeyListsAreCopies(t *testing.T) {
+	keys := CashFlowKeys()
+	keys[0] = "Modified"
+	if CashFlowKeys()[0] == "Modified" {
+		t.Error("CashFlowKeys should return a copy")
+	}
+	if len(IncomeStatementKeys()) == 0 || len(BalanceSheetKeys()) == 0 {
+		t.Error("Expected non-empty key lists")
+	}
+}
+
+func TestFetchFinancialsSubsetUnknownKey(t *testing.T) {
+	tkr, err := New("MSFT")
+	if err != nil {
+		t.Fatalf("Failed to create ticker: %v", err)
+	}
+
+	getter := func(string, url.Values) (string, error) {
+		t.Fatal("Expected no request for an invalid key")
+		return "", nil
+	}
+	if _, err := tkr.fetchFinancialsSubset("balance-sheet", "annual", []string{"Bogus"}, getter); err == nil {
+		t.Error("Expected error for unknown key")
+	}
+}
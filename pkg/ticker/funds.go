@@ -0,0 +1,188 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+)
+
+// fundsModules are the quoteSummary modules behind FundsData.
+var fundsModules = []string{"quoteType", "summaryProfile", "topHoldings", "fundProfile"}
+
+// FundsData returns the profile and portfolio breakdown of an ETF or mutual
+// fund: overview, fees, asset allocation, top holdings, equity and bond
+// statistics, bond ratings and sector weightings. Other quote types return
+// an error matching [client.ErrNoData].
+//
+// Example:
+//
+//	spy, _ := ticker.New("SPY")
+//	fd, err := spy.FundsData()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s, expense ratio %.2f%%\n", fd.Overview.CategoryName, *fd.Operations.ExpenseRatio.Value*100)
+//	for sector, w := range fd.SectorWeightings {
+//	    fmt.Printf("%s: %.1f%%\n", sector, w*100)
+//	}
+func (t *Ticker) FundsData() (*models.FundsData, error) {
+	return t.FundsDataContext(context.Background())
+}
+
+// FundsDataContext is like [Ticker.FundsData] but returns ctx's error as
+// soon as ctx is done.
+func (t *Ticker) FundsDataContext(ctx context.Context) (*models.FundsData, error) {
+	t.mu.RLock()
+	if t.fundsCache != nil {
+		cached := t.fundsCache
+		t.mu.RUnlock()
+		return cached, nil
+	}
+	t.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := t.fetchQuoteSummary(ctx, fundsModules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch funds data: %w", err)
+	}
+
+	fd, err := parseFundsData(t.symbol, data)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	t.fundsCache = fd
+	t.mu.Unlock()
+
+	return fd, nil
+}
+
+// parseFundsData converts the quoteSummary result of fundsModules.
+func parseFundsData(symbol string, data map[string]interface{}) (*models.FundsData, error) {
+	quoteType := ""
+	if qt, ok := data["quoteType"].(map[string]interface{}); ok {
+		quoteType = getString(qt, "quoteType")
+	}
+	if !models.IsFundQuoteType(quoteType) {
+		return nil, fmt.Errorf("%s has quote type %q, not a fund: %w", symbol, quoteType, client.WrapNoDataError(symbol))
+	}
+
+	fd := &models.FundsData{Symbol: symbol, QuoteType: quoteType}
+
+	if profile, ok := data["summaryProfile"].(map[string]interface{}); ok {
+		fd.Description = getString(profile, "longBusinessSummary")
+	}
+
+	if profile, ok := data["fundProfile"].(map[string]interface{}); ok {
+		fd.Overview = models.FundOverview{
+			CategoryName: getString(profile, "categoryName"),
+			Family:       getString(profile, "family"),
+			LegalType:    getString(profile, "legalType"),
+		}
+		fees, _ := profile["feesExpensesInvestment"].(map[string]interface{})
+		feesCat, _ := profile["feesExpensesInvestmentCat"].(map[string]interface{})
+		fd.Operations = models.FundOperations{
+			ExpenseRatio:     fundStat(fees, feesCat, "annualReportExpenseRatio", "annualReportExpenseRatio"),
+			HoldingsTurnover: fundStat(fees, feesCat, "annualHoldingsTurnover", "annualHoldingsTurnover"),
+			TotalNetAssets:   fundStat(fees, feesCat, "totalNetAssets", "totalNetAssets"),
+		}
+	}
+
+	holdings, ok := data["topHoldings"].(map[string]interface{})
+	if !ok {
+		return fd, nil
+	}
+
+	fd.AssetClasses = models.FundAssetClasses{
+		Cash:        getFloat64(holdings, "cashPosition"),
+		Stock:       getFloat64(holdings, "stockPosition"),
+		Bond:        getFloat64(holdings, "bondPosition"),
+		Preferred:   getFloat64(holdings, "preferredPosition"),
+		Convertible: getFloat64(holdings, "convertiblePosition"),
+		Other:       getFloat64(holdings, "otherPosition"),
+	}
+
+	if list, ok := holdings["holdings"].([]interface{}); ok {
+		for _, item := range list {
+			h, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fd.TopHoldings = append(fd.TopHoldings, models.FundHolding{
+				Symbol:  getString(h, "symbol"),
+				Name:    getString(h, "holdingName"),
+				Percent: getFloat64(h, "holdingPercent"),
+			})
+		}
+		sort.SliceStable(fd.TopHoldings, func(i, j int) bool {
+			return fd.TopHoldings[i].Percent > fd.TopHoldings[j].Percent
+		})
+	}
+
+	if eq, ok := holdings["equityHoldings"].(map[string]interface{}); ok {
+		fd.EquityHoldings = models.FundEquityHoldings{
+			PriceToEarnings:         fundStat(eq, eq, "priceToEarnings", "priceToEarningsCat"),
+			PriceToBook:             fundStat(eq, eq, "priceToBook", "priceToBookCat"),
+			PriceToSales:            fundStat(eq, eq, "priceToSales", "priceToSalesCat"),
+			PriceToCashflow:         fundStat(eq, eq, "priceToCashflow", "priceToCashflowCat"),
+			MedianMarketCap:         fundStat(eq, eq, "medianMarketCap", "medianMarketCapCat"),
+			ThreeYearEarningsGrowth: fundStat(eq, eq, "threeYearEarningsGrowth", "threeYearEarningsGrowthCat"),
+		}
+	}
+
+	if bond, ok := holdings["bondHoldings"].(map[string]interface{}); ok {
+		fd.BondHoldings = models.FundBondHoldings{
+			Duration:      fundStat(bond, bond, "duration", "durationCat"),
+			Maturity:      fundStat(bond, bond, "maturity", "maturityCat"),
+			CreditQuality: fundStat(bond, bond, "creditQuality", "creditQualityCat"),
+		}
+	}
+
+	fd.BondRatings = fundWeightings(holdings["bondRatings"])
+	fd.SectorWeightings = fundWeightings(holdings["sectorWeightings"])
+
+	return fd, nil
+}
+
+// fundStat reads a fund value from fund[key] and its category average from
+// category[catKey]. Either map may be nil.
+func fundStat(fund, category map[string]interface{}, key, catKey string) models.FundStat {
+	var s models.FundStat
+	if fund != nil {
+		s.Value = utils.GetFloatPtr(fund, key)
+	}
+	if category != nil {
+		s.CategoryAverage = utils.GetFloatPtr(category, catKey)
+	}
+	return s
+}
+
+// fundWeightings flattens Yahoo's list of single-entry objects, e.g.
+// [{"technology": 0.3}, {"realestate": 0.02}], into one map.
+func fundWeightings(v interface{}) map[string]float64 {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	out := make(map[string]float64, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k := range m {
+			if f, ok := utils.Float(m[k]); ok {
+				out[k] = f
+			}
+		}
+	}
+	return out
+}
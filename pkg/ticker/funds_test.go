@@ -0,0 +1,76 @@
+package ticker
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+)
+
+func TestParseFundsData(t *testing.T) {
+	body := `{
+		"quoteType": {"quoteType": "ETF"},
+		"summaryProfile": {"longBusinessSummary": "Tracks the S&P 500."},
+		"fundProfile": {
+			"categoryName": "Large Blend", "family": "SPDR State Street", "legalType": "Exchange Traded Fund",
+			"feesExpensesInvestment": {"annualReportExpenseRatio": {"raw": 0.0009, "fmt": "0.09%"}, "totalNetAssets": 560000},
+			"feesExpensesInvestmentCat": {"annualReportExpenseRatio": 0.0085}
+		},
+		"topHoldings": {
+			"cashPosition": 0.001, "stockPosition": 0.999,
+			"holdings": [
+				{"symbol": "MSFT", "holdingName": "Microsoft Corp", "holdingPercent": {"raw": 0.07}},
+				{"symbol": "AAPL", "holdingName": "Apple Inc", "holdingPercent": 0.071}
+			],
+			"equityHoldings": {"priceToEarnings": 0.04, "priceToEarningsCat": 0.045, "medianMarketCap": 250000},
+			"bondHoldings": {},
+			"bondRatings": [{"bb": 0}, {"aaa": 0.1}],
+			"sectorWeightings": [{"technology": 0.31}, {"realestate": 0.02}]
+		}
+	}`
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	fd, err := parseFundsData("SPY", data)
+	if err != nil {
+		t.Fatalf("parseFundsData() error: %v", err)
+	}
+
+	if fd.QuoteType != "ETF" || fd.Description != "Tracks the S&P 500." || fd.Overview.CategoryName != "Large Blend" {
+		t.Errorf("profile = %+v", fd)
+	}
+	if er := fd.Operations.ExpenseRatio; er.Value == nil || *er.Value != 0.0009 || er.CategoryAverage == nil || *er.CategoryAverage != 0.0085 {
+		t.Errorf("ExpenseRatio = %+v", er)
+	}
+	if fd.Operations.HoldingsTurnover.Value != nil {
+		t.Errorf("missing turnover should be nil, got %v", *fd.Operations.HoldingsTurnover.Value)
+	}
+	if fd.AssetClasses.Stock != 0.999 || fd.AssetClasses.Cash != 0.001 {
+		t.Errorf("AssetClasses = %+v", fd.AssetClasses)
+	}
+	if len(fd.TopHoldings) != 2 || fd.TopHoldings[0].Symbol != "AAPL" || fd.TopHoldings[1].Percent != 0.07 {
+		t.Errorf("TopHoldings = %+v", fd.TopHoldings)
+	}
+	if pe := fd.EquityHoldings.PriceToEarnings; pe.Value == nil || *pe.Value != 0.04 || *pe.CategoryAverage != 0.045 {
+		t.Errorf("PriceToEarnings = %+v", pe)
+	}
+	if fd.BondHoldings.Duration.Value != nil {
+		t.Error("empty bond holdings should have nil stats")
+	}
+	if fd.SectorWeightings["technology"] != 0.31 || len(fd.SectorWeightings) != 2 {
+		t.Errorf("SectorWeightings = %v", fd.SectorWeightings)
+	}
+	if fd.BondRatings["aaa"] != 0.1 {
+		t.Errorf("BondRatings = %v", fd.BondRatings)
+	}
+}
+
+func TestParseFundsDataNotFund(t *testing.T) {
+	data := map[string]interface{}{"quoteType": map[string]interface{}{"quoteType": "EQUITY"}}
+	if _, err := parseFundsData("AAPL", data); !errors.Is(err, client.ErrNoData) {
+		t.Errorf("expected ErrNoData for an equity, got %v", err)
+	}
+}
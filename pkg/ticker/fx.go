@@ -0,0 +1,338 @@
+package ticker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// fxLookback is how far before the first bar FX rates are fetched, so a
+// bar on a day without an FX quote can carry the previous rate forward.
+const fxLookback = 7 * 24 * time.Hour
+
+// minorCurrencies maps currencies quoted in minor units to their major
+// currency and the factor converting to it.
+var minorCurrencies = map[string]struct {
+	major string
+	scale float64
+}{
+	"GBp": {"GBP", 0.01}, // pence
+	"GBX": {"GBP", 0.01},
+	"ZAc": {"ZAR", 0.01}, // cents
+	"ILA": {"ILS", 0.01}, // agorot
+}
+
+// fxRate is the closing FX rate of one calendar day.
+type fxRate struct {
+	day  string // YYYY-MM-DD
+	rate float64
+}
+
+// convertHistory converts bars priced in meta.Currency into target using
+// daily closes of the FX pair fetched with the ticker's client.
+//
+// The returned route is nil when no FX pair was needed.
+func (t *Ticker) convertHistory(ctx context.Context, bars []models.Bar, meta models.ChartMeta, target string) ([]models.Bar, *models.FXRoute, error) {
+	from, scale := majorCurrency(meta.Currency)
+	to, _ := majorCurrency(target)
+	if from == "" {
+		return nil, nil, fmt.Errorf("cannot convert %s to %s: unknown listing currency", t.symbol, to)
+	}
+
+	loc := utils.LoadLocation(meta.ExchangeTimezoneName)
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	if strings.EqualFold(from, to) || len(bars) == 0 {
+		return applyFXRates(bars, loc, []fxRate{{rate: 1}}, scale), nil, nil
+	}
+
+	fetch := func(pair string, start, end time.Time) ([]fxRate, error) {
+		return t.fetchFXRates(ctx, pair, start, end)
+	}
+	rates, route, err := fetchRouteRates(fetch, from, to, bars[0].Date.Add(-fxLookback), bars[len(bars)-1].Date.Add(24*time.Hour))
+	if err != nil {
+		return nil, nil, err
+	}
+	return applyFXRates(bars, loc, rates, scale), &route, nil
+}
+
+// fetchRouteRates returns the daily rates converting major currency from
+// into to, ordered by day, and the route used. It fetches the direct pair
+// and, when Yahoo has no data for it, triangulates through USD.
+func fetchRouteRates(fetch fxRatesFetcher, from, to string, start, end time.Time) ([]fxRate, models.FXRoute, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	direct := fxPair(from, to)
+	route := models.FXRoute{From: from, To: to, Pairs: []string{direct}}
+
+	rates, err := fetch(direct, start, end)
+	if err == nil && len(rates) > 0 {
+		return rates, route, nil
+	}
+	if err == nil {
+		err = yferr.NoData("no %s rates for the requested period", direct)
+	}
+	if from == "USD" || to == "USD" || !isMissingPair(err) {
+		return nil, route, fmt.Errorf("failed to fetch %s rates: %w", direct, err)
+	}
+
+	route.Pairs = []string{fxPair(from, "USD"), fxPair("USD", to)}
+	legs := make([][]fxRate, len(route.Pairs))
+	for i, pair := range route.Pairs {
+		r, legErr := fetch(pair, start, end)
+		if legErr == nil && len(r) == 0 {
+			legErr = yferr.NoData("no %s rates for the requested period", pair)
+		}
+		if legErr != nil {
+			return nil, route, fmt.Errorf("failed to fetch %s rates (%v), or %s via USD: %w", direct, err, pair, legErr)
+		}
+		legs[i] = r
+	}
+	return crossFXRates(legs[0], legs[1]), route, nil
+}
+
+// isMissingPair reports whether err means Yahoo does not quote the pair,
+// as opposed to a failure worth surfacing such as rate limiting.
+func isMissingPair(err error) bool {
+	return errors.Is(err, yferr.ErrNoData) || errors.Is(err, yferr.ErrParse)
+}
+
+// crossFXRates multiplies two legs of a route day by day. A day quoted by
+// only one leg uses the latest earlier rate of the other, or its first rate
+// before that.
+func crossFXRates(a, b []fxRate) []fxRate {
+	out := make([]fxRate, 0, len(a)+len(b))
+	var i, j int
+	for i < len(a) || j < len(b) {
+		var day string
+		switch {
+		case j == len(b) || (i < len(a) && a[i].day < b[j].day):
+			day = a[i].day
+			i++
+		case i == len(a) || b[j].day < a[i].day:
+			day = b[j].day
+			j++
+		default:
+			day = a[i].day
+			i++
+			j++
+		}
+		out = append(out, fxRate{day: day, rate: a[max(i-1, 0)].rate * b[max(j-1, 0)].rate})
+	}
+	return out
+}
+
+func fxPair(from, to string) string {
+	return from + to + "=X"
+}
+
+// fetchFXRates returns the daily closes of an FX pair, ordered by day.
+func (t *Ticker) fetchFXRates(ctx context.Context, pair string, start, end time.Time) ([]fxRate, error) {
+	return fetchFXPairRates(ctx, t.client, pair, start, end)
+}
+
+// fetchFXPairRates returns the daily closes of an FX pair fetched with c,
+// ordered by day.
+func fetchFXPairRates(ctx context.Context, c *client.Client, pair string, start, end time.Time) ([]fxRate, error) {
+	fx, err := New(pair, WithClient(c))
+	if err != nil {
+		return nil, err
+	}
+	defer fx.Close()
+
+	bars, err := fx.HistoryContext(ctx, models.HistoryParams{Start: &start, End: &end, Interval: "1d"})
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if meta := fx.GetHistoryMetadata(); meta != nil {
+		if l := utils.LoadLocation(meta.ExchangeTimezoneName); l != nil {
+			loc = l
+		}
+	}
+	return fxRatesFromBars(bars, loc), nil
+}
+
+// fxRatesFromBars keys FX closes by their calendar day in loc.
+func fxRatesFromBars(bars []models.Bar, loc *time.Location) []fxRate {
+	rates := make([]fxRate, 0, len(bars))
+	for _, b := range bars {
+		if !isFinitePositive(b.Close) {
+			continue
+		}
+		rates = append(rates, fxRate{day: b.Date.In(loc).Format("2006-01-02"), rate: b.Close})
+	}
+	sort.SliceStable(rates, func(i, j int) bool { return rates[i].day < rates[j].day })
+	return rates
+}
+
+// applyFXRates multiplies the prices of each bar by scale and the rate of
+// the bar's calendar day in loc. Days without a rate use the latest earlier
+// rate, or the first rate for bars before it.
+func applyFXRates(bars []models.Bar, loc *time.Location, rates []fxRate, scale float64) []models.Bar {
+	out := make([]models.Bar, len(bars))
+	for i, b := range bars {
+		day := b.Date.In(loc).Format("2006-01-02")
+		// First rate after day; the one before it applies
+		j := sort.Search(len(rates), func(k int) bool { return rates[k].day > day })
+		if j > 0 {
+			j--
+		}
+		factor := scale * rates[j].rate
+
+		b.Open *= factor
+		b.High *= factor
+		b.Low *= factor
+		b.Close *= factor
+		b.AdjClose *= factor
+		b.AdjOpen *= factor
+		b.AdjHigh *= factor
+		b.AdjLow *= factor
+		b.CapitalGains *= factor
+		if b.DividendCurrency == "" {
+			b.Dividends *= factor
+		}
+		// Exact prices no longer match the converted values
+		b.Decimal = nil
+		out[i] = b
+	}
+	return out
+}
+
+// fxRatesFetcher returns the daily closes of an FX pair between start and end.
+type fxRatesFetcher func(pair string, start, end time.Time) ([]fxRate, error)
+
+// HistoricalFXRate returns a [models.FXRateFunc] backed by the daily closes
+// of Yahoo FX pairs such as "TWDUSD=X", fetched with c. A nil c uses the
+// shared [client.Default] client.
+//
+// The rate of a date is the close of that day, or of the latest earlier day
+// with a quote. Pairs Yahoo does not quote, such as NOKKRW=X, are
+// triangulated through USD. Minor currency units such as GBp are handled.
+// The days each fetch covers are cached, so the function can be reused
+// across statements and concurrent conversions do not wait on each other.
+//
+// Example:
+//
+//	income, _ := t.IncomeStatement("annual")
+//	usd, err := income.ConvertTo("USD", ticker.HistoricalFXRate(nil))
+func HistoricalFXRate(c *client.Client) models.FXRateFunc {
+	return fxRateFunc(func(pair string, start, end time.Time) ([]fxRate, error) {
+		fc := c
+		if fc == nil {
+			var err error
+			if fc, err = client.AcquireDefault(); err != nil {
+				return nil, fmt.Errorf("failed to create client: %w", err)
+			}
+			defer fc.Release()
+		}
+		return fetchFXPairRates(context.Background(), fc, pair, start, end)
+	})
+}
+
+// fxCall is a rate lookup shared by concurrent callers of the same pair
+// and day.
+type fxCall struct {
+	done chan struct{}
+	rate float64
+	err  error
+}
+
+// fxRateFunc looks up rates with fetch, caching the rate of each currency
+// pair and day. Every day a fetch determines is cached, not only the one
+// asked for, and concurrent lookups of the same pair and day share one
+// fetch; the lock is not held while fetching.
+func fxRateFunc(fetch fxRatesFetcher) models.FXRateFunc {
+	var (
+		mu       sync.Mutex
+		cache    = make(map[string]float64) // "pair day" -> rate
+		inflight = make(map[string]*fxCall)
+	)
+	return func(from, to string, date time.Time) (float64, error) {
+		fromMajor, fromScale := majorCurrency(from)
+		toMajor, toScale := majorCurrency(to)
+		if fromMajor == "" || toMajor == "" {
+			return 0, fmt.Errorf("unknown currency %q or %q", from, to)
+		}
+		if fromMajor == toMajor {
+			return fromScale / toScale, nil
+		}
+
+		date = date.UTC()
+		pair := fromMajor + toMajor
+		day := date.Format("2006-01-02")
+		key := pair + " " + day
+
+		mu.Lock()
+		if r, ok := cache[key]; ok {
+			mu.Unlock()
+			return fromScale * r / toScale, nil
+		}
+		if call := inflight[key]; call != nil {
+			mu.Unlock()
+			<-call.done
+			return fromScale * call.rate / toScale, call.err
+		}
+		call := &fxCall{done: make(chan struct{})}
+		inflight[key] = call
+		mu.Unlock()
+
+		rates, route, err := fetchRouteRates(fetch, fromMajor, toMajor, date.Add(-fxLookback), date.Add(24*time.Hour))
+
+		mu.Lock()
+		if err == nil {
+			// The window fixes the rate of each of its days that has a
+			// quote on or before it.
+			for d := 0; d <= int(fxLookback/(24*time.Hour)); d++ {
+				dd := date.AddDate(0, 0, -d).Format("2006-01-02")
+				if r, ok := rateOnOrBefore(rates, dd); ok {
+					cache[pair+" "+dd] = r
+				}
+			}
+			if r, ok := rateOnOrBefore(rates, day); ok {
+				call.rate = r
+			} else {
+				err = yferr.NoData("no %s rate on or before %s", route, day)
+			}
+		}
+		call.err = err
+		delete(inflight, key)
+		mu.Unlock()
+		close(call.done)
+
+		if err != nil {
+			return 0, err
+		}
+		return fromScale * call.rate / toScale, nil
+	}
+}
+
+// rateOnOrBefore returns the rate of the last day of rates, which are
+// ordered by day, that is not after day.
+func rateOnOrBefore(rates []fxRate, day string) (float64, bool) {
+	j := sort.Search(len(rates), func(k int) bool { return rates[k].day > day })
+	if j == 0 {
+		return 0, false
+	}
+	return rates[j-1].rate, true
+}
+
+// majorCurrency returns the major currency of code and the factor that
+// converts amounts in code to it.
+func majorCurrency(code string) (string, float64) {
+	if m, ok := minorCurrencies[code]; ok {
+		return m.major, m.scale
+	}
+	return strings.ToUpper(code), 1
+}
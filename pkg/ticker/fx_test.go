@@ -0,0 +1,186 @@
+package ticker
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+func TestApplyFXRates(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	london, _ := time.LoadLocation("Europe/London")
+
+	// FX bars are stamped at London midnight, 23:00 UTC the day before in summer
+	fx := []models.Bar{
+		{Date: time.Date(2024, 6, 2, 23, 0, 0, 0, time.UTC), Close: 0.5},
+		{Date: time.Date(2024, 6, 4, 23, 0, 0, 0, time.UTC), Close: 0.25},
+		{Date: time.Date(2024, 6, 5, 23, 0, 0, 0, time.UTC), Close: math.NaN()},
+	}
+	rates := fxRatesFromBars(fx, london)
+	if len(rates) != 2 || rates[0].day != "2024-06-03" || rates[1].day != "2024-06-05" {
+		t.Fatalf("rates = %+v", rates)
+	}
+
+	bars := []models.Bar{
+		{Date: time.Date(2024, 5, 31, 13, 30, 0, 0, time.UTC), Close: 100, Dividends: 1},
+		{Date: time.Date(2024, 6, 4, 13, 30, 0, 0, time.UTC), Open: 10, High: 12, Low: 8, Close: 100, Volume: 7},
+		{Date: time.Date(2024, 6, 6, 13, 30, 0, 0, time.UTC), Close: 100, Dividends: 2, DividendCurrency: "GBP"},
+		{Date: time.Date(2024, 6, 7, 13, 30, 0, 0, time.UTC), Close: math.NaN(), Decimal: &models.BarDecimal{}},
+	}
+	got := applyFXRates(bars, ny, rates, 1)
+
+	if got[0].Close != 50 || got[0].Dividends != 0.5 {
+		t.Errorf("bar before first rate should use it: %+v", got[0])
+	}
+	if b := got[1]; b.Open != 5 || b.High != 6 || b.Low != 4 || b.Close != 50 || b.Volume != 7 {
+		t.Errorf("carried-forward rate not applied: %+v", b)
+	}
+	if got[2].Close != 25 || got[2].Dividends != 2 {
+		t.Errorf("dividends in a separate currency should be kept: %+v", got[2])
+	}
+	if !math.IsNaN(got[3].Close) || got[3].Decimal != nil {
+		t.Errorf("missing price should stay NaN and Decimal be dropped: %+v", got[3])
+	}
+	if bars[1].Close != 100 {
+		t.Error("input bars should not be modified")
+	}
+}
+
+func TestMajorCurrency(t *testing.T) {
+	if c, s := majorCurrency("GBp"); c != "GBP" || s != 0.01 {
+		t.Errorf("GBp = %s/%v", c, s)
+	}
+	if c, s := majorCurrency("usd"); c != "USD" || s != 1 {
+		t.Errorf("usd = %s/%v", c, s)
+	}
+}
+
+func TestFXRateFunc(t *testing.T) {
+	calls := 0
+	fetch := func(pair string, start, end time.Time) ([]fxRate, error) {
+		calls++
+		if pair != "TWDUSD=X" {
+			t.Errorf("pair = %q", pair)
+		}
+		return []fxRate{{day: "2024-12-26", rate: 0.0305}, {day: "2024-12-27", rate: 0.0306}}, nil
+	}
+	rate := fxRateFunc(fetch)
+
+	// Dec 31 has no quote; the Dec 27 close applies
+	date := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	r, err := rate("TWD", "USD", date)
+	if err != nil || r != 0.0306 {
+		t.Errorf("rate = %v, %v; want 0.0306", r, err)
+	}
+	if _, err := rate("TWD", "USD", date); err != nil || calls != 1 {
+		t.Errorf("second lookup should be cached, got %d fetches (%v)", calls, err)
+	}
+
+	if r, _ := rate("GBp", "GBP", date); r != 0.01 {
+		t.Errorf("GBp/GBP = %v, want 0.01", r)
+	}
+	if _, err := rate("TWD", "USD", time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error when no rate precedes the date")
+	}
+}
+
+func TestFXRateFuncCachesWindow(t *testing.T) {
+	var calls atomic.Int32
+	started, release := make(chan struct{}, 3), make(chan struct{})
+	fetch := func(pair string, start, end time.Time) ([]fxRate, error) {
+		calls.Add(1)
+		if pair == "EURUSD=X" {
+			started <- struct{}{}
+			<-release
+		}
+		return []fxRate{{day: "2024-12-24", rate: 0.0304}, {day: "2024-12-26", rate: 0.0305}, {day: "2024-12-27", rate: 0.0306}}, nil
+	}
+	rate := fxRateFunc(fetch)
+
+	// A slow fetch of another pair must not block the lookups below
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rate("EUR", "USD", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+		}()
+	}
+	<-started
+
+	if r, err := rate("TWD", "USD", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)); err != nil || r != 0.0306 {
+		t.Fatalf("rate = %v, %v; want 0.0306", r, err)
+	}
+	// Days inside the fetched window come from the cache
+	for day, want := range map[int]float64{24: 0.0304, 25: 0.0304, 26: 0.0305, 30: 0.0306} {
+		if r, err := rate("TWD", "USD", time.Date(2024, 12, day, 0, 0, 0, 0, time.UTC)); err != nil || r != want {
+			t.Errorf("Dec %d rate = %v, %v; want %v", day, r, err, want)
+		}
+	}
+
+	close(release)
+	wg.Wait()
+	// One fetch for TWD/USD and one shared by the three EUR/USD lookups
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected 2 fetches, got %d", n)
+	}
+}
+
+func TestFetchRouteRates(t *testing.T) {
+	quoted := map[string][]fxRate{
+		"GBPUSD=X": {{day: "2024-06-03", rate: 1.25}},
+		"NOKUSD=X": {{day: "2024-06-03", rate: 0.1}, {day: "2024-06-05", rate: 0.2}},
+		"USDKRW=X": {{day: "2024-06-04", rate: 1000}, {day: "2024-06-05", rate: 1100}},
+	}
+	var fetched []string
+	fetch := func(pair string, start, end time.Time) ([]fxRate, error) {
+		fetched = append(fetched, pair)
+		if rates, ok := quoted[pair]; ok {
+			return rates, nil
+		}
+		return nil, yferr.NoData("no data for %s", pair)
+	}
+
+	rates, route, err := fetchRouteRates(fetch, "gbp", "usd", time.Time{}, time.Time{})
+	if err != nil || len(rates) != 1 || !route.Direct() || route.Pairs[0] != "GBPUSD=X" {
+		t.Fatalf("direct: rates %+v, route %+v, err %v", rates, route, err)
+	}
+
+	fetched = nil
+	rates, route, err = fetchRouteRates(fetch, "NOK", "KRW", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if route.String() != "NOK->USD->KRW" || len(fetched) != 3 || fetched[0] != "NOKKRW=X" {
+		t.Errorf("route = %v, fetched %v", route, fetched)
+	}
+	want := []fxRate{{"2024-06-03", 100}, {"2024-06-04", 100}, {"2024-06-05", 220}}
+	if len(rates) != len(want) {
+		t.Fatalf("rates = %+v, want %+v", rates, want)
+	}
+	for i := range want {
+		if rates[i].day != want[i].day || math.Abs(rates[i].rate-want[i].rate) > 1e-9 {
+			t.Errorf("rates[%d] = %+v, want %+v", i, rates[i], want[i])
+		}
+	}
+
+	if _, _, err := fetchRouteRates(fetch, "XYZ", "USD", time.Time{}, time.Time{}); !errors.Is(err, yferr.ErrNoData) {
+		t.Errorf("USD leg missing: err = %v, want ErrNoData", err)
+	}
+
+	limited := func(pair string, start, end time.Time) ([]fxRate, error) {
+		if pair == "NOKKRW=X" {
+			return nil, yferr.ErrRateLimited
+		}
+		return fetch(pair, start, end)
+	}
+	if _, _, err := fetchRouteRates(limited, "NOK", "KRW", time.Time{}, time.Time{}); !errors.Is(err, yferr.ErrRateLimited) {
+		t.Errorf("rate limiting should not triangulate: err = %v", err)
+	}
+}
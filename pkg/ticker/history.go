@@ -1,7 +1,9 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net/url"
@@ -11,6 +13,8 @@ import (
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 	"github.com/wnjoon/go-yfinance/pkg/repair"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // History fetches historical OHLCV data for the ticker.
@@ -23,8 +27,32 @@ import (
 //   - Interval: Data granularity (1m, 2m, 5m, 15m, 30m, 60m, 90m, 1h, 1d, 5d, 1wk, 1mo, 3mo)
 //   - Start/End: Specific date range (overrides Period)
 //   - PrePost: Include pre/post market data
-//   - AutoAdjust: Adjust prices for splits/dividends
+//   - AutoAdjust: Adjust prices for splits/dividends (AdjClose is dropped)
+//   - BackAdjust: Adjust Open/High/Low only, like Python's back_adjust
+//   - AdjustedColumns: Keep raw prices and add AdjOpen/AdjHigh/AdjLow
+//   - Adjust: Any of the above as a [models.AdjustMode], taking precedence
 //   - Actions: Include dividend and split data in bars
+//   - Currency: Convert prices into another currency with daily FX rates
+//   - Repair/RepairOptions: Fix bad data with [repair], as Python yfinance's
+//     history(repair=True); the interval, timezone, currency and quote type
+//     come from the chart metadata, falling back to Info
+//   - Chunked: Fetch "max" daily history by decade, repairing each decade,
+//     or long intraday ranges in windows Yahoo accepts
+//
+// Parameters are checked with [models.HistoryParams.Validate] before the
+// request, e.g. 1m bars are limited to a 7d period.
+//
+// Bars for which Yahoo sends no adjusted close, such as intraday bars,
+// get AdjClose = Close and [models.Bar.AdjCloseFallback] set, so adjustment
+// code can tell them from bars with nothing to adjust.
+//
+// A "max" daily request whose response starts well after the symbol's
+// first trade date is refetched by decade and stitched automatically.
+//
+// Concurrent calls with identical parameters share a single fetch; each
+// caller receives its own copy of the bars. With [WithHistoryCache],
+// completed results are also reused by later identical calls until they
+// expire.
 //
 // Example:
 //
@@ -33,18 +61,276 @@ import (
 //	    Interval: "1d",
 //	})
 func (t *Ticker) History(params models.HistoryParams) ([]models.Bar, error) {
-	params = normalizeHistoryParams(params)
+	return t.HistoryContext(context.Background(), params)
+}
 
-	result, err := t.fetchChartResult(params)
+// HistoryContext is like [Ticker.History] but returns ctx's error as soon
+// as ctx is done. A cancelled chunked fetch sends no further requests.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	bars, err := t.HistoryContext(ctx, models.HistoryParams{Period: "max", Chunked: true})
+//	if errors.Is(err, context.DeadlineExceeded) {
+//	    log.Println("history took longer than 10s")
+//	}
+func (t *Ticker) HistoryContext(ctx context.Context, params models.HistoryParams) ([]models.Bar, error) {
+	h, err := t.history(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	return h.Bars, nil
+}
 
-	// Parse OHLCV data
-	bars, err := t.parseChartData(result, params.AutoAdjust, params.Actions)
+// HistoryWithSummary is like [Ticker.History] but returns the bars in a
+// [models.History] together with the currency, the dividend, split and
+// capital gain events of the period and, when params.Repair is set, a
+// summary of the bars repaired by category. Events come from the same chart
+// response as the bars, so no separate [Ticker.Actions] request is needed,
+// and pipelines can log data quality per symbol without re-running repair
+// detection.
+//
+// Example:
+//
+//	h, err := t.HistoryWithSummary(models.HistoryParams{Period: "5y", Repair: true})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	log.Printf("%s: %d of %d bars repaired %v", h.Symbol, h.Repairs.Repaired, len(h.Bars), h.Repairs.ByCategory)
+//	for _, d := range h.Actions.Dividends {
+//	    fmt.Println(d.Date.Format("2006-01-02"), d.Amount)
+//	}
+func (t *Ticker) HistoryWithSummary(params models.HistoryParams) (*models.History, error) {
+	return t.HistoryWithSummaryContext(context.Background(), params)
+}
+
+// HistoryWithSummaryContext is like [Ticker.HistoryWithSummary] but returns
+// ctx's error as soon as ctx is done.
+func (t *Ticker) HistoryWithSummaryContext(ctx context.Context, params models.HistoryParams) (*models.History, error) {
+	return t.history(ctx, params)
+}
+
+// history fetches the history for params. Concurrent calls with identical
+// params share a single fetch, and later ones may be served from the
+// history cache; each caller receives its own copy.
+func (t *Ticker) history(ctx context.Context, params models.HistoryParams) (*models.History, error) {
+	return t.sharedHistory(ctx, normalizeHistoryParams(params), t.loadHistory)
+}
+
+// historyLoader fetches the history for normalized params.
+type historyLoader func(ctx context.Context, params models.HistoryParams) (*models.History, error)
+
+// historyCall is a history fetch shared by concurrent callers.
+type historyCall struct {
+	done    chan struct{}
+	history *models.History
+	err     error
+}
+
+// historyEntry is a completed history kept by the history cache.
+type historyEntry struct {
+	history *models.History
+	expires time.Time
+}
+
+// sharedHistory runs load for params, or copies the result of the
+// identical load still in the history cache or already in flight.
+//
+// A waiter stops waiting when its own ctx is done. The shared load runs
+// with the ctx of the caller that started it; if that ctx ends the load,
+// waiters whose ctx is still live start a new load instead of inheriting
+// the error.
+func (t *Ticker) sharedHistory(ctx context.Context, params models.HistoryParams, load historyLoader) (*models.History, error) {
+	keyBytes, err := json.Marshal(params)
+	if err != nil {
+		return load(ctx, params)
+	}
+	key := string(keyBytes)
+
+	for {
+		t.mu.Lock()
+		if entry, ok := t.historyCache[key]; ok {
+			if time.Now().Before(entry.expires) {
+				t.mu.Unlock()
+				return cloneHistory(entry.history), nil
+			}
+			delete(t.historyCache, key)
+		}
+		call, ok := t.historyCalls[key]
+		if !ok {
+			call = &historyCall{done: make(chan struct{})}
+			if t.historyCalls == nil {
+				t.historyCalls = make(map[string]*historyCall)
+			}
+			t.historyCalls[key] = call
+			t.mu.Unlock()
+			return t.leadHistory(ctx, key, call, params, load)
+		}
+		t.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if isContextError(call.err) && ctx.Err() == nil {
+			continue
+		}
+		if call.err != nil {
+			return nil, call.err
+		}
+		return cloneHistory(call.history), nil
+	}
+}
+
+// leadHistory runs the shared load of call and publishes its result.
+func (t *Ticker) leadHistory(ctx context.Context, key string, call *historyCall, params models.HistoryParams, load historyLoader) (*models.History, error) {
+	h, err := load(ctx, params)
+
+	// Waiters and cache hits copy the shared result, so the caller keeps
+	// the original
+	var shared *models.History
+	if err == nil {
+		shared = cloneHistory(h)
+	}
+
+	t.mu.Lock()
+	delete(t.historyCalls, key)
+	if err == nil && t.historyTTL > 0 {
+		if t.historyCache == nil {
+			t.historyCache = make(map[string]historyEntry)
+		}
+		t.historyCache[key] = historyEntry{history: shared, expires: time.Now().Add(t.historyTTL)}
+	}
+	t.mu.Unlock()
+
+	call.history = shared
+	call.err = err
+	close(call.done)
+	return h, err
+}
+
+// isContextError reports whether err comes from a cancelled or expired
+// context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// cloneHistory returns a copy of h that shares no slices or maps with it.
+func cloneHistory(h *models.History) *models.History {
+	if h == nil {
+		return nil
+	}
+	c := *h
+	c.Bars = append([]models.Bar(nil), h.Bars...)
+	for i := range c.Bars {
+		if d := c.Bars[i].Decimal; d != nil {
+			dc := *d
+			c.Bars[i].Decimal = &dc
+		}
+	}
+	if h.Actions != nil {
+		actions := models.Actions{
+			Dividends:    append([]models.Dividend(nil), h.Actions.Dividends...),
+			Splits:       append([]models.Split(nil), h.Actions.Splits...),
+			CapitalGains: append([]models.CapitalGain(nil), h.Actions.CapitalGains...),
+		}
+		c.Actions = &actions
+	}
+	if h.Repairs != nil {
+		repairs := models.RepairSummary{Repaired: h.Repairs.Repaired}
+		if h.Repairs.ByCategory != nil {
+			repairs.ByCategory = make(map[models.RepairCategory]int, len(h.Repairs.ByCategory))
+			for cat, n := range h.Repairs.ByCategory {
+				repairs.ByCategory[cat] = n
+			}
+		}
+		c.Repairs = &repairs
+	}
+	return &c
+}
+
+// loadHistory fetches, repairs and adjusts the history for normalized params.
+func (t *Ticker) loadHistory(ctx context.Context, params models.HistoryParams) (*models.History, error) {
+	var (
+		ch  chartHistory
+		err error
+	)
+	switch {
+	case isIntradayChunked(params):
+		if err := params.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid history params: %w", err)
+		}
+		ch, err = t.fetchIntradayChunked(ctx, params)
+	case isMaxDaily(params) && params.Chunked:
+		ch, err = t.fetchHistoryChunked(ctx, params)
+	default:
+		ch, err = t.fetchHistoryBars(ctx, params)
+		if err == nil && isMaxDaily(params) && historyTruncated(ch.meta, ch.bars) {
+			ch, err = t.fetchHistoryChunked(ctx, params)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
+	meta := ch.meta
+	if m := t.GetHistoryMetadata(); m != nil {
+		meta = *m
+	}
+	bars := ch.bars
+
+	// Adjust after repair, as Python yfinance does
+	adjustBars(bars, params)
+
+	h := &models.History{Symbol: t.symbol, Currency: meta.Currency, Actions: &ch.actions}
+	if params.Currency != "" {
+		bars, h.FXRoute, err = t.convertHistory(ctx, bars, meta, params.Currency)
+		if err != nil {
+			return nil, err
+		}
+		h.Currency, _ = majorCurrency(params.Currency)
+	}
+	h.Bars = bars
+	for i := range bars {
+		if bars[i].AdjCloseFallback {
+			h.AdjCloseFallbacks++
+		}
+	}
+	if params.Repair {
+		h.Repairs = &ch.repairs
+	}
+
+	return h, nil
+}
+
+// chartHistory is the parsed content of one or more chart responses.
+type chartHistory struct {
+	bars    []models.Bar
+	meta    models.ChartMeta
+	actions models.Actions
+	repairs models.RepairSummary
+}
+
+// fetchHistoryBars fetches one chart response and returns its parsed,
+// filtered and repaired bars, before adjustment, and its events.
+//
+// Bars of an explicit range are served from the client's bar cache when
+// caching is enabled; see [Ticker.cachedChartBars].
+func (t *Ticker) fetchHistoryBars(ctx context.Context, params models.HistoryParams) (chartHistory, error) {
+	var (
+		ch  chartHistory
+		err error
+	)
+	if bc, key := t.barCache(params); bc != nil {
+		ch, err = t.cachedChartBars(ctx, params, bc, key)
+	} else {
+		ch, err = t.fetchChartBars(ctx, params)
+	}
+	if err != nil {
+		return ch, err
+	}
+	bars := ch.bars
 
 	// Remove NaN rows unless KeepNA is true
 	if !params.KeepNA {
@@ -52,14 +338,57 @@ func (t *Ticker) History(params models.HistoryParams) ([]models.Bar, error) {
 	}
 
 	if params.Repair {
-		repairer := repair.New(repairOptionsFromHistoryParams(t.symbol, params, result.Meta))
-		bars, err = repairer.Repair(bars)
+		repairer := repair.New(repairOptionsFromHistoryParams(t.symbol, params, t.repairMeta(ctx, ch.meta)))
+		bars, ch.repairs, err = repairer.RepairWithSummary(bars)
 		if err != nil {
-			return nil, fmt.Errorf("failed to repair history: %w", err)
+			return ch, fmt.Errorf("failed to repair history: %w", err)
 		}
 	}
 
-	return bars, nil
+	ch.bars = bars
+	return ch, nil
+}
+
+// fetchChartBars fetches one chart response and returns its parsed bars,
+// before filtering and repair, and its events.
+func (t *Ticker) fetchChartBars(ctx context.Context, params models.HistoryParams) (chartHistory, error) {
+	var ch chartHistory
+	result, body, err := t.fetchChart(ctx, params)
+	if err != nil {
+		return ch, err
+	}
+	ch.meta = result.Meta
+	ch.actions = chartEvents(result)
+
+	// Parse OHLCV data
+	bars, err := t.parseChartData(result, params.Actions, params.MissingAsNaN)
+	if err != nil {
+		return ch, err
+	}
+
+	if params.Decimal {
+		if err := attachDecimalPrices(bars, body); err != nil {
+			return ch, err
+		}
+	}
+
+	if params.PrePost && models.IsIntradayInterval(params.Interval) {
+		labelSessions(bars, result.Meta.TradingPeriods)
+	}
+
+	ch.bars = bars
+	return ch, nil
+}
+
+// labelSessions sets the Session of each bar from the chart's trading
+// periods.
+func labelSessions(bars []models.Bar, periods *models.TradingPeriods) {
+	if periods == nil {
+		return
+	}
+	for i := range bars {
+		bars[i].Session = periods.SessionAt(bars[i].Date.Unix())
+	}
 }
 
 func normalizeHistoryParams(params models.HistoryParams) models.HistoryParams {
@@ -69,6 +398,10 @@ func normalizeHistoryParams(params models.HistoryParams) models.HistoryParams {
 	if params.Interval == "" {
 		params.Interval = "1d"
 	}
+	// Daily and longer bars have no pre/post market session
+	if !models.IsIntradayInterval(params.Interval) {
+		params.PrePost = false
+	}
 
 	return params
 }
@@ -130,65 +463,127 @@ func buildHistoryURLParams(params models.HistoryParams) url.Values {
 	return urlParams
 }
 
-func (t *Ticker) fetchChartResult(params models.HistoryParams) (*models.ChartResult, error) {
+func (t *Ticker) fetchChartResult(ctx context.Context, params models.HistoryParams) (*models.ChartResult, error) {
+	result, _, err := t.fetchChart(ctx, params)
+	return result, err
+}
+
+// fetchChart fetches and decodes the chart, also returning the raw body.
+func (t *Ticker) fetchChart(ctx context.Context, params models.HistoryParams) (*models.ChartResult, string, error) {
 	params = normalizeHistoryParams(params)
+	if err := params.Validate(); err != nil {
+		return nil, "", fmt.Errorf("invalid history params: %w", err)
+	}
 	urlParams := buildHistoryURLParams(params)
 
-	resp, err := t.getWithCrumb(t.chartURL(), urlParams)
+	resp, err := t.getWithCrumb(ctx, t.chartURL(), urlParams)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch history: %w", err)
+		return nil, "", fmt.Errorf("failed to fetch history: %w", err)
 	}
 
 	var chartResp models.ChartResponse
 	if err := json.Unmarshal([]byte(resp.Body), &chartResp); err != nil {
-		return nil, client.WrapInvalidResponseError(err)
+		return nil, "", client.WrapInvalidResponseError(err)
 	}
 
 	if chartResp.Chart.Error != nil {
-		return nil, fmt.Errorf("API error: %s", chartResp.Chart.Error.Description)
+		return nil, "", yferr.API("", chartResp.Chart.Error.Code, chartResp.Chart.Error.Description)
 	}
 
 	if len(chartResp.Chart.Result) == 0 {
-		return nil, client.WrapNotFoundError(t.symbol)
+		return nil, "", client.WrapNotFoundError(t.symbol)
 	}
 
 	result := chartResp.Chart.Result[0]
 
 	// Cache metadata
 	t.setHistoryMetadata(&result.Meta)
+	rememberSymbolMeta(t.symbol, result.Meta.ExchangeTimezoneName, result.Meta.InstrumentType)
 
-	return &result, nil
+	return &result, resp.Body, nil
 }
 
-// parseChartData converts chart API response to Bar slice.
-func (t *Ticker) parseChartData(result *models.ChartResult, autoAdjust bool, includeActions bool) ([]models.Bar, error) {
+// attachDecimalPrices re-reads the chart body keeping exact price text and
+// sets Bar.Decimal. bars must be index-aligned with the chart timestamps.
+func attachDecimalPrices(bars []models.Bar, body string) error {
+	var raw models.ChartRawResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		return client.WrapInvalidResponseError(err)
+	}
+	if len(raw.Chart.Result) == 0 || len(raw.Chart.Result[0].Indicators.Quote) == 0 {
+		return nil
+	}
+
+	indicators := raw.Chart.Result[0].Indicators
+	quote := indicators.Quote[0]
+	var adjClose []*json.Number
+	if len(indicators.AdjClose) > 0 {
+		adjClose = indicators.AdjClose[0].AdjClose
+	}
+
+	for i := range bars {
+		dec := &models.BarDecimal{
+			Open:     decimalAt(quote.Open, i),
+			High:     decimalAt(quote.High, i),
+			Low:      decimalAt(quote.Low, i),
+			Close:    decimalAt(quote.Close, i),
+			AdjClose: decimalAt(adjClose, i),
+		}
+		if dec.AdjClose.IsZero() {
+			dec.AdjClose = dec.Close
+		}
+		bars[i].Decimal = dec
+	}
+	return nil
+}
+
+func decimalAt(values []*json.Number, i int) models.Decimal {
+	if i >= len(values) || values[i] == nil {
+		return models.Decimal{}
+	}
+	d, err := models.ParseDecimal(values[i].String())
+	if err != nil {
+		return models.Decimal{}
+	}
+	return d
+}
+
+// parseChartData converts chart API response to unadjusted Bar slice.
+// When missingAsNaN is true, null prices are parsed as NaN instead of 0.
+func (t *Ticker) parseChartData(result *models.ChartResult, includeActions bool, missingAsNaN bool) ([]models.Bar, error) {
 	if len(result.Timestamp) == 0 {
 		return []models.Bar{}, nil
 	}
 
 	if len(result.Indicators.Quote) == 0 {
-		return nil, fmt.Errorf("no quote data in response")
+		return nil, yferr.Parse("response: no quote data", nil)
 	}
 
-	quote := result.Indicators.Quote[0]
+	quote := &result.Indicators.Quote[0]
 	timestamps := result.Timestamp
 
 	adjClose := chartAdjClose(result)
 	actions := chartActions(result, includeActions)
 
+	missing := 0.0
+	if missingAsNaN {
+		missing = math.NaN()
+	}
+
 	bars := make([]models.Bar, 0, len(timestamps))
 
 	for i, ts := range timestamps {
-		bar := chartBarAt(i, ts, quote, adjClose)
-		applyChartActions(&bar, ts, actions)
-		applyAutoAdjust(&bar, autoAdjust)
-		bars = append(bars, bar)
+		bars = append(bars, chartBarAt(i, ts, quote, adjClose, missing))
+		if actions.any {
+			applyChartActions(&bars[i], ts, actions)
+		}
 	}
 
 	return bars, nil
 }
 
 type chartActionMaps struct {
+	any                bool
 	dividends          map[int64]float64
 	dividendCurrencies map[int64]string
 	splits             map[int64]float64
@@ -202,15 +597,18 @@ func chartAdjClose(result *models.ChartResult) []*float64 {
 	return result.Indicators.AdjClose[0].AdjClose
 }
 
+// chartActions indexes the chart events by timestamp. The maps are only
+// allocated when there are events to apply.
 func chartActions(result *models.ChartResult, includeActions bool) chartActionMaps {
+	if !includeActions || result.Events == nil {
+		return chartActionMaps{}
+	}
 	actions := chartActionMaps{
-		dividends:          make(map[int64]float64),
+		any:                true,
+		dividends:          make(map[int64]float64, len(result.Events.Dividends)),
 		dividendCurrencies: make(map[int64]string),
-		splits:             make(map[int64]float64),
-		capitalGains:       make(map[int64]float64),
-	}
-	if !includeActions || result.Events == nil {
-		return actions
+		splits:             make(map[int64]float64, len(result.Events.Splits)),
+		capitalGains:       make(map[int64]float64, len(result.Events.CapitalGains)),
 	}
 
 	for _, div := range result.Events.Dividends {
@@ -230,31 +628,37 @@ func chartActions(result *models.ChartResult, includeActions bool) chartActionMa
 	return actions
 }
 
-func chartBarAt(i int, ts int64, quote models.ChartQuote, adjClose []*float64) models.Bar {
-	bar := models.Bar{Date: time.Unix(ts, 0).UTC()}
-	if i < len(quote.Open) && quote.Open[i] != nil {
-		bar.Open = *quote.Open[i]
-	}
-	if i < len(quote.High) && quote.High[i] != nil {
-		bar.High = *quote.High[i]
-	}
-	if i < len(quote.Low) && quote.Low[i] != nil {
-		bar.Low = *quote.Low[i]
-	}
-	if i < len(quote.Close) && quote.Close[i] != nil {
-		bar.Close = *quote.Close[i]
+// chartBarAt builds the bar at index i. Prices that are absent from the
+// response are set to missing (0 or NaN). Without an adjusted close the
+// bar's Close is used and flagged with AdjCloseFallback.
+func chartBarAt(i int, ts int64, quote *models.ChartQuote, adjClose []*float64, missing float64) models.Bar {
+	bar := models.Bar{
+		Date:     time.Unix(ts, 0).UTC(),
+		Open:     chartValueAt(quote.Open, i, missing),
+		High:     chartValueAt(quote.High, i, missing),
+		Low:      chartValueAt(quote.Low, i, missing),
+		Close:    chartValueAt(quote.Close, i, missing),
+		AdjClose: missing,
 	}
 	if i < len(quote.Volume) && quote.Volume[i] != nil {
 		bar.Volume = *quote.Volume[i]
 	}
-	if adjClose != nil && i < len(adjClose) && adjClose[i] != nil && isFinitePositive(*adjClose[i]) {
-		bar.AdjClose = *adjClose[i]
+	if adj := chartValueAt(adjClose, i, 0); isFinitePositive(adj) {
+		bar.AdjClose = adj
 	} else if isFinitePositive(bar.Close) {
 		bar.AdjClose = bar.Close
+		bar.AdjCloseFallback = true
 	}
 	return bar
 }
 
+func chartValueAt(values []*float64, i int, missing float64) float64 {
+	if i < len(values) && values[i] != nil {
+		return *values[i]
+	}
+	return missing
+}
+
 func applyChartActions(bar *models.Bar, ts int64, actions chartActionMaps) {
 	if div, ok := actions.dividends[ts]; ok {
 		bar.Dividends = div
@@ -270,12 +674,33 @@ func applyChartActions(bar *models.Bar, ts int64, actions chartActionMaps) {
 	}
 }
 
-func applyAutoAdjust(bar *models.Bar, autoAdjust bool) {
-	if !autoAdjust || bar.Close == 0 || bar.AdjClose == 0 {
-		return
+// adjustBars applies the adjustment selected by params (see
+// [models.AdjustMode]) to parsed bars.
+func adjustBars(bars []models.Bar, params models.HistoryParams) {
+	missing := 0.0
+	if params.MissingAsNaN {
+		missing = math.NaN()
+	}
+
+	mode := params.Adjustment()
+	for i := range bars {
+		switch mode {
+		case models.AdjustColumns:
+			applyAdjustedColumns(&bars[i])
+		case models.AdjustAuto:
+			applyAutoAdjust(&bars[i])
+			bars[i].AdjClose = missing
+		case models.AdjustBack:
+			applyBackAdjust(&bars[i])
+			bars[i].AdjClose = missing
+		}
 	}
-	ratio := bar.AdjClose / bar.Close
-	if !isFinitePositive(ratio) {
+}
+
+// applyAutoAdjust scales Open/High/Low/Close by AdjClose/Close.
+func applyAutoAdjust(bar *models.Bar) {
+	ratio, ok := adjustRatio(*bar)
+	if !ok {
 		return
 	}
 	bar.Open *= ratio
@@ -284,6 +709,40 @@ func applyAutoAdjust(bar *models.Bar, autoAdjust bool) {
 	bar.Close = bar.AdjClose
 }
 
+// applyBackAdjust scales Open/High/Low by AdjClose/Close, keeping Close as
+// Python yfinance's back_adjust does.
+func applyBackAdjust(bar *models.Bar) {
+	ratio, ok := adjustRatio(*bar)
+	if !ok {
+		return
+	}
+	bar.Open *= ratio
+	bar.High *= ratio
+	bar.Low *= ratio
+}
+
+// applyAdjustedColumns fills AdjOpen/AdjHigh/AdjLow, leaving the raw prices
+// untouched. Without a usable ratio the raw prices are copied.
+func applyAdjustedColumns(bar *models.Bar) {
+	ratio, ok := adjustRatio(*bar)
+	if !ok {
+		ratio = 1
+	}
+	bar.AdjOpen = bar.Open * ratio
+	bar.AdjHigh = bar.High * ratio
+	bar.AdjLow = bar.Low * ratio
+}
+
+// adjustRatio returns AdjClose/Close, reporting false when it is not a
+// finite positive number.
+func adjustRatio(bar models.Bar) (float64, bool) {
+	if bar.Close == 0 || bar.AdjClose == 0 {
+		return 0, false
+	}
+	ratio := bar.AdjClose / bar.Close
+	return ratio, isFinitePositive(ratio)
+}
+
 func isFinitePositive(value float64) bool {
 	return value > 0 && !math.IsNaN(value) && !math.IsInf(value, 0)
 }
@@ -292,8 +751,8 @@ func isFinitePositive(value float64) bool {
 func filterValidBars(bars []models.Bar) []models.Bar {
 	result := make([]models.Bar, 0, len(bars))
 	for _, bar := range bars {
-		// Skip bars where all OHLC values are zero
-		if bar.Open == 0 && bar.High == 0 && bar.Low == 0 && bar.Close == 0 {
+		// Skip bars where all OHLC values are zero or NaN
+		if bar.Missing() {
 			continue
 		}
 		result = append(result, bar)
@@ -329,69 +788,161 @@ func (t *Ticker) HistoryRange(start, end time.Time, interval string) ([]models.B
 
 // Dividends returns the dividend history for the ticker.
 //
-// Returns all historical dividend payments with dates and amounts.
+// Returns all historical dividend payments with dates and amounts. Use
+// [Ticker.DividendsWithParams] to fetch a shorter window.
 func (t *Ticker) Dividends() ([]models.Dividend, error) {
-	result, err := t.fetchChartResult(models.HistoryParams{
-		Period:   "max",
-		Interval: "1d",
-		Actions:  true,
-	})
+	return t.DividendsContext(context.Background())
+}
+
+// DividendsContext is like [Ticker.Dividends] but returns ctx's error as
+// soon as ctx is done.
+func (t *Ticker) DividendsContext(ctx context.Context) ([]models.Dividend, error) {
+	actions, err := t.ActionsWithParamsContext(ctx, models.ActionsParams{})
 	if err != nil {
 		return nil, err
 	}
+	return actions.Dividends, nil
+}
 
-	return parseDividendEvents(result), nil
+// DividendsWithParams returns the dividends paid within the period or
+// Start/End range of params, oldest first. Dates are in the exchange's
+// time zone.
+//
+// Example:
+//
+//	dividends, err := t.DividendsWithParams(models.ActionsParams{Period: "5y"})
+func (t *Ticker) DividendsWithParams(params models.ActionsParams) ([]models.Dividend, error) {
+	actions, err := t.ActionsWithParamsContext(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	return actions.Dividends, nil
 }
 
 // Splits returns the stock split history for the ticker.
 //
-// Returns all historical stock splits with dates and ratios.
+// Returns all historical stock splits with dates and ratios. Use
+// [Ticker.SplitsWithParams] to fetch a shorter window.
 func (t *Ticker) Splits() ([]models.Split, error) {
-	result, err := t.fetchChartResult(models.HistoryParams{
-		Period:   "max",
-		Interval: "1d",
-		Actions:  true,
-	})
+	return t.SplitsContext(context.Background())
+}
+
+// SplitsContext is like [Ticker.Splits] but returns ctx's error as soon as
+// ctx is done.
+func (t *Ticker) SplitsContext(ctx context.Context) ([]models.Split, error) {
+	actions, err := t.ActionsWithParamsContext(ctx, models.ActionsParams{})
 	if err != nil {
 		return nil, err
 	}
+	return actions.Splits, nil
+}
 
-	return parseSplitEvents(result), nil
+// SplitsWithParams returns the stock splits within the period or
+// Start/End range of params, oldest first. Dates are in the exchange's
+// time zone.
+func (t *Ticker) SplitsWithParams(params models.ActionsParams) ([]models.Split, error) {
+	actions, err := t.ActionsWithParamsContext(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	return actions.Splits, nil
 }
 
-// CapitalGains returns capital gain distributions for the ticker.
+// CapitalGains returns the capital gain distributions of an ETF or mutual
+// fund, oldest first, from the events of the full daily chart. Amounts are
+// per share in the listing currency. Other quote types have none and
+// return an empty series.
+//
+// Example:
+//
+//	gains, err := fund.CapitalGains()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, g := range gains {
+//	    fmt.Println(g.Date.Format("2006-01-02"), g.Amount)
+//	}
 func (t *Ticker) CapitalGains() ([]models.CapitalGain, error) {
-	result, err := t.fetchChartResult(models.HistoryParams{
-		Period:   "max",
-		Interval: "1d",
-		Actions:  true,
-	})
+	return t.CapitalGainsContext(context.Background())
+}
+
+// CapitalGainsContext is like [Ticker.CapitalGains] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) CapitalGainsContext(ctx context.Context) ([]models.CapitalGain, error) {
+	actions, err := t.ActionsWithParamsContext(ctx, models.ActionsParams{})
 	if err != nil {
 		return nil, err
 	}
-
-	return parseCapitalGainEvents(result), nil
+	return actions.CapitalGains, nil
 }
 
 // Actions returns dividends, splits, and capital gains for the ticker.
 //
 // This is a convenience method that combines the action event series into a
-// single response.
+// single response. Use [Ticker.ActionsWithParams] to fetch a shorter window.
 func (t *Ticker) Actions() (*models.Actions, error) {
-	result, err := t.fetchChartResult(models.HistoryParams{
-		Period:   "max",
-		Interval: "1d",
-		Actions:  true,
-	})
+	return t.ActionsContext(context.Background())
+}
+
+// ActionsContext is like [Ticker.Actions] but returns ctx's error as soon
+// as ctx is done.
+func (t *Ticker) ActionsContext(ctx context.Context) (*models.Actions, error) {
+	return t.ActionsWithParamsContext(ctx, models.ActionsParams{})
+}
+
+// ActionsWithParams returns the dividends, splits and capital gains within
+// the period or Start/End range of params. Dates are in the exchange's
+// time zone.
+//
+// Example:
+//
+//	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+//	actions, err := t.ActionsWithParams(models.ActionsParams{
+//	    Start:    &start,
+//	    Interval: "3mo",
+//	})
+func (t *Ticker) ActionsWithParams(params models.ActionsParams) (*models.Actions, error) {
+	return t.ActionsWithParamsContext(context.Background(), params)
+}
+
+// ActionsWithParamsContext is like [Ticker.ActionsWithParams] but returns
+// ctx's error as soon as ctx is done.
+func (t *Ticker) ActionsWithParamsContext(ctx context.Context, params models.ActionsParams) (*models.Actions, error) {
+	result, err := t.fetchChartResult(ctx, params.HistoryParams())
 	if err != nil {
 		return nil, err
 	}
 
-	return &models.Actions{
+	actions := chartEvents(result)
+	localizeActions(&actions, result.Meta.ExchangeTimezoneName)
+	return &actions, nil
+}
+
+// localizeActions moves the event dates into the named time zone, leaving
+// them in UTC if it is unknown.
+func localizeActions(actions *models.Actions, timezone string) {
+	loc := utils.LoadLocation(timezone)
+	if loc == nil {
+		return
+	}
+	for i := range actions.Dividends {
+		actions.Dividends[i].Date = actions.Dividends[i].Date.In(loc)
+	}
+	for i := range actions.Splits {
+		actions.Splits[i].Date = actions.Splits[i].Date.In(loc)
+	}
+	for i := range actions.CapitalGains {
+		actions.CapitalGains[i].Date = actions.CapitalGains[i].Date.In(loc)
+	}
+}
+
+// chartEvents parses all action events of a chart response.
+func chartEvents(result *models.ChartResult) models.Actions {
+	return models.Actions{
 		Dividends:    parseDividendEvents(result),
 		Splits:       parseSplitEvents(result),
 		CapitalGains: parseCapitalGainEvents(result),
-	}, nil
+	}
 }
 
 func parseDividendEvents(result *models.ChartResult) []models.Dividend {
@@ -0,0 +1,194 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// historyChunkYears is the span of each request of a chunked "max" fetch.
+const historyChunkYears = 10
+
+// truncationSlack is how long after the first trade date the first bar of
+// a complete "max" response may start.
+const truncationSlack = 31 * 24 * time.Hour
+
+// lookbackMargin keeps the first intraday chunk inside Yahoo's lookback
+// when it is validated a moment after the chunks are planned.
+const lookbackMargin = time.Minute
+
+// historyChunk is the [start, end) window of one chunked request.
+type historyChunk struct {
+	start, end time.Time
+}
+
+// isMaxDaily reports whether params request the full daily history.
+func isMaxDaily(params models.HistoryParams) bool {
+	return params.Period == "max" && params.Start == nil && params.End == nil && params.Interval == "1d"
+}
+
+// historyTruncated reports whether a "max" response starts well after the
+// symbol's first trade date.
+func historyTruncated(meta models.ChartMeta, bars []models.Bar) bool {
+	if meta.FirstTradeDate == 0 || len(bars) == 0 {
+		return false
+	}
+	first := time.Unix(meta.FirstTradeDate, 0)
+	return bars[0].Date.Sub(first) > truncationSlack
+}
+
+// fetchHistoryChunked fetches the full daily history in decade-long
+// requests and stitches them, repairing each chunk on its own.
+func (t *Ticker) fetchHistoryChunked(ctx context.Context, params models.HistoryParams) (chartHistory, error) {
+	// A short request is enough to learn the first trade date
+	probe := params
+	probe.Period = "5d"
+	result, err := t.fetchChartResult(ctx, probe)
+	if err != nil {
+		return chartHistory{}, err
+	}
+	if result.Meta.FirstTradeDate == 0 {
+		return t.fetchHistoryBars(ctx, params)
+	}
+
+	ch := chartHistory{meta: result.Meta}
+	for _, c := range historyChunks(time.Unix(result.Meta.FirstTradeDate, 0), time.Now()) {
+		chunk := params
+		chunk.Period = ""
+		chunk.Start, chunk.End = &c.start, &c.end
+
+		part, err := t.fetchHistoryBars(ctx, chunk)
+		if err != nil {
+			return chartHistory{}, fmt.Errorf("failed to fetch history from %s: %w", c.start.Format("2006-01-02"), err)
+		}
+		ch.bars = models.MergeBars(ch.bars, part.bars, models.PreferNew)
+		ch.actions = mergeActions(ch.actions, part.actions)
+		ch.repairs.Add(part.repairs)
+	}
+	return ch, nil
+}
+
+// mergeActions appends the events of b to a, skipping events of a kind
+// already present in a on the same date, e.g. one reported by two adjacent
+// chunks.
+func mergeActions(a, b models.Actions) models.Actions {
+	dividends := make(map[int64]bool, len(a.Dividends))
+	for _, d := range a.Dividends {
+		dividends[d.Date.Unix()] = true
+	}
+	for _, d := range b.Dividends {
+		if !dividends[d.Date.Unix()] {
+			a.Dividends = append(a.Dividends, d)
+		}
+	}
+
+	splits := make(map[int64]bool, len(a.Splits))
+	for _, s := range a.Splits {
+		splits[s.Date.Unix()] = true
+	}
+	for _, s := range b.Splits {
+		if !splits[s.Date.Unix()] {
+			a.Splits = append(a.Splits, s)
+		}
+	}
+
+	gains := make(map[int64]bool, len(a.CapitalGains))
+	for _, g := range a.CapitalGains {
+		gains[g.Date.Unix()] = true
+	}
+	for _, g := range b.CapitalGains {
+		if !gains[g.Date.Unix()] {
+			a.CapitalGains = append(a.CapitalGains, g)
+		}
+	}
+	return a
+}
+
+// historyChunks splits [first, now] into consecutive windows of
+// historyChunkYears, the last one ending a day after now.
+func historyChunks(first, now time.Time) []historyChunk {
+	end := now.Add(24 * time.Hour)
+	var chunks []historyChunk
+	for start := first; start.Before(end); {
+		next := start.AddDate(historyChunkYears, 0, 0)
+		if next.After(end) {
+			next = end
+		}
+		chunks = append(chunks, historyChunk{start: start, end: next})
+		start = next
+	}
+	return chunks
+}
+
+// isIntradayChunked reports whether params request chunked intraday data
+// that needs more than one request.
+func isIntradayChunked(params models.HistoryParams) bool {
+	if !params.Chunked {
+		return false
+	}
+	chunks, err := intradayChunks(params, time.Now())
+	return err == nil && len(chunks) > 1
+}
+
+// intradayChunks splits the range of intraday params into windows Yahoo
+// serves in one request, oldest first. A Period start older than Yahoo's
+// lookback is moved forward. Other intervals return no chunks.
+func intradayChunks(params models.HistoryParams, now time.Time) ([]historyChunk, error) {
+	window, lookback, ok := models.IntradayLimits(params.Interval)
+	if !ok {
+		return nil, nil
+	}
+	start, end, err := params.TimeRange(now)
+	if err != nil {
+		return nil, err
+	}
+	if params.Start == nil {
+		if oldest := now.Add(-lookback + lookbackMargin); start.Before(oldest) {
+			start = oldest
+		}
+	}
+
+	var chunks []historyChunk
+	for start.Before(end) {
+		next := start.Add(window)
+		if next.After(end) {
+			next = end
+		}
+		chunks = append(chunks, historyChunk{start: start, end: next})
+		start = next
+	}
+	return chunks, nil
+}
+
+// chunkParams returns params restricted to the window of c.
+func chunkParams(params models.HistoryParams, c historyChunk) models.HistoryParams {
+	params.Period = ""
+	params.Start, params.End = &c.start, &c.end
+	return params
+}
+
+// fetchIntradayChunked fetches a long intraday range window by window and
+// stitches the windows.
+func (t *Ticker) fetchIntradayChunked(ctx context.Context, params models.HistoryParams) (chartHistory, error) {
+	chunks, err := intradayChunks(params, time.Now())
+	if err != nil {
+		return chartHistory{}, fmt.Errorf("invalid history params: %w", err)
+	}
+
+	var ch chartHistory
+	for i, c := range chunks {
+		part, err := t.fetchHistoryBars(ctx, chunkParams(params, c))
+		if err != nil {
+			return chartHistory{}, fmt.Errorf("failed to fetch history from %s: %w", c.start.Format(time.RFC3339), err)
+		}
+		if i == 0 {
+			ch.meta = part.meta
+		}
+		ch.bars = models.MergeBars(ch.bars, part.bars, models.PreferNew)
+		ch.actions = mergeActions(ch.actions, part.actions)
+		ch.repairs.Add(part.repairs)
+	}
+	return ch, nil
+}
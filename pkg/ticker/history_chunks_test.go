@@ -0,0 +1,155 @@
+package ticker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestHistoryChunks(t *testing.T) {
+	first := time.Date(1962, 1, 2, 14, 30, 0, 0, time.UTC)
+	now := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	chunks := historyChunks(first, now)
+	if len(chunks) != 7 {
+		t.Fatalf("chunks = %d, want 7", len(chunks))
+	}
+	if !chunks[0].start.Equal(first) || !chunks[0].end.Equal(first.AddDate(10, 0, 0)) {
+		t.Errorf("first chunk = %+v", chunks[0])
+	}
+	for i := 1; i < len(chunks); i++ {
+		if !chunks[i].start.Equal(chunks[i-1].end) {
+			t.Errorf("chunk %d does not continue the previous one", i)
+		}
+	}
+	if last := chunks[len(chunks)-1]; !last.end.Equal(now.Add(24 * time.Hour)) {
+		t.Errorf("last chunk ends %s", last.end)
+	}
+
+	if got := historyChunks(now.AddDate(-1, 0, 0), now); len(got) != 1 {
+		t.Errorf("young symbol chunks = %d, want 1", len(got))
+	}
+}
+
+func TestIntradayChunks(t *testing.T) {
+	now := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	chunks, err := intradayChunks(models.HistoryParams{Period: "1mo", Interval: "1m", Chunked: true}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chunks) != 5 {
+		t.Fatalf("chunks = %d, want 5", len(chunks))
+	}
+	if want := now.Add(-30*24*time.Hour + lookbackMargin); !chunks[0].start.Equal(want) {
+		t.Errorf("first chunk starts %s, want %s", chunks[0].start, want)
+	}
+	for i, c := range chunks {
+		if c.end.Sub(c.start) > 7*24*time.Hour {
+			t.Errorf("chunk %d spans %s", i, c.end.Sub(c.start))
+		}
+		if i > 0 && !c.start.Equal(chunks[i-1].end) {
+			t.Errorf("chunk %d does not continue the previous one", i)
+		}
+	}
+	if last := chunks[len(chunks)-1]; !last.end.Equal(now) {
+		t.Errorf("last chunk ends %s", last.end)
+	}
+
+	if got, _ := intradayChunks(models.HistoryParams{Period: "1y", Interval: "1d"}, now); got != nil {
+		t.Errorf("daily chunks = %v, want none", got)
+	}
+}
+
+func TestStreamHistory(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.UTC) }
+	chunks := []historyChunk{{day(1), day(3)}, {day(3), day(5)}, {day(5), day(7)}}
+
+	load := func(ctx context.Context, p models.HistoryParams) (*models.History, error) {
+		switch {
+		case p.Start.Equal(day(1)):
+			return &models.History{Bars: []models.Bar{{Date: day(1)}, {Date: day(2)}, {Date: day(3)}}}, nil
+		case p.Start.Equal(day(3)):
+			return &models.History{Bars: []models.Bar{{Date: day(3)}, {Date: day(4)}}}, nil
+		}
+		return nil, errors.New("boom")
+	}
+
+	out := make(chan models.HistoryChunk, historyStreamBuffer)
+	streamHistory(context.Background(), models.HistoryParams{Interval: "1m"}, chunks, load, out)
+
+	var got []models.HistoryChunk
+	for c := range out {
+		got = append(got, c)
+	}
+	if len(got) != 3 {
+		t.Fatalf("chunks = %d, want 3", len(got))
+	}
+	if len(got[0].Bars) != 3 || !got[0].Start.Equal(day(1)) {
+		t.Errorf("first chunk = %+v", got[0])
+	}
+	if len(got[1].Bars) != 1 || !got[1].Bars[0].Date.Equal(day(4)) {
+		t.Errorf("second chunk bars = %+v, want only day 4", got[1].Bars)
+	}
+	if got[2].Err == nil || len(got[2].Bars) != 0 {
+		t.Errorf("last chunk = %+v, want error", got[2])
+	}
+}
+
+func TestHistoryTruncated(t *testing.T) {
+	first := time.Date(1962, 1, 2, 0, 0, 0, 0, time.UTC)
+	meta := models.ChartMeta{FirstTradeDate: first.Unix()}
+
+	if !historyTruncated(meta, []models.Bar{{Date: time.Date(1985, 1, 2, 0, 0, 0, 0, time.UTC)}}) {
+		t.Error("response starting decades late should be truncated")
+	}
+	if historyTruncated(meta, []models.Bar{{Date: first.AddDate(0, 0, 3)}}) {
+		t.Error("response starting at the first trade date is complete")
+	}
+	if historyTruncated(models.ChartMeta{}, []models.Bar{{Date: first}}) {
+		t.Error("unknown first trade date should not count as truncated")
+	}
+}
+
+func TestIsMaxDaily(t *testing.T) {
+	start := time.Now()
+	tests := []struct {
+		params models.HistoryParams
+		want   bool
+	}{
+		{models.HistoryParams{Period: "max", Interval: "1d"}, true},
+		{models.HistoryParams{Period: "max", Interval: "1wk"}, false},
+		{models.HistoryParams{Period: "10y", Interval: "1d"}, false},
+		{models.HistoryParams{Period: "max", Interval: "1d", Start: &start}, false},
+	}
+	for _, tt := range tests {
+		if got := isMaxDaily(tt.params); got != tt.want {
+			t.Errorf("isMaxDaily(%+v) = %v, want %v", tt.params, got, tt.want)
+		}
+	}
+}
+
+func TestMergeActions(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC) }
+	a := models.Actions{
+		Dividends: []models.Dividend{{Date: day(1), Amount: 0.5}},
+		Splits:    []models.Split{{Date: day(2), Numerator: 2, Denominator: 1}},
+	}
+	b := models.Actions{
+		Dividends:    []models.Dividend{{Date: day(1), Amount: 0.5}, {Date: day(10), Amount: 0.6}},
+		Splits:       []models.Split{{Date: day(1), Numerator: 3, Denominator: 1}},
+		CapitalGains: []models.CapitalGain{{Date: day(1), Amount: 1}},
+	}
+
+	got := mergeActions(a, b)
+	if len(got.Dividends) != 2 || got.Dividends[1].Amount != 0.6 {
+		t.Errorf("dividends = %+v, want boundary duplicate dropped", got.Dividends)
+	}
+	// Same date, different kind: kept
+	if len(got.Splits) != 2 || len(got.CapitalGains) != 1 {
+		t.Errorf("splits = %+v, gains = %+v", got.Splits, got.CapitalGains)
+	}
+}
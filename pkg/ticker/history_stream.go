@@ -0,0 +1,104 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// historyStreamBuffer is the capacity of the channel returned by HistoryStream.
+const historyStreamBuffer = 4
+
+// HistoryStream fetches the history for params one request window at a
+// time and sends each window's bars on the returned channel as soon as it
+// arrives, so long intraday ranges can be processed without holding every
+// bar in memory.
+//
+// Intraday ranges are split as with Chunked set (see
+// [models.IntradayLimits]); other requests are sent as a single chunk.
+// Bars repeated at window boundaries are sent only once. A failed window
+// is sent as a final chunk with Err set. The channel is closed after the
+// last chunk or when ctx is done.
+//
+// Example:
+//
+//	start := time.Now().AddDate(0, 0, -25)
+//	chunks, err := t.HistoryStream(ctx, models.HistoryParams{Start: &start, Interval: "1m"})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for c := range chunks {
+//	    if c.Err != nil {
+//	        log.Fatal(c.Err)
+//	    }
+//	    fmt.Println(c.Start.Format(time.RFC3339), len(c.Bars))
+//	}
+func (t *Ticker) HistoryStream(ctx context.Context, params models.HistoryParams) (<-chan models.HistoryChunk, error) {
+	params = normalizeHistoryParams(params)
+	if models.IsIntradayInterval(params.Interval) {
+		params.Chunked = true
+	}
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid history params: %w", err)
+	}
+	chunks, err := intradayChunks(params, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid history params: %w", err)
+	}
+
+	out := make(chan models.HistoryChunk, historyStreamBuffer)
+	go streamHistory(ctx, params, chunks, t.history, out)
+	return out, nil
+}
+
+// streamHistory loads each chunk of params in turn and sends its new bars
+// on out, which it closes when done. Without chunks, params are loaded as
+// a single chunk.
+func streamHistory(ctx context.Context, params models.HistoryParams, chunks []historyChunk, load historyLoader, out chan<- models.HistoryChunk) {
+	defer close(out)
+
+	windows := make([]models.HistoryParams, 0, len(chunks))
+	for _, c := range chunks {
+		windows = append(windows, chunkParams(params, c))
+	}
+	if len(chunks) == 0 {
+		params.Chunked = false
+		windows = append(windows, params)
+	}
+
+	var last time.Time
+	for i, w := range windows {
+		chunk := models.HistoryChunk{}
+		if i < len(chunks) {
+			chunk.Start, chunk.End = chunks[i].start, chunks[i].end
+		}
+
+		h, err := load(ctx, w)
+		if err != nil {
+			chunk.Err = err
+		} else {
+			for _, b := range h.Bars {
+				if last.IsZero() || b.Date.After(last) {
+					chunk.Bars = append(chunk.Bars, b)
+				}
+			}
+			if n := len(chunk.Bars); n > 0 {
+				last = chunk.Bars[n-1].Date
+				if chunk.Start.IsZero() {
+					chunk.Start, chunk.End = chunk.Bars[0].Date, last
+				}
+			}
+		}
+
+		select {
+		case out <- chunk:
+		case <-ctx.Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -1,10 +1,20 @@
 package ticker
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
@@ -34,6 +44,49 @@ func TestParseDividendEventsPreservesEventRows(t *testing.T) {
 	}
 }
 
+func TestChartEvents(t *testing.T) {
+	result := &models.ChartResult{
+		Events: &models.ChartEvents{
+			Dividends: map[string]models.DividendEvent{
+				"1704153600": {Date: 1704153600, Amount: 0.24},
+			},
+			Splits: map[string]models.SplitEvent{
+				"1704067200": {Date: 1704067200, Numerator: 4, Denominator: 1},
+			},
+		},
+	}
+
+	actions := chartEvents(result)
+	if len(actions.Dividends) != 1 || len(actions.Splits) != 1 || len(actions.CapitalGains) != 0 {
+		t.Errorf("unexpected actions %+v", actions)
+	}
+	if empty := chartEvents(&models.ChartResult{}); empty.Dividends != nil || empty.Splits != nil {
+		t.Errorf("expected no events, got %+v", empty)
+	}
+}
+
+func TestLocalizeActions(t *testing.T) {
+	// 2024-01-01 20:00 UTC is already Jan 2 in Tokyo.
+	actions := models.Actions{
+		Dividends: []models.Dividend{{Date: time.Unix(1704139200, 0).UTC(), Amount: 1}},
+		Splits:    []models.Split{{Date: time.Unix(1704139200, 0).UTC(), Numerator: 2, Denominator: 1}},
+	}
+
+	localizeActions(&actions, "Asia/Tokyo")
+	d := actions.Dividends[0].Date
+	if d.Location().String() != "Asia/Tokyo" || d.Day() != 2 || !d.Equal(time.Unix(1704139200, 0)) {
+		t.Errorf("expected Jan 2 in Asia/Tokyo, got %s", d)
+	}
+	if actions.Splits[0].Date.Location().String() != "Asia/Tokyo" {
+		t.Errorf("expected split localized, got %s", actions.Splits[0].Date)
+	}
+
+	localizeActions(&actions, "Not/AZone")
+	if actions.Dividends[0].Date.Location().String() != "Asia/Tokyo" {
+		t.Errorf("expected unknown zone to leave dates unchanged, got %s", actions.Dividends[0].Date)
+	}
+}
+
 func TestParseSplitEvents(t *testing.T) {
 	result := &models.ChartResult{
 		Events: &models.ChartEvents{
@@ -82,25 +135,147 @@ func TestParseCapitalGainEvents(t *testing.T) {
 func TestChartBarAtReplacesInfiniteAdjClose(t *testing.T) {
 	close := 100.0
 	adjClose := math.Inf(1)
-	quote := models.ChartQuote{Close: []*float64{&close}}
+	quote := &models.ChartQuote{Close: []*float64{&close}}
 
-	bar := chartBarAt(0, 1704067200, quote, []*float64{&adjClose})
+	bar := chartBarAt(0, 1704067200, quote, []*float64{&adjClose}, 0)
 
 	if bar.AdjClose != close {
 		t.Fatalf("Expected infinite AdjClose to fall back to Close %.2f, got %v", close, bar.AdjClose)
 	}
+	if !bar.AdjCloseFallback {
+		t.Error("Expected fallback AdjClose to be flagged")
+	}
+
+	bar = chartBarAt(0, 1704067200, quote, []*float64{ptrFloat64(99)}, 0)
+	if bar.AdjClose != 99 || bar.AdjCloseFallback {
+		t.Errorf("Expected reported AdjClose without fallback flag, got %+v", bar)
+	}
+
+	bar = chartBarAt(0, 1704067200, &models.ChartQuote{Close: []*float64{nil}}, nil, math.NaN())
+	if bar.AdjCloseFallback {
+		t.Error("Expected no fallback flag without a Close to copy")
+	}
+}
+
+func TestChartBarAtMissingAsNaN(t *testing.T) {
+	close := 100.0
+	quote := &models.ChartQuote{
+		Open:  []*float64{nil},
+		Close: []*float64{&close},
+	}
+
+	bar := chartBarAt(0, 1704067200, quote, nil, math.NaN())
+	if !math.IsNaN(bar.Open) || !math.IsNaN(bar.High) || !math.IsNaN(bar.Low) {
+		t.Fatalf("Expected missing prices to be NaN, got %+v", bar)
+	}
+	if bar.Close != close || bar.AdjClose != close {
+		t.Fatalf("Expected present Close/AdjClose to be kept, got %+v", bar)
+	}
+
+	bar = chartBarAt(0, 1704067200, quote, nil, 0)
+	if bar.Open != 0 {
+		t.Fatalf("Expected missing Open to default to 0, got %v", bar.Open)
+	}
+}
+
+func TestFilterValidBarsDropsNaN(t *testing.T) {
+	nan := math.NaN()
+	bars := []models.Bar{
+		{Open: nan, High: nan, Low: nan, Close: nan},
+		{Open: 1, High: 1, Low: 1, Close: 1},
+		{},
+	}
+	if got := filterValidBars(bars); len(got) != 1 {
+		t.Fatalf("Expected one valid bar, got %d", len(got))
+	}
+}
+
+func TestAttachDecimalPrices(t *testing.T) {
+	body := `{"chart":{"result":[{"indicators":{
+		"quote":[{"open":[187.44000244140625,null],"high":[190.1,1],"low":[185,1],"close":[189.5,1]}],
+		"adjclose":[{"adjclose":[189.25,null]}]}}]}}`
+	bars := make([]models.Bar, 2)
+
+	if err := attachDecimalPrices(bars, body); err != nil {
+		t.Fatalf("attachDecimalPrices() error: %v", err)
+	}
+	if bars[0].Decimal == nil {
+		t.Fatal("Expected decimal prices to be attached")
+	}
+	if got := bars[0].Decimal.Open.String(); got != "187.44000244140625" {
+		t.Errorf("Expected exact open text, got %s", got)
+	}
+	if got := bars[0].Decimal.AdjClose.String(); got != "189.25" {
+		t.Errorf("Expected adjclose 189.25, got %s", got)
+	}
+	if !bars[1].Decimal.Open.IsZero() {
+		t.Errorf("Expected null open to be zero, got %s", bars[1].Decimal.Open)
+	}
+	if got := bars[1].Decimal.AdjClose.String(); got != "1" {
+		t.Errorf("Expected missing adjclose to fall back to close, got %s", got)
+	}
 }
 
 func TestApplyAutoAdjustSkipsInfiniteRatio(t *testing.T) {
 	bar := models.Bar{Open: 100, High: 110, Low: 95, Close: 100, AdjClose: math.Inf(1)}
 
-	applyAutoAdjust(&bar, true)
+	applyAutoAdjust(&bar)
 
 	if bar.Open != 100 || bar.High != 110 || bar.Low != 95 || bar.Close != 100 {
 		t.Fatalf("Expected auto-adjust to skip infinite ratio, got %+v", bar)
 	}
 }
 
+func TestAdjustBars(t *testing.T) {
+	raw := []models.Bar{
+		{Open: 100, High: 110, Low: 90, Close: 100, AdjClose: 50},
+		{Open: 10, High: 12, Low: 8, Close: 0, AdjClose: 0},
+	}
+
+	adjusted := append([]models.Bar(nil), raw...)
+	adjustBars(adjusted, models.HistoryParams{AutoAdjust: true})
+	if b := adjusted[0]; b.Open != 50 || b.High != 55 || b.Low != 45 || b.Close != 50 {
+		t.Errorf("Expected OHLC scaled by 0.5, got %+v", b)
+	}
+	if adjusted[0].AdjClose != 0 {
+		t.Errorf("Expected AdjClose to be dropped, got %v", adjusted[0].AdjClose)
+	}
+	if adjusted[1].Open != 10 {
+		t.Errorf("Expected bar without close to stay unadjusted, got %+v", adjusted[1])
+	}
+
+	nan := append([]models.Bar(nil), raw...)
+	adjustBars(nan, models.HistoryParams{AutoAdjust: true, MissingAsNaN: true})
+	if !math.IsNaN(nan[0].AdjClose) {
+		t.Errorf("Expected dropped AdjClose to be NaN, got %v", nan[0].AdjClose)
+	}
+
+	both := append([]models.Bar(nil), raw...)
+	adjustBars(both, models.HistoryParams{AutoAdjust: true, AdjustedColumns: true})
+	b := both[0]
+	if b.Open != 100 || b.High != 110 || b.Low != 90 || b.Close != 100 {
+		t.Errorf("Expected raw OHLC to be kept, got %+v", b)
+	}
+	if b.AdjOpen != 50 || b.AdjHigh != 55 || b.AdjLow != 45 || b.AdjClose != 50 {
+		t.Errorf("Expected adjusted columns, got %+v", b)
+	}
+	if both[1].AdjOpen != 10 || both[1].AdjHigh != 12 {
+		t.Errorf("Expected raw prices copied without a ratio, got %+v", both[1])
+	}
+
+	back := append([]models.Bar(nil), raw...)
+	adjustBars(back, models.HistoryParams{BackAdjust: true})
+	if b := back[0]; b.Open != 50 || b.High != 55 || b.Low != 45 || b.Close != 100 || b.AdjClose != 0 {
+		t.Errorf("Expected Open/High/Low scaled and Close kept, got %+v", b)
+	}
+
+	explicit := append([]models.Bar(nil), raw...)
+	adjustBars(explicit, models.HistoryParams{AutoAdjust: true, Adjust: models.AdjustNone})
+	if explicit[0] != raw[0] {
+		t.Errorf("Expected Adjust none to override AutoAdjust, got %+v", explicit[0])
+	}
+}
+
 func TestRepairOptionsFromHistoryParams(t *testing.T) {
 	params := models.HistoryParams{
 		Interval: "1d",
@@ -142,3 +317,364 @@ func TestRepairOptionsFromHistoryParams(t *testing.T) {
 		t.Errorf("Repair flags not propagated correctly: %+v", opts)
 	}
 }
+
+func TestRepairMetaFromInfo(t *testing.T) {
+	tkr, err := New("VOD.L")
+	if err != nil {
+		t.Fatalf("Failed to create ticker: %v", err)
+	}
+	defer tkr.Close()
+
+	tkr.infoCache = &models.Info{QuoteType: "EQUITY", ExchangeTimezoneName: "Europe/London", Currency: "GBp"}
+	meta := tkr.repairMeta(context.Background(), models.ChartMeta{Currency: "GBP"})
+	if meta.InstrumentType != "EQUITY" || meta.ExchangeTimezoneName != "Europe/London" {
+		t.Errorf("Expected quote type and timezone from info, got %+v", meta)
+	}
+	if meta.Currency != "GBP" {
+		t.Errorf("Expected chart currency to win, got %s", meta.Currency)
+	}
+}
+
+// chartBody builds a daily chart response of n bars with a null bar every
+// 100 bars and a dividend on the first.
+func chartBody(n int) string {
+	var ts, open, high, low, close, volume, adj []string
+	for i := 0; i < n; i++ {
+		ts = append(ts, strconv.FormatInt(1704067200+int64(i)*86400, 10))
+		if i%100 == 99 {
+			for _, s := range []*[]string{&open, &high, &low, &close, &volume, &adj} {
+				*s = append(*s, "null")
+			}
+			continue
+		}
+		p := 100 + float64(i%250)*0.37
+		open = append(open, strconv.FormatFloat(p, 'f', -1, 64))
+		high = append(high, strconv.FormatFloat(p+1.25, 'f', -1, 64))
+		low = append(low, strconv.FormatFloat(p-1.25, 'f', -1, 64))
+		close = append(close, strconv.FormatFloat(p+0.5, 'f', -1, 64))
+		volume = append(volume, strconv.Itoa(1000000+i))
+		adj = append(adj, strconv.FormatFloat(p+0.4, 'f', -1, 64))
+	}
+	join := func(s []string) string { return "[" + strings.Join(s, ",") + "]" }
+	return `{"chart": {"result": [{"meta": {"symbol": "AAPL", "currency": "USD"},
+		"timestamp": ` + join(ts) + `,
+		"events": {"dividends": {"1704067200": {"amount": 0.24, "date": 1704067200}}},
+		"indicators": {"quote": [{"open": ` + join(open) + `, "high": ` + join(high) + `, "low": ` + join(low) +
+		`, "close": ` + join(close) + `, "volume": ` + join(volume) + `}], "adjclose": [{"adjclose": ` + join(adj) + `}]}}], "error": null}}`
+}
+
+func TestParseChartDataFromJSON(t *testing.T) {
+	var resp models.ChartResponse
+	if err := json.Unmarshal([]byte(chartBody(200)), &resp); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	bars, err := (&Ticker{}).parseChartData(&resp.Chart.Result[0], true, true)
+	if err != nil {
+		t.Fatalf("parseChartData() error: %v", err)
+	}
+	if len(bars) != 200 {
+		t.Fatalf("expected 200 bars, got %d", len(bars))
+	}
+	if bars[0].Open != 100 || bars[0].Close != 100.5 || bars[0].AdjClose != 100.4 || bars[0].Volume != 1000000 || bars[0].Dividends != 0.24 {
+		t.Errorf("unexpected first bar %+v", bars[0])
+	}
+	if b := bars[99]; !math.IsNaN(b.Open) || !math.IsNaN(b.Close) || !math.IsNaN(b.AdjClose) || b.Volume != 0 {
+		t.Errorf("expected null bar to be NaN, got %+v", b)
+	}
+	if !bars[1].Date.Equal(time.Unix(1704153600, 0)) {
+		t.Errorf("unexpected date %v", bars[1].Date)
+	}
+}
+
+func BenchmarkParseChart(b *testing.B) {
+	benchmarkParseChart(b, 100000)
+}
+
+// BenchmarkParseChart10y parses ten years of daily bars, which should take
+// well under 5ms.
+func BenchmarkParseChart10y(b *testing.B) {
+	benchmarkParseChart(b, 2520)
+}
+
+func benchmarkParseChart(b *testing.B, n int) {
+	body := []byte(chartBody(n))
+	tk := &Ticker{}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var resp models.ChartResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := tk.parseChartData(&resp.Chart.Result[0], true, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestSharedHistory(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(_ context.Context, params models.HistoryParams) (*models.History, error) {
+		loads.Add(1)
+		<-release
+		return &models.History{
+			Symbol:  "AAPL",
+			Bars:    []models.Bar{{Close: 1}, {Close: 2}},
+			Actions: &models.Actions{Dividends: []models.Dividend{{Amount: 0.25}}},
+		}, nil
+	}
+
+	params := normalizeHistoryParams(models.HistoryParams{Period: "1y"})
+	const callers = 5
+	results := make([]*models.History, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			h, err := tkr.sharedHistory(context.Background(), params, load)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			results[i] = h
+		}(i)
+	}
+
+	// Wait until the first load is running and the others are waiting on it
+	for {
+		tkr.mu.RLock()
+		inflight := len(tkr.historyCalls)
+		tkr.mu.RUnlock()
+		if inflight == 1 && loads.Load() == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := loads.Load(); n != 1 {
+		t.Errorf("expected one shared load, got %d", n)
+	}
+	// Each caller owns its result
+	results[0].Bars[0].Close = 99
+	results[0].Actions.Dividends[0].Amount = 99
+	for i := 1; i < callers; i++ {
+		if results[i].Bars[0].Close != 1 || results[i].Actions.Dividends[0].Amount != 0.25 {
+			t.Errorf("caller %d shares data with caller 0: %+v", i, results[i])
+		}
+	}
+
+	// Different params and later calls load again
+	tkr.sharedHistory(context.Background(), normalizeHistoryParams(models.HistoryParams{Period: "5d"}), load)
+	tkr.sharedHistory(context.Background(), params, load)
+	if n := loads.Load(); n != 3 {
+		t.Errorf("expected 3 loads, got %d", n)
+	}
+	if len(tkr.historyCalls) != 0 {
+		t.Errorf("expected no calls in flight, got %d", len(tkr.historyCalls))
+	}
+}
+
+func TestSharedHistoryError(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	failed := errors.New("boom")
+	_, err = tkr.sharedHistory(context.Background(), models.HistoryParams{Period: "1y"}, func(context.Context, models.HistoryParams) (*models.History, error) {
+		return nil, failed
+	})
+	if !errors.Is(err, failed) {
+		t.Errorf("expected load error, got %v", err)
+	}
+}
+
+func TestSharedHistoryCache(t *testing.T) {
+	tkr, err := New("AAPL", WithHistoryCache(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	var loads atomic.Int32
+	load := func(context.Context, models.HistoryParams) (*models.History, error) {
+		loads.Add(1)
+		return &models.History{Bars: []models.Bar{{Close: 1}}}, nil
+	}
+	params := normalizeHistoryParams(models.HistoryParams{Period: "1y"})
+
+	h, err := tkr.sharedHistory(context.Background(), params, load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Bars[0].Close = 99
+	h, err = tkr.sharedHistory(context.Background(), params, load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := loads.Load(); n != 1 {
+		t.Errorf("expected identical call to hit the cache, got %d loads", n)
+	}
+	if h.Bars[0].Close != 1 {
+		t.Errorf("cached history shares data with an earlier caller: %v", h.Bars)
+	}
+
+	// Other params still load
+	tkr.sharedHistory(context.Background(), normalizeHistoryParams(models.HistoryParams{Period: "1y", Interval: "1h", PrePost: true}), load)
+	if n := loads.Load(); n != 2 {
+		t.Errorf("expected different params to load, got %d loads", n)
+	}
+
+	// Expired entries load again
+	tkr.mu.Lock()
+	for key, entry := range tkr.historyCache {
+		entry.expires = time.Now().Add(-time.Second)
+		tkr.historyCache[key] = entry
+	}
+	tkr.mu.Unlock()
+	tkr.sharedHistory(context.Background(), params, load)
+	if n := loads.Load(); n != 3 {
+		t.Errorf("expected expired entry to load, got %d loads", n)
+	}
+
+	tkr.ClearCache()
+	tkr.sharedHistory(context.Background(), params, load)
+	if n := loads.Load(); n != 4 {
+		t.Errorf("expected ClearCache to drop the history cache, got %d loads", n)
+	}
+}
+
+func TestSharedHistoryContext(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	var loads atomic.Int32
+	started := make(chan struct{}, 2)
+	load := func(ctx context.Context, params models.HistoryParams) (*models.History, error) {
+		if loads.Add(1) == 1 {
+			started <- struct{}{}
+			<-ctx.Done()
+			return nil, fmt.Errorf("failed to fetch history: %w", ctx.Err())
+		}
+		return &models.History{Bars: []models.Bar{{Close: 1}}}, nil
+	}
+	params := normalizeHistoryParams(models.HistoryParams{Period: "1y"})
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := tkr.sharedHistory(leaderCtx, params, load)
+		leaderErr <- err
+	}()
+	<-started
+
+	// A waiter whose own ctx ends stops waiting
+	waiterCtx, cancelWaiter := context.WithCancel(context.Background())
+	cancelWaiter()
+	if _, err := tkr.sharedHistory(waiterCtx, params, load); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected cancelled waiter to return context.Canceled, got %v", err)
+	}
+
+	// A live waiter does not inherit the leader's cancellation
+	waiterResult := make(chan error, 1)
+	go func() {
+		h, err := tkr.sharedHistory(context.Background(), params, load)
+		if err == nil && len(h.Bars) != 1 {
+			err = fmt.Errorf("unexpected bars %v", h.Bars)
+		}
+		waiterResult <- err
+	}()
+	for {
+		tkr.mu.RLock()
+		inflight := len(tkr.historyCalls)
+		tkr.mu.RUnlock()
+		if inflight == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	cancelLeader()
+
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected leader to fail with context.Canceled, got %v", err)
+	}
+	if err := <-waiterResult; err != nil {
+		t.Errorf("expected live waiter to reload, got %v", err)
+	}
+	if n := loads.Load(); n != 2 {
+		t.Errorf("expected 2 loads, got %d", n)
+	}
+}
+
+func TestCachedChartBars(t *testing.T) {
+	config.Reset()
+	t.Cleanup(config.Reset)
+
+	c, err := client.New()
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+	tkr, err := New("AAPL", WithClient(c))
+	if err != nil {
+		t.Fatalf("Failed to create ticker: %v", err)
+	}
+	defer tkr.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 10)
+	params := models.HistoryParams{Start: &start, End: &end, Interval: "1d"}
+	if bc, _ := tkr.barCache(params); bc != nil {
+		t.Fatal("bars should not be cached while caching is disabled")
+	}
+
+	config.Get().EnableCache(time.Minute)
+	if bc, _ := tkr.barCache(models.HistoryParams{Period: "5d", Interval: "1d"}); bc != nil {
+		t.Error("Period requests should not use the bar cache")
+	}
+	bc, key := tkr.barCache(params)
+	if bc == nil {
+		t.Fatal("expected a bar cache for an explicit range")
+	}
+
+	// A download of a wider range by another Ticker on the same client
+	var bars []models.Bar
+	for d := -5; d < 15; d++ {
+		bars = append(bars, models.Bar{Date: start.AddDate(0, 0, d).Add(14 * time.Hour), Close: 100 + float64(d)})
+	}
+	bc.Store(key, client.BarSpan{
+		Start: start.AddDate(0, 0, -5),
+		End:   start.AddDate(0, 0, 15),
+		Bars:  bars,
+		Meta:  models.ChartMeta{Symbol: "AAPL", Currency: "USD"},
+	})
+
+	ch, err := tkr.fetchHistoryBars(context.Background(), params)
+	if err != nil {
+		t.Fatalf("fetchHistoryBars: %v", err)
+	}
+	if len(ch.bars) != 10 || ch.bars[0].Close != 100 || ch.bars[9].Close != 109 {
+		t.Errorf("bars not served from the cached range: %+v", ch.bars)
+	}
+	if meta := tkr.GetHistoryMetadata(); meta == nil || meta.Currency != "USD" {
+		t.Errorf("history metadata should come from the cached range: %+v", meta)
+	}
+}
@@ -1,10 +1,12 @@
 package ticker
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // holdersCache stores cached holders data.
@@ -41,7 +43,13 @@ var holdersModules = []string{
 //	fmt.Printf("Insiders: %.2f%%\n", holders.InsidersPercentHeld*100)
 //	fmt.Printf("Institutions: %.2f%%\n", holders.InstitutionsPercentHeld*100)
 func (t *Ticker) MajorHolders() (*models.MajorHolders, error) {
-	if err := t.ensureHoldersCache(); err != nil {
+	return t.MajorHoldersContext(context.Background())
+}
+
+// MajorHoldersContext is like [Ticker.MajorHolders] but returns ctx's error as soon as
+// ctx is done.
+func (t *Ticker) MajorHoldersContext(ctx context.Context) (*models.MajorHolders, error) {
+	if err := t.ensureHoldersCache(ctx); err != nil {
 		return nil, err
 	}
 
@@ -49,7 +57,7 @@ func (t *Ticker) MajorHolders() (*models.MajorHolders, error) {
 	defer t.mu.RUnlock()
 
 	if t.holdersCache == nil || t.holdersCache.major == nil {
-		return nil, fmt.Errorf("major holders data not available")
+		return nil, yferr.NoData("major holders data not available")
 	}
 
 	return t.holdersCache.major, nil
@@ -69,7 +77,13 @@ func (t *Ticker) MajorHolders() (*models.MajorHolders, error) {
 //	    fmt.Printf("%s: %d shares (%.2f%%)\n", h.Holder, h.Shares, h.PctHeld*100)
 //	}
 func (t *Ticker) InstitutionalHolders() ([]models.Holder, error) {
-	if err := t.ensureHoldersCache(); err != nil {
+	return t.InstitutionalHoldersContext(context.Background())
+}
+
+// InstitutionalHoldersContext is like [Ticker.InstitutionalHolders] but returns ctx's error as soon as
+// ctx is done.
+func (t *Ticker) InstitutionalHoldersContext(ctx context.Context) ([]models.Holder, error) {
+	if err := t.ensureHoldersCache(ctx); err != nil {
 		return nil, err
 	}
 
@@ -97,7 +111,7 @@ func (t *Ticker) InstitutionalHolders() ([]models.Holder, error) {
 //	    fmt.Printf("%s: %d shares\n", h.Holder, h.Shares)
 //	}
 func (t *Ticker) MutualFundHolders() ([]models.Holder, error) {
-	if err := t.ensureHoldersCache(); err != nil {
+	if err := t.ensureHoldersCache(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -126,7 +140,13 @@ func (t *Ticker) MutualFundHolders() ([]models.Holder, error) {
 //	        tx.Insider, tx.Transaction, tx.Shares, tx.StartDate.Format("2006-01-02"))
 //	}
 func (t *Ticker) InsiderTransactions() ([]models.InsiderTransaction, error) {
-	if err := t.ensureHoldersCache(); err != nil {
+	return t.InsiderTransactionsContext(context.Background())
+}
+
+// InsiderTransactionsContext is like [Ticker.InsiderTransactions] but returns ctx's error as soon as
+// ctx is done.
+func (t *Ticker) InsiderTransactionsContext(ctx context.Context) ([]models.InsiderTransaction, error) {
+	if err := t.ensureHoldersCache(ctx); err != nil {
 		return nil, err
 	}
 
@@ -155,7 +175,7 @@ func (t *Ticker) InsiderTransactions() ([]models.InsiderTransaction, error) {
 //	        insider.Name, insider.Position, insider.TotalShares())
 //	}
 func (t *Ticker) InsiderRosterHolders() ([]models.InsiderHolder, error) {
-	if err := t.ensureHoldersCache(); err != nil {
+	if err := t.ensureHoldersCache(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -188,7 +208,7 @@ func (t *Ticker) InsiderRoster() ([]models.InsiderHolder, error) {
 //	}
 //	fmt.Printf("Net shares: %d (%s)\n", purchases.Net.Shares, purchases.Period)
 func (t *Ticker) InsiderPurchases() (*models.InsiderPurchases, error) {
-	if err := t.ensureHoldersCache(); err != nil {
+	if err := t.ensureHoldersCache(context.Background()); err != nil {
 		return nil, err
 	}
 
@@ -203,7 +223,7 @@ func (t *Ticker) InsiderPurchases() (*models.InsiderPurchases, error) {
 }
 
 // ensureHoldersCache fetches and caches all holders data.
-func (t *Ticker) ensureHoldersCache() error {
+func (t *Ticker) ensureHoldersCache(ctx context.Context) error {
 	t.mu.RLock()
 	cached := t.holdersCache != nil
 	t.mu.RUnlock()
@@ -212,7 +232,7 @@ func (t *Ticker) ensureHoldersCache() error {
 		return nil
 	}
 
-	data, err := t.fetchQuoteSummary(holdersModules)
+	data, err := t.fetchQuoteSummary(ctx, holdersModules)
 	if err != nil {
 		return fmt.Errorf("failed to fetch holders data: %w", err)
 	}
@@ -24,6 +24,40 @@ func TestMajorHoldersModel(t *testing.T) {
 	}
 }
 
+func TestMajorHoldersRows(t *testing.T) {
+	holders := models.MajorHolders{
+		InsidersPercentHeld:          0.00066,
+		InstitutionsPercentHeld:      0.6177,
+		InstitutionsFloatPercentHeld: 0.62041,
+		InstitutionsCount:            6379,
+	}
+
+	want := []models.MajorHoldersRow{
+		{Value: "0.07%", Label: "% of Shares Held by All Insider"},
+		{Value: "61.77%", Label: "% of Shares Held by Institutions"},
+		{Value: "62.04%", Label: "% of Float Held by Institutions"},
+		{Value: "6,379", Label: "Number of Institutions Holding Shares"},
+	}
+	rows := holders.Rows()
+	if len(rows) != len(want) {
+		t.Fatalf("expected %d rows, got %d", len(want), len(rows))
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d: expected %+v, got %+v", i, want[i], rows[i])
+		}
+	}
+
+	holders.InstitutionsCount = 1234567
+	if got := holders.Rows()[3].Value; got != "1,234,567" {
+		t.Errorf("expected 1,234,567, got %s", got)
+	}
+	holders.InstitutionsCount = 999
+	if got := holders.Rows()[3].Value; got != "999" {
+		t.Errorf("expected 999, got %s", got)
+	}
+}
+
 func TestHolderModel(t *testing.T) {
 	now := time.Now()
 	holder := models.Holder{
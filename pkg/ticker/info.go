@@ -1,6 +1,7 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -10,10 +11,18 @@ import (
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Info fetches comprehensive company information for the ticker.
 func (t *Ticker) Info() (*models.Info, error) {
+	return t.InfoContext(context.Background())
+}
+
+// InfoContext is like [Ticker.Info] but returns ctx's error as soon as ctx
+// is done. A cached info is returned without checking ctx.
+func (t *Ticker) InfoContext(ctx context.Context) (*models.Info, error) {
 	// Check cache first
 	t.mu.RLock()
 	if t.infoCache != nil {
@@ -23,7 +32,77 @@ func (t *Ticker) Info() (*models.Info, error) {
 	}
 	t.mu.RUnlock()
 
-	// Fetch from API
+	lang, region := config.Get().GetLocale()
+	info, err := t.fetchInfo(ctx, lang, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the info
+	t.mu.Lock()
+	t.infoCache = info
+	t.mu.Unlock()
+
+	return info, nil
+}
+
+// InfoWithLocale is like [Ticker.Info] but requests the profile in the
+// given Yahoo locale instead of the configured one (see
+// [config.Config.SetLocale]), e.g. lang "de-DE" and region "DE". Text such
+// as LongBusinessSummary is localized where Yahoo has a translation and
+// falls back to English otherwise. An empty lang or region uses the
+// configured value. Results are cached per locale.
+//
+// Example:
+//
+//	info, err := t.InfoWithLocale("fr-FR", "FR")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(info.LongBusinessSummary)
+func (t *Ticker) InfoWithLocale(lang, region string) (*models.Info, error) {
+	return t.InfoWithLocaleContext(context.Background(), lang, region)
+}
+
+// InfoWithLocaleContext is like [Ticker.InfoWithLocale] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) InfoWithLocaleContext(ctx context.Context, lang, region string) (*models.Info, error) {
+	defaultLang, defaultRegion := config.Get().GetLocale()
+	if lang == "" {
+		lang = defaultLang
+	}
+	if region == "" {
+		region = defaultRegion
+	}
+	if lang == defaultLang && region == defaultRegion {
+		return t.InfoContext(ctx)
+	}
+
+	key := lang + "/" + region
+	t.mu.RLock()
+	if info, ok := t.localeInfoCache[key]; ok {
+		t.mu.RUnlock()
+		return info, nil
+	}
+	t.mu.RUnlock()
+
+	info, err := t.fetchInfo(ctx, lang, region)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	if t.localeInfoCache == nil {
+		t.localeInfoCache = make(map[string]*models.Info)
+	}
+	t.localeInfoCache[key] = info
+	t.mu.Unlock()
+
+	return info, nil
+}
+
+// fetchInfo fetches the info modules in the lang and region locale.
+func (t *Ticker) fetchInfo(ctx context.Context, lang, region string) (*models.Info, error) {
 	modules := []string{
 		"assetProfile",
 		"summaryDetail",
@@ -36,27 +115,23 @@ func (t *Ticker) Info() (*models.Info, error) {
 	params.Set("modules", joinModules(modules))
 	params.Set("corsDomain", "finance.yahoo.com")
 	params.Set("formatted", "false")
-	lang, region := config.Get().GetLocale()
 	params.Set("lang", lang)
 	params.Set("region", region)
 
-	resp, err := t.getWithCrumb(t.quoteSummaryURL(), params)
+	resp, err := t.getWithCrumb(ctx, t.quoteSummaryURL(), params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch info: %w", err)
 	}
+	t.keepRawSummary(resp.Body)
 
 	info, err := t.parseInfoResponse(resp.Body)
 	if err != nil {
 		return nil, err
 	}
-	if trailingPegRatio, err := t.fetchTrailingPegRatio(); err == nil && trailingPegRatio != nil {
+	if trailingPegRatio, err := t.fetchTrailingPegRatio(ctx); err == nil && trailingPegRatio != nil {
 		info.TrailingPegRatio = *trailingPegRatio
 	}
-
-	// Cache the info
-	t.mu.Lock()
-	t.infoCache = info
-	t.mu.Unlock()
+	rememberSymbolMeta(t.symbol, info.ExchangeTimezoneName, info.QuoteType)
 
 	return info, nil
 }
@@ -68,7 +143,7 @@ func (t *Ticker) parseInfoResponse(body string) (*models.Info, error) {
 	}
 
 	if summaryResp.QuoteSummary.Error != nil {
-		return nil, fmt.Errorf("API error: %s", summaryResp.QuoteSummary.Error.Description)
+		return nil, yferr.API("", summaryResp.QuoteSummary.Error.Code, summaryResp.QuoteSummary.Error.Description)
 	}
 
 	if len(summaryResp.QuoteSummary.Result) == 0 {
@@ -79,7 +154,7 @@ func (t *Ticker) parseInfoResponse(body string) (*models.Info, error) {
 	return t.parseInfo(&result), nil
 }
 
-func (t *Ticker) fetchTrailingPegRatio() (*float64, error) {
+func (t *Ticker) fetchTrailingPegRatio(ctx context.Context) (*float64, error) {
 	params := url.Values{}
 	params.Set("symbol", t.symbol)
 	params.Set("type", "trailingPegRatio")
@@ -87,7 +162,7 @@ func (t *Ticker) fetchTrailingPegRatio() (*float64, error) {
 	params.Set("period2", fmt.Sprintf("%d", time.Now().UTC().Truncate(24*time.Hour).Add(24*time.Hour).Unix()))
 
 	apiURL := fmt.Sprintf("%s/ws/fundamentals-timeseries/v1/finance/timeseries/%s", endpoints.Query1URL, url.PathEscape(t.symbol))
-	resp, err := t.getWithCrumb(apiURL, params)
+	resp, err := t.getWithCrumb(ctx, apiURL, params)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +211,8 @@ func (t *Ticker) parseInfo(result *models.QuoteSummaryResult) *models.Info {
 	if profile := result.AssetProfile; profile != nil {
 		info.Sector = getString(profile, "sector")
 		info.Industry = getString(profile, "industry")
+		info.SectorKey = getString(profile, "sectorKey")
+		info.IndustryKey = getString(profile, "industryKey")
 		info.FullTimeEmployees = getInt64(profile, "fullTimeEmployees")
 		info.City = getString(profile, "city")
 		info.State = getString(profile, "state")
@@ -303,44 +380,11 @@ func getString(m map[string]interface{}, key string) string {
 }
 
 func getFloat64(m map[string]interface{}, key string) float64 {
-	if v, ok := m[key]; ok {
-		switch val := v.(type) {
-		case float64:
-			return val
-		case int64:
-			return float64(val)
-		case int:
-			return float64(val)
-		case map[string]interface{}:
-			// Handle Yahoo's format like {"raw": 123.45, "fmt": "123.45"}
-			if raw, ok := val["raw"]; ok {
-				if f, ok := raw.(float64); ok {
-					return f
-				}
-			}
-		}
-	}
-	return 0
+	return utils.GetFloat(m, key)
 }
 
 func getInt64(m map[string]interface{}, key string) int64 {
-	if v, ok := m[key]; ok {
-		switch val := v.(type) {
-		case float64:
-			return int64(val)
-		case int64:
-			return val
-		case int:
-			return int64(val)
-		case map[string]interface{}:
-			if raw, ok := val["raw"]; ok {
-				if f, ok := raw.(float64); ok {
-					return int64(f)
-				}
-			}
-		}
-	}
-	return 0
+	return utils.GetInt64(m, key)
 }
 
 func getInt(m map[string]interface{}, key string) int {
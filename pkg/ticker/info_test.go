@@ -2,9 +2,13 @@ package ticker
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/config"
+	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
 func TestParseInfoResponseSparsePayloads(t *testing.T) {
@@ -155,3 +159,116 @@ func TestParseTrailingPegRatioTimeseriesLatestValue(t *testing.T) {
 		t.Fatalf("Expected latest trailing PEG 1.5, got %v", value)
 	}
 }
+
+func TestParseSustainabilityResponse(t *testing.T) {
+	tkr, err := New("MSFT")
+	if err != nil {
+		t.Fatalf("Failed to create ticker: %v", err)
+	}
+
+	esg, err := tkr.parseSustainabilityResponse(`{"quoteSummary":{"result":[{"esgScores":{
+		"totalEsg":{"raw":17.3},"environmentScore":1.2,"peerGroup":"Software & Services","ratingYear":2024,
+		"esgPerformance":"OUT_PERF","peerCount":120,
+		"peerEsgScorePerformance":{"min":9.1,"avg":19.5,"max":35.2},
+		"peerSocialPerformance":{},
+		"relatedControversy":["Business Ethics Incidents"]
+	}}],"error":null}}`)
+	if err != nil {
+		t.Fatalf("Expected ESG scores to parse, got %v", err)
+	}
+	if esg.TotalEsg != 17.3 || esg.EnvironmentScore != 1.2 || esg.RatingYear != 2024 {
+		t.Errorf("Unexpected scores: %+v", esg)
+	}
+	if esg.Performance != "OUT_PERF" || esg.PeerCount != 120 {
+		t.Errorf("Unexpected peer comparison: %+v", esg)
+	}
+	if p := esg.PeerEsg; p == nil || p.Min != 9.1 || p.Avg != 19.5 || p.Max != 35.2 {
+		t.Errorf("Unexpected peer ESG range: %+v", p)
+	}
+	if esg.PeerSocial != nil || esg.PeerGovernance != nil {
+		t.Errorf("Expected missing peer ranges to be nil, got %+v %+v", esg.PeerSocial, esg.PeerGovernance)
+	}
+	if len(esg.RelatedControversy) != 1 || esg.RelatedControversy[0] != "Business Ethics Incidents" {
+		t.Errorf("Unexpected controversies: %v", esg.RelatedControversy)
+	}
+
+	_, err = tkr.parseSustainabilityResponse(`{"quoteSummary":{"result":[{}],"error":null}}`)
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("Expected not-found error without esgScores, got %v", err)
+	}
+}
+
+func TestInfoWithLocaleCache(t *testing.T) {
+	tkr, err := New("SAP")
+	if err != nil {
+		t.Fatalf("Failed to create ticker: %v", err)
+	}
+	defer tkr.Close()
+
+	german := &models.Info{Symbol: "SAP", LongBusinessSummary: "Die SAP SE bietet weltweit Anwendungen an."}
+	english := &models.Info{Symbol: "SAP", LongBusinessSummary: "SAP SE provides applications worldwide."}
+	tkr.localeInfoCache = map[string]*models.Info{"de-DE/DE": german}
+	tkr.infoCache = english
+
+	info, err := tkr.InfoWithLocale("de-DE", "DE")
+	if err != nil || info != german {
+		t.Errorf("Expected cached German info, got %+v, %v", info, err)
+	}
+
+	// The configured locale is served by Info
+	lang, region := config.Get().GetLocale()
+	if info, err := tkr.InfoWithLocale(lang, region); err != nil || info != english {
+		t.Errorf("Expected configured locale to use the Info cache, got %+v, %v", info, err)
+	}
+	if info, err := tkr.InfoWithLocale("", ""); err != nil || info != english {
+		t.Errorf("Expected empty locale to use the Info cache, got %+v, %v", info, err)
+	}
+	if n := tkr.Stats().Total(); n != 0 {
+		t.Errorf("Expected no requests for cached locales, got %d", n)
+	}
+
+	tkr.ClearCache()
+	if tkr.localeInfoCache != nil {
+		t.Error("Expected ClearCache to drop localized info")
+	}
+}
+
+// quoteSummaryBody returns an info response with the modules Info reads,
+// each holding fields keys of Yahoo's {"raw", "fmt"} values.
+func quoteSummaryBody(fields int) string {
+	var b strings.Builder
+	b.WriteString(`{"quoteSummary":{"result":[{"assetProfile":{"sector":"Technology","industry":"Consumer Electronics",
+		"city":"Cupertino","country":"United States","fullTimeEmployees":161000,"longBusinessSummary":"`)
+	b.WriteString(strings.Repeat("Apple Inc. designs, manufactures and markets smartphones. ", 20))
+	b.WriteString(`","companyOfficers":[`)
+	for i := 0; i < 10; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"name":"Officer %d","title":"Senior Vice President","age":55,"yearBorn":1969,"totalPay":{"raw":%d,"fmt":"%dM"}}`, i, 1000000+i, i)
+	}
+	b.WriteString(`]}`)
+	for _, module := range []string{"summaryDetail", "defaultKeyStatistics", "financialData", "price", "quoteType"} {
+		fmt.Fprintf(&b, `,"%s":{"currency":"USD","marketCap":{"raw":3000000000000,"fmt":"3T"}`, module)
+		for i := 0; i < fields; i++ {
+			fmt.Fprintf(&b, `,"field%d":{"raw":%.4f,"fmt":"%.2f"}`, i, float64(i)*1.2345, float64(i)*1.2345)
+		}
+		b.WriteByte('}')
+	}
+	b.WriteString(`}],"error":null}}`)
+	return b.String()
+}
+
+func BenchmarkParseInfoResponse(b *testing.B) {
+	body := quoteSummaryBody(60)
+	tkr := &Ticker{symbol: "AAPL"}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tkr.parseInfoResponse(body); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
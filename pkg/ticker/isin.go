@@ -0,0 +1,111 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/client"
+)
+
+// isinPattern matches an ISIN: country code, nine alphanumerics and a
+// check digit.
+var isinPattern = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{9}[0-9]$`)
+
+// ISIN returns the International Securities Identification Number of the
+// instrument, e.g. "US0378331005" for AAPL.
+//
+// Yahoo does not publish ISINs, so like Python yfinance the ISIN is looked
+// up in Business Insider's search suggestions by the ticker's short name
+// (see [Ticker.Info]) or symbol. Currencies, crypto pairs and indices
+// ("-" or "^" in the symbol) have none. When no ISIN is found the error
+// matches [client.ErrNoData]. Both outcomes are cached.
+//
+// Example:
+//
+//	isin, err := t.ISIN()
+//	if client.IsNoDataError(err) {
+//	    fmt.Println("no ISIN")
+//	}
+func (t *Ticker) ISIN() (string, error) {
+	return t.ISINContext(context.Background())
+}
+
+// ISINContext is like [Ticker.ISIN] but returns ctx's error as soon as ctx
+// is done.
+func (t *Ticker) ISINContext(ctx context.Context) (string, error) {
+	t.mu.RLock()
+	if t.isinCache != nil {
+		isin := *t.isinCache
+		t.mu.RUnlock()
+		return isinResult(t.symbol, isin)
+	}
+	t.mu.RUnlock()
+
+	isin := ""
+	if !strings.ContainsAny(t.symbol, "-^") {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+
+		query := t.symbol
+		if info, err := t.InfoContext(ctx); err == nil && info.ShortName != "" {
+			query = info.ShortName
+		}
+
+		params := url.Values{}
+		params.Set("max_results", "25")
+		params.Set("query", query)
+
+		resp, err := t.client.GetContext(t.withStats(ctx), endpoints.ISINSearchURL, params)
+		if err != nil {
+			return "", fmt.Errorf("failed to look up ISIN: %w", err)
+		}
+		if resp.StatusCode >= 400 {
+			return "", client.HTTPStatusToError(resp.StatusCode, resp.Body)
+		}
+		isin = parseISINSuggestions(t.symbol, query, resp.Body)
+	}
+
+	t.mu.Lock()
+	t.isinCache = &isin
+	t.mu.Unlock()
+
+	return isinResult(t.symbol, isin)
+}
+
+// isinResult reports an empty ISIN as missing data.
+func isinResult(symbol, isin string) (string, error) {
+	if isin == "" {
+		return "", fmt.Errorf("no ISIN for %s: %w", symbol, client.WrapNoDataError(symbol))
+	}
+	return isin, nil
+}
+
+// parseISINSuggestions extracts the ISIN of symbol from a search suggestion
+// response. Each suggestion carries "SYMBOL|ISIN|..." keywords; when symbol
+// itself is not listed but query matched, the first suggestion without a
+// symbol is used, as Python yfinance does. It returns "" when none is found.
+func parseISINSuggestions(symbol, query, body string) string {
+	marker := `"` + strings.ToUpper(symbol) + `|`
+	if !strings.Contains(body, marker) {
+		if !strings.Contains(strings.ToLower(body), strings.ToLower(query)) {
+			return ""
+		}
+		marker = `"|`
+	}
+
+	_, rest, ok := strings.Cut(body, marker)
+	if !ok {
+		return ""
+	}
+	isin, _, _ := strings.Cut(rest, `"`)
+	isin, _, _ = strings.Cut(isin, "|")
+	if !isinPattern.MatchString(isin) {
+		return ""
+	}
+	return isin
+}
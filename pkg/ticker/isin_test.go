@@ -0,0 +1,58 @@
+package ticker
+
+import (
+	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+)
+
+func TestParseISINSuggestions(t *testing.T) {
+	body := `mmSuggestDeliver(0, new Array("Name", "Category", "Keywords", "Bias", "Extension", "IDs"), new Array(` +
+		`new Array("Apple Inc.", "Stocks", "AAPL|US0378331005|AAPL||AAPL", "0", "", "908440|AAPL|1|AAPL"),` +
+		`new Array("Apple Hospitality REIT", "Stocks", "APLE|US03784Y2000|APLE||APLE", "0", "", "")), 2, 0);`
+
+	if isin := parseISINSuggestions("AAPL", "Apple Inc.", body); isin != "US0378331005" {
+		t.Errorf("expected US0378331005, got %q", isin)
+	}
+	if isin := parseISINSuggestions("MSFT", "Microsoft Corporation", body); isin != "" {
+		t.Errorf("expected no ISIN for unmatched query, got %q", isin)
+	}
+
+	noSymbol := `new Array(new Array("Siemens AG", "Stocks", "|DE0007236101|SIE||SIE", "0", "", ""))`
+	if isin := parseISINSuggestions("SIE.DE", "Siemens AG", noSymbol); isin != "DE0007236101" {
+		t.Errorf("expected fallback to the matched suggestion, got %q", isin)
+	}
+
+	bad := `new Array(new Array("Apple Inc.", "Stocks", "AAPL|not-an-isin|AAPL", "0", "", ""))`
+	if isin := parseISINSuggestions("AAPL", "Apple Inc.", bad); isin != "" {
+		t.Errorf("expected malformed ISIN to be rejected, got %q", isin)
+	}
+}
+
+func TestISINWithoutLookup(t *testing.T) {
+	tkr, err := New("EURUSD=X")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	isin := "US0378331005"
+	tkr.isinCache = &isin
+	if got, err := tkr.ISIN(); err != nil || got != isin {
+		t.Errorf("ISIN() = %q, %v, want cached %q", got, err, isin)
+	}
+
+	for _, symbol := range []string{"BTC-USD", "^GSPC"} {
+		tkr, err := New(symbol)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tkr.ISIN(); !client.IsNoDataError(err) {
+			t.Errorf("%s: expected ErrNoData, got %v", symbol, err)
+		}
+		if n := tkr.Stats().Total(); n != 0 {
+			t.Errorf("%s: expected no requests, got %d", symbol, n)
+		}
+		tkr.Close()
+	}
+}
@@ -1,12 +1,14 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // newsAPIResponse represents the Yahoo Finance news API response structure.
@@ -55,6 +57,12 @@ type newsStreamItem struct {
 //	    fmt.Printf("%s: %s\n", article.Publisher, article.Title)
 //	}
 func (t *Ticker) News(count int, tab models.NewsTab) ([]models.NewsArticle, error) {
+	return t.NewsContext(context.Background(), count, tab)
+}
+
+// NewsContext is like [Ticker.News] but returns ctx's error as soon as ctx
+// is done.
+func (t *Ticker) NewsContext(ctx context.Context, count int, tab models.NewsTab) ([]models.NewsArticle, error) {
 	// Check cache first
 	t.mu.RLock()
 	if t.newsCache != nil {
@@ -90,7 +98,10 @@ func (t *Ticker) News(count int, tab models.NewsTab) ([]models.NewsArticle, erro
 	}
 
 	// Make POST request with JSON body
-	resp, err := t.client.PostJSON(url, nil, body)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	resp, err := t.client.PostJSONContext(t.withStats(ctx), url, nil, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch news: %w", err)
 	}
@@ -105,7 +116,7 @@ func (t *Ticker) News(count int, tab models.NewsTab) ([]models.NewsArticle, erro
 	// Parse response
 	var apiResp newsAPIResponse
 	if err := json.Unmarshal([]byte(resp.Body), &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse news response: %w", err)
+		return nil, yferr.Parse("news response", err)
 	}
 
 	// Convert to NewsArticle slice, filtering out ads
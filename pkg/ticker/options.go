@@ -1,13 +1,21 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // cachedExpirations stores expiration dates after first fetch
@@ -18,11 +26,17 @@ type optionsCache struct {
 
 // Options returns all available expiration dates for options.
 func (t *Ticker) Options() ([]time.Time, error) {
+	return t.OptionsContext(context.Background())
+}
+
+// OptionsContext is like [Ticker.Options] but returns ctx's error as soon
+// as ctx is done.
+func (t *Ticker) OptionsContext(ctx context.Context) ([]time.Time, error) {
 	if t.optionsCache != nil && len(t.optionsCache.expirations) > 0 {
 		return t.getExpirationTimes(), nil
 	}
 
-	resp, err := t.fetchOptions("")
+	resp, err := t.fetchOptions(ctx, "")
 	if err != nil {
 		return nil, err
 	}
@@ -32,10 +46,21 @@ func (t *Ticker) Options() ([]time.Time, error) {
 
 // OptionChain returns the option chain for a specific expiration date.
 // If date is empty, returns the nearest expiration.
+//
+// Deprecated: Use [Ticker.OptionChainWithParams], which takes a typed expiry
+// and supports strike and contract type filters.
 func (t *Ticker) OptionChain(date string) (*models.OptionChain, error) {
+	return t.OptionChainContext(context.Background(), date)
+}
+
+// OptionChainContext is like [Ticker.OptionChain] but returns ctx's error
+// as soon as ctx is done.
+//
+// Deprecated: Use [Ticker.OptionChainWithParamsContext].
+func (t *Ticker) OptionChainContext(ctx context.Context, date string) (*models.OptionChain, error) {
 	// If no date specified, fetch default (nearest expiration)
 	if date == "" {
-		resp, err := t.fetchOptions("")
+		resp, err := t.fetchOptions(ctx, "")
 		if err != nil {
 			return nil, err
 		}
@@ -44,7 +69,7 @@ func (t *Ticker) OptionChain(date string) (*models.OptionChain, error) {
 
 	// Ensure we have expiration dates cached
 	if t.optionsCache == nil || len(t.optionsCache.expirations) == 0 {
-		_, err := t.Options()
+		_, err := t.OptionsContext(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -60,7 +85,7 @@ func (t *Ticker) OptionChain(date string) (*models.OptionChain, error) {
 		return nil, fmt.Errorf("expiration date %s not found, available: %v", date, available)
 	}
 
-	resp, err := t.fetchOptions(fmt.Sprintf("%d", timestamp))
+	resp, err := t.fetchOptions(ctx, fmt.Sprintf("%d", timestamp))
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +94,242 @@ func (t *Ticker) OptionChain(date string) (*models.OptionChain, error) {
 }
 
 // OptionChainAtExpiry is an alias for OptionChain with a specific date.
+//
+// Deprecated: Use [Ticker.OptionChainWithParams].
 func (t *Ticker) OptionChainAtExpiry(date time.Time) (*models.OptionChain, error) {
 	return t.OptionChain(date.Format("2006-01-02"))
 }
 
+// OptionChainWithParams returns the option chain for params.Expiry, or the
+// nearest expiration if it is zero, filtered by strike range and contract
+// type. The expiry must be one of the dates returned by [Ticker.Options];
+// otherwise an error listing the available dates is returned.
+//
+// Example:
+//
+//	expirations, _ := t.Options()
+//	chain, err := t.OptionChainWithParams(models.OptionChainParams{
+//	    Expiry:    expirations[0],
+//	    MinStrike: 150,
+//	    MaxStrike: 200,
+//	    PutsOnly:  true,
+//	})
+func (t *Ticker) OptionChainWithParams(params models.OptionChainParams) (*models.OptionChain, error) {
+	return t.OptionChainWithParamsContext(context.Background(), params)
+}
+
+// OptionChainWithParamsContext is like [Ticker.OptionChainWithParams] but
+// returns ctx's error as soon as ctx is done.
+func (t *Ticker) OptionChainWithParamsContext(ctx context.Context, params models.OptionChainParams) (*models.OptionChain, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid option chain params: %w", err)
+	}
+
+	dateParam := ""
+	if !params.Expiry.IsZero() {
+		if _, err := t.OptionsContext(ctx); err != nil {
+			return nil, err
+		}
+		ts, err := t.optionsCache.lookupExpiry(params.Expiry)
+		if err != nil {
+			return nil, err
+		}
+		dateParam = fmt.Sprintf("%d", ts)
+	}
+
+	resp, err := t.fetchOptions(ctx, dateParam)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := t.parseOptionChain(resp)
+	if err != nil {
+		return nil, err
+	}
+	return params.Filter(chain), nil
+}
+
+// optionChainInterval spaces the requests of AllOptionChains so that a
+// long list of expirations does not trip Yahoo's rate limit.
+const optionChainInterval = 100 * time.Millisecond
+
+// AllOptionChains returns the option chain of every expiration date in
+// OptionsData.Chains, in the order of ExpirationDates. OptionChain holds the
+// nearest expiration.
+//
+// Chains are fetched concurrently by up to config MaxConcurrent workers,
+// with requests spaced apart and retried on rate limits per
+// config.SetMaxRetries. An expired crumb is refreshed once. If any chain
+// fails, the remaining requests are canceled and the error is returned.
+//
+// Example:
+//
+//	data, err := t.AllOptionChains()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, chain := range data.Chains {
+//	    fmt.Printf("%s: %d calls, %d puts\n", chain.Expiration.Format("2006-01-02"), len(chain.Calls), len(chain.Puts))
+//	}
+func (t *Ticker) AllOptionChains() (*models.OptionsData, error) {
+	return t.AllOptionChainsContext(context.Background())
+}
+
+// AllOptionChainsContext is like [Ticker.AllOptionChains] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) AllOptionChainsContext(ctx context.Context) (*models.OptionsData, error) {
+	policy := client.DefaultRetryPolicy()
+	var reauth sync.Once
+
+	resp, err := t.fetchOptionsRetry(ctx, policy, "", &reauth)
+	if err != nil {
+		return nil, err
+	}
+	expirations, err := t.parseExpirations(resp)
+	if err != nil {
+		return nil, err
+	}
+	nearest, err := t.parseOptionChain(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.OptionChain.Result[0]
+	data := &models.OptionsData{
+		ExpirationDates: expirations,
+		Strikes:         result.Strikes,
+		HasMiniOptions:  result.HasMiniOptions,
+		OptionChain:     nearest,
+		Chains:          make([]*models.OptionChain, len(expirations)),
+	}
+	// The first response already carries the nearest chain
+	for i, exp := range expirations {
+		if exp.Unix() == nearest.Expiration.Unix() {
+			data.Chains[i] = nearest
+		}
+	}
+
+	err = forEachPaced(ctx, len(expirations), config.Get().GetMaxConcurrent(), optionChainInterval, func(ctx context.Context, i int) error {
+		if data.Chains[i] != nil {
+			return nil
+		}
+		day := expirations[i].UTC().Format("2006-01-02")
+		resp, err := t.fetchOptionsRetry(ctx, policy, strconv.FormatInt(expirations[i].Unix(), 10), &reauth)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s option chain: %w", day, err)
+		}
+		chain, err := t.parseOptionChain(resp)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s option chain: %w", day, err)
+		}
+		data.Chains[i] = chain
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// fetchOptionsRetry is fetchOptions retried per policy. An authentication
+// failure resets the crumb, at most once per reauth, and is retried once.
+func (t *Ticker) fetchOptionsRetry(ctx context.Context, policy client.RetryPolicy, dateParam string, reauth *sync.Once) (*models.OptionChainResponse, error) {
+	var resp *models.OptionChainResponse
+	err := client.Retry(ctx, policy, func() error {
+		var err error
+		resp, err = t.fetchOptions(ctx, dateParam)
+		if errors.Is(err, yferr.ErrAuth) {
+			reauth.Do(t.auth.Reset)
+			resp, err = t.fetchOptions(ctx, dateParam)
+		}
+		return err
+	})
+	return resp, err
+}
+
+// forEachPaced calls fn for 0..n-1 on up to workers goroutines, starting
+// calls at least interval apart. The first error cancels the ctx passed to
+// the remaining calls and is returned.
+func forEachPaced(ctx context.Context, n, workers int, interval time.Duration, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	jobs := make(chan int)
+	for w := 0; w < max(min(workers, n), 1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := fn(ctx, i); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+feed:
+	for i := 0; i < n; i++ {
+		if i > 0 && tick != nil {
+			select {
+			case <-ctx.Done():
+				break feed
+			case <-tick:
+			}
+		}
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- i:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// lookupExpiry returns the timestamp of the expiration on the calendar day
+// of expiry. Yahoo expirations are midnight UTC, so the day is matched in
+// UTC as well as in expiry's own location.
+func (c *optionsCache) lookupExpiry(expiry time.Time) (int64, error) {
+	candidates := []string{expiry.UTC().Format("2006-01-02"), expiry.Format("2006-01-02")}
+
+	available := make([]string, 0, len(c.expirations))
+	for _, ts := range c.expirations {
+		day := time.Unix(ts, 0).UTC().Format("2006-01-02")
+		for _, want := range candidates {
+			if day == want {
+				return ts, nil
+			}
+		}
+		available = append(available, day)
+	}
+	sort.Strings(available)
+	return 0, fmt.Errorf("expiration date %s not found, available: %v", candidates[0], available)
+}
+
 // fetchOptions fetches options data from Yahoo Finance API.
-func (t *Ticker) fetchOptions(dateParam string) (*models.OptionChainResponse, error) {
+func (t *Ticker) fetchOptions(ctx context.Context, dateParam string) (*models.OptionChainResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	apiURL := fmt.Sprintf("%s/%s", endpoints.OptionsURL, t.symbol)
 
 	params := url.Values{}
@@ -84,23 +339,21 @@ func (t *Ticker) fetchOptions(dateParam string) (*models.OptionChainResponse, er
 
 	params, err := t.auth.AddCrumbToParams(params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to add crumb: %w", err)
+		return nil, yferr.Wrap(yferr.ErrAuth, "failed to add crumb", err)
 	}
 
 	var resp models.OptionChainResponse
-	err = t.client.GetJSON(apiURL, params, &resp)
+	err = t.client.GetJSONContext(t.withStats(ctx), apiURL, params, &resp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch options: %w", err)
 	}
 
 	if resp.OptionChain.Error != nil {
-		return nil, fmt.Errorf("API error: %s - %s",
-			resp.OptionChain.Error.Code,
-			resp.OptionChain.Error.Description)
+		return nil, yferr.API("", resp.OptionChain.Error.Code, resp.OptionChain.Error.Description)
 	}
 
 	if len(resp.OptionChain.Result) == 0 {
-		return nil, fmt.Errorf("no options data available for %s", t.symbol)
+		return nil, yferr.NoData("no options data available for %s", t.symbol)
 	}
 
 	return &resp, nil
@@ -109,7 +362,7 @@ func (t *Ticker) fetchOptions(dateParam string) (*models.OptionChainResponse, er
 // parseExpirations extracts and caches expiration dates from the response.
 func (t *Ticker) parseExpirations(resp *models.OptionChainResponse) ([]time.Time, error) {
 	if len(resp.OptionChain.Result) == 0 {
-		return nil, fmt.Errorf("no options data in response")
+		return nil, yferr.NoData("no options data in response")
 	}
 
 	result := resp.OptionChain.Result[0]
@@ -135,7 +388,7 @@ func (t *Ticker) parseExpirations(resp *models.OptionChainResponse) ([]time.Time
 // parseOptionChain parses the option chain from the API response.
 func (t *Ticker) parseOptionChain(resp *models.OptionChainResponse) (*models.OptionChain, error) {
 	if len(resp.OptionChain.Result) == 0 {
-		return nil, fmt.Errorf("no options data in response")
+		return nil, yferr.NoData("no options data in response")
 	}
 
 	result := resp.OptionChain.Result[0]
@@ -186,18 +439,24 @@ func (t *Ticker) getExpirationTimes() []time.Time {
 
 // Strikes returns available strike prices for options.
 func (t *Ticker) Strikes() ([]float64, error) {
+	return t.StrikesContext(context.Background())
+}
+
+// StrikesContext is like [Ticker.Strikes] but returns ctx's error as soon
+// as ctx is done.
+func (t *Ticker) StrikesContext(ctx context.Context) ([]float64, error) {
 	if t.optionsCache != nil && len(t.optionsCache.strikes) > 0 {
 		return t.optionsCache.strikes, nil
 	}
 
 	// Fetch options to populate cache
-	_, err := t.Options()
+	_, err := t.OptionsContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if t.optionsCache == nil {
-		return nil, fmt.Errorf("no strikes data available")
+		return nil, yferr.NoData("no strikes data available")
 	}
 
 	return t.optionsCache.strikes, nil
@@ -205,7 +464,7 @@ func (t *Ticker) Strikes() ([]float64, error) {
 
 // OptionsJSON returns raw JSON response for debugging.
 func (t *Ticker) OptionsJSON() ([]byte, error) {
-	resp, err := t.fetchOptions("")
+	resp, err := t.fetchOptions(context.Background(), "")
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,79 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
+)
+
+// optionStoreKey returns the store key of the chain snapshots of symbol
+// expiring on expiry, e.g. "options/AAPL/2024-06-21".
+func optionStoreKey(symbol string, expiry time.Time) string {
+	return store.Key("options", strings.ToUpper(symbol), expiry.UTC().Format("2006-01-02"))
+}
+
+// SaveOptionChain stores chain in st as the snapshot of symbol's chain for
+// its expiration at time at, so later snapshots can be compared with
+// [models.OptionChain.Diff].
+//
+// Example:
+//
+//	chain, _ := t.OptionChainWithParams(models.OptionChainParams{Expiry: expiry})
+//	err := ticker.SaveOptionChain(st, "AAPL", chain, time.Now())
+func SaveOptionChain(st store.Store, symbol string, chain *models.OptionChain, at time.Time) error {
+	if chain == nil {
+		return fmt.Errorf("option chain is required")
+	}
+	if err := store.PutJSON(st, optionStoreKey(symbol, chain.Expiration), at, chain); err != nil {
+		return fmt.Errorf("failed to save option chain: %w", err)
+	}
+	return nil
+}
+
+// LoadOptionChain returns the latest snapshot saved with [SaveOptionChain]
+// of symbol's chain expiring on expiry, taken at or before at, and the time
+// it was taken. It returns [store.ErrNotFound] when there is none.
+//
+// Example:
+//
+//	prev, _, err := ticker.LoadOptionChain(st, "AAPL", expiry, time.Now().AddDate(0, 0, -1))
+//	if err == nil {
+//	    diff := chain.Diff(prev)
+//	    fmt.Println(len(diff.Calls), "calls changed since yesterday")
+//	}
+func LoadOptionChain(st store.Store, symbol string, expiry, at time.Time) (*models.OptionChain, time.Time, error) {
+	var chain models.OptionChain
+	taken, err := store.GetJSON(st, optionStoreKey(symbol, expiry), at, &chain)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return &chain, taken, nil
+}
+
+// SnapshotOptionChain fetches the option chain selected by params and saves
+// it to st at the current time.
+//
+// Example:
+//
+//	st, _ := store.NewFileStore("./snapshots")
+//	chain, err := t.SnapshotOptionChain(st, models.OptionChainParams{Expiry: expiry})
+func (t *Ticker) SnapshotOptionChain(st store.Store, params models.OptionChainParams) (*models.OptionChain, error) {
+	return t.SnapshotOptionChainContext(context.Background(), st, params)
+}
+
+// SnapshotOptionChainContext is like [Ticker.SnapshotOptionChain] but
+// returns ctx's error as soon as ctx is done.
+func (t *Ticker) SnapshotOptionChainContext(ctx context.Context, st store.Store, params models.OptionChainParams) (*models.OptionChain, error) {
+	chain, err := t.OptionChainWithParamsContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := SaveOptionChain(st, t.symbol, chain, time.Now()); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
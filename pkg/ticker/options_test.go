@@ -1,8 +1,15 @@
 package ticker
 
 import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/store"
 )
 
 func TestOptionsModels(t *testing.T) {
@@ -124,3 +131,101 @@ func TestOptionModelHelpers(t *testing.T) {
 //
 // 	t.Logf("Found %d strike prices", len(strikes))
 // }
+
+func TestLookupExpiry(t *testing.T) {
+	cache := &optionsCache{expirations: map[string]int64{
+		"2024-01-19": 1705622400, // 2024-01-19 00:00 UTC
+		"2024-02-16": 1708041600,
+	}}
+
+	ts, err := cache.lookupExpiry(time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC))
+	if err != nil || ts != 1705622400 {
+		t.Errorf("lookupExpiry(UTC date) = %d, %v", ts, err)
+	}
+
+	ny, _ := time.LoadLocation("America/New_York")
+	ts, err = cache.lookupExpiry(time.Unix(1708041600, 0).In(ny))
+	if err != nil || ts != 1708041600 {
+		t.Errorf("lookupExpiry(New York time) = %d, %v", ts, err)
+	}
+
+	if _, err := cache.lookupExpiry(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error for unknown expiry")
+	}
+}
+
+func TestOptionChainStore(t *testing.T) {
+	st, err := store.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiry := time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2024, 6, 3, 15, 0, 0, 0, time.UTC)
+
+	chain := &models.OptionChain{Expiration: expiry, Calls: []models.Option{{ContractSymbol: "AAPL240621C00190000", Strike: 190}}}
+	if err := SaveOptionChain(st, "aapl", chain, at); err != nil {
+		t.Fatal(err)
+	}
+
+	got, taken, err := LoadOptionChain(st, "AAPL", expiry, at.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !taken.Equal(at) || len(got.Calls) != 1 || got.Calls[0].Strike != 190 {
+		t.Errorf("LoadOptionChain() = %+v at %s", got, taken)
+	}
+	if _, _, err := LoadOptionChain(st, "AAPL", expiry, at.Add(-time.Hour)); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("LoadOptionChain() before snapshot error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestForEachPaced(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		seen    = make(map[int]bool)
+		running int
+		peak    int
+	)
+	err := forEachPaced(context.Background(), 6, 2, time.Millisecond, func(ctx context.Context, i int) error {
+		mu.Lock()
+		seen[i] = true
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 6 || peak > 2 {
+		t.Errorf("seen %d of 6 calls, peak concurrency %d (max 2)", len(seen), peak)
+	}
+
+	errFail := errors.New("boom")
+	var calls atomic.Int32
+	err = forEachPaced(context.Background(), 50, 1, time.Millisecond, func(ctx context.Context, i int) error {
+		calls.Add(1)
+		if i == 1 {
+			return errFail
+		}
+		return nil
+	})
+	if !errors.Is(err, errFail) {
+		t.Errorf("err = %v, want %v", err, errFail)
+	}
+	if n := calls.Load(); n >= 50 {
+		t.Errorf("remaining calls should be canceled after the first error, got %d", n)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := forEachPaced(ctx, 3, 1, 0, func(context.Context, int) error { return nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled ctx: err = %v", err)
+	}
+}
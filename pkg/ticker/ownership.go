@@ -0,0 +1,209 @@
+package ticker
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// InstitutionalOwnershipTrend returns institutional ownership grouped by
+// report date, oldest first, with the net shares bought or sold since each
+// holder's previous filing.
+//
+// Yahoo lists the latest filing of the largest holders only, so periods
+// cover the dates those holders last reported rather than a full quarterly
+// history. The accumulation indicator compares each holding with the
+// holder's previous report.
+//
+// Example:
+//
+//	trend, err := ticker.InstitutionalOwnershipTrend()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if trend.Accumulating() {
+//	    fmt.Printf("institutions bought %d shares (%.1f%%)\n",
+//	        trend.Total.SharesChange, trend.Total.Accumulation*100)
+//	}
+func (t *Ticker) InstitutionalOwnershipTrend() (*models.OwnershipTrend, error) {
+	holders, err := t.InstitutionalHolders()
+	if err != nil {
+		return nil, err
+	}
+	if len(holders) == 0 {
+		return nil, yferr.NoData("institutional ownership not available for %s", t.symbol)
+	}
+
+	trend := ownershipTrend(holders)
+	trend.Symbol = t.symbol
+	return trend, nil
+}
+
+// ownershipTrend groups holders by report day and computes the accumulation
+// indicator of each group and of all holders.
+func ownershipTrend(holders []models.Holder) *models.OwnershipTrend {
+	type accumulator struct {
+		period      models.OwnershipPeriod
+		priorShares float64
+	}
+
+	byDay := make(map[time.Time]*accumulator)
+	var total accumulator
+	for _, h := range holders {
+		day := h.DateReported.UTC().Truncate(24 * time.Hour)
+		acc, ok := byDay[day]
+		if !ok {
+			acc = &accumulator{period: models.OwnershipPeriod{ReportDate: day}}
+			byDay[day] = acc
+		}
+		for _, a := range []*accumulator{acc, &total} {
+			addHolding(&a.period, &a.priorShares, h)
+		}
+		if day.After(total.period.ReportDate) {
+			total.period.ReportDate = day
+		}
+	}
+
+	trend := &models.OwnershipTrend{Periods: make([]models.OwnershipPeriod, 0, len(byDay))}
+	for _, acc := range byDay {
+		finishPeriod(&acc.period, acc.priorShares)
+		trend.Periods = append(trend.Periods, acc.period)
+	}
+	sort.Slice(trend.Periods, func(i, j int) bool {
+		return trend.Periods[i].ReportDate.Before(trend.Periods[j].ReportDate)
+	})
+	finishPeriod(&total.period, total.priorShares)
+	trend.Total = total.period
+	return trend
+}
+
+// addHolding adds h to p. The holder's previous position is derived from
+// its fractional change; a holding without a usable previous position
+// counts as held in full before.
+func addHolding(p *models.OwnershipPeriod, priorShares *float64, h models.Holder) {
+	p.Holders++
+	p.Shares += h.Shares
+	p.Value += h.Value
+	p.PctHeld += h.PctHeld
+
+	switch {
+	case h.PctChange > 0:
+		p.Increased++
+	case h.PctChange < 0:
+		p.Decreased++
+	default:
+		p.Unchanged++
+	}
+
+	prior := float64(h.Shares)
+	if growth := 1 + h.PctChange; growth > 0 && !math.IsInf(h.PctChange, 0) && !math.IsNaN(h.PctChange) {
+		prior = float64(h.Shares) / growth
+	}
+	*priorShares += prior
+	p.SharesChange += h.Shares - int64(math.Round(prior))
+}
+
+// finishPeriod computes the ratios of p once all holdings are added.
+func finishPeriod(p *models.OwnershipPeriod, priorShares float64) {
+	if priorShares > 0 {
+		p.Accumulation = float64(p.SharesChange) / priorShares
+	}
+	if p.Holders > 0 {
+		p.Breadth = float64(p.Increased-p.Decreased) / float64(p.Holders)
+	}
+}
+
+// InsiderActivityByQuarter aggregates [Ticker.InsiderTransactions] into
+// calendar quarters, oldest first, with purchase and sale totals and a net
+// score weighted by count and by value. Unlike [Ticker.InsiderPurchases],
+// which covers a single recent period, it shows how insider sentiment
+// changed over time. Quarters without purchases or sales are omitted.
+//
+// Example:
+//
+//	quarters, err := ticker.InsiderActivityByQuarter()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, q := range quarters {
+//	    fmt.Printf("%s: net %+.2f by count, %+.2f by value\n", q.Quarter, q.CountScore, q.ValueScore)
+//	}
+func (t *Ticker) InsiderActivityByQuarter() ([]models.InsiderActivity, error) {
+	transactions, err := t.InsiderTransactions()
+	if err != nil {
+		return nil, err
+	}
+	return insiderActivity(transactions), nil
+}
+
+// insiderActivity groups transactions by calendar quarter. Transactions
+// without a date are skipped.
+func insiderActivity(transactions []models.InsiderTransaction) []models.InsiderActivity {
+	byQuarter := make(map[time.Time]*models.InsiderActivity)
+	for _, tx := range transactions {
+		if tx.StartDate.IsZero() {
+			continue
+		}
+		d := tx.StartDate.UTC()
+		start := time.Date(d.Year(), d.Month()-(d.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+		q := byQuarter[start]
+		if q == nil {
+			q = &models.InsiderActivity{
+				Quarter: fmt.Sprintf("%dQ%d", start.Year(), (int(start.Month())-1)/3+1),
+				Start:   start,
+			}
+			byQuarter[start] = q
+		}
+
+		switch insiderDirection(tx) {
+		case 1:
+			addInsiderTrade(&q.Purchases, tx)
+		case -1:
+			addInsiderTrade(&q.Sales, tx)
+		default:
+			q.Other++
+		}
+	}
+
+	result := make([]models.InsiderActivity, 0, len(byQuarter))
+	for _, q := range byQuarter {
+		buys, sells := q.Purchases, q.Sales
+		n := buys.Transactions + sells.Transactions
+		if n == 0 {
+			continue
+		}
+		q.CountScore = float64(buys.Transactions-sells.Transactions) / float64(n)
+		if v := buys.Value + sells.Value; v > 0 {
+			q.ValueScore = (buys.Value - sells.Value) / v
+		}
+		result = append(result, *q)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return result
+}
+
+// insiderDirection classifies a transaction from its description as a
+// purchase (1), a sale (-1) or neither (0).
+func insiderDirection(tx models.InsiderTransaction) int {
+	text := strings.ToLower(tx.Transaction + " " + tx.Text)
+	switch {
+	case strings.Contains(text, "purchase") || strings.Contains(text, "buy"):
+		return 1
+	case strings.Contains(text, "sale") || strings.Contains(text, "sell"):
+		return -1
+	}
+	return 0
+}
+
+func addInsiderTrade(totals *models.InsiderTradeTotals, tx models.InsiderTransaction) {
+	totals.Transactions++
+	totals.Shares += tx.Shares
+	totals.Value += math.Abs(tx.Value)
+}
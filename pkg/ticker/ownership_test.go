@@ -0,0 +1,104 @@
+package ticker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestOwnershipTrend(t *testing.T) {
+	june := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	// Same instant as june, in a local zone
+	juneLocal := june.In(time.FixedZone("EDT", -4*3600))
+
+	holders := []models.Holder{
+		{DateReported: june, Holder: "A", Shares: 1100, Value: 110, PctHeld: 0.011, PctChange: 0.1},
+		{DateReported: juneLocal, Holder: "B", Shares: 900, Value: 90, PctHeld: 0.009, PctChange: -0.1},
+		{DateReported: june, Holder: "C", Shares: 600, Value: 60, PctHeld: 0.006, PctChange: 0.2},
+		{DateReported: march, Holder: "D", Shares: 500, Value: 50, PctHeld: 0.005},
+	}
+
+	trend := ownershipTrend(holders)
+	if len(trend.Periods) != 2 {
+		t.Fatalf("expected 2 periods, got %+v", trend.Periods)
+	}
+
+	first, last := trend.Periods[0], trend.Periods[1]
+	if !first.ReportDate.Equal(march) || first.Holders != 1 || first.Unchanged != 1 || first.SharesChange != 0 || first.Breadth != 0 {
+		t.Errorf("unexpected march period %+v", first)
+	}
+	if !last.ReportDate.Equal(june) || last.Holders != 3 || last.Shares != 2600 || last.Increased != 2 || last.Decreased != 1 {
+		t.Errorf("unexpected june period %+v", last)
+	}
+	if last.SharesChange != 100 || math.Abs(last.Accumulation-0.04) > 1e-9 || math.Abs(last.Breadth-1.0/3) > 1e-9 {
+		t.Errorf("expected +100 shares, 4%% accumulation and 1/3 breadth, got %+v", last)
+	}
+
+	total := trend.Total
+	if !total.ReportDate.Equal(june) || total.Holders != 4 || total.Shares != 3100 || math.Abs(total.Value-310) > 1e-9 {
+		t.Errorf("unexpected total %+v", total)
+	}
+	if total.SharesChange != 100 || math.Abs(total.Accumulation-1.0/30) > 1e-9 || total.Breadth != 0.25 {
+		t.Errorf("unexpected total indicator %+v", total)
+	}
+	if !trend.Accumulating() || trend.Distributing() {
+		t.Error("expected accumulation")
+	}
+}
+
+func TestOwnershipTrendFullExit(t *testing.T) {
+	trend := ownershipTrend([]models.Holder{
+		{DateReported: time.Unix(1719705600, 0), Shares: 0, PctChange: -1},
+		{DateReported: time.Unix(1719705600, 0), Shares: 800, PctChange: -0.2},
+	})
+
+	total := trend.Total
+	if total.Decreased != 2 || total.SharesChange != -200 || total.Accumulation != -0.2 || total.Breadth != -1 {
+		t.Errorf("unexpected total %+v", total)
+	}
+	if !trend.Distributing() {
+		t.Error("expected distribution")
+	}
+}
+
+func TestInsiderActivity(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 12, 0, 0, 0, time.UTC) }
+	transactions := []models.InsiderTransaction{
+		{StartDate: day(2024, 8, 1), Text: "Sale at price 220.00 per share.", Shares: 1000, Value: 220000},
+		{StartDate: day(2024, 9, 30), Text: "Sale at price 230.00 per share.", Shares: 500, Value: 115000},
+		{StartDate: day(2024, 7, 15), Text: "Purchase at price 210.00 per share.", Shares: 100, Value: 21000},
+		{StartDate: day(2024, 7, 20), Text: "Stock Award(Grant) at price 0.00 per share.", Shares: 5000},
+		{StartDate: day(2024, 2, 10), Transaction: "Purchase", Shares: 200, Value: 36000},
+		{StartDate: day(2023, 12, 5), Text: "Stock Gift", Shares: 50},
+		{Text: "Sale without a date", Shares: 1, Value: 1},
+	}
+
+	quarters := insiderActivity(transactions)
+	if len(quarters) != 2 {
+		t.Fatalf("expected 2 quarters with trades, got %d: %+v", len(quarters), quarters)
+	}
+
+	q1, q3 := quarters[0], quarters[1]
+	if q1.Quarter != "2024Q1" || !q1.Start.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected first quarter: %+v", q1)
+	}
+	if q1.CountScore != 1 || q1.ValueScore != 1 {
+		t.Errorf("expected all-buy quarter to score 1, got %+v", q1)
+	}
+
+	if q3.Quarter != "2024Q3" || q3.Purchases.Transactions != 1 || q3.Sales.Transactions != 2 || q3.Other != 1 {
+		t.Errorf("unexpected third quarter totals: %+v", q3)
+	}
+	if q3.Sales.Shares != 1500 || q3.Sales.Value != 335000 {
+		t.Errorf("unexpected sale totals: %+v", q3.Sales)
+	}
+	if math.Abs(q3.CountScore-(-1.0/3)) > 1e-9 {
+		t.Errorf("expected count score -1/3, got %v", q3.CountScore)
+	}
+	if want := (21000.0 - 335000) / 356000; math.Abs(q3.ValueScore-want) > 1e-9 {
+		t.Errorf("expected value score %v, got %v", want, q3.ValueScore)
+	}
+}
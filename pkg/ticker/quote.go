@@ -1,26 +1,107 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/config"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Quote fetches the current quote for the ticker.
 func (t *Ticker) Quote() (*models.Quote, error) {
+	return t.QuoteContext(context.Background())
+}
+
+// QuoteContext is like [Ticker.Quote] but returns ctx's error as soon as
+// ctx is done, e.g. when its deadline passes before Yahoo answers.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+//	defer cancel()
+//	quote, err := t.QuoteContext(ctx)
+func (t *Ticker) QuoteContext(ctx context.Context) (*models.Quote, error) {
+	results, err := t.fetchQuoteResults(ctx, t.symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, client.WrapNotFoundError(t.symbol)
+	}
+
+	quote := quoteFromResult(results[0])
+
+	// Cache the quote
+	t.mu.Lock()
+	t.quoteCache = quote
+	t.mu.Unlock()
+
+	return quote, nil
+}
+
+// maxQuoteBatch is the number of symbols requested per quote API call.
+const maxQuoteBatch = 50
+
+// Quotes fetches the current quotes of several symbols, requesting up to
+// 50 symbols per API call instead of one call per symbol. Options are the
+// same as for [New].
+//
+// Symbols Yahoo does not know are missing from the returned map, which is
+// keyed by upper-case symbol. Returned quotes are not cached.
+//
+// Example:
+//
+//	quotes, err := ticker.Quotes([]string{"AAPL", "MSFT", "GOOGL"})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for sym, q := range quotes {
+//	    fmt.Printf("%s: %.2f\n", sym, q.RegularMarketPrice)
+//	}
+func Quotes(symbols []string, opts ...Option) (map[string]*models.Quote, error) {
+	symbols = utils.NormalizeSymbols(symbols)
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("at least one symbol is required")
+	}
+
+	t, err := New(symbols[0], opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer t.Close()
+
+	quotes := make(map[string]*models.Quote, len(symbols))
+	for _, batch := range quoteBatches(symbols, maxQuoteBatch) {
+		results, err := t.fetchQuoteResults(context.Background(), strings.Join(batch, ","))
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range results {
+			quotes[strings.ToUpper(r.Symbol)] = quoteFromResult(r)
+		}
+	}
+	return quotes, nil
+}
+
+// fetchQuoteResults calls the quote API for a comma-separated symbol list.
+func (t *Ticker) fetchQuoteResults(ctx context.Context, symbols string) ([]models.QuoteResult, error) {
 	params := url.Values{}
-	params.Set("symbols", t.symbol)
+	params.Set("symbols", symbols)
 	params.Set("formatted", "false")
 	lang, region := config.Get().GetLocale()
 	params.Set("lang", lang)
 	params.Set("region", region)
 
-	resp, err := t.getWithCrumb(t.quoteURL(), params)
+	resp, err := t.getWithCrumb(ctx, t.quoteURL(), params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch quote: %w", err)
 	}
@@ -31,16 +112,27 @@ func (t *Ticker) Quote() (*models.Quote, error) {
 	}
 
 	if quoteResp.QuoteResponse.Error != nil {
-		return nil, fmt.Errorf("API error: %s", quoteResp.QuoteResponse.Error.Description)
+		return nil, yferr.API("", quoteResp.QuoteResponse.Error.Code, quoteResp.QuoteResponse.Error.Description)
 	}
+	return quoteResp.QuoteResponse.Result, nil
+}
 
-	if len(quoteResp.QuoteResponse.Result) == 0 {
-		return nil, client.WrapNotFoundError(t.symbol)
+// quoteBatches splits symbols into chunks of at most size.
+func quoteBatches(symbols []string, size int) [][]string {
+	var batches [][]string
+	for len(symbols) > size {
+		batches = append(batches, symbols[:size])
+		symbols = symbols[size:]
 	}
+	if len(symbols) > 0 {
+		batches = append(batches, symbols)
+	}
+	return batches
+}
 
-	result := quoteResp.QuoteResponse.Result[0]
-
-	quote := &models.Quote{
+// quoteFromResult converts a quote API result to a Quote.
+func quoteFromResult(result models.QuoteResult) *models.Quote {
+	return &models.Quote{
 		Symbol:                      result.Symbol,
 		ShortName:                   result.ShortName,
 		LongName:                    result.LongName,
@@ -49,6 +141,7 @@ func (t *Ticker) Quote() (*models.Quote, error) {
 		ExchangeName:                result.FullExchangeName,
 		ExchangeTimezoneName:        result.ExchangeTimezoneName,
 		Currency:                    result.Currency,
+		FinancialCurrency:           result.FinancialCurrency,
 		RegularMarketPrice:          result.RegularMarketPrice,
 		RegularMarketChange:         result.RegularMarketChange,
 		RegularMarketChangePercent:  result.RegularMarketChangePercent,
@@ -57,15 +150,15 @@ func (t *Ticker) Quote() (*models.Quote, error) {
 		RegularMarketOpen:           result.RegularMarketOpen,
 		RegularMarketPreviousClose:  result.RegularMarketPreviousClose,
 		RegularMarketVolume:         result.RegularMarketVolume,
-		RegularMarketTime:           time.Unix(result.RegularMarketTime, 0),
+		RegularMarketTime:           unixTime(result.RegularMarketTime),
 		PreMarketPrice:              result.PreMarketPrice,
 		PreMarketChange:             result.PreMarketChange,
 		PreMarketChangePercent:      result.PreMarketChangePercent,
-		PreMarketTime:               time.Unix(result.PreMarketTime, 0),
+		PreMarketTime:               unixTime(result.PreMarketTime),
 		PostMarketPrice:             result.PostMarketPrice,
 		PostMarketChange:            result.PostMarketChange,
 		PostMarketChangePercent:     result.PostMarketChangePercent,
-		PostMarketTime:              time.Unix(result.PostMarketTime, 0),
+		PostMarketTime:              unixTime(result.PostMarketTime),
 		FiftyTwoWeekHigh:            result.FiftyTwoWeekHigh,
 		FiftyTwoWeekLow:             result.FiftyTwoWeekLow,
 		FiftyTwoWeekChangePerc:      result.FiftyTwoWeekChangePercent,
@@ -95,57 +188,152 @@ func (t *Ticker) Quote() (*models.Quote, error) {
 		AskSize:                     result.AskSize,
 		MarketState:                 result.MarketState,
 	}
-
-	// Cache the quote
-	t.mu.Lock()
-	t.quoteCache = quote
-	t.mu.Unlock()
-
-	return quote, nil
 }
 
 // FastInfo returns a FastInfo struct with commonly used data.
-// This fetches data from the history endpoint which can be faster for some fields.
+//
+// It makes two requests. Like Python yfinance's fast_info, price-derived
+// fields are computed from a one-year daily chart request:
+//   - LastPrice, Open, DayHigh, DayLow, LastVolume: latest bar
+//   - PreviousClose: close of the bar before the latest one
+//   - FiftyDayAverage, TwoHundredDayAverage: mean of the last 50/200 closes
+//   - TenDayAverageVolume, ThreeMonthAverageVolume: mean volume over the window
+//   - YearHigh, YearLow, YearChange: over the one-year window
+//
+// Shares then comes from a quote request, and MarketCap is derived as
+// Shares × LastPrice. If only the quote fails, the chart-derived info is
+// returned together with the error, with Shares and MarketCap left zero.
+//
+// Example:
+//
+//	fi, err := t.FastInfo()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%.2f (52w %.2f - %.2f)\n", fi.LastPrice, fi.YearLow, fi.YearHigh)
 func (t *Ticker) FastInfo() (*models.FastInfo, error) {
-	// First, ensure we have history metadata
-	if t.GetHistoryMetadata() == nil {
-		_, err := t.History(models.HistoryParams{Period: "5d", Interval: "1d"})
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch history for fast info: %w", err)
-		}
+	bars, err := t.History(models.HistoryParams{Period: "1y", Interval: "1d"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch history for fast info: %w", err)
 	}
 
 	meta := t.GetHistoryMetadata()
 	if meta == nil {
-		return nil, fmt.Errorf("no metadata available")
+		return nil, yferr.NoData("no metadata available")
 	}
 
-	// Get latest quote data
+	info := fastInfoFromHistory(meta, bars)
+
 	quote, err := t.Quote()
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch quote: %w", err)
+		return info, fmt.Errorf("failed to fetch shares for fast info: %w", err)
+	}
+	info.Shares = quote.SharesOutstanding
+	info.MarketCap = float64(info.Shares) * info.LastPrice
+
+	return info, nil
+}
+
+// fastInfoFromHistory derives FastInfo fields from chart metadata and
+// one year of daily bars.
+func fastInfoFromHistory(meta *models.ChartMeta, bars []models.Bar) *models.FastInfo {
+	info := &models.FastInfo{
+		Currency:      meta.Currency,
+		QuoteType:     meta.InstrumentType,
+		Exchange:      meta.ExchangeName,
+		Timezone:      meta.ExchangeTimezoneName,
+		LastPrice:     meta.RegularMarketPrice,
+		PreviousClose: meta.ChartPreviousClose,
+	}
+	info.RegularMarketPreviousClose = meta.PreviousClose
+
+	if len(bars) == 0 {
+		if info.RegularMarketPreviousClose == 0 {
+			info.RegularMarketPreviousClose = info.PreviousClose
+		}
+		return info
+	}
+
+	last := bars[len(bars)-1]
+	if !models.IsMissing(last.Close) {
+		info.LastPrice = last.Close
+	}
+	info.Open = last.Open
+	info.DayHigh = last.High
+	info.DayLow = last.Low
+	info.LastVolume = last.Volume
+
+	if len(bars) > 1 && !models.IsMissing(bars[len(bars)-2].Close) {
+		info.PreviousClose = bars[len(bars)-2].Close
+	}
+	if info.RegularMarketPreviousClose == 0 {
+		info.RegularMarketPreviousClose = info.PreviousClose
+	}
+
+	closes := make([]float64, 0, len(bars))
+	for _, bar := range bars {
+		if !models.IsMissing(bar.Close) {
+			closes = append(closes, bar.Close)
+		}
 	}
+	info.FiftyDayAverage = meanOfLast(closes, 50)
+	info.TwoHundredDayAverage = meanOfLast(closes, 200)
 
-	return &models.FastInfo{
-		Currency:                   meta.Currency,
-		QuoteType:                  meta.InstrumentType,
-		Exchange:                   meta.ExchangeName,
-		Timezone:                   meta.ExchangeTimezoneName,
-		Shares:                     quote.SharesOutstanding,
-		MarketCap:                  float64(quote.MarketCap),
-		LastPrice:                  quote.RegularMarketPrice,
-		PreviousClose:              quote.RegularMarketPreviousClose,
-		Open:                       quote.RegularMarketOpen,
-		DayHigh:                    quote.RegularMarketDayHigh,
-		DayLow:                     quote.RegularMarketDayLow,
-		RegularMarketPreviousClose: quote.RegularMarketPreviousClose,
-		LastVolume:                 quote.RegularMarketVolume,
-		FiftyDayAverage:            quote.FiftyDayAverage,
-		TwoHundredDayAverage:       quote.TwoHundredDayAverage,
-		TenDayAverageVolume:        quote.AverageDailyVolume10Day,
-		ThreeMonthAverageVolume:    quote.AverageDailyVolume3Month,
-		YearHigh:                   quote.FiftyTwoWeekHigh,
-		YearLow:                    quote.FiftyTwoWeekLow,
-		YearChange:                 quote.FiftyTwoWeekChangePerc / 100, // Convert from percentage
-	}, nil
+	volumes := make([]float64, len(bars))
+	for i, bar := range bars {
+		volumes[i] = float64(bar.Volume)
+	}
+	info.TenDayAverageVolume = int64(meanOfLast(volumes, 10))
+
+	threeMonthsAgo := last.Date.AddDate(0, -3, 0)
+	var volSum float64
+	var volCount int
+	for _, bar := range bars {
+		if !bar.Date.Before(threeMonthsAgo) {
+			volSum += float64(bar.Volume)
+			volCount++
+		}
+	}
+	if volCount > 0 {
+		info.ThreeMonthAverageVolume = int64(volSum / float64(volCount))
+	}
+
+	for _, bar := range bars {
+		if !models.IsMissing(bar.High) && bar.High > info.YearHigh {
+			info.YearHigh = bar.High
+		}
+		if !models.IsMissing(bar.Low) && (info.YearLow == 0 || bar.Low < info.YearLow) {
+			info.YearLow = bar.Low
+		}
+	}
+
+	if len(closes) > 1 && closes[0] != 0 {
+		info.YearChange = closes[len(closes)-1]/closes[0] - 1
+	}
+
+	return info
+}
+
+// unixTime converts a Unix timestamp, mapping a missing (zero) value to the
+// zero time rather than the Unix epoch.
+func unixTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// meanOfLast returns the mean of the last n values (or all values if fewer).
+func meanOfLast(values []float64, n int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	if len(values) > n {
+		values = values[len(values)-n:]
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
 }
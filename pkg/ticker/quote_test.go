@@ -0,0 +1,107 @@
+package ticker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestFastInfoFromHistory(t *testing.T) {
+	meta := &models.ChartMeta{
+		Currency:             "USD",
+		InstrumentType:       "EQUITY",
+		ExchangeName:         "NMS",
+		ExchangeTimezoneName: "America/New_York",
+		RegularMarketPrice:   999,
+		PreviousClose:        0,
+	}
+
+	start := time.Date(2024, 1, 1, 14, 30, 0, 0, time.UTC)
+	bars := make([]models.Bar, 0, 250)
+	for i := 0; i < 250; i++ {
+		price := float64(100 + i)
+		bars = append(bars, models.Bar{
+			Date:   start.AddDate(0, 0, i),
+			Open:   price - 1,
+			High:   price + 2,
+			Low:    price - 2,
+			Close:  price,
+			Volume: int64(1000 + i),
+		})
+	}
+
+	info := fastInfoFromHistory(meta, bars)
+
+	if info.Currency != "USD" || info.Exchange != "NMS" || info.Timezone != "America/New_York" {
+		t.Errorf("unexpected metadata fields: %+v", info)
+	}
+	if info.LastPrice != 349 {
+		t.Errorf("LastPrice = %v, want 349", info.LastPrice)
+	}
+	if info.PreviousClose != 348 || info.RegularMarketPreviousClose != 348 {
+		t.Errorf("PreviousClose = %v/%v, want 348", info.PreviousClose, info.RegularMarketPreviousClose)
+	}
+	if info.Open != 348 || info.DayHigh != 351 || info.DayLow != 347 || info.LastVolume != 1249 {
+		t.Errorf("unexpected latest bar fields: %+v", info)
+	}
+	// Mean of closes 300..349
+	if info.FiftyDayAverage != 324.5 {
+		t.Errorf("FiftyDayAverage = %v, want 324.5", info.FiftyDayAverage)
+	}
+	// Mean of closes 150..349
+	if info.TwoHundredDayAverage != 249.5 {
+		t.Errorf("TwoHundredDayAverage = %v, want 249.5", info.TwoHundredDayAverage)
+	}
+	if info.TenDayAverageVolume != 1244 {
+		t.Errorf("TenDayAverageVolume = %v, want 1244", info.TenDayAverageVolume)
+	}
+	if info.ThreeMonthAverageVolume <= info.TenDayAverageVolume-100 {
+		t.Errorf("unexpected ThreeMonthAverageVolume: %v", info.ThreeMonthAverageVolume)
+	}
+	if info.YearHigh != 351 || info.YearLow != 98 {
+		t.Errorf("YearHigh/Low = %v/%v, want 351/98", info.YearHigh, info.YearLow)
+	}
+	if math.Abs(info.YearChange-(349.0/100.0-1)) > 1e-12 {
+		t.Errorf("YearChange = %v, want %v", info.YearChange, 349.0/100.0-1)
+	}
+}
+
+func TestFastInfoFromHistoryEmpty(t *testing.T) {
+	meta := &models.ChartMeta{RegularMarketPrice: 10, ChartPreviousClose: 9}
+
+	info := fastInfoFromHistory(meta, nil)
+	if info.LastPrice != 10 || info.PreviousClose != 9 || info.RegularMarketPreviousClose != 9 {
+		t.Errorf("expected metadata fallbacks, got %+v", info)
+	}
+}
+
+func TestQuoteBatches(t *testing.T) {
+	symbols := []string{"AAPL", "MSFT", "GOOG"}
+	batches := quoteBatches(symbols, 2)
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 || batches[1][0] != "GOOG" {
+		t.Errorf("quoteBatches = %v", batches)
+	}
+	if got := quoteBatches(nil, 2); len(got) != 0 {
+		t.Errorf("quoteBatches(nil) = %v", got)
+	}
+}
+
+func TestQuoteFromResult(t *testing.T) {
+	q := quoteFromResult(models.QuoteResult{
+		Symbol:             "SONY",
+		Currency:           "USD",
+		FinancialCurrency:  "JPY",
+		FullExchangeName:   "NYSE",
+		RegularMarketPrice: 90.5,
+		RegularMarketTime:  1700000000,
+		MarketState:        "REGULAR",
+	})
+	if q.Symbol != "SONY" || q.FinancialCurrency != "JPY" || q.ExchangeName != "NYSE" {
+		t.Errorf("unexpected quote: %+v", q)
+	}
+	if q.RegularMarketPrice != 90.5 || q.RegularMarketTime.Unix() != 1700000000 {
+		t.Errorf("price/time = %v/%v", q.RegularMarketPrice, q.RegularMarketTime)
+	}
+}
@@ -0,0 +1,176 @@
+package ticker
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// Ratios returns financial ratios computed per period from the income
+// statement and balance sheet.
+//
+// Parameters:
+//   - freq: "annual", "yearly", or "quarterly" (default: "annual")
+//
+// The latest period is cross-checked against the ratios Yahoo reports in
+// financialData; differences are listed in [models.FinancialRatios.Checks].
+// The cross-check is skipped if the info cannot be fetched.
+//
+// Example:
+//
+//	ratios, err := ticker.Ratios("annual")
+//	if latest := ratios.Latest(); latest != nil && latest.CurrentRatio != nil {
+//	    fmt.Printf("Current ratio: %.2f\n", *latest.CurrentRatio)
+//	}
+//	for _, c := range ratios.Checks {
+//	    fmt.Printf("%s: computed %.4f, reported %.4f\n", c.Name, c.Computed, c.Reported)
+//	}
+func (t *Ticker) Ratios(freq string) (*models.FinancialRatios, error) {
+	return t.RatiosContext(context.Background(), freq)
+}
+
+// RatiosContext is like [Ticker.Ratios] but returns ctx's error as soon as
+// ctx is done, including while fetching the info for the cross-check.
+func (t *Ticker) RatiosContext(ctx context.Context, freq string) (*models.FinancialRatios, error) {
+	freq = normalizeFrequency(freq)
+
+	income, err := t.IncomeStatementContext(ctx, freq)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := t.BalanceSheetContext(ctx, freq)
+	if err != nil {
+		return nil, err
+	}
+
+	ratios := computeRatios(income, balance)
+	ratios.Frequency = freq
+
+	if info, err := t.InfoContext(ctx); err == nil {
+		ratios.Checks = crossCheckRatios(ratios.Latest(), info, freq)
+	} else if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return ratios, nil
+}
+
+// computeRatios computes ratios for every date present in both statements,
+// so the latest period never mixes one statement's figures with the other's
+// missing ones.
+func computeRatios(income, balance *models.FinancialStatement) *models.FinancialRatios {
+	inBalance := make(map[int64]bool, len(balance.Dates))
+	for _, d := range balance.Dates {
+		inBalance[d.Unix()] = true
+	}
+	seen := make(map[int64]bool)
+	var dates []time.Time
+	for _, d := range income.Dates {
+		if inBalance[d.Unix()] && !seen[d.Unix()] {
+			seen[d.Unix()] = true
+			dates = append(dates, d)
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	ratios := &models.FinancialRatios{Periods: make([]models.PeriodRatios, 0, len(dates))}
+	for _, date := range dates {
+		get := func(fs *models.FinancialStatement, field string) (float64, bool) {
+			return fs.Get(field, date)
+		}
+
+		p := models.PeriodRatios{Date: date}
+
+		currentAssets, okCA := get(balance, "CurrentAssets")
+		currentLiab, okCL := get(balance, "CurrentLiabilities")
+		if okCA && okCL {
+			p.CurrentRatio = ratio(currentAssets, currentLiab)
+			inventory, _ := get(balance, "Inventory")
+			p.QuickRatio = ratio(currentAssets-inventory, currentLiab)
+		}
+
+		equity, okEq := get(balance, "StockholdersEquity")
+		if debt, ok := get(balance, "TotalDebt"); ok && okEq {
+			p.DebtToEquity = ratio(debt, equity)
+		}
+
+		netIncome, okNI := get(income, "NetIncome")
+		if okNI && okEq {
+			p.ReturnOnEquity = ratio(netIncome, equity)
+		}
+		if assets, ok := get(balance, "TotalAssets"); ok && okNI {
+			p.ReturnOnAssets = ratio(netIncome, assets)
+		}
+
+		if revenue, ok := get(income, "TotalRevenue"); ok {
+			if gross, ok := get(income, "GrossProfit"); ok {
+				p.GrossMargin = ratio(gross, revenue)
+			}
+			if operating, ok := get(income, "OperatingIncome"); ok {
+				p.OperatingMargin = ratio(operating, revenue)
+			}
+			if okNI {
+				p.NetMargin = ratio(netIncome, revenue)
+			}
+		}
+
+		ratios.Periods = append(ratios.Periods, p)
+	}
+
+	return ratios
+}
+
+// crossCheckRatios compares the latest ratios with financialData values.
+// Margins and returns on equity and assets are only compared for annual
+// periods, since financialData reports trailing twelve month values that a
+// single quarter does not match.
+func crossCheckRatios(latest *models.PeriodRatios, info *models.Info, freq string) []models.RatioCheck {
+	if latest == nil || info == nil {
+		return nil
+	}
+
+	type candidate struct {
+		name     string
+		computed *float64
+		reported float64
+	}
+	candidates := []candidate{
+		{"currentRatio", latest.CurrentRatio, info.CurrentRatio},
+		{"quickRatio", latest.QuickRatio, info.QuickRatio},
+		{"debtToEquity", latest.DebtToEquity, info.DebtToEquity / 100}, // reported in percent
+	}
+	if freq == "annual" {
+		candidates = append(candidates,
+			candidate{"grossMargin", latest.GrossMargin, info.GrossMargins},
+			candidate{"operatingMargin", latest.OperatingMargin, info.OperatingMargins},
+			candidate{"netMargin", latest.NetMargin, info.ProfitMargins},
+			candidate{"returnOnEquity", latest.ReturnOnEquity, info.ReturnOnEquity},
+			candidate{"returnOnAssets", latest.ReturnOnAssets, info.ReturnOnAssets},
+		)
+	}
+
+	var checks []models.RatioCheck
+	for _, c := range candidates {
+		if c.computed == nil || c.reported == 0 {
+			continue
+		}
+		checks = append(checks, models.RatioCheck{
+			Name:       c.name,
+			Computed:   *c.computed,
+			Reported:   c.reported,
+			Difference: *c.computed - c.reported,
+		})
+	}
+	return checks
+}
+
+// ratio returns num/den, or nil when den is zero.
+func ratio(num, den float64) *float64 {
+	if den == 0 {
+		return nil
+	}
+	v := num / den
+	return &v
+}
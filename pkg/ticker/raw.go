@@ -0,0 +1,123 @@
+package ticker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// WithRawResponses keeps the raw JSON of every quoteSummary module the
+// ticker fetches, so parsing problems can be reported with the exact
+// payload. Use [Ticker.RawModule] to read a module and
+// [Ticker.DumpRawResponses] to write them all to a directory.
+//
+// Example:
+//
+//	t, _ := ticker.New("AAPL", ticker.WithRawResponses())
+//	info, err := t.Info()
+//	if err != nil || info.Sector == "" {
+//	    paths, _ := t.DumpRawResponses("./yf-debug")
+//	    fmt.Println("attach to the bug report:", paths)
+//	}
+func WithRawResponses() Option {
+	return func(t *Ticker) {
+		t.keepRaw = true
+	}
+}
+
+// RawModule returns the raw JSON of a quoteSummary module such as
+// "assetProfile" from the latest fetch that included it. ok is false if the
+// module was not fetched or WithRawResponses is not set.
+func (t *Ticker) RawModule(name string) (json.RawMessage, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	raw, ok := t.rawModules[name]
+	return raw, ok
+}
+
+// RawModuleNames returns the names of the kept quoteSummary modules, sorted.
+func (t *Ticker) RawModuleNames() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	names := make([]string, 0, len(t.rawModules))
+	for name := range t.rawModules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DumpRawResponses writes each kept module to dir as
+// "<SYMBOL>-<module>.json", creating dir if needed, and returns the paths
+// written. Characters of the symbol that are unsafe in file names, such as
+// "^" and "=", are replaced by "_".
+func (t *Ticker) DumpRawResponses(dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create dump directory: %w", err)
+	}
+
+	t.mu.RLock()
+	modules := make(map[string]json.RawMessage, len(t.rawModules))
+	for name, raw := range t.rawModules {
+		modules[name] = raw
+	}
+	t.mu.RUnlock()
+
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, safeFileName(t.symbol)+"-"+safeFileName(name)+".json")
+		if err := os.WriteFile(path, modules[name], 0o644); err != nil {
+			return paths, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// keepRawSummary stores the modules of a quoteSummary response body when
+// WithRawResponses is set. Bodies that are not quoteSummary results are
+// ignored; the caller reports the parse error.
+func (t *Ticker) keepRawSummary(body string) {
+	if !t.keepRaw {
+		return
+	}
+
+	var resp struct {
+		QuoteSummary struct {
+			Result []map[string]json.RawMessage `json:"result"`
+		} `json:"quoteSummary"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil || len(resp.QuoteSummary.Result) == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.rawModules == nil {
+		t.rawModules = make(map[string]json.RawMessage)
+	}
+	for name, raw := range resp.QuoteSummary.Result[0] {
+		t.rawModules[name] = raw
+	}
+}
+
+// safeFileName replaces characters other than letters, digits, '.', '-'
+// and '_' with '_'.
+func safeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, s)
+}
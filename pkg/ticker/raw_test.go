@@ -0,0 +1,62 @@
+package ticker
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const rawSummaryBody = `{"quoteSummary":{"result":[{
+	"assetProfile":{"sector":"Technology"},
+	"summaryDetail":{"beta":{"raw":1.2,"fmt":"1.20"}}
+}],"error":null}}`
+
+func TestRawResponses(t *testing.T) {
+	tkr, err := New("^gspc", WithRawResponses())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	tkr.keepRawSummary(rawSummaryBody)
+	tkr.keepRawSummary(`{"quoteSummary":{"result":[{"esgScores":{"totalEsg":20}}]}}`)
+	tkr.keepRawSummary(`not json`)
+
+	if got := tkr.RawModuleNames(); !reflect.DeepEqual(got, []string{"assetProfile", "esgScores", "summaryDetail"}) {
+		t.Errorf("unexpected modules %v", got)
+	}
+	raw, ok := tkr.RawModule("assetProfile")
+	if !ok || string(raw) != `{"sector":"Technology"}` {
+		t.Errorf("unexpected assetProfile %s", raw)
+	}
+
+	dir := filepath.Join(t.TempDir(), "dump")
+	paths, err := tkr.DumpRawResponses(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 3 || filepath.Base(paths[0]) != "_GSPC-assetProfile.json" {
+		t.Fatalf("unexpected paths %v", paths)
+	}
+	data, err := os.ReadFile(paths[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"beta":{"raw":1.2,"fmt":"1.20"}}` {
+		t.Errorf("unexpected dump %s", data)
+	}
+}
+
+func TestRawResponsesDisabled(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	tkr.keepRawSummary(rawSummaryBody)
+	if _, ok := tkr.RawModule("assetProfile"); ok {
+		t.Error("expected no raw modules without WithRawResponses")
+	}
+}
@@ -0,0 +1,236 @@
+package ticker
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/stats"
+	"github.com/wnjoon/go-yfinance/pkg/utils"
+)
+
+const (
+	// tradingDaysPerYear annualizes daily volatility.
+	tradingDaysPerYear = 252
+
+	// minRiskObservations is the fewest daily returns a beta or volatility
+	// is computed from.
+	minRiskObservations = 10
+)
+
+// dailyClose is an adjusted close keyed by its exchange-local trading day.
+type dailyClose struct {
+	day   string // YYYY-MM-DD
+	date  time.Time
+	close float64
+}
+
+// Beta returns the historical beta of the ticker against benchmark, from
+// the daily returns of both over period (default "1y"). Unlike
+// [models.Info] Beta, which is a single value published by Yahoo, it can be
+// computed against any benchmark and window.
+//
+// Prices are adjusted for splits and dividends, and only days on which both
+// symbols traded are used, so listings on different exchanges can be
+// compared.
+//
+// Example:
+//
+//	b, err := t.Beta("^GSPC", "2y")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("beta %.2f, correlation %.2f over %d days\n", b.Beta, b.Correlation, b.Observations)
+func (t *Ticker) Beta(benchmark, period string) (*models.Beta, error) {
+	benchmark = strings.ToUpper(strings.TrimSpace(benchmark))
+	if benchmark == "" {
+		return nil, fmt.Errorf("benchmark symbol is required")
+	}
+	if period == "" {
+		period = "1y"
+	}
+
+	closes, err := t.dailyCloses(period)
+	if err != nil {
+		return nil, err
+	}
+
+	bench, err := New(benchmark, WithClient(t.client))
+	if err != nil {
+		return nil, err
+	}
+	defer bench.Close()
+	benchCloses, err := bench.dailyCloses(period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch benchmark %s: %w", benchmark, err)
+	}
+
+	beta, err := computeBeta(closes, benchCloses)
+	if err != nil {
+		return nil, err
+	}
+	beta.Symbol = t.symbol
+	beta.Benchmark = benchmark
+	beta.Period = period
+	return beta, nil
+}
+
+// RealizedVolatility returns the annualized volatility of the ticker's
+// daily log returns over period (default "1y"). A window of 2 or more
+// trading days also returns the rolling volatility series, e.g. 21 for
+// one-month volatility; 0 computes the period value only.
+//
+// Example:
+//
+//	vol, err := t.RealizedVolatility("1y", 21)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("1y vol %.1f%%\n", vol.Annualized*100)
+//	last := vol.Series[len(vol.Series)-1]
+//	fmt.Printf("21d vol on %s: %.1f%%\n", last.Date.Format("2006-01-02"), last.Value*100)
+func (t *Ticker) RealizedVolatility(period string, window int) (*models.Volatility, error) {
+	if window < 0 || window == 1 {
+		return nil, fmt.Errorf("volatility window must be 0 or at least 2, got %d", window)
+	}
+	if period == "" {
+		period = "1y"
+	}
+
+	closes, err := t.dailyCloses(period)
+	if err != nil {
+		return nil, err
+	}
+	vol, err := computeVolatility(closes, window)
+	if err != nil {
+		return nil, err
+	}
+	vol.Symbol = t.symbol
+	vol.Period = period
+	return vol, nil
+}
+
+// dailyCloses fetches adjusted daily closes over period.
+func (t *Ticker) dailyCloses(period string) ([]dailyClose, error) {
+	bars, err := t.History(models.HistoryParams{Period: period, Interval: "1d", AutoAdjust: true})
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if meta := t.GetHistoryMetadata(); meta != nil {
+		if l := utils.LoadLocation(meta.ExchangeTimezoneName); l != nil {
+			loc = l
+		}
+	}
+	return dailyClosesFromBars(bars, loc), nil
+}
+
+// dailyClosesFromBars keys the positive closes of bars by their calendar
+// day in loc. bars must be ordered by date.
+func dailyClosesFromBars(bars []models.Bar, loc *time.Location) []dailyClose {
+	closes := make([]dailyClose, 0, len(bars))
+	for _, b := range bars {
+		if !isFinitePositive(b.Close) {
+			continue
+		}
+		day := b.Date.In(loc).Format("2006-01-02")
+		// Keep the last bar of a day, e.g. a live bar after the daily one
+		if n := len(closes); n > 0 && closes[n-1].day == day {
+			closes = closes[:n-1]
+		}
+		closes = append(closes, dailyClose{day: day, date: b.Date, close: b.Close})
+	}
+	return closes
+}
+
+// computeBeta regresses the returns of symbol on those of bench over the
+// days both have a close.
+func computeBeta(symbol, bench []dailyClose) (*models.Beta, error) {
+	benchByDay := make(map[string]float64, len(bench))
+	for _, c := range bench {
+		benchByDay[c.day] = c.close
+	}
+
+	var pairs []dailyClose
+	var benchCloses []float64
+	for _, c := range symbol {
+		if b, ok := benchByDay[c.day]; ok {
+			pairs = append(pairs, c)
+			benchCloses = append(benchCloses, b)
+		}
+	}
+	symbolCloses := make([]float64, len(pairs))
+	for i, c := range pairs {
+		symbolCloses[i] = c.close
+	}
+
+	sr, br := stats.PctChange(symbolCloses), stats.PctChange(benchCloses)
+	var x, y []float64
+	for i := range sr {
+		if !math.IsNaN(sr[i]) && !math.IsNaN(br[i]) {
+			x = append(x, sr[i])
+			y = append(y, br[i])
+		}
+	}
+	if len(x) < minRiskObservations {
+		return nil, fmt.Errorf("not enough overlapping history: %d daily returns, need %d", len(x), minRiskObservations)
+	}
+
+	variance := stats.Covariance(y, y, 1)
+	if variance == 0 {
+		return nil, fmt.Errorf("benchmark returns have no variance")
+	}
+	return &models.Beta{
+		Start:        pairs[0].date,
+		End:          pairs[len(pairs)-1].date,
+		Observations: len(x),
+		Beta:         stats.Covariance(x, y, 1) / variance,
+		Correlation:  stats.Correlation(x, y),
+	}, nil
+}
+
+// computeVolatility computes the annualized volatility of closes and, when
+// window is set, its trailing rolling series.
+func computeVolatility(closes []dailyClose, window int) (*models.Volatility, error) {
+	prices := make([]float64, len(closes))
+	for i, c := range closes {
+		prices[i] = c.close
+	}
+
+	var (
+		returns []float64
+		dates   []time.Time
+	)
+	for i, r := range stats.LogReturns(prices) {
+		if !math.IsNaN(r) {
+			returns = append(returns, r)
+			dates = append(dates, closes[i+1].date)
+		}
+	}
+	if len(returns) < minRiskObservations {
+		return nil, fmt.Errorf("not enough history: %d daily returns, need %d", len(returns), minRiskObservations)
+	}
+	if window > len(returns) {
+		return nil, fmt.Errorf("volatility window %d exceeds the %d daily returns in the period", window, len(returns))
+	}
+
+	annualize := math.Sqrt(tradingDaysPerYear)
+	vol := &models.Volatility{
+		Window:       window,
+		Observations: len(returns),
+		Annualized:   stats.Std(returns, 1) * annualize,
+	}
+	if window > 0 {
+		vol.Series = make([]models.VolatilityPoint, 0, len(returns)-window+1)
+		for end := window; end <= len(returns); end++ {
+			vol.Series = append(vol.Series, models.VolatilityPoint{
+				Date:  dates[end-1],
+				Value: stats.Std(returns[end-window:end], 1) * annualize,
+			})
+		}
+	}
+	return vol, nil
+}
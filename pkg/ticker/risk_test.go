@@ -0,0 +1,128 @@
+package ticker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// riskCloses builds daily closes from start whose simple returns are rets.
+func riskCloses(start time.Time, rets []float64) []dailyClose {
+	price := 100.0
+	closes := []dailyClose{{day: start.Format("2006-01-02"), date: start, close: price}}
+	for i, r := range rets {
+		price *= 1 + r
+		d := start.AddDate(0, 0, i+1)
+		closes = append(closes, dailyClose{day: d.Format("2006-01-02"), date: d, close: price})
+	}
+	return closes
+}
+
+func TestComputeBeta(t *testing.T) {
+	start := time.Date(2024, 1, 1, 14, 30, 0, 0, time.UTC)
+	var benchRets, symRets []float64
+	for i := 0; i < 30; i++ {
+		r := 0.01 * float64(i%3-1)
+		benchRets = append(benchRets, r)
+		symRets = append(symRets, 2*r)
+	}
+	symbol := riskCloses(start, symRets)
+	bench := riskCloses(start, benchRets)
+	// A holiday on the benchmark exchange only: the pair straddling it drops out
+	bench = append(bench[:10:10], bench[11:]...)
+
+	beta, err := computeBeta(symbol, bench)
+	if err != nil {
+		t.Fatalf("computeBeta() error: %v", err)
+	}
+	if beta.Observations != 29 {
+		t.Errorf("Observations = %d, want 29", beta.Observations)
+	}
+	// Returns across the gap no longer scale exactly, so allow some slack
+	if math.Abs(beta.Beta-2) > 0.1 || beta.Correlation < 0.95 {
+		t.Errorf("Beta = %.4f, Correlation = %.4f, want about 2 and 1", beta.Beta, beta.Correlation)
+	}
+	if !beta.Start.Equal(start) || !beta.End.Equal(symbol[len(symbol)-1].date) {
+		t.Errorf("unexpected range %s - %s", beta.Start, beta.End)
+	}
+
+	if _, err := computeBeta(symbol[:5], bench); err == nil {
+		t.Error("expected error for too little overlapping history")
+	}
+	flat := riskCloses(start, make([]float64, 30))
+	if _, err := computeBeta(symbol, flat); err == nil {
+		t.Error("expected error for a constant benchmark")
+	}
+}
+
+func TestComputeVolatility(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rets := make([]float64, 40)
+	for i := range rets {
+		rets[i] = 0.01
+		if i%2 == 1 {
+			rets[i] = -0.01
+		}
+	}
+	closes := riskCloses(start, rets)
+
+	vol, err := computeVolatility(closes, 20)
+	if err != nil {
+		t.Fatalf("computeVolatility() error: %v", err)
+	}
+	if vol.Observations != 40 || vol.Window != 20 {
+		t.Errorf("unexpected counts %+v", vol)
+	}
+	// Alternating +-1% is about 1% daily, ~16% annualized
+	if vol.Annualized < 0.15 || vol.Annualized > 0.17 {
+		t.Errorf("Annualized = %.4f, want about 0.16", vol.Annualized)
+	}
+	if len(vol.Series) != 21 {
+		t.Fatalf("Series length = %d, want 21", len(vol.Series))
+	}
+	if !vol.Series[0].Date.Equal(closes[20].date) || !vol.Series[20].Date.Equal(closes[40].date) {
+		t.Errorf("series dates %s .. %s", vol.Series[0].Date, vol.Series[20].Date)
+	}
+
+	if v, err := computeVolatility(closes, 0); err != nil || v.Series != nil {
+		t.Errorf("window 0 should give no series, got %v, %v", v, err)
+	}
+	if _, err := computeVolatility(closes, 41); err == nil {
+		t.Error("expected error for window longer than the history")
+	}
+	if _, err := computeVolatility(closes[:5], 0); err == nil {
+		t.Error("expected error for too little history")
+	}
+}
+
+func TestDailyClosesFromBars(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	bars := []models.Bar{
+		{Date: time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC), Close: 100},
+		{Date: time.Date(2024, 1, 3, 14, 30, 0, 0, time.UTC), Close: 0},
+		{Date: time.Date(2024, 1, 4, 14, 30, 0, 0, time.UTC), Close: 101},
+		{Date: time.Date(2024, 1, 4, 20, 0, 0, 0, time.UTC), Close: 102},
+	}
+	closes := dailyClosesFromBars(bars, ny)
+	if len(closes) != 2 || closes[0].day != "2024-01-02" || closes[1].close != 102 {
+		t.Errorf("unexpected closes %+v", closes)
+	}
+}
+
+func TestRealizedVolatilityWindow(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+	for _, w := range []int{-1, 1} {
+		if _, err := tkr.RealizedVolatility("1y", w); err == nil {
+			t.Errorf("expected error for window %d", w)
+		}
+	}
+	if _, err := tkr.Beta(" ", "1y"); err == nil {
+		t.Error("expected error for empty benchmark")
+	}
+}
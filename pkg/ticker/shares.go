@@ -0,0 +1,112 @@
+package ticker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/internal/endpoints"
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// defaultSharesHistorySpan is the range of SharesHistory without a start,
+// about 18 months as in Python yfinance.
+const defaultSharesHistorySpan = 548 * 24 * time.Hour
+
+// SharesHistory returns the shares outstanding reported between start and
+// end, oldest first, like Python yfinance's get_shares_full. Yahoo reports
+// a count whenever it changes, so the dates are irregular and several
+// counts may share a day.
+//
+// A zero end means today; a zero start means about 18 months before end.
+// A symbol without share data returns an error matching [client.ErrNoData].
+//
+// Example:
+//
+//	end := time.Now()
+//	shares, err := t.SharesHistory(end.AddDate(-3, 0, 0), end)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	first, last := shares[0], shares[len(shares)-1]
+//	fmt.Printf("dilution: %.2f%%\n", (float64(last.Shares)/float64(first.Shares)-1)*100)
+func (t *Ticker) SharesHistory(start, end time.Time) ([]models.SharesCount, error) {
+	return t.SharesHistoryContext(context.Background(), start, end)
+}
+
+// SharesHistoryContext is like [Ticker.SharesHistory] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) SharesHistoryContext(ctx context.Context, start, end time.Time) ([]models.SharesCount, error) {
+	if end.IsZero() {
+		end = time.Now().UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	}
+	if start.IsZero() {
+		start = end.Add(-defaultSharesHistorySpan)
+	}
+	if !start.Before(end) {
+		return nil, fmt.Errorf("start %s must be before end %s", start.Format("2006-01-02"), end.Format("2006-01-02"))
+	}
+
+	params := url.Values{}
+	params.Set("symbol", t.symbol)
+	params.Set("period1", fmt.Sprintf("%d", start.Unix()))
+	params.Set("period2", fmt.Sprintf("%d", end.Unix()))
+
+	apiURL := fmt.Sprintf("%s/%s", endpoints.FundamentalsURL, url.PathEscape(t.symbol))
+	resp, err := t.getWithCrumb(ctx, apiURL, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch shares history: %w", err)
+	}
+	return parseSharesHistory(t.symbol, resp.Body)
+}
+
+// sharesResponse is the timeseries response without a type filter, which
+// carries the share counts in shares_out.
+type sharesResponse struct {
+	Timeseries struct {
+		Result []struct {
+			Timestamp []int64    `json:"timestamp"`
+			SharesOut []*float64 `json:"shares_out"`
+		} `json:"result"`
+		Error *struct {
+			Code        string `json:"code"`
+			Description string `json:"description"`
+		} `json:"error"`
+	} `json:"timeseries"`
+}
+
+// parseSharesHistory converts a shares timeseries response, skipping null
+// counts.
+func parseSharesHistory(symbol, body string) ([]models.SharesCount, error) {
+	var raw sharesResponse
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		return nil, client.WrapInvalidResponseError(err)
+	}
+	if raw.Timeseries.Error != nil {
+		return nil, yferr.API("shares history", raw.Timeseries.Error.Code, raw.Timeseries.Error.Description)
+	}
+	if len(raw.Timeseries.Result) == 0 || len(raw.Timeseries.Result[0].SharesOut) == 0 {
+		return nil, client.WrapNoDataError(symbol)
+	}
+
+	result := raw.Timeseries.Result[0]
+	shares := make([]models.SharesCount, 0, len(result.SharesOut))
+	for i, v := range result.SharesOut {
+		if v == nil || i >= len(result.Timestamp) {
+			continue
+		}
+		shares = append(shares, models.SharesCount{
+			Date:   time.Unix(result.Timestamp[i], 0).UTC(),
+			Shares: int64(*v),
+		})
+	}
+	sort.SliceStable(shares, func(i, j int) bool {
+		return shares[i].Date.Before(shares[j].Date)
+	})
+	return shares, nil
+}
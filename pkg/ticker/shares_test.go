@@ -0,0 +1,55 @@
+package ticker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+)
+
+func TestParseSharesHistory(t *testing.T) {
+	body := `{"timeseries":{"result":[{
+		"timestamp":[1704153600,1704067200,1704240000],
+		"shares_out":[15460000000,15461000000,null]
+	}],"error":null}}`
+
+	shares, err := parseSharesHistory("AAPL", body)
+	if err != nil {
+		t.Fatalf("parseSharesHistory() error: %v", err)
+	}
+	if len(shares) != 2 {
+		t.Fatalf("Expected null count to be skipped, got %d counts", len(shares))
+	}
+	if !shares[0].Date.Equal(time.Unix(1704067200, 0)) || shares[0].Shares != 15461000000 {
+		t.Errorf("Expected oldest count first, got %+v", shares[0])
+	}
+	if shares[1].Shares != 15460000000 {
+		t.Errorf("Unexpected second count: %+v", shares[1])
+	}
+}
+
+func TestParseSharesHistoryNoData(t *testing.T) {
+	_, err := parseSharesHistory("SPY", `{"timeseries":{"result":[{"timestamp":[]}],"error":null}}`)
+	if !errors.Is(err, client.ErrNoData) {
+		t.Errorf("Expected ErrNoData, got %v", err)
+	}
+
+	_, err = parseSharesHistory("SPY", `{"timeseries":{"result":null,"error":{"code":"Bad Request","description":"invalid"}}}`)
+	if err == nil || errors.Is(err, client.ErrNoData) {
+		t.Errorf("Expected API error, got %v", err)
+	}
+}
+
+func TestSharesHistoryInvalidRange(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	now := time.Now()
+	if _, err := tkr.SharesHistory(now, now.AddDate(0, -1, 0)); err == nil {
+		t.Error("Expected error when start is after end")
+	}
+}
@@ -0,0 +1,134 @@
+package ticker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/config"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// Sustainability fetches the ESG risk scores for the ticker. A not-found
+// error is returned for symbols without ESG coverage, such as most ETFs.
+//
+// Example:
+//
+//	esg, err := t.Sustainability()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("ESG risk %.1f (E %.1f, S %.1f, G %.1f)\n",
+//	    esg.TotalEsg, esg.EnvironmentScore, esg.SocialScore, esg.GovernanceScore)
+//	if p := esg.PeerEsg; p != nil {
+//	    fmt.Printf("%s peers (%d): %.1f-%.1f, avg %.1f\n", esg.PeerGroup, esg.PeerCount, p.Min, p.Max, p.Avg)
+//	}
+func (t *Ticker) Sustainability() (*models.ESGScores, error) {
+	return t.SustainabilityContext(context.Background())
+}
+
+// SustainabilityContext is like [Ticker.Sustainability] but returns ctx's
+// error as soon as ctx is done.
+func (t *Ticker) SustainabilityContext(ctx context.Context) (*models.ESGScores, error) {
+	t.mu.RLock()
+	if t.esgCache != nil {
+		defer t.mu.RUnlock()
+		return t.esgCache, nil
+	}
+	t.mu.RUnlock()
+
+	params := url.Values{}
+	params.Set("modules", "esgScores")
+	params.Set("corsDomain", "finance.yahoo.com")
+	params.Set("formatted", "false")
+	lang, region := config.Get().GetLocale()
+	params.Set("lang", lang)
+	params.Set("region", region)
+
+	resp, err := t.getWithCrumb(ctx, t.quoteSummaryURL(), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sustainability: %w", err)
+	}
+	t.keepRawSummary(resp.Body)
+
+	esg, err := t.parseSustainabilityResponse(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	t.esgCache = esg
+	t.mu.Unlock()
+
+	return esg, nil
+}
+
+func (t *Ticker) parseSustainabilityResponse(body string) (*models.ESGScores, error) {
+	var summaryResp models.QuoteSummaryResponse
+	if err := json.Unmarshal([]byte(body), &summaryResp); err != nil {
+		return nil, client.WrapInvalidResponseError(err)
+	}
+
+	if summaryResp.QuoteSummary.Error != nil {
+		return nil, yferr.API("", summaryResp.QuoteSummary.Error.Code, summaryResp.QuoteSummary.Error.Description)
+	}
+
+	if len(summaryResp.QuoteSummary.Result) == 0 || summaryResp.QuoteSummary.Result[0].EsgScores == nil {
+		return nil, client.WrapNotFoundError(t.symbol)
+	}
+
+	esg := summaryResp.QuoteSummary.Result[0].EsgScores
+	return &models.ESGScores{
+		TotalEsg:           getFloat64(esg, "totalEsg"),
+		EnvironmentScore:   getFloat64(esg, "environmentScore"),
+		SocialScore:        getFloat64(esg, "socialScore"),
+		GovernanceScore:    getFloat64(esg, "governanceScore"),
+		HighestControversy: getFloat64(esg, "highestControversy"),
+		Percentile:         getFloat64(esg, "percentile"),
+		PeerGroup:          getString(esg, "peerGroup"),
+		RatingYear:         getInt(esg, "ratingYear"),
+		RatingMonth:        getInt(esg, "ratingMonth"),
+
+		Performance: getString(esg, "esgPerformance"),
+		PeerCount:   getInt(esg, "peerCount"),
+
+		PeerEsg:                esgPeerRange(esg["peerEsgScorePerformance"]),
+		PeerEnvironment:        esgPeerRange(esg["peerEnvironmentPerformance"]),
+		PeerSocial:             esgPeerRange(esg["peerSocialPerformance"]),
+		PeerGovernance:         esgPeerRange(esg["peerGovernancePerformance"]),
+		PeerHighestControversy: esgPeerRange(esg["peerHighestControversyPerformance"]),
+
+		RelatedControversy: esgStrings(esg["relatedControversy"]),
+	}, nil
+}
+
+// esgPeerRange reads a {min, avg, max} peer performance object.
+func esgPeerRange(v interface{}) *models.ESGPeerRange {
+	m, ok := v.(map[string]interface{})
+	if !ok || len(m) == 0 {
+		return nil
+	}
+	return &models.ESGPeerRange{
+		Min: getFloat64(m, "min"),
+		Avg: getFloat64(m, "avg"),
+		Max: getFloat64(m, "max"),
+	}
+}
+
+// esgStrings reads a list of strings, skipping other values.
+func esgStrings(v interface{}) []string {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	var out []string
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -1,14 +1,18 @@
 package ticker
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
 	"github.com/wnjoon/go-yfinance/pkg/client"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 // Ticker represents a single stock/ETF/fund ticker.
@@ -16,12 +20,14 @@ type Ticker struct {
 	symbol string
 
 	// HTTP client and authentication
-	client *client.Client
-	auth   *client.AuthManager
+	client     *client.Client
+	auth       *client.AuthManager
+	ownsClient bool
 
 	// Cached data
 	mu                sync.RWMutex
 	infoCache         *models.Info
+	localeInfoCache   map[string]*models.Info // info by "lang/region"
 	quoteCache        *models.Quote
 	historyMeta       *models.ChartMeta
 	optionsCache      *optionsCache
@@ -32,9 +38,27 @@ type Ticker struct {
 	holdersCache      *holdersCache
 	calendarCache     *models.Calendar
 	newsCache         []models.NewsArticle
+	earningsCache     map[int][]models.EarningsDate // earnings dates by limit
+	fundsCache        *models.FundsData
+	isinCache         *string // "" when the symbol has no ISIN
+	esgCache          *models.ESGScores
 
-	// Ownership tracking for cleanup
-	ownsClient bool
+	// History fetches in flight, keyed by normalized params
+	historyCalls map[string]*historyCall
+
+	// Completed history fetches, kept for historyTTL with WithHistoryCache
+	historyTTL   time.Duration
+	historyCache map[string]historyEntry
+
+	// Check the symbol in New, set with WithValidation
+	validate bool
+
+	// Raw quoteSummary modules, kept with WithRawResponses
+	keepRaw    bool
+	rawModules map[string]json.RawMessage
+
+	// Requests made by this ticker
+	stats client.RequestStats
 }
 
 // Option is a function that configures a Ticker.
@@ -44,7 +68,41 @@ type Option func(*Ticker)
 func WithClient(c *client.Client) Option {
 	return func(t *Ticker) {
 		t.client = c
-		t.ownsClient = false
+	}
+}
+
+// WithHistoryCache keeps completed History results for ttl. Repeated calls
+// with identical parameters, common when several indicators are computed
+// from the same bars, are then served from memory, while calls with other
+// parameters still fetch. Each caller receives its own copy of the bars.
+// A ttl of zero or less disables the cache, which is the default.
+//
+// Example:
+//
+//	t, _ := ticker.New("AAPL", ticker.WithHistoryCache(time.Minute))
+//	params := models.HistoryParams{Period: "1y", Interval: "1d"}
+//	bars, _ := t.History(params) // fetches
+//	bars, _ = t.History(params)  // served from the cache
+func WithHistoryCache(ttl time.Duration) Option {
+	return func(t *Ticker) {
+		t.historyTTL = ttl
+	}
+}
+
+// WithValidation makes New check the symbol with [client.Client.QuoteType]
+// and fail with an error matching [client.ErrInvalidSymbol] for unknown
+// symbols. The resolved timezone and quote type are cached, so
+// [Ticker.Timezone] and [Ticker.QuoteType] need no further request.
+//
+// Example:
+//
+//	t, err := ticker.New("APPL", ticker.WithValidation())
+//	if client.IsInvalidSymbolError(err) {
+//	    log.Fatal("typo in symbol")
+//	}
+func WithValidation() Option {
+	return func(t *Ticker) {
+		t.validate = true
 	}
 }
 
@@ -55,24 +113,31 @@ func New(symbol string, opts ...Option) (*Ticker, error) {
 	}
 
 	t := &Ticker{
-		symbol:     strings.ToUpper(symbol),
-		ownsClient: true,
+		symbol: strings.ToUpper(symbol),
 	}
 
 	for _, opt := range opts {
 		opt(t)
 	}
 
-	// Create default client if not provided
+	// Use the shared default client if not provided
 	if t.client == nil {
 		var err error
-		t.client, err = client.New()
+		t.client, err = client.AcquireDefault()
 		if err != nil {
 			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
+		t.ownsClient = true
 	}
 
-	t.auth = client.NewAuthManager(t.client)
+	t.auth = t.client.Auth()
+
+	if t.validate {
+		if _, err := t.symbolType(context.Background()); err != nil {
+			t.Close()
+			return nil, fmt.Errorf("failed to validate %s: %w", t.symbol, err)
+		}
+	}
 
 	return t, nil
 }
@@ -82,32 +147,55 @@ func (t *Ticker) Symbol() string {
 	return t.symbol
 }
 
-// Close releases resources used by the Ticker.
-// If the client was created by the Ticker, it will be closed.
+// Close releases the shared [client.Default] client if the Ticker acquired
+// it. A client passed with WithClient is left open.
 func (t *Ticker) Close() {
-	if t.ownsClient && t.client != nil {
-		t.client.Close()
+	if t.ownsClient {
+		t.ownsClient = false
+		t.client.Release()
 	}
 }
 
-// getWithCrumb performs a GET request with crumb authentication.
-func (t *Ticker) getWithCrumb(rawURL string, params url.Values) (*client.Response, error) {
+// Stats returns the counters of HTTP requests made by this ticker, keyed by
+// endpoint category.
+//
+// Only the ticker's own data requests are counted; responses served from
+// the client's response cache are not. Authentication requests and requests
+// of other tickers sharing the client are reported by [client.Client.Stats].
+//
+// Example:
+//
+//	t.History(params)
+//	t.Info()
+//	fmt.Println(t.Stats().Counts()) // map[chart:1 quoteSummary:1]
+func (t *Ticker) Stats() *client.RequestStats {
+	return &t.stats
+}
+
+// withStats returns ctx with the ticker's stats attached, so the client
+// records the requests it makes but not the responses it serves from its
+// cache.
+func (t *Ticker) withStats(ctx context.Context) context.Context {
+	return client.WithStats(ctx, &t.stats)
+}
+
+// getWithCrumb performs a GET request with crumb authentication. The
+// request stops waiting for a response when ctx is done.
+func (t *Ticker) getWithCrumb(ctx context.Context, rawURL string, params url.Values) (*client.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	params, err := t.auth.AddCrumbToParams(params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get crumb: %w", err)
+		return nil, yferr.Wrap(yferr.ErrAuth, "failed to get crumb", err)
 	}
 
-	resp, err := t.client.Get(rawURL, params)
+	resp, err := t.client.GetContext(t.withStats(ctx), rawURL, params)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check for rate limiting
-	if resp.StatusCode == 429 {
-		return nil, client.WrapRateLimitError()
-	}
-
-	// Check for errors
+	// Check for errors, including rate limiting (429)
 	if resp.StatusCode >= 400 {
 		return nil, client.HTTPStatusToError(resp.StatusCode, resp.Body)
 	}
@@ -136,6 +224,7 @@ func (t *Ticker) ClearCache() {
 	defer t.mu.Unlock()
 
 	t.infoCache = nil
+	t.localeInfoCache = nil
 	t.quoteCache = nil
 	t.historyMeta = nil
 	t.optionsCache = nil
@@ -145,6 +234,11 @@ func (t *Ticker) ClearCache() {
 	t.holdersCache = nil
 	t.calendarCache = nil
 	t.newsCache = nil
+	t.earningsCache = nil
+	t.fundsCache = nil
+	t.isinCache = nil
+	t.esgCache = nil
+	t.historyCache = nil
 }
 
 // GetHistoryMetadata returns the cached history metadata.
@@ -1,7 +1,12 @@
 package ticker
 
 import (
+	"context"
+	"errors"
 	"testing"
+
+	"github.com/wnjoon/go-yfinance/pkg/cache"
+	"github.com/wnjoon/go-yfinance/pkg/models"
 )
 
 func TestNew(t *testing.T) {
@@ -245,3 +250,107 @@ func TestGetHistoryMetadata(t *testing.T) {
 // 	"VFIAX",                  // Mutual Fund (5 letters)
 // 	"^GSPC", "^DJI", "^IXIC", // Indices (Carat symbol handling)
 // }
+
+func TestTimezoneFromCache(t *testing.T) {
+	cache.ClearGlobal()
+	defer cache.ClearGlobal()
+
+	tkr, err := New("7203.T")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	rememberSymbolMeta("7203.T", "Asia/Tokyo", "EQUITY")
+
+	if tz, err := tkr.Timezone(); err != nil || tz != "Asia/Tokyo" {
+		t.Errorf("Timezone() = %q, %v", tz, err)
+	}
+	if qt, err := tkr.QuoteType(); err != nil || qt != "EQUITY" {
+		t.Errorf("QuoteType() = %q, %v", qt, err)
+	}
+	if n := tkr.Stats().Total(); n != 0 {
+		t.Errorf("cached metadata should not hit the network, got %d requests", n)
+	}
+
+	tkr.setHistoryMetadata(&models.ChartMeta{ExchangeTimezoneName: "Asia/Seoul"})
+	if tz, _ := tkr.Timezone(); tz != "Asia/Seoul" {
+		t.Errorf("history metadata should take precedence, got %q", tz)
+	}
+}
+
+func TestContextMethodsCancelled(t *testing.T) {
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tkr.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := tkr.QuoteContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("QuoteContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.HistoryContext(ctx, models.HistoryParams{Period: "1mo"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("HistoryContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.InfoContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("InfoContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.IncomeStatementContext(ctx, "quarterly"); !errors.Is(err, context.Canceled) {
+		t.Errorf("IncomeStatementContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.NewsContext(ctx, 5, models.NewsTabNews); !errors.Is(err, context.Canceled) {
+		t.Errorf("NewsContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.CapitalGainsContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("CapitalGainsContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.RecommendationsContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("RecommendationsContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.EPSTrendContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("EPSTrendContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.MajorHoldersContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("MajorHoldersContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.StrikesContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("StrikesContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.DividendsContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("DividendsContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.HistoryWithSummaryContext(ctx, models.HistoryParams{Period: "1mo"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("HistoryWithSummaryContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.RatiosContext(ctx, "annual"); !errors.Is(err, context.Canceled) {
+		t.Errorf("RatiosContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := tkr.DividendStreakContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("DividendStreakContext: expected context.Canceled, got %v", err)
+	}
+	if n := tkr.Stats().Total(); n != 0 {
+		t.Errorf("expected no requests after cancellation, got %d", n)
+	}
+}
+
+func TestWithValidation(t *testing.T) {
+	cache.ClearGlobal()
+	defer cache.ClearGlobal()
+
+	// Without network access the symbol cannot be resolved
+	if _, err := New("AAPL", WithValidation()); err == nil {
+		t.Error("expected New to fail when the symbol cannot be validated")
+	}
+
+	tkr, err := New("AAPL")
+	if err != nil {
+		t.Fatalf("New without validation should not make requests: %v", err)
+	}
+	defer tkr.Close()
+	if n := tkr.Stats().Total(); n != 0 {
+		t.Errorf("expected no requests without validation, got %d", n)
+	}
+}
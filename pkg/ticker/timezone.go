@@ -0,0 +1,135 @@
+package ticker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wnjoon/go-yfinance/pkg/cache"
+	"github.com/wnjoon/go-yfinance/pkg/client"
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
+)
+
+// Timezone returns the IANA name of the ticker's exchange timezone, e.g.
+// "America/New_York".
+//
+// The timezone is taken from this ticker's history metadata or from the
+// symbol metadata shared in the global cache (see [cache.SymbolTimezone]).
+// Only when neither has it is the symbol resolved with
+// [client.Client.QuoteType], and the result is cached for other Ticker
+// instances.
+//
+// Example:
+//
+//	tz, err := t.Timezone()
+//	loc, _ := time.LoadLocation(tz)
+func (t *Ticker) Timezone() (string, error) {
+	return t.TimezoneContext(context.Background())
+}
+
+// TimezoneContext is like [Ticker.Timezone] but returns ctx's error as soon
+// as ctx is done.
+func (t *Ticker) TimezoneContext(ctx context.Context) (string, error) {
+	return t.symbolMeta(ctx, "timezone", cache.SymbolTimezone, func(f symbolFields) string {
+		return f.timezone
+	})
+}
+
+// QuoteType returns the ticker's quote type, e.g. "EQUITY", "ETF" or
+// "CRYPTOCURRENCY". It is resolved and cached like [Ticker.Timezone].
+//
+// Example:
+//
+//	qt, err := t.QuoteType()
+func (t *Ticker) QuoteType() (string, error) {
+	return t.QuoteTypeContext(context.Background())
+}
+
+// QuoteTypeContext is like [Ticker.QuoteType] but returns ctx's error as
+// soon as ctx is done.
+func (t *Ticker) QuoteTypeContext(ctx context.Context) (string, error) {
+	return t.symbolMeta(ctx, "quote type", cache.SymbolQuoteType, func(f symbolFields) string {
+		return f.quoteType
+	})
+}
+
+// symbolFields are the symbol metadata fields resolved by symbolMeta.
+type symbolFields struct {
+	timezone  string
+	quoteType string
+}
+
+// symbolMeta resolves a symbol metadata field from the ticker, the global
+// cache or, failing both, a quoteType request.
+func (t *Ticker) symbolMeta(ctx context.Context, name string, cached func(string) (string, bool), field func(symbolFields) string) (string, error) {
+	if m := t.GetHistoryMetadata(); m != nil {
+		if v := field(symbolFields{m.ExchangeTimezoneName, m.InstrumentType}); v != "" {
+			return v, nil
+		}
+	}
+	if v, ok := cached(t.symbol); ok {
+		return v, nil
+	}
+
+	st, err := t.symbolType(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch %s: %w", name, err)
+	}
+	if v := field(symbolFields{st.Timezone, st.QuoteType}); v != "" {
+		return v, nil
+	}
+	return "", yferr.NoData("no %s for %s", name, t.symbol)
+}
+
+// symbolType resolves the symbol with the quoteType endpoint and shares the
+// result through the global cache.
+func (t *Ticker) symbolType(ctx context.Context) (*client.SymbolType, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	st, err := t.client.QuoteTypeContext(t.withStats(ctx), t.symbol)
+	if err != nil {
+		return nil, err
+	}
+	rememberSymbolMeta(t.symbol, st.Timezone, st.QuoteType)
+	return st, nil
+}
+
+// repairMeta fills in the timezone, quote type and currency that repair
+// relies on when the chart metadata lacks them, preferring an already
+// fetched Info over new requests.
+func (t *Ticker) repairMeta(ctx context.Context, meta models.ChartMeta) models.ChartMeta {
+	t.mu.RLock()
+	info := t.infoCache
+	t.mu.RUnlock()
+	if info != nil {
+		if meta.ExchangeTimezoneName == "" && meta.Timezone == "" {
+			meta.ExchangeTimezoneName = info.ExchangeTimezoneName
+		}
+		if meta.InstrumentType == "" {
+			meta.InstrumentType = info.QuoteType
+		}
+		if meta.Currency == "" {
+			meta.Currency = info.Currency
+		}
+	}
+
+	if meta.ExchangeTimezoneName == "" && meta.Timezone == "" {
+		if tz, err := t.TimezoneContext(ctx); err == nil {
+			meta.ExchangeTimezoneName = tz
+		}
+	}
+	if meta.InstrumentType == "" {
+		if qt, err := t.QuoteTypeContext(ctx); err == nil {
+			meta.InstrumentType = qt
+		}
+	}
+	return meta
+}
+
+// rememberSymbolMeta shares the symbol's timezone and quote type with other
+// Ticker instances through the global cache.
+func rememberSymbolMeta(symbol, tz, quoteType string) {
+	cache.SetSymbolTimezone(symbol, tz)
+	cache.SetSymbolQuoteType(symbol, quoteType)
+}
@@ -0,0 +1,97 @@
+package ticker
+
+import (
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// ttmQuarters is the number of quarters summed into one TTM value.
+const ttmQuarters = 4
+
+// Quarter spacing bounds. Fiscal quarters end 13 weeks apart, give or take
+// a week for 52/53-week years; anything wider means a quarter is missing.
+const (
+	ttmMinQuarterGap = 70 * 24 * time.Hour
+	ttmMaxQuarterGap = 110 * 24 * time.Hour
+)
+
+// ttmAveragedFields are per-share denominators that are averaged, not summed,
+// over the four quarters.
+var ttmAveragedFields = map[string]bool{
+	"BasicAverageShares":   true,
+	"DilutedAverageShares": true,
+}
+
+// computeTTM builds a trailing twelve month statement from a quarterly one.
+//
+// For every quarter that closes a run of four consecutive quarters, each
+// field gets the sum of those quarters (dated at the last one), so the
+// result is a rolling TTM series rather than just the latest value. Share
+// counts are averaged, BeginningCashPosition is taken from the first
+// quarter and EndCashPosition from the last. A field is skipped at a date
+// unless all four quarters report it, so gaps never produce partial sums.
+// Items have PeriodType "TTM".
+func computeTTM(quarterly *models.FinancialStatement) *models.FinancialStatement {
+	stmt := models.NewFinancialStatement()
+	stmt.Currency = quarterly.Currency
+	allDates := make(map[time.Time]bool)
+
+	for field, items := range quarterly.Data {
+		var ttm []models.FinancialItem
+		for end := ttmQuarters - 1; end < len(items); end++ {
+			window := items[end-ttmQuarters+1 : end+1]
+			if !consecutiveQuarters(window) {
+				continue
+			}
+			last := window[len(window)-1]
+			ttm = append(ttm, models.FinancialItem{
+				AsOfDate:     last.AsOfDate,
+				CurrencyCode: last.CurrencyCode,
+				PeriodType:   "TTM",
+				Value:        ttmValue(field, window),
+			})
+			allDates[last.AsOfDate] = true
+		}
+		if len(ttm) > 0 {
+			stmt.Data[field] = ttm
+		}
+	}
+
+	for _, d := range quarterly.Dates {
+		if allDates[d] {
+			stmt.Dates = append(stmt.Dates, d)
+		}
+	}
+	return stmt
+}
+
+// consecutiveQuarters reports whether items, ordered by date, are adjacent
+// quarters with none missing in between.
+func consecutiveQuarters(items []models.FinancialItem) bool {
+	for i := 1; i < len(items); i++ {
+		gap := items[i].AsOfDate.Sub(items[i-1].AsOfDate)
+		if gap < ttmMinQuarterGap || gap > ttmMaxQuarterGap {
+			return false
+		}
+	}
+	return true
+}
+
+func ttmValue(field string, window []models.FinancialItem) float64 {
+	switch field {
+	case "BeginningCashPosition":
+		return window[0].Value
+	case "EndCashPosition":
+		return window[len(window)-1].Value
+	}
+
+	var sum float64
+	for _, item := range window {
+		sum += item.Value
+	}
+	if ttmAveragedFields[field] {
+		return sum / float64(len(window))
+	}
+	return sum
+}
@@ -0,0 +1,99 @@
+package ticker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func quarterlyStatement(dates []time.Time, fields map[string][]float64) *models.FinancialStatement {
+	stmt := models.NewFinancialStatement()
+	stmt.Currency = "USD"
+	stmt.Dates = dates
+	for field, values := range fields {
+		for i, v := range values {
+			if v < 0 {
+				continue // negative marks a missing quarter in these tests
+			}
+			stmt.Data[field] = append(stmt.Data[field], models.FinancialItem{
+				AsOfDate:     dates[i],
+				CurrencyCode: "USD",
+				PeriodType:   "3M",
+				Value:        v,
+			})
+		}
+	}
+	return stmt
+}
+
+func TestComputeTTM(t *testing.T) {
+	dates := []time.Time{
+		time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+	quarterly := quarterlyStatement(dates, map[string][]float64{
+		"TotalRevenue":          {10, 20, 30, 40, 50},
+		"DilutedAverageShares":  {100, 100, 96, 96, 92},
+		"BeginningCashPosition": {1, 2, 3, 4, 5},
+		"EndCashPosition":       {2, 3, 4, 5, 6},
+		"EBITDA":                {1, -1, 1, 1, 1},
+	})
+
+	ttm := computeTTM(quarterly)
+
+	if len(ttm.Dates) != 2 || !ttm.Dates[0].Equal(dates[3]) || !ttm.Dates[1].Equal(dates[4]) {
+		t.Fatalf("unexpected TTM dates: %v", ttm.Dates)
+	}
+	if ttm.Currency != "USD" {
+		t.Errorf("expected currency USD, got %q", ttm.Currency)
+	}
+
+	tests := []struct {
+		field string
+		date  time.Time
+		want  float64
+	}{
+		{"TotalRevenue", dates[3], 100},
+		{"TotalRevenue", dates[4], 140},
+		{"DilutedAverageShares", dates[4], 96},
+		{"BeginningCashPosition", dates[4], 2},
+		{"EndCashPosition", dates[4], 6},
+	}
+	for _, tc := range tests {
+		got, ok := ttm.Get(tc.field, tc.date)
+		if !ok || got != tc.want {
+			t.Errorf("%s at %s: got %v (ok=%v), want %v", tc.field, tc.date.Format("2006-01-02"), got, ok, tc.want)
+		}
+	}
+
+	if items := ttm.Data["TotalRevenue"]; items[0].PeriodType != "TTM" {
+		t.Errorf("expected PeriodType TTM, got %q", items[0].PeriodType)
+	}
+	if _, ok := ttm.Data["EBITDA"]; ok {
+		t.Error("expected no EBITDA TTM when a quarter is missing")
+	}
+}
+
+func TestIncomeStatementTTMUsesQuarterly(t *testing.T) {
+	dates := []time.Time{
+		time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 9, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC),
+	}
+	tkr := &Ticker{financialsCache: &financialsCache{
+		incomeQuarterly: quarterlyStatement(dates, map[string][]float64{"NetIncome": {1, 2, 3, 4}}),
+	}}
+
+	ttm, err := tkr.IncomeStatement("ttm")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, ok := ttm.GetLatest("NetIncome"); !ok || v != 10 {
+		t.Errorf("expected TTM NetIncome 10, got %v (ok=%v)", v, ok)
+	}
+}
@@ -1,6 +1,7 @@
 package ticker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/wnjoon/go-yfinance/internal/endpoints"
 	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/yferr"
 )
 
 var valuationMeasureLabels = map[string]string{
@@ -127,7 +129,7 @@ func (t *Ticker) fetchValuationMeasures(freq, prefix string) (*models.ValuationM
 	params.Set("period2", fmt.Sprintf("%d", time.Now().UTC().Truncate(24*time.Hour).Add(24*time.Hour).Unix()))
 
 	apiURL := fmt.Sprintf("%s/%s", endpoints.FundamentalsURL, url.PathEscape(t.symbol))
-	resp, err := t.getWithCrumb(apiURL, params)
+	resp, err := t.getWithCrumb(context.Background(), apiURL, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch valuation measures: %w", err)
 	}
@@ -145,7 +147,7 @@ func valuationPrefix(freq string) (string, error) {
 func parseValuationMeasuresTimeseries(body, prefix string) (*models.ValuationMeasures, error) {
 	var rawResp map[string]interface{}
 	if err := json.Unmarshal([]byte(body), &rawResp); err != nil {
-		return nil, fmt.Errorf("failed to parse valuation measures response: %w", err)
+		return nil, yferr.Parse("valuation measures response", err)
 	}
 
 	timeseries, ok := rawResp["timeseries"].(map[string]interface{})
@@ -0,0 +1,232 @@
+package utils
+
+import "time"
+
+// holidayCalendar returns the full-day market holidays of a year.
+type holidayCalendar func(year int) []time.Time
+
+// Holiday calendars by exchange code. Exchanges without an entry only
+// close on weekends.
+var exchangeCalendars = map[string]holidayCalendar{
+	// US exchanges (NYSE/NASDAQ rules)
+	"NYQ": usHolidays, "NMS": usHolidays, "NGM": usHolidays, "NCM": usHolidays,
+	"NYS": usHolidays, "PCX": usHolidays, "ASE": usHolidays, "BTS": usHolidays,
+	"PNK": usHolidays, "OTC": usHolidays, "OTCM": usHolidays,
+
+	// London
+	"LSE": ukHolidays, "IOB": ukHolidays,
+
+	// Euronext, XETRA and other TARGET-calendar exchanges
+	"FRA": euHolidays, "ETR": euHolidays, "PAR": euHolidays, "AMS": euHolidays,
+	"BRU": euHolidays, "MIL": euHolidays, "MCE": euHolidays, "VIE": euHolidays,
+}
+
+// alwaysOpen lists exchanges that trade every day.
+var alwaysOpen = map[string]bool{
+	"CCC": true, // Crypto
+}
+
+// IsTradingDay reports whether the exchange trades on the calendar day of t
+// in the exchange's timezone. Weekends and known full-day holidays are
+// non-trading days; half days count as trading days.
+//
+// Holiday rules are built in for US, London and major continental European
+// exchanges. Other exchanges are treated as closed on weekends only.
+//
+// Example:
+//
+//	if !utils.IsTradingDay("NYQ", time.Now()) {
+//	    return // NYSE closed today
+//	}
+func IsTradingDay(exchange string, t time.Time) bool {
+	return isTradingDate(exchange, localDate(exchange, t))
+}
+
+// IsHoliday reports whether the calendar day of t, in the exchange's
+// timezone, is a known full-day holiday of the exchange.
+func IsHoliday(exchange string, t time.Time) bool {
+	return isHolidayDate(exchange, localDate(exchange, t))
+}
+
+// NextTradingDay returns midnight, in the exchange's timezone, of the first
+// trading day after the calendar day of t.
+//
+// Example:
+//
+//	next := utils.NextTradingDay("NYQ", time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC))
+//	// 2024-07-05 00:00 America/New_York (July 4th is a holiday)
+func NextTradingDay(exchange string, t time.Time) time.Time {
+	return stepTradingDay(exchange, t, 1)
+}
+
+// PreviousTradingDay returns midnight, in the exchange's timezone, of the
+// last trading day before the calendar day of t.
+//
+// Example:
+//
+//	prev := utils.PreviousTradingDay("NYQ", time.Now())
+func PreviousTradingDay(exchange string, t time.Time) time.Time {
+	return stepTradingDay(exchange, t, -1)
+}
+
+// stepTradingDay walks from the local date of t in direction dir (+1 or -1)
+// until it reaches a trading day.
+func stepTradingDay(exchange string, t time.Time, dir int) time.Time {
+	d := localDate(exchange, t)
+	for i := 0; i < 366; i++ {
+		d = d.AddDate(0, 0, dir)
+		if isTradingDate(exchange, d) {
+			break
+		}
+	}
+	return d
+}
+
+// localDate returns midnight of t's calendar day in the exchange timezone.
+func localDate(exchange string, t time.Time) time.Time {
+	loc := LoadLocation(GetTimezone(exchange))
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+func isTradingDate(exchange string, d time.Time) bool {
+	if alwaysOpen[exchange] {
+		return true
+	}
+	if wd := d.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return !isHolidayDate(exchange, d)
+}
+
+func isHolidayDate(exchange string, d time.Time) bool {
+	cal, ok := exchangeCalendars[exchange]
+	if !ok {
+		return false
+	}
+	for _, h := range cal(d.Year()) {
+		if h.Month() == d.Month() && h.Day() == d.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// usHolidays returns the NYSE full-day holidays of year.
+func usHolidays(year int) []time.Time {
+	days := []time.Time{
+		nthWeekday(year, time.January, time.Monday, 3),    // Martin Luther King Jr. Day
+		nthWeekday(year, time.February, time.Monday, 3),   // Washington's Birthday
+		easter(year).AddDate(0, 0, -2),                    // Good Friday
+		lastWeekday(year, time.May, time.Monday),          // Memorial Day
+		observed(date(year, time.July, 4)),                // Independence Day
+		nthWeekday(year, time.September, time.Monday, 1),  // Labor Day
+		nthWeekday(year, time.November, time.Thursday, 4), // Thanksgiving
+		observed(date(year, time.December, 25)),           // Christmas
+	}
+	// New Year's Day on a Saturday is not observed on the prior Friday.
+	if ny := date(year, time.January, 1); ny.Weekday() != time.Saturday {
+		days = append(days, observed(ny))
+	}
+	if year >= 2022 {
+		days = append(days, observed(date(year, time.June, 19))) // Juneteenth
+	}
+	return days
+}
+
+// ukHolidays returns the London Stock Exchange full-day holidays of year.
+func ukHolidays(year int) []time.Time {
+	e := easter(year)
+	christmas, boxing := date(year, time.December, 25), date(year, time.December, 26)
+	switch christmas.Weekday() {
+	case time.Saturday:
+		christmas, boxing = christmas.AddDate(0, 0, 2), boxing.AddDate(0, 0, 2)
+	case time.Sunday:
+		christmas = christmas.AddDate(0, 0, 2)
+	case time.Friday:
+		boxing = boxing.AddDate(0, 0, 2)
+	}
+	return []time.Time{
+		mondayAfterWeekend(date(year, time.January, 1)), // New Year's Day
+		e.AddDate(0, 0, -2),                             // Good Friday
+		e.AddDate(0, 0, 1),                              // Easter Monday
+		nthWeekday(year, time.May, time.Monday, 1),      // Early May bank holiday
+		lastWeekday(year, time.May, time.Monday),        // Spring bank holiday
+		lastWeekday(year, time.August, time.Monday),     // Summer bank holiday
+		christmas,
+		boxing,
+	}
+}
+
+// euHolidays returns the TARGET-calendar closing days shared by Euronext
+// and XETRA.
+func euHolidays(year int) []time.Time {
+	e := easter(year)
+	return []time.Time{
+		date(year, time.January, 1),
+		e.AddDate(0, 0, -2), // Good Friday
+		e.AddDate(0, 0, 1),  // Easter Monday
+		date(year, time.May, 1),
+		date(year, time.December, 25),
+		date(year, time.December, 26),
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// observed moves a Saturday holiday to Friday and a Sunday holiday to Monday.
+func observed(d time.Time) time.Time {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, -1)
+	case time.Sunday:
+		return d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// mondayAfterWeekend moves a weekend holiday to the following Monday.
+func mondayAfterWeekend(d time.Time) time.Time {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, 2)
+	case time.Sunday:
+		return d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// nthWeekday returns the n-th (1-based) weekday of the month.
+func nthWeekday(year int, month time.Month, wd time.Weekday, n int) time.Time {
+	d := date(year, month, 1)
+	offset := (int(wd) - int(d.Weekday()) + 7) % 7
+	return d.AddDate(0, 0, offset+7*(n-1))
+}
+
+// lastWeekday returns the last weekday of the month.
+func lastWeekday(year int, month time.Month, wd time.Weekday) time.Time {
+	d := date(year, month+1, 1).AddDate(0, 0, -1)
+	offset := (int(d.Weekday()) - int(wd) + 7) % 7
+	return d.AddDate(0, 0, -offset)
+}
+
+// easter returns Easter Sunday of year (Gregorian, anonymous algorithm).
+func easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
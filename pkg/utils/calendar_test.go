@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsTradingDay(t *testing.T) {
+	ny := LoadLocation("America/New_York")
+	tests := []struct {
+		name     string
+		exchange string
+		date     time.Time
+		want     bool
+	}{
+		{"regular weekday", "NYQ", time.Date(2024, 7, 3, 12, 0, 0, 0, ny), true},
+		{"independence day", "NYQ", time.Date(2024, 7, 4, 12, 0, 0, 0, ny), false},
+		{"good friday", "NMS", time.Date(2024, 3, 29, 12, 0, 0, 0, ny), false},
+		{"thanksgiving", "NYQ", time.Date(2024, 11, 28, 12, 0, 0, 0, ny), false},
+		{"juneteenth observed", "NYQ", time.Date(2027, 6, 18, 12, 0, 0, 0, ny), false},
+		{"saturday new year not observed", "NYQ", time.Date(2021, 12, 31, 12, 0, 0, 0, ny), true},
+		{"weekend", "NYQ", time.Date(2024, 7, 6, 12, 0, 0, 0, ny), false},
+		{"boxing day london", "LSE", time.Date(2024, 12, 26, 12, 0, 0, 0, time.UTC), false},
+		{"easter monday xetra", "ETR", time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC), false},
+		{"unknown calendar", "TYO", time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), true},
+		{"crypto weekend", "CCC", time.Date(2024, 7, 6, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTradingDay(tt.exchange, tt.date); got != tt.want {
+				t.Errorf("IsTradingDay(%s, %s) = %v, want %v", tt.exchange, tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextPreviousTradingDay(t *testing.T) {
+	ny := LoadLocation("America/New_York")
+
+	next := NextTradingDay("NYQ", time.Date(2024, 7, 3, 15, 0, 0, 0, ny))
+	if want := time.Date(2024, 7, 5, 0, 0, 0, 0, ny); !next.Equal(want) {
+		t.Errorf("NextTradingDay = %s, want %s", next, want)
+	}
+
+	// Friday 2024-03-29 is Good Friday, so Monday steps back to Thursday.
+	prev := PreviousTradingDay("NYQ", time.Date(2024, 4, 1, 10, 0, 0, 0, ny))
+	if want := time.Date(2024, 3, 28, 0, 0, 0, 0, ny); !prev.Equal(want) {
+		t.Errorf("PreviousTradingDay = %s, want %s", prev, want)
+	}
+
+	// 2024-07-04 03:00 UTC is still July 3rd in New York.
+	next = NextTradingDay("NYQ", time.Date(2024, 7, 4, 3, 0, 0, 0, time.UTC))
+	if want := time.Date(2024, 7, 5, 0, 0, 0, 0, ny); !next.Equal(want) {
+		t.Errorf("NextTradingDay across timezones = %s, want %s", next, want)
+	}
+}
+
+func TestEaster(t *testing.T) {
+	for year, want := range map[int]time.Time{
+		2024: date(2024, time.March, 31),
+		2025: date(2025, time.April, 20),
+		2026: date(2026, time.April, 5),
+	} {
+		if got := easter(year); !got.Equal(want) {
+			t.Errorf("easter(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
@@ -39,6 +39,12 @@
 //	    t := utils.ConvertToTimezone(time.Now(), "America/New_York")
 //	}
 //
+// Parse Yahoo timestamps (epoch seconds or milliseconds, RFC3339, or
+// zone-less dates) into a market's timezone with [ParseTime]. [RegionTimezone]
+// maps country codes such as "GB" to their market timezone:
+//
+//	t, ok := utils.ParseTime("2024-07-25", utils.RegionTimezone("JP"))
+//
 // # Exchange Mappings
 //
 // The package includes timezone mappings for major exchanges:
@@ -55,6 +61,43 @@
 //	    fmt.Println("NYSE is open")
 //	}
 //
+// # Trading Calendar
+//
+// Holiday-aware trading day helpers for scheduling daily jobs per exchange.
+// Full-day holidays are built in for US, London and major continental
+// European exchanges; other exchanges only close on weekends:
+//
+//	utils.IsTradingDay("NYQ", time.Now())
+//	next := utils.NextTradingDay("NYQ", time.Now())      // midnight, exchange time
+//	prev := utils.PreviousTradingDay("LSE", time.Now())
+//
+// # Gap Detection
+//
+// [DetectGaps] reports missing sessions and intraday intervals in a History
+// result, skipping the exchange's weekends and holidays:
+//
+//	gaps, err := utils.DetectGaps(bars, "1d", "NMS")
+//	if len(gaps) > 0 {
+//	    log.Printf("%d gaps in history", len(gaps))
+//	}
+//
+// # Value Coercion
+//
+// Yahoo returns numbers as JSON numbers, numeric strings or {"raw", "fmt"}
+// objects depending on the endpoint. [Float] and [Int64] accept all of them,
+// including "1,234.5", "1.25%" and "2.5B", and report whether a value was
+// present; [GetFloat], [GetInt64], [GetInt] and [GetFloatPtr] read map keys:
+//
+//	v, ok := utils.Float(quote["marketCap"]) // {"raw": 3.0e12, "fmt": "3T"}
+//	volume := utils.GetInt64(row, "volume")  // "52,340,100"
+//
+// # Symbols
+//
+// [NormalizeSymbols] trims and upper-cases a symbol list, dropping blanks
+// and duplicates in order, as the batch APIs do with their input:
+//
+//	symbols := utils.NormalizeSymbols([]string{"aapl", " MSFT ", "AAPL"}) // AAPL, MSFT
+//
 // # Thread Safety
 //
 // All utility functions are thread-safe.
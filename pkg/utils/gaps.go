@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+// intradaySteps maps intraday intervals to their bar spacing.
+var intradaySteps = map[string]time.Duration{
+	"1m":  time.Minute,
+	"2m":  2 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"60m": time.Hour,
+	"90m": 90 * time.Minute,
+	"1h":  time.Hour,
+}
+
+// DetectGaps reports missing data in a History result so data quality
+// issues can be caught before analysis. Weekends and holidays of the
+// exchange (see [IsTradingDay]) are not gaps.
+//
+// For "1d" bars every trading day between two bars without a bar of its own
+// is missing. For intraday bars, a jump of more than one interval within a
+// session and trading days without any bar are reported; as session hours
+// are not known, bars missing at the open or close of a session are not.
+// Other intervals return an error. The bars need not be sorted.
+//
+// Example:
+//
+//	bars, _ := t.History(models.HistoryParams{Period: "1y", Interval: "1d"})
+//	gaps, err := utils.DetectGaps(bars, "1d", "NMS")
+//	for _, g := range gaps {
+//	    fmt.Printf("%d sessions missing after %s\n", g.Missing, g.After.Format("2006-01-02"))
+//	}
+func DetectGaps(bars []models.Bar, interval, exchange string) ([]models.BarGap, error) {
+	step, intraday := intradaySteps[interval]
+	if !intraday && interval != "1d" {
+		return nil, fmt.Errorf("gap detection supports 1d and intraday intervals, got %q", interval)
+	}
+
+	dates := make([]time.Time, len(bars))
+	for i, b := range bars {
+		dates[i] = b.Date
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	var gaps []models.BarGap
+	for i := 1; i < len(dates); i++ {
+		prev, cur := dates[i-1], dates[i]
+		prevDay, curDay := localDate(exchange, prev), localDate(exchange, cur)
+
+		gap := models.BarGap{After: prev, Before: cur}
+		if prevDay.Equal(curDay) {
+			if intraday {
+				gap.Missing = int(cur.Sub(prev)/step) - 1
+			}
+		} else {
+			gap.Sessions = tradingDaysBetween(exchange, prevDay, curDay)
+			if !intraday {
+				gap.Missing = len(gap.Sessions)
+			}
+		}
+
+		if gap.Missing > 0 || len(gap.Sessions) > 0 {
+			gaps = append(gaps, gap)
+		}
+	}
+	return gaps, nil
+}
+
+// tradingDaysBetween returns the trading days strictly between two local
+// dates.
+func tradingDaysBetween(exchange string, from, to time.Time) []time.Time {
+	var days []time.Time
+	for d := from.AddDate(0, 0, 1); d.Before(to); d = d.AddDate(0, 0, 1) {
+		if isTradingDate(exchange, d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
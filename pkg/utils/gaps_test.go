@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+func TestDetectGapsDaily(t *testing.T) {
+	ny := LoadLocation("America/New_York")
+	at := func(month time.Month, day int) models.Bar {
+		return models.Bar{Date: time.Date(2024, month, day, 9, 30, 0, 0, ny)}
+	}
+
+	// Jul 4 is a holiday and Jul 6-7 a weekend; Jul 9 and 10 are missing.
+	bars := []models.Bar{at(7, 11), at(7, 2), at(7, 3), at(7, 5), at(7, 8)}
+
+	gaps, err := DetectGaps(bars, "1d", "NYQ")
+	if err != nil {
+		t.Fatalf("DetectGaps() error: %v", err)
+	}
+	if len(gaps) != 1 {
+		t.Fatalf("Expected 1 gap, got %d: %+v", len(gaps), gaps)
+	}
+	g := gaps[0]
+	if g.Missing != 2 || len(g.Sessions) != 2 || g.Sessions[0].Day() != 9 {
+		t.Errorf("Unexpected gap: %+v", g)
+	}
+	if !g.After.Equal(at(7, 8).Date) || !g.Before.Equal(at(7, 11).Date) {
+		t.Errorf("Unexpected gap bounds: %s - %s", g.After, g.Before)
+	}
+}
+
+func TestDetectGapsIntraday(t *testing.T) {
+	ny := LoadLocation("America/New_York")
+	at := func(day, hour, min int) models.Bar {
+		return models.Bar{Date: time.Date(2024, 7, day, hour, min, 0, 0, ny)}
+	}
+
+	bars := []models.Bar{
+		at(2, 9, 30), at(2, 9, 35), at(2, 9, 50), // 9:40 and 9:45 missing
+		at(3, 9, 30), // next session
+		at(8, 9, 30), // Jul 5 missing; Jul 4 holiday, weekend skipped
+	}
+
+	gaps, err := DetectGaps(bars, "5m", "NYQ")
+	if err != nil {
+		t.Fatalf("DetectGaps() error: %v", err)
+	}
+	if len(gaps) != 2 {
+		t.Fatalf("Expected 2 gaps, got %d: %+v", len(gaps), gaps)
+	}
+	if gaps[0].Missing != 2 || len(gaps[0].Sessions) != 0 {
+		t.Errorf("Unexpected intra-session gap: %+v", gaps[0])
+	}
+	if gaps[1].Missing != 0 || len(gaps[1].Sessions) != 1 || gaps[1].Sessions[0].Day() != 5 {
+		t.Errorf("Unexpected session gap: %+v", gaps[1])
+	}
+}
+
+func TestDetectGapsUnsupportedInterval(t *testing.T) {
+	if _, err := DetectGaps(nil, "1wk", "NYQ"); err == nil {
+		t.Error("Expected error for weekly interval")
+	}
+}
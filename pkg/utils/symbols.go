@@ -0,0 +1,23 @@
+package utils
+
+import "strings"
+
+// NormalizeSymbols trims and upper-cases symbols, dropping blanks and
+// duplicates while keeping the original order.
+//
+// Example:
+//
+//	utils.NormalizeSymbols([]string{"aapl", " MSFT ", "", "AAPL"}) // ["AAPL" "MSFT"]
+func NormalizeSymbols(symbols []string) []string {
+	seen := make(map[string]bool, len(symbols))
+	out := make([]string, 0, len(symbols))
+	for _, s := range symbols {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	return out
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeSymbols(t *testing.T) {
+	got := NormalizeSymbols([]string{"aapl", " MSFT ", "", "AAPL", "goog", "brk-b"})
+	want := []string{"AAPL", "MSFT", "GOOG", "BRK-B"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeSymbols = %v, want %v", got, want)
+	}
+	if got := NormalizeSymbols(nil); len(got) != 0 {
+		t.Errorf("NormalizeSymbols(nil) = %v", got)
+	}
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/wnjoon/go-yfinance/pkg/cache"
@@ -101,20 +103,27 @@ func CacheTimezone(exchange, timezone string) {
 	cache.SetGlobal(tzCachePrefix+exchange, timezone)
 }
 
+// locations caches loaded timezones by name, as time.LoadLocation reads
+// the zone database on every call.
+var locations sync.Map
+
 // LoadLocation loads a timezone location by name.
 // Returns nil if the timezone is invalid.
 func LoadLocation(name string) *time.Location {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
 	loc, err := time.LoadLocation(name)
 	if err != nil {
 		return nil
 	}
+	locations.Store(name, loc)
 	return loc
 }
 
 // IsValidTimezone checks if a timezone name is valid.
 func IsValidTimezone(name string) bool {
-	_, err := time.LoadLocation(name)
-	return err == nil
+	return LoadLocation(name) != nil
 }
 
 // ConvertToTimezone converts a UTC time to the specified timezone.
@@ -132,6 +141,103 @@ func ParseTimestamp(timestamp int64, timezone string) time.Time {
 	return ConvertToTimezone(t, timezone)
 }
 
+// regionTimezones maps country and region codes to their main market timezone.
+var regionTimezones = map[string]string{
+	"US":  "America/New_York",
+	"CA":  "America/Toronto",
+	"MX":  "America/Mexico_City",
+	"BR":  "America/Sao_Paulo",
+	"AR":  "America/Argentina/Buenos_Aires",
+	"GB":  "Europe/London",
+	"UK":  "Europe/London",
+	"IE":  "Europe/Dublin",
+	"EU":  "Europe/Berlin",
+	"EMU": "Europe/Berlin",
+	"DE":  "Europe/Berlin",
+	"FR":  "Europe/Paris",
+	"IT":  "Europe/Rome",
+	"ES":  "Europe/Madrid",
+	"NL":  "Europe/Amsterdam",
+	"CH":  "Europe/Zurich",
+	"SE":  "Europe/Stockholm",
+	"NO":  "Europe/Oslo",
+	"JP":  "Asia/Tokyo",
+	"CN":  "Asia/Shanghai",
+	"HK":  "Asia/Hong_Kong",
+	"KR":  "Asia/Seoul",
+	"TW":  "Asia/Taipei",
+	"SG":  "Asia/Singapore",
+	"IN":  "Asia/Kolkata",
+	"AU":  "Australia/Sydney",
+	"NZ":  "Pacific/Auckland",
+	"ZA":  "Africa/Johannesburg",
+}
+
+// RegionTimezone returns the main market timezone of a country or region
+// code such as "US", "GB" or "EU", or "" if the code is unknown.
+func RegionTimezone(region string) string {
+	return regionTimezones[strings.ToUpper(strings.TrimSpace(region))]
+}
+
+// timeLayouts are the string formats accepted by [ParseTime], most specific
+// first. Layouts without a zone are read in the target timezone.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// epochMillisThreshold separates epoch seconds from milliseconds; a seconds
+// value this large would be past the year 5000.
+const epochMillisThreshold = 1e11
+
+// ParseTime parses a timestamp returned by Yahoo and converts it to timezone.
+// It accepts epoch seconds or milliseconds (as numbers or numeric strings),
+// RFC3339 with or without fractional seconds, and zone-less date-times or
+// dates, which are read as local times in timezone. An empty or invalid
+// timezone means UTC. ok is false for empty or unparseable values.
+//
+// Example:
+//
+//	t, _ := utils.ParseTime("2024-07-25T20:30:00.000Z", "America/New_York") // 16:30 EDT
+//	t, _ = utils.ParseTime(1721939400, "America/New_York")                  // same instant
+//	t, _ = utils.ParseTime("2024-07-25", "Asia/Tokyo")                      // midnight JST
+func ParseTime(v any, timezone string) (time.Time, bool) {
+	loc := time.UTC
+	if timezone != "" {
+		if l := LoadLocation(timezone); l != nil {
+			loc = l
+		}
+	}
+
+	if s, ok := v.(string); ok {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return time.Time{}, false
+		}
+		for _, layout := range timeLayouts {
+			if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+				return t.In(loc), true
+			}
+		}
+	}
+
+	epoch, ok := Float(v)
+	if !ok || epoch <= 0 {
+		return time.Time{}, false
+	}
+	if epoch >= epochMillisThreshold {
+		return time.UnixMilli(int64(epoch)).In(loc), true
+	}
+	sec := int64(epoch)
+	nsec := int64((epoch - float64(sec)) * 1e9)
+	return time.Unix(sec, nsec).In(loc), true
+}
+
 // MarketIsOpen checks if a market is currently open based on typical trading hours.
 // This is a simplified check and doesn't account for holidays.
 func MarketIsOpen(exchange string) bool {
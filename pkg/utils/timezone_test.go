@@ -44,6 +44,9 @@ func TestLoadLocation(t *testing.T) {
 	if loc == nil {
 		t.Error("Expected non-nil location for America/New_York")
 	}
+	if again := LoadLocation("America/New_York"); again != loc {
+		t.Error("Expected the cached location on the second load")
+	}
 
 	// Invalid timezone
 	loc = LoadLocation("Invalid/Timezone")
@@ -102,6 +105,60 @@ func TestParseTimestamp(t *testing.T) {
 	}
 }
 
+func TestParseTime(t *testing.T) {
+	want := time.Date(2024, 7, 25, 20, 30, 0, 0, time.UTC)
+
+	for _, v := range []any{
+		"2024-07-25T20:30:00Z",
+		"2024-07-25T20:30:00.000Z",
+		"2024-07-25T16:30:00-04:00",
+		"2024-07-25T16:30:00",
+		"2024-07-25 16:30:00",
+		float64(1721939400),
+		int64(1721939400000),
+		"1721939400",
+	} {
+		got, ok := ParseTime(v, "America/New_York")
+		if !ok || !got.Equal(want) {
+			t.Errorf("ParseTime(%v) = %v, %v; want %v", v, got, ok, want)
+			continue
+		}
+		if got.Location().String() != "America/New_York" {
+			t.Errorf("ParseTime(%v) location = %s", v, got.Location())
+		}
+	}
+
+	// Date-only values are midnight in the target timezone
+	got, ok := ParseTime("2024-07-25", "Asia/Tokyo")
+	if !ok || got.Hour() != 0 || got.Day() != 25 || got.Location().String() != "Asia/Tokyo" {
+		t.Errorf("unexpected date-only result %v", got)
+	}
+
+	// Unknown timezone falls back to UTC
+	got, ok = ParseTime("2024-07-25", "")
+	if !ok || !got.Equal(time.Date(2024, 7, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected UTC result %v", got)
+	}
+
+	for _, v := range []any{nil, "", "soon", 0, -5, true} {
+		if _, ok := ParseTime(v, "UTC"); ok {
+			t.Errorf("expected ParseTime(%v) to fail", v)
+		}
+	}
+}
+
+func TestRegionTimezone(t *testing.T) {
+	if tz := RegionTimezone("us"); tz != "America/New_York" {
+		t.Errorf("expected America/New_York, got %s", tz)
+	}
+	if tz := RegionTimezone("JP"); tz != "Asia/Tokyo" {
+		t.Errorf("expected Asia/Tokyo, got %s", tz)
+	}
+	if tz := RegionTimezone("XX"); tz != "" {
+		t.Errorf("expected empty timezone, got %s", tz)
+	}
+}
+
 func TestMarketIsOpen(t *testing.T) {
 	// This is a basic smoke test since actual result depends on current time
 	// Just verify it doesn't panic
@@ -0,0 +1,53 @@
+package utils
+
+import "github.com/wnjoon/go-yfinance/internal/numeric"
+
+// Float coerces a decoded JSON value to float64. It accepts numbers, numeric
+// strings and Yahoo's {"raw": ..., "fmt": ...} objects, preferring raw over
+// fmt. Strings may contain thousands separators, a trailing "%" or a
+// K/M/B/T magnitude suffix. A plain "1.5%" is 1.5, the unit of Yahoo's
+// numeric percent fields, while an fmt of "1.5%" is 0.015 like its raw
+// value. ok is false for null, NaN, infinities and anything unparseable.
+//
+// Example:
+//
+//	v, _ := utils.Float(map[string]any{"raw": 0.0123, "fmt": "1.23%"}) // 0.0123
+//	v, _ = utils.Float("1,234.5")                                      // 1234.5
+//	v, _ = utils.Float("2.5B")                                         // 2.5e9
+func Float(v any) (float64, bool) {
+	return numeric.Float(v)
+}
+
+// Int64 coerces a decoded JSON value to int64 like [Float], truncating
+// fractions. Integer strings are parsed exactly.
+func Int64(v any) (int64, bool) {
+	return numeric.Int64(v)
+}
+
+// GetFloat returns m[key] coerced with [Float], or 0 if it is missing or
+// not a number.
+func GetFloat(m map[string]any, key string) float64 {
+	f, _ := Float(m[key])
+	return f
+}
+
+// GetFloatPtr returns m[key] coerced with [Float], or nil if it is missing
+// or not a number.
+func GetFloatPtr(m map[string]any, key string) *float64 {
+	if f, ok := Float(m[key]); ok {
+		return &f
+	}
+	return nil
+}
+
+// GetInt64 returns m[key] coerced with [Int64], or 0 if it is missing or
+// not a number.
+func GetInt64(m map[string]any, key string) int64 {
+	i, _ := Int64(m[key])
+	return i
+}
+
+// GetInt returns m[key] coerced with [Int64] as an int, or 0.
+func GetInt(m map[string]any, key string) int {
+	return int(GetInt64(m, key))
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestFloat(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  any
+		want   float64
+		wantOK bool
+	}{
+		{"float64", 3.14, 3.14, true},
+		{"float32", float32(0.5), 0.5, true},
+		{"int", 42, 42, true},
+		{"int64", int64(1234567890), 1234567890, true},
+		{"json number", json.Number("12.5"), 12.5, true},
+		{"numeric string", "189.75", 189.75, true},
+		{"thousands separators", " 1,234.5 ", 1234.5, true},
+		{"percent", "-1.25%", -1.25, true},
+		{"fmt percent", map[string]any{"fmt": "1.30%"}, 0.013, true},
+		{"billions", "2.5B", 2.5e9, true},
+		{"thousands suffix", "12k", 12000, true},
+		{"raw preferred", map[string]any{"raw": 0.0123, "fmt": "1.30%"}, 0.0123, true},
+		{"fmt fallback", map[string]any{"fmt": "3.02T"}, 3.02e12, true},
+		{"raw string", map[string]any{"raw": "7"}, 7, true},
+		{"empty object", map[string]any{}, 0, false},
+		{"null", nil, 0, false},
+		{"empty string", "", 0, false},
+		{"not available", "N/A", 0, false},
+		{"dash", "-", 0, false},
+		{"suffix only", "B", 0, false},
+		{"nan", math.NaN(), 0, false},
+		{"nan string", "NaN", 0, false},
+		{"infinity", math.Inf(1), 0, false},
+		{"bool", true, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Float(tt.input)
+			if ok != tt.wantOK || math.Abs(got-tt.want) > 1e-9*math.Max(1, math.Abs(tt.want)) {
+				t.Errorf("Float(%v) = %v, %v; want %v, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestInt64(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  any
+		want   int64
+		wantOK bool
+	}{
+		{"float truncated", 3.99, 3, true},
+		{"int", 42, 42, true},
+		{"exact integer string", "9007199254740993", 9007199254740993, true},
+		{"json number", json.Number("1700000000"), 1700000000, true},
+		{"raw object", map[string]any{"raw": 1.5e6, "fmt": "1.5M"}, 1500000, true},
+		{"formatted volume", "1.2M", 1200000, true},
+		{"out of range", 1e20, 0, false},
+		{"null", nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Int64(tt.input)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Int64(%v) = %d, %v; want %d, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMapGetters(t *testing.T) {
+	m := map[string]any{
+		"price":  map[string]any{"raw": 189.5, "fmt": "189.50"},
+		"volume": "52,340,100",
+		"count":  12.0,
+		"bad":    "n/a",
+	}
+
+	if got := GetFloat(m, "price"); got != 189.5 {
+		t.Errorf("GetFloat(price) = %v, want 189.5", got)
+	}
+	if got := GetInt64(m, "volume"); got != 52340100 {
+		t.Errorf("GetInt64(volume) = %d, want 52340100", got)
+	}
+	if got := GetInt(m, "count"); got != 12 {
+		t.Errorf("GetInt(count) = %d, want 12", got)
+	}
+	if got := GetFloat(m, "bad"); got != 0 {
+		t.Errorf("GetFloat(bad) = %v, want 0", got)
+	}
+	if p := GetFloatPtr(m, "price"); p == nil || *p != 189.5 {
+		t.Errorf("GetFloatPtr(price) = %v, want 189.5", p)
+	}
+	if p := GetFloatPtr(m, "missing"); p != nil {
+		t.Errorf("GetFloatPtr(missing) = %v, want nil", *p)
+	}
+}
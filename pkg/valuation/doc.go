@@ -0,0 +1,45 @@
+// Package valuation provides simple intrinsic value estimates built on ticker data.
+//
+// # Overview
+//
+// The valuation package computes a discounted cash flow (DCF) value and the
+// Graham number for a ticker. Every result carries the inputs it was computed
+// from, so the estimate can be inspected and reproduced.
+//
+// # Basic Usage
+//
+//	t, _ := ticker.New("AAPL")
+//	defer t.Close()
+//
+//	report, err := valuation.Analyze(t, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if report.DCF != nil {
+//	    fmt.Printf("DCF value: %.2f (growth %.1f%% from %s)\n",
+//	        report.DCF.ValuePerShare, report.DCF.Inputs.GrowthRate*100, report.DCF.Inputs.GrowthSource)
+//	}
+//	if report.Graham != nil {
+//	    fmt.Printf("Graham number: %.2f\n", report.Graham.Value)
+//	}
+//
+// # DCF Model
+//
+// Free cash flow is projected for [DCFParams.Years] years at the growth rate,
+// followed by a Gordon growth terminal value at [DCFParams.TerminalGrowth].
+// All cash flows are discounted at [DCFParams.DiscountRate]. Net debt is
+// subtracted from the enterprise value to obtain the equity value.
+//
+// The growth rate is taken from [DCFParams.GrowthRate] if set, otherwise from
+// the analysts' +5y (then +1y) growth estimate, otherwise from the historical
+// free cash flow CAGR.
+//
+// # Custom Inputs
+//
+// [DCF] and [Graham] are pure functions and can be used with inputs from any
+// source.
+//
+// # Thread Safety
+//
+// All valuation package functions are safe for concurrent use.
+package valuation
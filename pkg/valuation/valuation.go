@@ -0,0 +1,311 @@
+package valuation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+	"github.com/wnjoon/go-yfinance/pkg/ticker"
+)
+
+// Growth rate sources reported in [DCFInputs.GrowthSource].
+const (
+	GrowthFromParams     = "params"
+	GrowthFromAnalysts5y = "analysts_+5y"
+	GrowthFromAnalysts1y = "analysts_+1y"
+	GrowthFromHistory    = "historical_fcf_cagr"
+	GrowthNone           = "none"
+)
+
+// DCFParams configures the discounted cash flow model.
+type DCFParams struct {
+	// DiscountRate is the annual discount rate (default 0.09).
+	DiscountRate float64
+
+	// TerminalGrowth is the perpetual growth rate after the projection (default 0.025).
+	TerminalGrowth float64
+
+	// Years is the number of projected years (default 5).
+	Years int
+
+	// GrowthRate overrides the projected free cash flow growth rate when set.
+	GrowthRate *float64
+}
+
+// DefaultDCFParams returns default DCF parameters.
+func DefaultDCFParams() DCFParams {
+	return DCFParams{
+		DiscountRate:   0.09,
+		TerminalGrowth: 0.025,
+		Years:          5,
+	}
+}
+
+// DCFInputs are the values a DCF valuation is computed from.
+type DCFInputs struct {
+	// FreeCashFlow is the annual free cash flow history, oldest first.
+	FreeCashFlow []models.FinancialItem `json:"freeCashFlow,omitempty"`
+
+	// BaseFCF is the free cash flow the projection starts from.
+	BaseFCF float64 `json:"baseFcf"`
+
+	// GrowthRate is the projected annual growth rate.
+	GrowthRate float64 `json:"growthRate"`
+
+	// GrowthSource describes where GrowthRate came from.
+	GrowthSource string `json:"growthSource"`
+
+	DiscountRate   float64 `json:"discountRate"`
+	TerminalGrowth float64 `json:"terminalGrowth"`
+	Years          int     `json:"years"`
+
+	// NetDebt is subtracted from the enterprise value.
+	NetDebt float64 `json:"netDebt"`
+
+	// SharesOutstanding is used for the per-share value.
+	SharesOutstanding int64 `json:"sharesOutstanding"`
+
+	// Price is the current share price, used for the upside (0 if unknown).
+	Price float64 `json:"price,omitempty"`
+}
+
+// DCFResult is the output of a DCF valuation together with its inputs.
+type DCFResult struct {
+	Inputs DCFInputs `json:"inputs"`
+
+	// ProjectedFCF holds the free cash flow of each projected year.
+	ProjectedFCF []float64 `json:"projectedFcf"`
+
+	// DiscountedFCF holds the present value of each projected year.
+	DiscountedFCF []float64 `json:"discountedFcf"`
+
+	TerminalValue           float64 `json:"terminalValue"`
+	DiscountedTerminalValue float64 `json:"discountedTerminalValue"`
+	EnterpriseValue         float64 `json:"enterpriseValue"`
+	EquityValue             float64 `json:"equityValue"`
+
+	// ValuePerShare is EquityValue / SharesOutstanding.
+	ValuePerShare float64 `json:"valuePerShare"`
+
+	// Upside is ValuePerShare / Price - 1 (0 if the price is unknown).
+	Upside float64 `json:"upside"`
+}
+
+// GrahamResult is the Graham number together with its inputs.
+type GrahamResult struct {
+	EPS               float64 `json:"eps"`
+	BookValuePerShare float64 `json:"bookValuePerShare"`
+	Price             float64 `json:"price,omitempty"`
+
+	// Value is sqrt(22.5 × EPS × BookValuePerShare).
+	Value float64 `json:"value"`
+
+	// Upside is Value / Price - 1 (0 if the price is unknown).
+	Upside float64 `json:"upside"`
+}
+
+// Report holds the valuations of a ticker. A valuation that could not be
+// computed is nil and its error is recorded in Errors.
+type Report struct {
+	Symbol string        `json:"symbol"`
+	DCF    *DCFResult    `json:"dcf,omitempty"`
+	Graham *GrahamResult `json:"graham,omitempty"`
+
+	// Errors maps "dcf" or "graham" to the reason it could not be computed.
+	Errors map[string]error `json:"-"`
+}
+
+// DCF computes a discounted cash flow valuation from explicit inputs.
+//
+// Example:
+//
+//	res, err := valuation.DCF(valuation.DCFInputs{
+//	    BaseFCF:           100e9,
+//	    GrowthRate:        0.08,
+//	    DiscountRate:      0.09,
+//	    TerminalGrowth:    0.025,
+//	    Years:             5,
+//	    SharesOutstanding: 15e9,
+//	})
+func DCF(in DCFInputs) (*DCFResult, error) {
+	if in.Years <= 0 {
+		return nil, fmt.Errorf("years must be positive")
+	}
+	if in.DiscountRate <= in.TerminalGrowth {
+		return nil, fmt.Errorf("discount rate %.4f must exceed terminal growth %.4f", in.DiscountRate, in.TerminalGrowth)
+	}
+	if in.SharesOutstanding <= 0 {
+		return nil, fmt.Errorf("shares outstanding must be positive")
+	}
+	if in.BaseFCF <= 0 {
+		return nil, fmt.Errorf("base free cash flow must be positive, got %.0f", in.BaseFCF)
+	}
+
+	res := &DCFResult{
+		Inputs:        in,
+		ProjectedFCF:  make([]float64, in.Years),
+		DiscountedFCF: make([]float64, in.Years),
+	}
+
+	fcf := in.BaseFCF
+	for year := 1; year <= in.Years; year++ {
+		fcf *= 1 + in.GrowthRate
+		discount := math.Pow(1+in.DiscountRate, float64(year))
+		res.ProjectedFCF[year-1] = fcf
+		res.DiscountedFCF[year-1] = fcf / discount
+		res.EnterpriseValue += fcf / discount
+	}
+
+	res.TerminalValue = fcf * (1 + in.TerminalGrowth) / (in.DiscountRate - in.TerminalGrowth)
+	res.DiscountedTerminalValue = res.TerminalValue / math.Pow(1+in.DiscountRate, float64(in.Years))
+	res.EnterpriseValue += res.DiscountedTerminalValue
+	res.EquityValue = res.EnterpriseValue - in.NetDebt
+	res.ValuePerShare = res.EquityValue / float64(in.SharesOutstanding)
+	res.Upside = upside(res.ValuePerShare, in.Price)
+
+	return res, nil
+}
+
+// Graham computes the Graham number sqrt(22.5 × EPS × BVPS). Both EPS and
+// book value per share must be positive.
+func Graham(eps, bookValuePerShare, price float64) (*GrahamResult, error) {
+	if eps <= 0 || bookValuePerShare <= 0 {
+		return nil, fmt.Errorf("graham number requires positive EPS and book value, got %.2f and %.2f", eps, bookValuePerShare)
+	}
+	value := math.Sqrt(22.5 * eps * bookValuePerShare)
+	return &GrahamResult{
+		EPS:               eps,
+		BookValuePerShare: bookValuePerShare,
+		Price:             price,
+		Value:             value,
+		Upside:            upside(value, price),
+	}, nil
+}
+
+// source is the subset of ticker methods used by Analyze.
+type source interface {
+	Symbol() string
+	Info() (*models.Info, error)
+	CashFlow(freq string) (*models.FinancialStatement, error)
+	BalanceSheet(freq string) (*models.FinancialStatement, error)
+	GrowthEstimates() ([]models.GrowthEstimate, error)
+}
+
+// Analyze computes the DCF value and Graham number of a ticker from its
+// annual cash flow, balance sheet, info and growth estimates. params may be
+// nil to use [DefaultDCFParams].
+//
+// An error is returned only if the ticker info cannot be fetched; otherwise
+// valuations that cannot be computed are reported in [Report.Errors].
+func Analyze(t *ticker.Ticker, params *DCFParams) (*Report, error) {
+	return analyze(t, params)
+}
+
+func analyze(src source, params *DCFParams) (*Report, error) {
+	p := DefaultDCFParams()
+	if params != nil {
+		p = *params
+	}
+
+	info, err := src.Info()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch info: %w", err)
+	}
+
+	report := &Report{
+		Symbol: src.Symbol(),
+		Errors: make(map[string]error),
+	}
+
+	if dcf, err := analyzeDCF(src, info, p); err != nil {
+		report.Errors["dcf"] = err
+	} else {
+		report.DCF = dcf
+	}
+
+	if graham, err := Graham(info.TrailingEps, info.BookValue, info.CurrentPrice); err != nil {
+		report.Errors["graham"] = err
+	} else {
+		report.Graham = graham
+	}
+
+	return report, nil
+}
+
+func analyzeDCF(src source, info *models.Info, p DCFParams) (*DCFResult, error) {
+	cashFlow, err := src.CashFlow("annual")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cash flow: %w", err)
+	}
+	history := cashFlow.Data["FreeCashFlow"]
+	if len(history) == 0 {
+		return nil, fmt.Errorf("no free cash flow history")
+	}
+
+	in := DCFInputs{
+		FreeCashFlow:      history,
+		BaseFCF:           history[len(history)-1].Value,
+		DiscountRate:      p.DiscountRate,
+		TerminalGrowth:    p.TerminalGrowth,
+		Years:             p.Years,
+		SharesOutstanding: info.SharesOutstanding,
+		Price:             info.CurrentPrice,
+	}
+	if in.SharesOutstanding == 0 {
+		in.SharesOutstanding = info.ImpliedSharesOutstanding
+	}
+
+	if balance, err := src.BalanceSheet("annual"); err == nil {
+		in.NetDebt, _ = balance.GetLatest("NetDebt")
+	}
+
+	in.GrowthRate, in.GrowthSource = growthRate(src, p, history)
+
+	return DCF(in)
+}
+
+// growthRate picks the projected growth rate and reports its source.
+func growthRate(src source, p DCFParams, history []models.FinancialItem) (float64, string) {
+	if p.GrowthRate != nil {
+		return *p.GrowthRate, GrowthFromParams
+	}
+
+	if estimates, err := src.GrowthEstimates(); err == nil {
+		for _, want := range []struct{ period, source string }{
+			{"+5y", GrowthFromAnalysts5y},
+			{"+1y", GrowthFromAnalysts1y},
+		} {
+			for _, ge := range estimates {
+				if ge.Period == want.period && ge.StockGrowth != nil {
+					return *ge.StockGrowth, want.source
+				}
+			}
+		}
+	}
+
+	if g, ok := fcfCAGR(history); ok {
+		return g, GrowthFromHistory
+	}
+	return 0, GrowthNone
+}
+
+// fcfCAGR returns the compound annual growth rate between the first and last
+// free cash flow. Both must be positive.
+func fcfCAGR(history []models.FinancialItem) (float64, bool) {
+	if len(history) < 2 {
+		return 0, false
+	}
+	first, last := history[0], history[len(history)-1]
+	years := last.AsOfDate.Sub(first.AsOfDate).Hours() / (24 * 365.25)
+	if first.Value <= 0 || last.Value <= 0 || years < 0.5 {
+		return 0, false
+	}
+	return math.Pow(last.Value/first.Value, 1/years) - 1, true
+}
+
+func upside(value, price float64) float64 {
+	if price <= 0 {
+		return 0
+	}
+	return value/price - 1
+}
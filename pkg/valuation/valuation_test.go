@@ -0,0 +1,119 @@
+package valuation
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wnjoon/go-yfinance/pkg/models"
+)
+
+type fakeSource struct {
+	info     *models.Info
+	cashFlow *models.FinancialStatement
+	balance  *models.FinancialStatement
+	growth   []models.GrowthEstimate
+}
+
+func (f *fakeSource) Symbol() string              { return "TEST" }
+func (f *fakeSource) Info() (*models.Info, error) { return f.info, nil }
+func (f *fakeSource) CashFlow(string) (*models.FinancialStatement, error) {
+	return f.cashFlow, nil
+}
+func (f *fakeSource) BalanceSheet(string) (*models.FinancialStatement, error) {
+	if f.balance == nil {
+		return nil, errors.New("no balance sheet")
+	}
+	return f.balance, nil
+}
+func (f *fakeSource) GrowthEstimates() ([]models.GrowthEstimate, error) {
+	return f.growth, nil
+}
+
+func TestDCF(t *testing.T) {
+	res, err := DCF(DCFInputs{
+		BaseFCF:           100,
+		GrowthRate:        0.10,
+		DiscountRate:      0.10,
+		TerminalGrowth:    0.0,
+		Years:             2,
+		SharesOutstanding: 10,
+		Price:             10,
+	})
+	if err != nil {
+		t.Fatalf("DCF() error: %v", err)
+	}
+
+	// Growth equals discount rate, so each discounted year is worth 100
+	for i, pv := range res.DiscountedFCF {
+		if math.Abs(pv-100) > 1e-9 {
+			t.Errorf("year %d: expected PV 100, got %f", i+1, pv)
+		}
+	}
+	// Terminal value 121/0.10 = 1210, discounted by 1.21 = 1000
+	if math.Abs(res.DiscountedTerminalValue-1000) > 1e-9 {
+		t.Errorf("expected discounted terminal value 1000, got %f", res.DiscountedTerminalValue)
+	}
+	if math.Abs(res.ValuePerShare-120) > 1e-9 || math.Abs(res.Upside-11) > 1e-9 {
+		t.Errorf("unexpected value %f / upside %f", res.ValuePerShare, res.Upside)
+	}
+
+	if _, err := DCF(DCFInputs{BaseFCF: 1, DiscountRate: 0.02, TerminalGrowth: 0.03, Years: 5, SharesOutstanding: 1}); err == nil {
+		t.Error("Expected error when terminal growth exceeds discount rate")
+	}
+}
+
+func TestGraham(t *testing.T) {
+	res, err := Graham(4, 10, 25)
+	if err != nil {
+		t.Fatalf("Graham() error: %v", err)
+	}
+	if math.Abs(res.Value-30) > 1e-9 || math.Abs(res.Upside-0.2) > 1e-9 {
+		t.Errorf("unexpected graham result: %+v", res)
+	}
+	if _, err := Graham(-1, 10, 25); err == nil {
+		t.Error("Expected error for negative EPS")
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	fcf := []models.FinancialItem{
+		{AsOfDate: time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC), Value: 100},
+		{AsOfDate: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), Value: 121},
+	}
+	src := &fakeSource{
+		info: &models.Info{SharesOutstanding: 10, CurrentPrice: 50, TrailingEps: -1, BookValue: 5},
+		cashFlow: &models.FinancialStatement{
+			Data: map[string][]models.FinancialItem{"FreeCashFlow": fcf},
+		},
+		balance: &models.FinancialStatement{
+			Data: map[string][]models.FinancialItem{"NetDebt": {{Value: 200}}},
+		},
+	}
+
+	report, err := analyze(src, nil)
+	if err != nil {
+		t.Fatalf("analyze() error: %v", err)
+	}
+	if report.DCF == nil {
+		t.Fatalf("Expected DCF result, errors: %v", report.Errors)
+	}
+	in := report.DCF.Inputs
+	if in.GrowthSource != GrowthFromHistory || math.Abs(in.GrowthRate-0.10) > 0.001 {
+		t.Errorf("Expected ~10%% historical growth, got %f from %s", in.GrowthRate, in.GrowthSource)
+	}
+	if in.BaseFCF != 121 || in.NetDebt != 200 {
+		t.Errorf("unexpected inputs: %+v", in)
+	}
+	if report.Graham != nil || report.Errors["graham"] == nil {
+		t.Error("Expected Graham number to fail for negative EPS")
+	}
+
+	g := 0.05
+	src.growth = []models.GrowthEstimate{{Period: "+1y", StockGrowth: &g}}
+	report, _ = analyze(src, nil)
+	if report.DCF.Inputs.GrowthSource != GrowthFromAnalysts1y || report.DCF.Inputs.GrowthRate != 0.05 {
+		t.Errorf("Expected analyst +1y growth, got %+v", report.DCF.Inputs)
+	}
+}
@@ -0,0 +1,39 @@
+// Package yferr defines the error kinds shared by go-yfinance packages.
+//
+// # Overview
+//
+// Errors returned by the ticker, screener, calendars, sector, industry and
+// market packages match one of the sentinel errors below with errors.Is
+// when their cause is known, so callers can react without parsing
+// messages:
+//
+//   - [ErrNoData]: Yahoo has no data for the request (unknown symbol,
+//     missing module, empty result)
+//   - [ErrRateLimited]: Yahoo rejected the request with HTTP 429
+//   - [ErrAuth]: cookie or crumb authentication failed
+//   - [ErrParse]: the response could not be decoded or lacks required
+//     fields
+//
+// Errors from the client package, such as client.ErrRateLimit, match the
+// corresponding sentinel too.
+//
+// # Basic Usage
+//
+//	info, err := t.Info()
+//	switch {
+//	case errors.Is(err, yferr.ErrNoData):
+//	    log.Printf("%s has no profile", t.Symbol())
+//	case errors.Is(err, yferr.ErrRateLimited):
+//	    time.Sleep(time.Minute)
+//	case err != nil:
+//	    log.Fatal(err)
+//	}
+//
+// # Wrapping
+//
+// [Wrap], [NoData] and [Parse] build an [*Error] of a kind. It matches both
+// its kind and its cause:
+//
+//	return yferr.Parse("screener response", err)
+//	return yferr.NoData("no dividends found for %s", symbol)
+package yferr
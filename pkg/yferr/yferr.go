@@ -0,0 +1,113 @@
+package yferr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors for the kinds of failure callers commonly handle.
+var (
+	// ErrNoData means Yahoo returned no data for the request.
+	ErrNoData = errors.New("no data")
+
+	// ErrRateLimited means Yahoo rate limited the request.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrAuth means cookie or crumb authentication failed.
+	ErrAuth = errors.New("authentication failed")
+
+	// ErrParse means the response could not be decoded.
+	ErrParse = errors.New("invalid response")
+)
+
+// Error is an error of a known kind with an optional cause.
+//
+// errors.Is matches both Kind and Err, and errors.As can reach types in
+// the cause chain.
+type Error struct {
+	// Kind is one of the sentinel errors of this package.
+	Kind error
+
+	// Msg describes the failure. Empty means Kind's message.
+	Msg string
+
+	// Err is the underlying error, or nil.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	msg := e.Msg
+	if msg == "" {
+		msg = e.Kind.Error()
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", msg, e.Err)
+	}
+	return msg
+}
+
+// Unwrap returns the kind and the cause.
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// Wrap returns an error of kind with message msg and cause err, which may
+// be nil.
+func Wrap(kind error, msg string, err error) error {
+	return &Error{Kind: kind, Msg: msg, Err: err}
+}
+
+// NoData returns an [ErrNoData] error with a formatted message.
+//
+// Example:
+//
+//	return nil, yferr.NoData("no options data available for %s", symbol)
+func NoData(format string, args ...any) error {
+	return &Error{Kind: ErrNoData, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Parse returns an [ErrParse] error reading "failed to parse <what>: <err>".
+// A nil err gives "invalid <what>", for responses that decode but lack
+// required fields.
+//
+// Example:
+//
+//	if err := json.Unmarshal(body, &raw); err != nil {
+//	    return yferr.Parse("sector data", err)
+//	}
+func Parse(what string, err error) error {
+	if err == nil {
+		return &Error{Kind: ErrParse, Msg: "invalid " + what}
+	}
+	return &Error{Kind: ErrParse, Msg: "failed to parse " + what, Err: err}
+}
+
+// API returns the error for an error object in a Yahoo response, reading
+// "<what> API error: <code> - <description>". Yahoo's "Not Found" code,
+// sent for unknown symbols and delisted tickers, matches [ErrNoData];
+// other codes are not classified.
+//
+// Example:
+//
+//	if raw.Finance.Error != nil {
+//	    return yferr.API("screener", raw.Finance.Error.Code, raw.Finance.Error.Description)
+//	}
+func API(what, code, description string) error {
+	msg := "API error: "
+	if what != "" {
+		msg = what + " " + msg
+	}
+	if code != "" {
+		msg += code + " - "
+	}
+	msg += description
+
+	if code == "Not Found" {
+		return &Error{Kind: ErrNoData, Msg: msg}
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,58 @@
+package yferr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestWrapMatchesKindAndCause(t *testing.T) {
+	cause := errors.New("boom")
+	err := Wrap(ErrAuth, "failed to add crumb", cause)
+
+	if !errors.Is(err, ErrAuth) || !errors.Is(err, cause) {
+		t.Errorf("expected %v to match ErrAuth and its cause", err)
+	}
+	if errors.Is(err, ErrNoData) {
+		t.Error("did not expect ErrNoData")
+	}
+	if got := err.Error(); got != "failed to add crumb: boom" {
+		t.Errorf("Error() = %q", got)
+	}
+	if got := Wrap(ErrRateLimited, "", nil).Error(); got != "rate limited" {
+		t.Errorf("Error() without message = %q", got)
+	}
+}
+
+func TestNoData(t *testing.T) {
+	err := NoData("no dividends found for %s", "AAPL")
+	if !errors.Is(err, ErrNoData) || err.Error() != "no dividends found for AAPL" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	var v struct{}
+	cause := json.Unmarshal([]byte("{"), &v)
+	err := Parse("sector data", cause)
+
+	var syntaxErr *json.SyntaxError
+	if !errors.Is(err, ErrParse) || !errors.As(err, &syntaxErr) {
+		t.Errorf("expected %v to match ErrParse and *json.SyntaxError", err)
+	}
+	if got := Parse("response: missing result", nil).Error(); got != "invalid response: missing result" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestAPI(t *testing.T) {
+	err := API("sector", "Not Found", "No data found")
+	if !errors.Is(err, ErrNoData) || err.Error() != "sector API error: Not Found - No data found" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	err = API("", "Bad Request", "invalid range")
+	if errors.Is(err, ErrNoData) || err.Error() != "API error: Bad Request - invalid range" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
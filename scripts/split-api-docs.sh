@@ -49,6 +49,7 @@ The API reference is generated from the Go packages under `pkg/` and split by pa
 |---------|-------------|
 | [ticker](api/ticker.md) | Single-symbol quotes, history, financials, options, holders, analysis, news, and valuation data |
 | [multi](api/multi.md) | Batch historical downloads for multiple symbols |
+| [download](api/download.md) | Streaming bulk history export to CSV files |
 | [screener](api/screener.md) | Predefined and custom equity, fund, and ETF screeners |
 | [search](api/search.md) | Symbol, company, news, list, and research search |
 | [lookup](api/lookup.md) | Ticker lookup by asset type |
@@ -56,6 +57,7 @@ The API reference is generated from the Go packages under `pkg/` and split by pa
 | [sector](api/sector.md) | Sector overview, companies, industries, ETFs, and funds |
 | [industry](api/industry.md) | Industry overview, sector mapping, top companies, and reports |
 | [calendars](api/calendars.md) | Earnings, IPO, economic events, and split calendars |
+| [valuation](api/valuation.md) | DCF and Graham number intrinsic value estimates |
 | [live](api/live.md) | Real-time WebSocket pricing stream |
 
 ## Support Packages